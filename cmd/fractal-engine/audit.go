@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	"dogecoin.org/fractal-engine/pkg/config"
+	"dogecoin.org/fractal-engine/pkg/service"
+	"dogecoin.org/fractal-engine/pkg/store"
+)
+
+// Exit codes of the audit subcommand
+const (
+	auditExitHealthy    = 0
+	auditExitViolations = 1
+	auditExitError      = 2
+)
+
+// runAudit checks the state in the database once and prints the report as JSON on
+// stdout. It exits non-zero if any invariant is violated or the audit cannot run.
+func runAudit(args []string) int {
+	flags := flag.NewFlagSet("audit", flag.ExitOnError)
+	databaseURL := databaseFlags(flags)
+	flags.Parse(args)
+
+	ctx := context.Background()
+	cfg := config.Config{DatabaseURL: databaseURL()}
+
+	tokenStore, err := store.NewTokenisationStore(cfg.DatabaseURL, cfg)
+	if err != nil {
+		log.Println("Failed to create tokenisation store:", err)
+		return auditExitError
+	}
+
+	defer tokenStore.DB.Close()
+
+	report, err := service.NewAuditor(tokenStore, 0).Audit(ctx)
+	if err != nil {
+		log.Println("Failed to audit state:", err)
+		return auditExitError
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		log.Println("Failed to write audit report:", err)
+		return auditExitError
+	}
+
+	if !report.Healthy() {
+		return auditExitViolations
+	}
+
+	return auditExitHealthy
+}
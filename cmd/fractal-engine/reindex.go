@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"dogecoin.org/fractal-engine/pkg/config"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/service"
+	"dogecoin.org/fractal-engine/pkg/store"
+)
+
+// runReindex rolls the state in the database back to --from-height and replays the
+// blocks above it from the Dogecoin node. The engine must be stopped while it runs.
+func runReindex(args []string) int {
+	var fromHeight int64
+	var dogeNetChain string
+	var confirmations string
+
+	flags := flag.NewFlagSet("reindex", flag.ExitOnError)
+	flags.Int64Var(&fromHeight, "from-height", -1, "Height to roll the state back to before replaying the blocks above it")
+	flags.StringVar(&dogeNetChain, "doge-net-chain", getEnv("DOGE_NET_CHAIN", "mainnet"), "DogeNet Chain")
+	flags.StringVar(&confirmations, "confirmations", getEnv("CONFIRMATIONS", ""), "Confirmations required per action, e.g. mint=1,invoice=1,payment=6")
+	dogeRpc := dogeFlags(flags)
+	databaseURL := databaseFlags(flags)
+	flags.Parse(args)
+
+	if fromHeight < 0 {
+		log.Println("A height to reindex from is required, e.g. reindex --from-height 5000000")
+		return 2
+	}
+
+	confirmationPolicy, err := config.ParseConfirmationPolicy(confirmations)
+	if err != nil {
+		log.Println("Invalid confirmations:", err)
+		return 2
+	}
+
+	ctx := context.Background()
+	cfg := &config.Config{
+		DogeNetChain:  dogeNetChain,
+		DatabaseURL:   databaseURL(),
+		Confirmations: confirmationPolicy,
+	}
+
+	dogeRpc(cfg)
+
+	tokenStore, err := store.NewTokenisationStore(cfg.DatabaseURL, *cfg)
+	if err != nil {
+		log.Println("Failed to create tokenisation store:", err)
+		return 1
+	}
+
+	defer tokenStore.DB.Close()
+
+	reindexer := service.NewReindexer(cfg, tokenStore, doge.NewRpcClient(cfg))
+
+	log.Println("Reindexing from height:", fromHeight)
+
+	height, err := reindexer.Reindex(ctx, fromHeight)
+	if err != nil {
+		log.Println("Failed to reindex:", err)
+		return 1
+	}
+
+	log.Println("Reindexed to height:", height)
+
+	return 0
+}
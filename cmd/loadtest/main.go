@@ -172,7 +172,8 @@ func cleanDatabase(ctx context.Context, db *store.TokenisationStore) error {
 	tables := []string{
 		"invoice_signatures",
 		"pending_token_balances",
-		"token_balances",
+		"ledger_entries",
+		"ledger_balances",
 		"unconfirmed_invoices",
 		"invoices",
 		"buy_offers",
@@ -406,7 +407,7 @@ func createInvoiceWithTx(ctx context.Context, db *store.TokenisationStore, mintH
 		SellerAddress:   addresses[rand.Intn(len(addresses))],
 		MintHash:        mintHash,
 		Quantity:        int(rand.Int63n(100) + 1),
-		Price:           int(rand.Int63n(100000) + 1),
+		Price:           rand.Int63n(100000) + 1,
 		CreatedAt:       time.Now(),
 		BlockHeight:     rand.Int63n(1000000),
 		TransactionHash: generateHash(),
@@ -424,7 +425,7 @@ func countRecords(ctx context.Context, db *store.TokenisationStore) map[string]i
 		"sell_offers",
 		"buy_offers",
 		"invoices",
-		"token_balances",
+		"ledger_entries",
 		"unconfirmed_mints",
 		"unconfirmed_invoices",
 		"onchain_transactions",
@@ -572,7 +573,7 @@ func runQueryTests(ctx context.Context, db *store.TokenisationStore, cfg LoadTes
 	// Test 15: Balance aggregation query
 	results = append(results, testQuery("AggregateBalancesByMint", func() (int, error) {
 		rows, err := db.DB.QueryContext(ctx,
-			"SELECT mint_hash, SUM(quantity) as total FROM token_balances GROUP BY mint_hash ORDER BY mint_hash LIMIT 100")
+			"SELECT mint_hash, SUM(quantity) as total FROM ledger_balances WHERE account NOT IN ($1, $2) GROUP BY mint_hash ORDER BY mint_hash LIMIT 100", store.LedgerAccountIssued, store.LedgerAccountBurned)
 		if err != nil {
 			return 0, err
 		}
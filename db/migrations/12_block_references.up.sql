@@ -0,0 +1,26 @@
+ALTER TABLE token_balances ADD COLUMN block_height BIGINT;
+ALTER TABLE token_balances ADD COLUMN block_hash TEXT;
+ALTER TABLE token_balances ADD COLUMN transaction_hash TEXT;
+
+ALTER TABLE pending_token_balances ADD COLUMN block_height BIGINT;
+ALTER TABLE pending_token_balances ADD COLUMN block_hash TEXT;
+
+ALTER TABLE mints ADD COLUMN block_hash TEXT;
+
+ALTER TABLE invoices ADD COLUMN block_hash TEXT;
+ALTER TABLE invoices ADD COLUMN paid_block_height BIGINT;
+ALTER TABLE invoices ADD COLUMN paid_block_hash TEXT;
+ALTER TABLE invoices ADD COLUMN paid_transaction_hash TEXT;
+
+CREATE INDEX IF NOT EXISTS onchain_transactions_block_height_idx
+    ON onchain_transactions (block_height);
+CREATE INDEX IF NOT EXISTS token_balances_block_height_idx
+    ON token_balances (block_height);
+CREATE INDEX IF NOT EXISTS pending_token_balances_block_height_idx
+    ON pending_token_balances (block_height);
+CREATE INDEX IF NOT EXISTS mints_block_height_idx
+    ON mints (block_height);
+CREATE INDEX IF NOT EXISTS invoices_block_height_idx
+    ON invoices (block_height);
+CREATE INDEX IF NOT EXISTS invoices_paid_block_height_idx
+    ON invoices (paid_block_height);
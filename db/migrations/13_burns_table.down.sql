@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS unique_burn_hash_public_key_idx;
+DROP TABLE IF EXISTS burn_signatures;
+
+DROP INDEX IF EXISTS burns_block_height_idx;
+DROP INDEX IF EXISTS burns_mint_hash_idx;
+DROP TABLE IF EXISTS burns;
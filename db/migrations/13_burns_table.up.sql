@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS burns (
+    id UUID PRIMARY KEY,
+    hash TEXT NOT NULL UNIQUE,
+    mint_hash TEXT NOT NULL,
+    owner_address TEXT NOT NULL,
+    quantity INT NOT NULL,
+    transaction_hash TEXT NOT NULL,
+    block_height BIGINT NOT NULL,
+    block_hash TEXT,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS burns_mint_hash_idx
+    ON burns (mint_hash);
+CREATE INDEX IF NOT EXISTS burns_block_height_idx
+    ON burns (block_height);
+
+CREATE TABLE IF NOT EXISTS burn_signatures (
+    id UUID PRIMARY KEY,
+    burn_hash TEXT NOT NULL,
+    mint_hash TEXT NOT NULL,
+    owner_address TEXT NOT NULL,
+    quantity INT NOT NULL,
+    signature TEXT NOT NULL,
+    public_key TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS unique_burn_hash_public_key_idx
+    ON burn_signatures (burn_hash, public_key);
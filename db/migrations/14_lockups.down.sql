@@ -0,0 +1,3 @@
+ALTER TABLE mints DROP COLUMN block_time;
+ALTER TABLE token_balances DROP COLUMN block_time;
+ALTER TABLE onchain_transactions DROP COLUMN block_time;
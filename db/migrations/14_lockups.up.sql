@@ -0,0 +1,3 @@
+ALTER TABLE onchain_transactions ADD COLUMN block_time TIMESTAMP;
+ALTER TABLE token_balances ADD COLUMN block_time TIMESTAMP;
+ALTER TABLE mints ADD COLUMN block_time TIMESTAMP;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS unique_attestation_mint_address_public_key_idx;
+DROP TABLE IF EXISTS attestations;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS attestations (
+    id UUID PRIMARY KEY,
+    mint_hash TEXT NOT NULL,
+    address TEXT NOT NULL,
+    signature TEXT NOT NULL,
+    public_key TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS unique_attestation_mint_address_public_key_idx
+    ON attestations (mint_hash, address, public_key);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS sell_offers_mint_price_idx;
+DROP INDEX IF EXISTS buy_offers_mint_price_idx;
+
+DROP INDEX IF EXISTS unique_offer_fills_buy_sell_idx;
+DROP TABLE IF EXISTS offer_fills;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS offer_fills (
+    id UUID PRIMARY KEY,
+    mint_hash TEXT NOT NULL,
+    buy_offer_hash TEXT NOT NULL,
+    sell_offer_hash TEXT NOT NULL,
+    invoice_hash TEXT NOT NULL UNIQUE,
+    quantity INT NOT NULL,
+    price INT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS unique_offer_fills_buy_sell_idx
+    ON offer_fills (buy_offer_hash, sell_offer_hash);
+
+CREATE INDEX IF NOT EXISTS buy_offers_mint_price_idx
+    ON buy_offers (mint_hash, price);
+CREATE INDEX IF NOT EXISTS sell_offers_mint_price_idx
+    ON sell_offers (mint_hash, price);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS webhook_deliveries_status_next_attempt_idx;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhooks;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS onchain_transactions_tx_hash_idx;
+
+ALTER TABLE onchain_transactions DROP COLUMN batch_atomic;
+ALTER TABLE onchain_transactions DROP COLUMN sub_index;
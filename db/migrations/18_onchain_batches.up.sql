@@ -0,0 +1,5 @@
+ALTER TABLE onchain_transactions ADD COLUMN sub_index INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE onchain_transactions ADD COLUMN batch_atomic BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS onchain_transactions_tx_hash_idx
+    ON onchain_transactions (tx_hash);
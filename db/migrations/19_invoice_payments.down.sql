@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS invoice_payments_block_height_idx;
+DROP INDEX IF EXISTS invoice_payments_invoice_hash_idx;
+DROP TABLE IF EXISTS invoice_payments;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS invoice_payments (
+    id TEXT PRIMARY KEY,
+    invoice_hash TEXT NOT NULL,
+    transaction_hash TEXT NOT NULL,
+    payer_address TEXT NOT NULL,
+    amount_koinu BIGINT NOT NULL,
+    applied_koinu BIGINT NOT NULL,
+    overpaid_koinu BIGINT NOT NULL,
+    block_height BIGINT NOT NULL,
+    block_hash TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS invoice_payments_invoice_hash_idx
+    ON invoice_payments (invoice_hash);
+CREATE INDEX IF NOT EXISTS invoice_payments_block_height_idx
+    ON invoice_payments (block_height);
@@ -0,0 +1,2 @@
+ALTER TABLE invoices DROP COLUMN splits;
+ALTER TABLE unconfirmed_invoices DROP COLUMN splits;
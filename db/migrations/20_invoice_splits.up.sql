@@ -0,0 +1,2 @@
+ALTER TABLE unconfirmed_invoices ADD COLUMN splits TEXT;
+ALTER TABLE invoices ADD COLUMN splits TEXT;
@@ -0,0 +1,5 @@
+ALTER TABLE invoices DROP COLUMN closed_transaction_hash;
+ALTER TABLE invoices DROP COLUMN closed_block_height;
+ALTER TABLE invoices DROP COLUMN status;
+ALTER TABLE invoices DROP COLUMN expires_at_height;
+ALTER TABLE unconfirmed_invoices DROP COLUMN expires_at_height;
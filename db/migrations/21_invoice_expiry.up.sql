@@ -0,0 +1,7 @@
+ALTER TABLE unconfirmed_invoices ADD COLUMN expires_at_height BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE invoices ADD COLUMN expires_at_height BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE invoices ADD COLUMN status TEXT NOT NULL DEFAULT 'on_chain';
+ALTER TABLE invoices ADD COLUMN closed_block_height BIGINT;
+ALTER TABLE invoices ADD COLUMN closed_transaction_hash TEXT;
+
+UPDATE invoices SET status = 'paid' WHERE paid_at IS NOT NULL;
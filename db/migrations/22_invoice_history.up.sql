@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS invoice_history (
+    id UUID PRIMARY KEY,
+    invoice_hash TEXT NOT NULL,
+    from_status TEXT NOT NULL,
+    to_status TEXT NOT NULL,
+    block_height BIGINT,
+    transaction_hash TEXT,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS invoice_history_invoice_hash_idx
+    ON invoice_history (invoice_hash, created_at);
+
+CREATE INDEX IF NOT EXISTS invoice_history_block_height_idx
+    ON invoice_history (block_height);
+
+UPDATE unconfirmed_invoices SET status = 'draft' WHERE status = '';
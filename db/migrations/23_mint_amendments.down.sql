@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS mint_versions_superseded_block_height_idx;
+DROP INDEX IF EXISTS unique_mint_versions_mint_hash_version_idx;
+DROP TABLE IF EXISTS mint_versions;
+
+DROP INDEX IF EXISTS unique_mint_amendment_hash_public_key_idx;
+DROP TABLE IF EXISTS mint_amendment_signatures;
+
+DROP INDEX IF EXISTS mint_amendments_block_height_idx;
+DROP INDEX IF EXISTS mint_amendments_mint_hash_idx;
+DROP TABLE IF EXISTS mint_amendments;
@@ -0,0 +1,53 @@
+CREATE TABLE IF NOT EXISTS mint_amendments (
+    id UUID PRIMARY KEY,
+    hash TEXT NOT NULL UNIQUE,
+    mint_hash TEXT NOT NULL,
+    description TEXT NOT NULL,
+    metadata TEXT,
+    feed_url TEXT,
+    contract_of_sale TEXT,
+    public_key TEXT NOT NULL,
+    signature TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    version INT,
+    transaction_hash TEXT,
+    block_height BIGINT,
+    block_hash TEXT
+);
+
+CREATE INDEX IF NOT EXISTS mint_amendments_mint_hash_idx
+    ON mint_amendments (mint_hash);
+CREATE INDEX IF NOT EXISTS mint_amendments_block_height_idx
+    ON mint_amendments (block_height);
+
+CREATE TABLE IF NOT EXISTS mint_amendment_signatures (
+    id UUID PRIMARY KEY,
+    amendment_hash TEXT NOT NULL,
+    mint_hash TEXT NOT NULL,
+    signature TEXT NOT NULL,
+    public_key TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS unique_mint_amendment_hash_public_key_idx
+    ON mint_amendment_signatures (amendment_hash, public_key);
+
+CREATE TABLE IF NOT EXISTS mint_versions (
+    id UUID PRIMARY KEY,
+    mint_hash TEXT NOT NULL,
+    version INT NOT NULL,
+    description TEXT,
+    metadata TEXT,
+    feed_url TEXT,
+    contract_of_sale TEXT,
+    amendment_hash TEXT,
+    transaction_hash TEXT,
+    block_height BIGINT,
+    superseded_block_height BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS unique_mint_versions_mint_hash_version_idx
+    ON mint_versions (mint_hash, version);
+CREATE INDEX IF NOT EXISTS mint_versions_superseded_block_height_idx
+    ON mint_versions (superseded_block_height);
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS mint_ownership_transfers (
+    id UUID PRIMARY KEY,
+    hash TEXT NOT NULL UNIQUE,
+    mint_hash TEXT NOT NULL,
+    new_owner_address TEXT NOT NULL,
+    new_public_key TEXT NOT NULL,
+    signature_requirement_type TEXT,
+    asset_managers TEXT DEFAULT '[]',
+    min_signatures INTEGER DEFAULT 0,
+    public_key TEXT NOT NULL,
+    signature TEXT NOT NULL,
+    new_owner_signature TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    previous_owner_address TEXT,
+    previous_public_key TEXT,
+    previous_signature_requirement_type TEXT,
+    previous_asset_managers TEXT,
+    previous_min_signatures INTEGER,
+    transaction_hash TEXT,
+    block_height BIGINT,
+    block_hash TEXT
+);
+
+CREATE INDEX IF NOT EXISTS mint_ownership_transfers_mint_hash_idx
+    ON mint_ownership_transfers (mint_hash);
+CREATE INDEX IF NOT EXISTS mint_ownership_transfers_block_height_idx
+    ON mint_ownership_transfers (block_height);
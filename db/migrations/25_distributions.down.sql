@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS distribution_payments_block_height_idx;
+DROP INDEX IF EXISTS distribution_payments_distribution_hash_idx;
+DROP TABLE IF EXISTS distribution_payments;
+DROP INDEX IF EXISTS distributions_mint_hash_idx;
+DROP TABLE IF EXISTS distributions;
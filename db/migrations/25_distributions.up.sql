@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS distributions (
+    id UUID PRIMARY KEY,
+    hash TEXT NOT NULL UNIQUE,
+    mint_hash TEXT NOT NULL,
+    total_koinu BIGINT NOT NULL,
+    record_height BIGINT NOT NULL,
+    public_key TEXT NOT NULL,
+    signature TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS distributions_mint_hash_idx
+    ON distributions (mint_hash);
+
+CREATE TABLE IF NOT EXISTS distribution_payments (
+    id TEXT PRIMARY KEY,
+    distribution_hash TEXT NOT NULL,
+    transaction_hash TEXT NOT NULL,
+    address TEXT NOT NULL,
+    amount_koinu BIGINT NOT NULL,
+    applied_koinu BIGINT NOT NULL,
+    overpaid_koinu BIGINT NOT NULL,
+    block_height BIGINT NOT NULL,
+    block_hash TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS distribution_payments_distribution_hash_idx
+    ON distribution_payments (distribution_hash);
+CREATE INDEX IF NOT EXISTS distribution_payments_block_height_idx
+    ON distribution_payments (block_height);
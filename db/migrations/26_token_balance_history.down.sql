@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS token_balances_transaction_hash_idx;
+DROP INDEX IF EXISTS token_balances_address_mint_hash_block_height_idx;
+DROP INDEX IF EXISTS token_balances_mint_hash_block_height_idx;
@@ -0,0 +1,6 @@
+CREATE INDEX IF NOT EXISTS token_balances_mint_hash_block_height_idx
+    ON token_balances (mint_hash, block_height);
+CREATE INDEX IF NOT EXISTS token_balances_address_mint_hash_block_height_idx
+    ON token_balances (address, mint_hash, block_height);
+CREATE INDEX IF NOT EXISTS token_balances_transaction_hash_idx
+    ON token_balances (transaction_hash);
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS token_balances (
+    mint_hash TEXT NOT NULL,
+    address TEXT NOT NULL,
+    quantity INT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    block_height BIGINT,
+    block_hash TEXT,
+    transaction_hash TEXT,
+    block_time TIMESTAMP
+);
+
+INSERT INTO token_balances (mint_hash, address, quantity, created_at, updated_at, block_height, block_hash, transaction_hash, block_time)
+SELECT mint_hash, account, credit - debit, created_at, created_at, block_height, block_hash, transaction_hash, block_time
+FROM ledger_entries
+WHERE account NOT IN ('@issued', '@burned');
+
+CREATE INDEX IF NOT EXISTS token_balances_mint_hash_quantity_idx
+    ON token_balances (mint_hash, quantity);
+CREATE INDEX IF NOT EXISTS token_balances_block_height_idx
+    ON token_balances (block_height);
+CREATE INDEX IF NOT EXISTS token_balances_mint_hash_block_height_idx
+    ON token_balances (mint_hash, block_height);
+CREATE INDEX IF NOT EXISTS token_balances_address_mint_hash_block_height_idx
+    ON token_balances (address, mint_hash, block_height);
+CREATE INDEX IF NOT EXISTS token_balances_transaction_hash_idx
+    ON token_balances (transaction_hash);
+
+DROP TABLE IF EXISTS ledger_balances;
+DROP TABLE IF EXISTS ledger_entries;
@@ -0,0 +1,77 @@
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    id TEXT PRIMARY KEY,
+    posting_id TEXT NOT NULL,
+    mint_hash TEXT NOT NULL,
+    account TEXT NOT NULL,
+    debit INT NOT NULL,
+    credit INT NOT NULL,
+    action_type INT,
+    transaction_hash TEXT,
+    block_height BIGINT,
+    block_hash TEXT,
+    block_time TIMESTAMP,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS ledger_entries_mint_hash_account_block_height_idx
+    ON ledger_entries (mint_hash, account, block_height);
+CREATE INDEX IF NOT EXISTS ledger_entries_account_mint_hash_idx
+    ON ledger_entries (account, mint_hash);
+CREATE INDEX IF NOT EXISTS ledger_entries_block_height_idx
+    ON ledger_entries (block_height);
+CREATE INDEX IF NOT EXISTS ledger_entries_transaction_hash_idx
+    ON ledger_entries (transaction_hash);
+CREATE INDEX IF NOT EXISTS ledger_entries_posting_id_idx
+    ON ledger_entries (posting_id);
+
+CREATE TABLE IF NOT EXISTS ledger_balances (
+    mint_hash TEXT NOT NULL,
+    account TEXT NOT NULL,
+    quantity BIGINT NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (mint_hash, account)
+);
+
+CREATE INDEX IF NOT EXISTS ledger_balances_account_idx
+    ON ledger_balances (account);
+
+-- Existing balance rows become the address side of their postings
+INSERT INTO ledger_entries (id, posting_id, mint_hash, account, debit, credit, action_type, transaction_hash, block_height, block_hash, block_time, created_at)
+SELECT
+    'token_balance-' || ROW_NUMBER() OVER (),
+    COALESCE(t.transaction_hash, ''),
+    t.mint_hash,
+    t.address,
+    CASE WHEN t.quantity < 0 THEN -t.quantity ELSE 0 END,
+    CASE WHEN t.quantity > 0 THEN t.quantity ELSE 0 END,
+    CASE
+        WHEN t.transaction_hash IS NULL OR t.transaction_hash = '' THEN NULL
+        WHEN EXISTS (SELECT 1 FROM mints m WHERE m.transaction_hash = t.transaction_hash) THEN 1
+        WHEN EXISTS (SELECT 1 FROM invoices i WHERE i.paid_transaction_hash = t.transaction_hash) THEN 5
+        WHEN EXISTS (SELECT 1 FROM burns b WHERE b.transaction_hash = t.transaction_hash) THEN 10
+        ELSE 9
+    END,
+    t.transaction_hash,
+    t.block_height,
+    t.block_hash,
+    t.block_time,
+    t.created_at
+FROM token_balances t;
+
+-- Confirmed mints were issued from the issuance account
+INSERT INTO ledger_entries (id, posting_id, mint_hash, account, debit, credit, action_type, transaction_hash, block_height, block_hash, block_time, created_at)
+SELECT 'issuance-' || m.hash, m.transaction_hash, m.hash, '@issued', m.fraction_count, 0, 1, m.transaction_hash, m.block_height, m.block_hash, m.block_time, m.created_at
+FROM mints m
+WHERE EXISTS (SELECT 1 FROM token_balances t WHERE t.transaction_hash = m.transaction_hash AND t.mint_hash = m.hash);
+
+-- Confirmed burns were credited to the burned account
+INSERT INTO ledger_entries (id, posting_id, mint_hash, account, debit, credit, action_type, transaction_hash, block_height, block_hash, block_time, created_at)
+SELECT 'burn-' || b.hash, b.transaction_hash, b.mint_hash, '@burned', 0, b.quantity, 10, b.transaction_hash, b.block_height, b.block_hash, NULL, b.created_at
+FROM burns b;
+
+INSERT INTO ledger_balances (mint_hash, account, quantity, updated_at)
+SELECT mint_hash, account, SUM(credit - debit), CURRENT_TIMESTAMP
+FROM ledger_entries
+GROUP BY mint_hash, account;
+
+DROP TABLE token_balances;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS audit_reports (
+    id SERIAL PRIMARY KEY,
+    checked_at TIMESTAMP NOT NULL,
+    block_height BIGINT NOT NULL,
+    mints_checked INT NOT NULL,
+    violation_count INT NOT NULL,
+    violations TEXT NOT NULL
+);
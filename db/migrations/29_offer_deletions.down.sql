@@ -0,0 +1,8 @@
+DELETE FROM buy_offers WHERE deleted_block_height IS NOT NULL;
+DELETE FROM sell_offers WHERE deleted_block_height IS NOT NULL;
+
+ALTER TABLE buy_offers DROP COLUMN deleted_block_height;
+ALTER TABLE buy_offers DROP COLUMN delete_pending;
+
+ALTER TABLE sell_offers DROP COLUMN deleted_block_height;
+ALTER TABLE sell_offers DROP COLUMN delete_pending;
@@ -0,0 +1,5 @@
+ALTER TABLE buy_offers ADD COLUMN delete_pending BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE buy_offers ADD COLUMN deleted_block_height BIGINT;
+
+ALTER TABLE sell_offers ADD COLUMN delete_pending BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE sell_offers ADD COLUMN deleted_block_height BIGINT;
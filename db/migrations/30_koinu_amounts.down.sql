@@ -0,0 +1,13 @@
+ALTER TABLE unconfirmed_invoices ADD COLUMN price_doge INT NOT NULL DEFAULT 0;
+UPDATE unconfirmed_invoices SET price_doge = price / 100000000;
+ALTER TABLE unconfirmed_invoices DROP COLUMN price;
+ALTER TABLE unconfirmed_invoices DROP COLUMN doge_price;
+ALTER TABLE unconfirmed_invoices RENAME COLUMN price_doge TO price;
+
+ALTER TABLE invoices ADD COLUMN price_doge INT NOT NULL DEFAULT 0;
+UPDATE invoices SET price_doge = price / 100000000;
+ALTER TABLE invoices DROP COLUMN price;
+ALTER TABLE invoices DROP COLUMN doge_price;
+ALTER TABLE invoices RENAME COLUMN price_doge TO price;
+
+ALTER TABLE onchain_transactions DROP COLUMN values_version;
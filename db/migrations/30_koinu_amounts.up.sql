@@ -0,0 +1,16 @@
+-- Values of transactions saved before this migration are DOGE amounts, later ones are koinu
+ALTER TABLE onchain_transactions ADD COLUMN values_version INT NOT NULL DEFAULT 1;
+
+-- Invoice prices become koinu per fraction, which needs a wider column. Existing invoices
+-- keep the DOGE price they were hashed and signed with.
+ALTER TABLE invoices ADD COLUMN doge_price INT NOT NULL DEFAULT 0;
+ALTER TABLE invoices ADD COLUMN price_koinu BIGINT NOT NULL DEFAULT 0;
+UPDATE invoices SET doge_price = price, price_koinu = CAST(price AS BIGINT) * 100000000;
+ALTER TABLE invoices DROP COLUMN price;
+ALTER TABLE invoices RENAME COLUMN price_koinu TO price;
+
+ALTER TABLE unconfirmed_invoices ADD COLUMN doge_price INT NOT NULL DEFAULT 0;
+ALTER TABLE unconfirmed_invoices ADD COLUMN price_koinu BIGINT NOT NULL DEFAULT 0;
+UPDATE unconfirmed_invoices SET doge_price = price, price_koinu = CAST(price AS BIGINT) * 100000000;
+ALTER TABLE unconfirmed_invoices DROP COLUMN price;
+ALTER TABLE unconfirmed_invoices RENAME COLUMN price_koinu TO price;
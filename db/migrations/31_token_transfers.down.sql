@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS token_transfers_block_height_idx;
+DROP INDEX IF EXISTS token_transfers_mint_hash_idx;
+DROP TABLE IF EXISTS token_transfers;
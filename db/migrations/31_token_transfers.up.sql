@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS token_transfers (
+    id UUID PRIMARY KEY,
+    hash TEXT NOT NULL UNIQUE,
+    mint_hash TEXT NOT NULL,
+    from_address TEXT NOT NULL,
+    to_address TEXT NOT NULL,
+    quantity INTEGER NOT NULL,
+    nonce BIGINT NOT NULL,
+    public_key TEXT NOT NULL,
+    signature TEXT NOT NULL,
+    redeem_script TEXT,
+    created_at TIMESTAMP NOT NULL,
+    transaction_hash TEXT,
+    block_height BIGINT,
+    block_hash TEXT
+);
+
+CREATE INDEX IF NOT EXISTS token_transfers_mint_hash_idx
+    ON token_transfers (mint_hash);
+CREATE INDEX IF NOT EXISTS token_transfers_block_height_idx
+    ON token_transfers (block_height);
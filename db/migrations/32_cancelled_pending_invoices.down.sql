@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS cancelled_pending_invoices_cancelled_block_height_idx;
+DROP TABLE IF EXISTS cancelled_pending_invoices;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS cancelled_pending_invoices (
+    invoice_hash TEXT NOT NULL,
+    mint_hash TEXT NOT NULL,
+    owner_address TEXT NOT NULL,
+    quantity INT NOT NULL,
+    pending_created_at TIMESTAMP NOT NULL,
+    pending_block_height BIGINT,
+    pending_block_hash TEXT,
+    onchain_transaction_id TEXT NOT NULL,
+    tx_hash TEXT NOT NULL,
+    block_height BIGINT NOT NULL,
+    block_hash TEXT NOT NULL,
+    transaction_number INTEGER NOT NULL,
+    action_type TEXT NOT NULL,
+    action_version INTEGER NOT NULL,
+    action_data BYTEA NOT NULL,
+    address TEXT NOT NULL,
+    "values" JSONB NOT NULL,
+    block_time TIMESTAMP,
+    sub_index INTEGER NOT NULL DEFAULT 0,
+    batch_atomic BOOLEAN NOT NULL DEFAULT FALSE,
+    values_version INT NOT NULL DEFAULT 1,
+    onchain_created_at TIMESTAMP NOT NULL,
+    cancelled_block_height BIGINT NOT NULL,
+    PRIMARY KEY (invoice_hash, mint_hash)
+);
+
+CREATE INDEX IF NOT EXISTS cancelled_pending_invoices_cancelled_block_height_idx
+    ON cancelled_pending_invoices (cancelled_block_height);
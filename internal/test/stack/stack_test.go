@@ -5,6 +5,8 @@ import (
 	"log"
 	"testing"
 	"time"
+
+	"dogecoin.org/fractal-engine/pkg/doge"
 )
 
 func TestSimpleFlow(t *testing.T) {
@@ -45,7 +47,7 @@ func TestSimpleFlow(t *testing.T) {
 	}, 30, 5*time.Second)
 
 	// Create invoice
-	invoiceHash := Invoice(seller, buyer.Address, mintHash, sellQty, 20)
+	invoiceHash := Invoice(seller, buyer.Address, mintHash, sellQty, 20*doge.KoinuPerDoge)
 	AssertEqualWithRetry(t, func() interface{} {
 		return GetPendingTokenBalance(seller, mintHash)
 	}, sellQty, 10, 3*time.Second)
@@ -364,13 +364,13 @@ func Payment(buyerConfig *StackConfig, sellerConfig *StackConfig, invoiceHash st
 	return txId
 }
 
-func Invoice(stackConfig *StackConfig, buyerAddress string, mintHash string, quantity int, price int) string {
+func Invoice(stackConfig *StackConfig, buyerAddress string, mintHash string, quantity int, price int64) string {
 	invoicePayload := rpc.CreateInvoiceRequestPayload{
 		PaymentAddress: stackConfig.Address,
 		BuyerAddress:   buyerAddress,
 		MintHash:       mintHash,
 		Quantity:       quantity,
-		Price:          price,
+		PriceKoinu:     price,
 		SellerAddress:  stackConfig.Address,
 	}
 
@@ -406,7 +406,7 @@ func InvoiceSignature(stackConfig *StackConfig, invoice store.UnconfirmedInvoice
 	invoiceBody := store.InvoiceSignatureBody{
 		Hash:           invoice.Hash,
 		MintHash:       invoice.MintHash,
-		Price:          invoice.Price,
+		PriceKoinu:     invoice.Price,
 		Quantity:       invoice.Quantity,
 		BuyerAddress:   invoice.BuyerAddress,
 		PaymentAddress: invoice.PaymentAddress,
@@ -16,6 +16,7 @@ import (
 	"dogecoin.org/fractal-engine/pkg/protocol"
 	"dogecoin.org/fractal-engine/pkg/rpc"
 	"github.com/charmbracelet/huh"
+	"github.com/shopspring/decimal"
 	"github.com/urfave/cli/v3"
 )
 
@@ -33,6 +34,10 @@ var InvoiceCommand = &cli.Command{
 					Usage: "Path to the config file",
 					Value: "config.toml",
 				},
+				&cli.Int64Flag{
+					Name:  "expires-at-height",
+					Usage: "Last block height the invoice can be paid in (0 for no expiry)",
+				},
 			},
 		},
 		{
@@ -131,7 +136,7 @@ func createInvoiceAction(ctx context.Context, cmd *cli.Command) error {
 			Title("What is the quantity?").
 			Value(&quantity),
 		huh.NewInput().
-			Title("What is the price per?").
+			Title("What is the price per fraction in DOGE?").
 			Value(&pricePer),
 	)
 
@@ -167,19 +172,25 @@ func createInvoiceAction(ctx context.Context, cmd *cli.Command) error {
 		log.Fatal(err)
 	}
 
-	pricePerInt, err := strconv.Atoi(pricePer)
+	pricePerDoge, err := decimal.NewFromString(pricePer)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	pricePerKoinu, err := doge.ToKoinu(pricePerDoge)
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	invoiceRequest := rpc.CreateInvoiceRequest{
 		Payload: rpc.CreateInvoiceRequestPayload{
-			PaymentAddress: address,
-			BuyerAddress:   buyerAddress,
-			MintHash:       mintHash,
-			Quantity:       quantityInt,
-			Price:          pricePerInt,
-			SellerAddress:  address,
+			PaymentAddress:  address,
+			BuyerAddress:    buyerAddress,
+			MintHash:        mintHash,
+			Quantity:        quantityInt,
+			PriceKoinu:      pricePerKoinu,
+			SellerAddress:   address,
+			ExpiresAtHeight: cmd.Int64("expires-at-height"),
 		},
 	}
 
@@ -215,7 +226,7 @@ func createInvoiceAction(ctx context.Context, cmd *cli.Command) error {
 		log.Fatal("No utxos found for address", address)
 	}
 
-	envelope := protocol.NewInvoiceTransactionEnvelope(response.Hash, mintHash, int32(quantityInt), protocol.ACTION_INVOICE)
+	envelope := protocol.NewExpiringInvoiceTransactionEnvelope(response.Hash, mintHash, int32(quantityInt), invoiceRequest.Payload.ExpiresAtHeight, protocol.ACTION_INVOICE)
 	encodedTransactionBody := envelope.Serialize()
 
 	inputs := []interface{}{
@@ -20,6 +20,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
 	"github.com/dogeorg/doge/koinu"
+	"github.com/shopspring/decimal"
 	"github.com/urfave/cli/v3"
 )
 
@@ -102,7 +103,7 @@ func payInvoiceAction(ctx context.Context, cmd *cli.Command) error {
 		items = append(items, climodels.SelectSimpleListItem{
 			OfferId: invoice.Id,
 			Name:    "Invoice: " + invoice.Hash + " (Seller: " + invoice.SellerAddress + ")",
-			Desc:    "Price: " + strconv.Itoa(invoice.Price) + " Qty: " + strconv.Itoa(invoice.Quantity),
+			Desc:    "Price: " + decimal.New(invoice.Price, -8).String() + " Qty: " + strconv.Itoa(invoice.Quantity),
 		})
 	}
 
@@ -147,7 +148,7 @@ func payInvoiceAction(ctx context.Context, cmd *cli.Command) error {
 	}
 
 	dogeUtxoValue := utxos.UTXOs[0].Value
-	buyOfferValue := koinu.Koinu(selectedInvoice.Quantity * selectedInvoice.Price)
+	buyOfferValue := koinu.Koinu(selectedInvoice.AmountDueKoinu())
 	fee, err := koinu.ParseKoinu("0.002")
 
 	if err != nil {
@@ -159,21 +160,23 @@ func payInvoiceAction(ctx context.Context, cmd *cli.Command) error {
 	}
 
 	change := dogeUtxoValue - buyOfferValue - fee
-	sellerAddress := selectedInvoice.SellerAddress
 
 	outputs := map[string]interface{}{
 		"data": hex.EncodeToString(encodedTransactionBody),
 	}
 
-	if address == sellerAddress {
-		outputs[address] = change
-	} else {
-		outputs[address] = change
-		outputs[sellerAddress] = buyOfferValue
+	// Every recipient of the invoice needs its own output
+	for recipient, amount := range selectedInvoice.SplitAmountsKoinu() {
+		if recipient == address {
+			change += koinu.Koinu(amount)
+			continue
+		}
+
+		outputs[recipient] = koinu.Koinu(amount)
 	}
 
+	outputs[address] = change
 	fmt.Println(outputs[address])
-	fmt.Println(outputs[sellerAddress])
 
 	dogeClient := doge.NewRpcClient(&fecfg.Config{
 		DogeScheme:   config.DogeScheme,
@@ -2,15 +2,24 @@ package commands
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
 	"strconv"
+	"time"
 
 	fecli "dogecoin.org/fractal-engine/pkg/cli"
 	climodels "dogecoin.org/fractal-engine/pkg/cli/climodels"
 	"dogecoin.org/fractal-engine/pkg/cli/keys"
+	fecfg "dogecoin.org/fractal-engine/pkg/config"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/indexer"
+	"dogecoin.org/fractal-engine/pkg/rpc"
+	"dogecoin.org/fractal-engine/pkg/store"
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
+	"github.com/dogeorg/doge/koinu"
 	"github.com/urfave/cli/v3"
 )
 
@@ -30,6 +39,18 @@ var TokensCommand = &cli.Command{
 				},
 			},
 		},
+		{
+			Name:   "transfer",
+			Usage:  "Transfer tokens to another address",
+			Action: transferTokensAction,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "config-path",
+					Usage: "Path to the config file",
+					Value: "config.toml",
+				},
+			},
+		},
 	},
 }
 
@@ -96,3 +117,157 @@ func listTokensAction(ctx context.Context, cmd *cli.Command) error {
 
 	return nil
 }
+
+func transferTokensAction(ctx context.Context, cmd *cli.Command) error {
+	configPath := cmd.String("config-path")
+
+	config, err := fecli.LoadConfig(configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	secureStore := keys.NewSecureStore()
+
+	privHex, err := secureStore.Get(config.ActiveKey + "_private_key")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	address, err := secureStore.Get(config.ActiveKey + "_address")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	chain, err := secureStore.Get(config.ActiveKey + "_chain")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	chainByte, err := doge.GetPrefix(chain)
+	if err != nil {
+		log.Fatal(err)
+	}
+	chainCfg := doge.GetChainCfg(chainByte)
+
+	tokenisationClient, err := getTokenisationClient(ctx, cmd)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var mintHash string
+	var toAddress string
+	var quantity string
+
+	group := huh.NewGroup(
+		huh.NewInput().
+			Title("What is the token hash?").
+			Value(&mintHash),
+		huh.NewInput().
+			Title("What is the recipient address?").
+			Value(&toAddress),
+		huh.NewInput().
+			Title("What is the quantity?").
+			Value(&quantity),
+	)
+
+	form := huh.NewForm(group)
+	err = form.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	quantityInt, err := strconv.Atoi(quantity)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	response, err := tokenisationClient.TransferTokens(&rpc.TransferTokensRequest{
+		Payload: store.TokenTransferBody{
+			FromAddress: address,
+			ToAddress:   toAddress,
+			MintHash:    mintHash,
+			Quantity:    quantityInt,
+			Nonce:       time.Now().UnixNano(),
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	indexerClient := indexer.NewIndexerClient(config.IndexerURL)
+
+	utxos, err := indexerClient.GetUTXO(address)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(utxos.UTXOs) == 0 {
+		log.Fatal("No utxos found for address", address)
+	}
+
+	fee, err := koinu.ParseKoinu("0.002")
+	if err != nil {
+		log.Fatal("Failed to parse fee value", err)
+	}
+
+	if utxos.UTXOs[0].Value < fee {
+		log.Fatal("Insufficient balance for transfer fee")
+	}
+
+	inputs := []interface{}{
+		map[string]interface{}{
+			"txid": utxos.UTXOs[0].TxID,
+			"vout": utxos.UTXOs[0].VOut,
+		},
+	}
+
+	// The change output identifies the sender, so it must be the only address output
+	outputs := map[string]interface{}{
+		"data":  response.EncodedTransactionBody,
+		address: utxos.UTXOs[0].Value - fee,
+	}
+
+	dogeClient := doge.NewRpcClient(&fecfg.Config{
+		DogeScheme:   config.DogeScheme,
+		DogeHost:     config.DogeHost,
+		DogePort:     config.DogePort,
+		DogeUser:     config.DogeUser,
+		DogePassword: config.DogePassword,
+	})
+
+	rawTx, err := dogeClient.Request(ctx, "createrawtransaction", []interface{}{inputs, outputs})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var rawTxResponse string
+	if err := json.Unmarshal(*rawTx, &rawTxResponse); err != nil {
+		log.Fatal(err)
+	}
+
+	encodedTx, err := doge.SignRawTransaction(rawTxResponse, privHex, []doge.PrevOutput{
+		{
+			Address: address,
+			Amount:  int64(utxos.UTXOs[0].Value),
+		},
+	}, chainCfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	res, err := dogeClient.Request(ctx, "sendrawtransaction", []interface{}{encodedTx})
+	if err != nil {
+		log.Println("error sending raw transaction", err)
+		return err
+	}
+
+	var txid string
+	if err := json.Unmarshal(*res, &txid); err != nil {
+		log.Println("error parsing send raw transaction response", err)
+		return err
+	}
+
+	fmt.Println("Transfer sent:", txid)
+
+	return nil
+}
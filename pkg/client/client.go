@@ -10,7 +10,10 @@ import (
 
 	"dogecoin.org/fractal-engine/pkg/doge"
 	"dogecoin.org/fractal-engine/pkg/rpc"
+	"dogecoin.org/fractal-engine/pkg/rpc/protocol"
+	"dogecoin.org/fractal-engine/pkg/rpc/protocol/protocolconnect"
 	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 type TokenisationClient struct {
@@ -532,3 +535,60 @@ func (c *TokenisationClient) CreateInvoiceSignature(signature *rpc.CreateInvoice
 
 	return result, nil
 }
+
+func (c *TokenisationClient) TransferTokens(transfer *rpc.TransferTokensRequest) (rpc.TransferTokensResponse, error) {
+	signature, err := doge.SignPayload(transfer.Payload, c.privHex, c.pubHex)
+	if err != nil {
+		return rpc.TransferTokensResponse{}, err
+	}
+
+	transfer.SignedRequest = rpc.SignedRequest{
+		PublicKey: c.pubHex,
+		Signature: signature,
+	}
+
+	fromAddress := &protocol.Address{}
+	fromAddress.SetValue(transfer.Payload.FromAddress)
+	toAddress := &protocol.Address{}
+	toAddress.SetValue(transfer.Payload.ToAddress)
+	mintHash := &protocol.Hash{}
+	mintHash.SetValue(transfer.Payload.MintHash)
+
+	payload := &protocol.TransferTokensRequestPayload{}
+	payload.SetFromAddress(fromAddress)
+	payload.SetToAddress(toAddress)
+	payload.SetMintHash(mintHash)
+	payload.SetQuantity(int32(transfer.Payload.Quantity))
+	payload.SetNonce(transfer.Payload.Nonce)
+
+	request := &protocol.TransferTokensRequest{}
+	request.SetPayload(payload)
+	request.SetPublicKey(transfer.PublicKey)
+	request.SetSignature(transfer.Signature)
+
+	jsonValue, err := protojson.Marshal(request)
+	if err != nil {
+		return rpc.TransferTokensResponse{}, err
+	}
+
+	resp, err := c.httpClient.Post(c.baseUrl+protocolconnect.FractalEngineRpcServiceTransferTokensProcedure, "application/json", bytes.NewBuffer(jsonValue))
+	if err != nil {
+		return rpc.TransferTokensResponse{}, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return rpc.TransferTokensResponse{}, fmt.Errorf("failed to transfer tokens: %s", string(body))
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	var result protocol.TransferTokensResponse
+	err = protojson.Unmarshal(body, &result)
+	if err != nil {
+		return rpc.TransferTokensResponse{}, err
+	}
+
+	return rpc.TransferTokensResponse{Hash: result.GetHash().GetValue(), EncodedTransactionBody: result.GetEncodedTransactionBody()}, nil
+}
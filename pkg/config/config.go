@@ -1,6 +1,13 @@
 package config
 
-import "code.dogecoin.org/gossip/dnet"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.dogecoin.org/gossip/dnet"
+	"dogecoin.org/fractal-engine/pkg/metrics"
+)
 
 type Config struct {
 	RpcServerHost      string
@@ -23,6 +30,7 @@ type Config struct {
 	BuyOfferLimit      int
 	SellOfferLimit     int
 	CORSAllowedOrigins string
+	Confirmations      ConfirmationPolicy
 }
 
 func NewConfig() *Config {
@@ -46,5 +54,54 @@ func NewConfig() *Config {
 		BuyOfferLimit:      10,
 		SellOfferLimit:     10,
 		CORSAllowedOrigins: "*",
+		Confirmations:      DefaultConfirmationPolicy(),
 	}
 }
+
+// ConfirmationPolicy is the number of confirmations each on-chain action needs before
+// it is applied, keyed by action name (mint, invoice, payment, transfer, burn, ...).
+// Actions that are not listed are applied as soon as they are seen.
+type ConfirmationPolicy map[string]int
+
+// DefaultConfirmationPolicy waits six blocks before settling payments.
+func DefaultConfirmationPolicy() ConfirmationPolicy {
+	return ConfirmationPolicy{"payment": 6}
+}
+
+// Required returns the confirmations needed for an action.
+func (p ConfirmationPolicy) Required(action string) int {
+	return p[action]
+}
+
+// ParseConfirmationPolicy reads a policy such as "mint=1,invoice=1,payment=6". Actions
+// that are not listed keep their default depth, and unknown action names are rejected
+// so that a typo cannot silently leave an action unconfirmed.
+func ParseConfirmationPolicy(value string) (ConfirmationPolicy, error) {
+	policy := DefaultConfirmationPolicy()
+
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		action, depth, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid confirmation policy entry: %s", entry)
+		}
+
+		action = strings.TrimSpace(action)
+		if !metrics.IsActionName(action) {
+			return nil, fmt.Errorf("unknown action in confirmation policy: %s", action)
+		}
+
+		confirmations, err := strconv.Atoi(strings.TrimSpace(depth))
+		if err != nil || confirmations < 0 {
+			return nil, fmt.Errorf("invalid confirmations for %s: %s", action, depth)
+		}
+
+		policy[action] = confirmations
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,25 @@
+package config_test
+
+import (
+	"testing"
+
+	"dogecoin.org/fractal-engine/pkg/config"
+	"gotest.tools/assert"
+)
+
+func TestParseConfirmationPolicy(t *testing.T) {
+	policy, err := config.ParseConfirmationPolicy("mint=1, transfer=2")
+	assert.NilError(t, err)
+	assert.Equal(t, 1, policy.Required("mint"))
+	assert.Equal(t, 2, policy.Required("transfer"))
+	assert.Equal(t, 6, policy.Required("payment"))
+
+	_, err = config.ParseConfirmationPolicy("mint=1,paymnet=6")
+	assert.ErrorContains(t, err, "unknown action in confirmation policy: paymnet")
+
+	_, err = config.ParseConfirmationPolicy("mint=-1")
+	assert.ErrorContains(t, err, "invalid confirmations for mint")
+
+	_, err = config.ParseConfirmationPolicy("mint")
+	assert.ErrorContains(t, err, "invalid confirmation policy entry")
+}
@@ -0,0 +1,21 @@
+package doge
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// KoinuPerDoge is the number of koinu, the smallest Dogecoin unit, in one DOGE.
+const KoinuPerDoge int64 = 100_000_000
+
+// ToKoinu converts an exact DOGE amount, such as a transaction output value, to koinu.
+// Amounts with more than eight decimal places cannot appear on chain and are rejected.
+func ToKoinu(value decimal.Decimal) (int64, error) {
+	koinu := value.Shift(8)
+	if !koinu.IsInteger() {
+		return 0, fmt.Errorf("amount has more precision than a koinu: %s", value.String())
+	}
+
+	return koinu.IntPart(), nil
+}
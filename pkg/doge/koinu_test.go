@@ -0,0 +1,39 @@
+package doge_test
+
+import (
+	"testing"
+
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"github.com/shopspring/decimal"
+	"gotest.tools/assert"
+)
+
+func TestToKoinu(t *testing.T) {
+	cases := map[string]int64{
+		"0":            0,
+		"1":            doge.KoinuPerDoge,
+		"0.1":          10_000_000,
+		"0.00000001":   1,
+		"5000":         5000 * doge.KoinuPerDoge,
+		"12.34567891":  1_234_567_891,
+		"99999999.999": 9_999_999_999_900_000,
+	}
+
+	for value, expected := range cases {
+		koinu, err := doge.ToKoinu(decimal.RequireFromString(value))
+		assert.NilError(t, err, value)
+		assert.Equal(t, koinu, expected, value)
+	}
+
+	// Summing float64 amounts loses koinu, decimal amounts do not
+	total := int64(0)
+	for i := 0; i < 10; i++ {
+		koinu, err := doge.ToKoinu(decimal.RequireFromString("0.1"))
+		assert.NilError(t, err)
+		total += koinu
+	}
+	assert.Equal(t, total, doge.KoinuPerDoge)
+
+	_, err := doge.ToKoinu(decimal.RequireFromString("0.000000001"))
+	assert.ErrorContains(t, err, "more precision than a koinu")
+}
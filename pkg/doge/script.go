@@ -0,0 +1,180 @@
+package doge
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/cosmos/btcutil/base58"
+)
+
+// P2SH address version bytes
+const (
+	ScriptPrefixMainnet = 0x16
+	ScriptPrefixTestnet = 0xC4
+	ScriptPrefixRegtest = 0xC4
+)
+
+const (
+	opZero          = 0x00
+	opPushData1     = 0x4c
+	opPushData2     = 0x4d
+	opPushData4     = 0x4e
+	opOne           = 0x51
+	opSixteen       = 0x60
+	opCheckMultisig = 0xae
+)
+
+// GetScriptPrefix returns the P2SH version byte for a network's P2PKH version byte.
+func GetScriptPrefix(prefix byte) (byte, error) {
+	switch prefix {
+	case PrefixMainnet:
+		return ScriptPrefixMainnet, nil
+	case PrefixTestnet:
+		return ScriptPrefixTestnet, nil
+	case PrefixRegtest:
+		return ScriptPrefixRegtest, nil
+	}
+
+	return 0, fmt.Errorf("invalid prefix: %x", prefix)
+}
+
+// ScriptToP2SHAddress returns the pay-to-script-hash address of a redeem script.
+func ScriptToP2SHAddress(script []byte, scriptPrefix byte) string {
+	return base58.CheckEncode(btcutil.Hash160(script), scriptPrefix)
+}
+
+// ParseScriptPushes returns the data pushed by a push-only script, such as a
+// scriptSig. OP_0 pushes an empty element and OP_1..OP_16 push their number.
+func ParseScriptPushes(script []byte) ([][]byte, error) {
+	pushes := [][]byte{}
+
+	for i := 0; i < len(script); {
+		op := script[i]
+		i++
+
+		var size int
+		switch {
+		case op == opZero:
+			pushes = append(pushes, []byte{})
+			continue
+		case op >= opOne && op <= opSixteen:
+			pushes = append(pushes, []byte{op - opOne + 1})
+			continue
+		case op < opPushData1:
+			size = int(op)
+		case op == opPushData1:
+			if i+1 > len(script) {
+				return nil, errors.New("truncated script")
+			}
+			size = int(script[i])
+			i++
+		case op == opPushData2:
+			if i+2 > len(script) {
+				return nil, errors.New("truncated script")
+			}
+			size = int(binary.LittleEndian.Uint16(script[i:]))
+			i += 2
+		case op == opPushData4:
+			if i+4 > len(script) {
+				return nil, errors.New("truncated script")
+			}
+			size = int(binary.LittleEndian.Uint32(script[i:]))
+			i += 4
+		default:
+			return nil, fmt.Errorf("script is not push only: opcode %x", op)
+		}
+
+		if size < 0 || i+size > len(script) {
+			return nil, errors.New("truncated script")
+		}
+
+		pushes = append(pushes, script[i:i+size])
+		i += size
+	}
+
+	return pushes, nil
+}
+
+// ParseMultisigScript decodes a standard m-of-n CHECKMULTISIG script into the
+// number of required signatures and the member public keys.
+func ParseMultisigScript(script []byte) (int, [][]byte, error) {
+	if len(script) < 3 || script[len(script)-1] != opCheckMultisig {
+		return 0, nil, errors.New("not a multisig script")
+	}
+
+	pushes, err := ParseScriptPushes(script[:len(script)-1])
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if len(pushes) < 3 || len(pushes[0]) != 1 || len(pushes[len(pushes)-1]) != 1 {
+		return 0, nil, errors.New("not a multisig script")
+	}
+
+	required := int(pushes[0][0])
+	total := int(pushes[len(pushes)-1][0])
+	pubKeys := pushes[1 : len(pushes)-1]
+
+	if total != len(pubKeys) || required < 1 || required > total {
+		return 0, nil, errors.New("invalid multisig signature counts")
+	}
+
+	for _, pubKey := range pubKeys {
+		if !isPublicKey(pubKey) {
+			return 0, nil, errors.New("invalid public key in multisig script")
+		}
+	}
+
+	return required, pubKeys, nil
+}
+
+// AddressFromScriptSig derives the address an input spends from its scriptSig.
+// A P2PKH spend ends with the signer's public key; a P2SH spend ends with the
+// redeem script, whose hash is the address.
+func AddressFromScriptSig(scriptSigHex string, prefix byte) (string, error) {
+	scriptSig, err := hex.DecodeString(scriptSigHex)
+	if err != nil {
+		return "", fmt.Errorf("invalid script sig hex: %v", err)
+	}
+
+	pushes, err := ParseScriptPushes(scriptSig)
+	if err != nil {
+		return "", err
+	}
+
+	if len(pushes) < 2 {
+		return "", errors.New("unsupported script sig")
+	}
+
+	last := pushes[len(pushes)-1]
+
+	if len(pushes) == 2 && isPublicKey(last) {
+		return PublicKeyToDogeAddress(hex.EncodeToString(last), prefix)
+	}
+
+	// Multisig spends start with the OP_0 consumed by the CHECKMULTISIG off-by-one
+	if len(pushes[0]) == 0 && len(last) > 0 {
+		scriptPrefix, err := GetScriptPrefix(prefix)
+		if err != nil {
+			return "", err
+		}
+
+		return ScriptToP2SHAddress(last, scriptPrefix), nil
+	}
+
+	return "", errors.New("unsupported script sig")
+}
+
+func isPublicKey(data []byte) bool {
+	switch len(data) {
+	case 33:
+		return data[0] == 0x02 || data[0] == 0x03
+	case 65:
+		return data[0] == 0x04
+	}
+
+	return false
+}
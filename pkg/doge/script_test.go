@@ -0,0 +1,82 @@
+package doge_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"gotest.tools/assert"
+)
+
+func multisigScript(t *testing.T, required int, pubKeys ...string) []byte {
+	t.Helper()
+
+	script := []byte{byte(0x50 + required)}
+	for _, pubKey := range pubKeys {
+		pubKeyBytes, err := hex.DecodeString(pubKey)
+		assert.NilError(t, err)
+		script = append(script, byte(len(pubKeyBytes)))
+		script = append(script, pubKeyBytes...)
+	}
+
+	return append(script, byte(0x50+len(pubKeys)), 0xae)
+}
+
+func TestParseMultisigScript(t *testing.T) {
+	_, pubA, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	_, pubB, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	required, pubKeys, err := doge.ParseMultisigScript(multisigScript(t, 2, pubA, pubB))
+	assert.NilError(t, err)
+	assert.Equal(t, required, 2)
+	assert.Equal(t, len(pubKeys), 2)
+	assert.Equal(t, hex.EncodeToString(pubKeys[0]), pubA)
+	assert.Equal(t, hex.EncodeToString(pubKeys[1]), pubB)
+
+	_, _, err = doge.ParseMultisigScript(multisigScript(t, 3, pubA, pubB))
+	assert.ErrorContains(t, err, "invalid multisig signature counts")
+
+	_, _, err = doge.ParseMultisigScript([]byte{0x76, 0xa9, 0x14})
+	assert.ErrorContains(t, err, "not a multisig script")
+}
+
+func TestAddressFromScriptSig(t *testing.T) {
+	_, pubA, addressA, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	_, pubB, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	signature := make([]byte, 71)
+	pubKeyBytes, err := hex.DecodeString(pubA)
+	assert.NilError(t, err)
+
+	// P2PKH: <sig> <pubkey>
+	scriptSig := append([]byte{byte(len(signature))}, signature...)
+	scriptSig = append(scriptSig, byte(len(pubKeyBytes)))
+	scriptSig = append(scriptSig, pubKeyBytes...)
+
+	address, err := doge.AddressFromScriptSig(hex.EncodeToString(scriptSig), doge.PrefixRegtest)
+	assert.NilError(t, err)
+	assert.Equal(t, address, addressA)
+
+	// P2SH multisig: OP_0 <sig> <redeem script>
+	redeemScript := multisigScript(t, 1, pubA, pubB)
+	scriptSig = append([]byte{0x00, byte(len(signature))}, signature...)
+	scriptSig = append(scriptSig, 0x4c, byte(len(redeemScript)))
+	scriptSig = append(scriptSig, redeemScript...)
+
+	expected, err := btcutil.NewAddressScriptHash(redeemScript, &chaincfg.RegressionNetParams)
+	assert.NilError(t, err)
+
+	address, err = doge.AddressFromScriptSig(hex.EncodeToString(scriptSig), doge.PrefixRegtest)
+	assert.NilError(t, err)
+	assert.Equal(t, address, expected.EncodeAddress())
+	assert.Equal(t, address, doge.ScriptToP2SHAddress(redeemScript, doge.ScriptPrefixRegtest))
+
+	_, err = doge.AddressFromScriptSig("6a", doge.PrefixRegtest)
+	assert.ErrorContains(t, err, "not push only")
+}
@@ -0,0 +1,86 @@
+package dogenet
+
+import (
+	"context"
+	"log"
+
+	"code.dogecoin.org/gossip/dnet"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *DogeNetClient) GossipAttestation(record store.Attestation) error {
+	attestationMessage := protocol.AttestationMessage{
+		MintHash:  record.MintHash,
+		Address:   record.Address,
+		Signature: record.Signature,
+		PublicKey: record.PublicKey,
+		CreatedAt: timestamppb.New(record.CreatedAt),
+	}
+
+	envelope := protocol.AttestationMessageEnvelope{
+		Type:    protocol.ACTION_ATTESTATION,
+		Version: protocol.DEFAULT_VERSION,
+		Payload: &attestationMessage,
+	}
+
+	data, err := proto.Marshal(&envelope)
+	if err != nil {
+		log.Fatalf("Failed to marshal: %v", err)
+	}
+
+	err = c.send(TagAttestation, data)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *DogeNetClient) recvAttestation(msg dnet.Message) {
+	log.Printf("[FE] received attestation message")
+	ctx := context.Background()
+
+	envelope := protocol.AttestationMessageEnvelope{}
+	err := proto.Unmarshal(msg.Payload, &envelope)
+	if err != nil {
+		log.Println("Error deserializing message envelope:", err)
+		return
+	}
+
+	if envelope.Type != protocol.ACTION_ATTESTATION {
+		log.Printf("[FE] unexpected action: [%s][%s][%d]", msg.Chan, msg.Tag, envelope.Type)
+		return
+	}
+
+	attestation := envelope.Payload
+
+	record := store.Attestation{
+		MintHash:  attestation.MintHash,
+		Address:   attestation.Address,
+		Signature: attestation.Signature,
+		PublicKey: attestation.PublicKey,
+		CreatedAt: attestation.CreatedAt.AsTime(),
+	}
+
+	mint, err := c.store.GetMintByHash(ctx, record.MintHash)
+	if err != nil {
+		log.Println("Error getting mint:", err)
+		return
+	}
+
+	if err := record.Validate(mint); err != nil {
+		log.Println("Invalid attestation:", err)
+		return
+	}
+
+	id, err := c.store.SaveAttestation(ctx, &record)
+	if err != nil {
+		log.Println("Error saving attestation:", err)
+		return
+	}
+
+	log.Printf("[FE] attestation saved: %v", id)
+}
@@ -0,0 +1,90 @@
+package dogenet
+
+import (
+	"context"
+	"log"
+
+	"code.dogecoin.org/gossip/dnet"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *DogeNetClient) GossipBurnSignature(record store.BurnSignature) error {
+	burnSignatureMessage := protocol.BurnSignatureMessage{
+		BurnHash:     record.BurnHash,
+		MintHash:     record.MintHash,
+		OwnerAddress: record.OwnerAddress,
+		Quantity:     int32(record.Quantity),
+		Signature:    record.Signature,
+		PublicKey:    record.PublicKey,
+		CreatedAt:    timestamppb.New(record.CreatedAt),
+	}
+
+	envelope := protocol.BurnSignatureMessageEnvelope{
+		Type:    protocol.ACTION_BURN_SIGNATURE,
+		Version: protocol.DEFAULT_VERSION,
+		Payload: &burnSignatureMessage,
+	}
+
+	data, err := proto.Marshal(&envelope)
+	if err != nil {
+		log.Fatalf("Failed to marshal: %v", err)
+	}
+
+	err = c.send(TagBurnSignature, data)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *DogeNetClient) recvBurnSignature(msg dnet.Message) {
+	log.Printf("[FE] received burn signature message")
+	ctx := context.Background()
+
+	envelope := protocol.BurnSignatureMessageEnvelope{}
+	err := proto.Unmarshal(msg.Payload, &envelope)
+	if err != nil {
+		log.Println("Error deserializing message envelope:", err)
+		return
+	}
+
+	if envelope.Type != protocol.ACTION_BURN_SIGNATURE {
+		log.Printf("[FE] unexpected action: [%s][%s][%d]", msg.Chan, msg.Tag, envelope.Type)
+		return
+	}
+
+	burnSignature := envelope.Payload
+
+	record := store.BurnSignature{
+		BurnHash:     burnSignature.BurnHash,
+		MintHash:     burnSignature.MintHash,
+		OwnerAddress: burnSignature.OwnerAddress,
+		Quantity:     int(burnSignature.Quantity),
+		Signature:    burnSignature.Signature,
+		PublicKey:    burnSignature.PublicKey,
+		CreatedAt:    burnSignature.CreatedAt.AsTime(),
+	}
+
+	mint, err := c.store.GetMintByHash(ctx, record.MintHash)
+	if err != nil {
+		log.Println("Error getting mint:", err)
+		return
+	}
+
+	if err := record.Validate(mint); err != nil {
+		log.Println("Invalid burn signature:", err)
+		return
+	}
+
+	id, err := c.store.SaveBurnSignature(ctx, &record)
+	if err != nil {
+		log.Println("Error saving burn signature:", err)
+		return
+	}
+
+	log.Printf("[FE] burn signature saved: %v", id)
+}
@@ -6,6 +6,7 @@ import (
 
 	"code.dogecoin.org/gossip/dnet"
 	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/events"
 	"dogecoin.org/fractal-engine/pkg/protocol"
 	"dogecoin.org/fractal-engine/pkg/store"
 
@@ -41,9 +42,7 @@ func (c *DogeNetClient) GossipBuyOffer(record store.BuyOffer) error {
 		log.Fatalf("Failed to marshal: %v", err)
 	}
 
-	encodedMsg := dnet.EncodeMessageRaw(ChanFE, TagBuyOffer, c.feKey, data)
-
-	err = encodedMsg.Send(c.sock)
+	err = c.send(TagBuyOffer, data)
 	if err != nil {
 		return err
 	}
@@ -69,9 +68,7 @@ func (c *DogeNetClient) GossipDeleteBuyOffer(hash string, publicKey string, sign
 		log.Fatalf("Failed to marshal: %v", err)
 	}
 
-	encodedMsg := dnet.EncodeMessageRaw(ChanFE, TagDeleteBuyOffer, c.feKey, data)
-
-	err = encodedMsg.Send(c.sock)
+	err = c.send(TagDeleteBuyOffer, data)
 	if err != nil {
 		return err
 	}
@@ -152,6 +149,14 @@ func (c *DogeNetClient) recvBuyOffer(ctx context.Context, msg dnet.Message) {
 	}
 
 	log.Printf("[FE] buy offer saved: %v", id)
+
+	c.store.Events.Publish(events.Event{
+		Type:      events.EventOfferCreated,
+		MintHash:  offerWithoutID.MintHash,
+		Hash:      offerWithoutID.Hash,
+		Addresses: events.Addresses(offerWithoutID.OffererAddress, offerWithoutID.SellerAddress),
+		Quantity:  offerWithoutID.Quantity,
+	})
 }
 
 func (c *DogeNetClient) recvDeleteBuyOffer(msg dnet.Message) {
@@ -178,11 +183,24 @@ func (c *DogeNetClient) recvDeleteBuyOffer(msg dnet.Message) {
 		return
 	}
 
-	err = c.store.DeleteBuyOffer(ctx, message.Hash, envelope.PublicKey)
+	// Look the offer up before it is withdrawn so that the event carries its mint
+	offer, lookupErr := c.store.GetBuyOfferByHash(ctx, message.Hash)
+
+	err = c.store.MarkBuyOfferDeletePending(ctx, message.Hash, envelope.PublicKey)
 	if err != nil {
 		log.Println("Error deleting buy offer:", err)
 		return
 	}
 
-	log.Printf("[FE] buy offer deleted: %v", message.Hash)
+	log.Printf("[FE] buy offer pending deletion: %v", message.Hash)
+
+	if lookupErr == nil && offer.PublicKey == envelope.PublicKey {
+		c.store.Events.Publish(events.Event{
+			Type:      events.EventOfferDeleted,
+			MintHash:  offer.MintHash,
+			Hash:      offer.Hash,
+			Addresses: events.Addresses(offer.OffererAddress, offer.SellerAddress),
+			Quantity:  offer.Quantity,
+		})
+	}
 }
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"dogecoin.org/fractal-engine/pkg/config"
+	"dogecoin.org/fractal-engine/pkg/metrics"
 
 	"code.dogecoin.org/gossip/dnet"
 	"code.dogecoin.org/governor"
@@ -44,7 +45,15 @@ type GossipClient interface {
 	GossipDeleteBuyOffer(hash string, publicKey string, signature string) error
 	GossipDeleteSellOffer(hash string, publicKey string, signature string) error
 	GossipUnconfirmedInvoice(record store.UnconfirmedInvoice) error
+	GossipCancelInvoice(hash string, publicKey string, signature string) error
 	GossipInvoiceSignature(record store.InvoiceSignature) error
+	GossipBurnSignature(record store.BurnSignature) error
+	GossipAttestation(record store.Attestation) error
+	GossipMintAmendment(record store.MintAmendment) error
+	GossipMintAmendmentSignature(record store.MintAmendmentSignature) error
+	GossipMintOwnershipTransfer(record store.MintOwnershipTransfer) error
+	GossipDistribution(record store.Distribution) error
+	GossipTokenTransfer(record store.TokenTransfer) error
 	GetNodes() (GetNodesResponse, error)
 	AddPeer(addPeer AddPeer) error
 	CheckRunning() error
@@ -71,7 +80,12 @@ const GossipInterval = 71 * time.Second // gossip a random identity to peers
 func convertToStructPBMap(m map[string]interface{}) map[string]*structpb.Value {
 	fields := make(map[string]*structpb.Value)
 	for k, v := range m {
-		fields[k] = &structpb.Value{Kind: &structpb.Value_StringValue{StringValue: v.(string)}}
+		value, err := structpb.NewValue(v)
+		if err != nil {
+			// Fall back to the string form for anything without a JSON representation
+			value = structpb.NewStringValue(fmt.Sprint(v))
+		}
+		fields[k] = value
 	}
 	return fields
 }
@@ -251,6 +265,7 @@ func (c *DogeNetClient) Run() {
 		}
 
 		log.Printf("[FE] message received\n")
+		metrics.GossipMessages.WithLabelValues(metrics.GossipReceived, msg.Tag.String()).Inc()
 
 		switch msg.Tag {
 		case TagMint:
@@ -267,12 +282,41 @@ func (c *DogeNetClient) Run() {
 			c.recvDeleteSellOffer(msg)
 		case TagInvoiceSignature:
 			c.recvInvoiceSignature(msg)
+		case TagBurnSignature:
+			c.recvBurnSignature(msg)
+		case TagAttestation:
+			c.recvAttestation(msg)
+		case TagCancelInvoice:
+			c.recvCancelInvoice(msg)
+		case TagMintAmendment:
+			c.recvMintAmendment(msg)
+		case TagMintAmendmentSignature:
+			c.recvMintAmendmentSignature(msg)
+		case TagMintOwnershipTransfer:
+			c.recvMintOwnershipTransfer(msg)
+		case TagDistribution:
+			c.recvDistribution(msg)
+		case TagTokenTransfer:
+			c.recvTokenTransfer(msg)
 		default:
 			log.Printf("[FE] unknown message: [%s][%s]", msg.Chan, msg.Tag)
 		}
 	}
 }
 
+// send signs a payload for the fractal engine channel and writes it to dogenet.
+func (c *DogeNetClient) send(tag dnet.Tag4CC, data []byte) error {
+	encodedMsg := dnet.EncodeMessageRaw(ChanFE, tag, c.feKey, data)
+
+	err := encodedMsg.Send(c.sock)
+	if err != nil {
+		return err
+	}
+
+	metrics.GossipMessages.WithLabelValues(metrics.GossipSent, tag.String()).Inc()
+	return nil
+}
+
 func (c *DogeNetClient) Stop() {
 	fmt.Println("Stopping dogenet client")
 	c.Stopping = true
@@ -350,6 +394,7 @@ func (s *DogeNetClient) gossipRandomInvoices(ctx context.Context) {
 			SellerAddress:  invoice.SellerAddress,
 			PublicKey:      invoice.PublicKey,
 			Signature:      invoice.Signature,
+			Splits:         invoice.Splits,
 		}
 
 		err = s.GossipUnconfirmedInvoice(unconfirmedInvoice)
@@ -0,0 +1,119 @@
+package dogenet
+
+import (
+	"context"
+	"log"
+	"slices"
+
+	"code.dogecoin.org/gossip/dnet"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *DogeNetClient) GossipDistribution(record store.Distribution) error {
+	message := protocol.DistributionMessage{
+		Hash:         record.Hash,
+		MintHash:     record.MintHash,
+		TotalKoinu:   record.TotalKoinu,
+		RecordHeight: record.RecordHeight,
+		CreatedAt:    timestamppb.New(record.CreatedAt),
+	}
+
+	envelope := protocol.DistributionMessageEnvelope{
+		Type:      protocol.ACTION_DISTRIBUTION,
+		Version:   protocol.DEFAULT_VERSION,
+		Payload:   &message,
+		PublicKey: record.PublicKey,
+		Signature: record.Signature,
+	}
+
+	data, err := proto.Marshal(&envelope)
+	if err != nil {
+		log.Fatalf("Failed to marshal: %v", err)
+	}
+
+	err = c.send(TagDistribution, data)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// recvDistribution saves a gossiped distribution declared by the owner or an asset
+// manager of a confirmed mint.
+func (c *DogeNetClient) recvDistribution(msg dnet.Message) {
+	log.Printf("[FE] received distribution message")
+	ctx := context.Background()
+
+	envelope := protocol.DistributionMessageEnvelope{}
+	err := proto.Unmarshal(msg.Payload, &envelope)
+	if err != nil {
+		log.Println("Error deserializing message envelope:", err)
+		return
+	}
+
+	if envelope.Type != protocol.ACTION_DISTRIBUTION {
+		log.Printf("[FE] unexpected action: [%s][%s][%d]", msg.Chan, msg.Tag, envelope.Type)
+		return
+	}
+
+	message := envelope.Payload
+
+	record := store.Distribution{
+		Hash:         message.Hash,
+		MintHash:     message.MintHash,
+		TotalKoinu:   message.TotalKoinu,
+		RecordHeight: message.RecordHeight,
+		PublicKey:    envelope.PublicKey,
+		Signature:    envelope.Signature,
+		CreatedAt:    message.CreatedAt.AsTime(),
+	}
+
+	if err := record.Validate(); err != nil {
+		log.Println("Invalid distribution:", err)
+		return
+	}
+
+	mint, err := c.store.GetMintByHash(ctx, record.MintHash)
+	if err != nil {
+		log.Println("Error getting mint:", err)
+		return
+	}
+
+	if mint.Hash == "" {
+		log.Println("Distribution is not for a confirmed mint")
+		return
+	}
+
+	isAssetManager := slices.ContainsFunc(mint.AssetManagers, func(am store.AssetManager) bool { return am.PublicKey == record.PublicKey })
+	if !isAssetManager {
+		prefix, err := doge.GetPrefix(c.cfg.DogeNetChain)
+		if err != nil {
+			log.Println("Error getting prefix:", err)
+			return
+		}
+
+		address, err := doge.PublicKeyToDogeAddress(record.PublicKey, prefix)
+		if err != nil {
+			log.Println("Error converting public key to doge address:", err)
+			return
+		}
+
+		if address != mint.OwnerAddress {
+			log.Println("Distribution is not signed by the owner or an asset manager of the mint")
+			return
+		}
+	}
+
+	id, err := c.store.SaveDistribution(ctx, &record)
+	if err != nil {
+		log.Println("Error saving distribution:", err)
+		return
+	}
+
+	log.Printf("[FE] distribution saved: %v", id)
+}
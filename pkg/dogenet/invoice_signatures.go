@@ -30,9 +30,7 @@ func (c *DogeNetClient) GossipInvoiceSignature(record store.InvoiceSignature) er
 		log.Fatalf("Failed to marshal: %v", err)
 	}
 
-	encodedMsg := dnet.EncodeMessageRaw(ChanFE, TagInvoiceSignature, c.feKey, data)
-
-	err = encodedMsg.Send(c.sock)
+	err = c.send(TagInvoiceSignature, data)
 	if err != nil {
 		return err
 	}
@@ -12,17 +12,28 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// GossipUnconfirmedInvoice sends an invoice with the price it was signed with, which is
+// the DOGE price for an invoice from before prices were in koinu.
 func (c *DogeNetClient) GossipUnconfirmedInvoice(record store.UnconfirmedInvoice) error {
+	payload := &protocol.InvoicePayload{
+		PaymentAddress:  record.PaymentAddress,
+		MintHash:        record.MintHash,
+		BuyerAddress:    record.BuyerAddress,
+		Quantity:        int32(record.Quantity),
+		SellerAddress:   record.SellerAddress,
+		Splits:          toProtocolInvoiceSplits(record.Splits),
+		ExpiresAtHeight: record.ExpiresAtHeight,
+	}
+
+	if record.DogePrice > 0 {
+		payload.Price = int32(record.DogePrice)
+	} else {
+		payload.PriceKoinu = record.Price
+	}
+
 	invoiceMessage := protocol.InvoiceMessage{
-		Id: record.Id,
-		Payload: &protocol.InvoicePayload{
-			PaymentAddress: record.PaymentAddress,
-			MintHash:       record.MintHash,
-			BuyerAddress:   record.BuyerAddress,
-			Quantity:       int32(record.Quantity),
-			Price:          int32(record.Price),
-			SellerAddress:  record.SellerAddress,
-		},
+		Id:        record.Id,
+		Payload:   payload,
 		Hash:      record.Hash,
 		CreatedAt: timestamppb.New(record.CreatedAt),
 	}
@@ -40,9 +51,7 @@ func (c *DogeNetClient) GossipUnconfirmedInvoice(record store.UnconfirmedInvoice
 		log.Fatalf("Failed to marshal: %v", err)
 	}
 
-	encodedMsg := dnet.EncodeMessageRaw(ChanFE, TagInvoice, c.feKey, data)
-
-	err = encodedMsg.Send(c.sock)
+	err = c.send(TagInvoice, data)
 	if err != nil {
 		return err
 	}
@@ -69,12 +78,15 @@ func (c *DogeNetClient) recvInvoice(msg dnet.Message) {
 	invoice := envelope.Payload
 
 	invoiceSignaturePayload := &protocol.InvoicePayload{
-		PaymentAddress: invoice.Payload.PaymentAddress,
-		BuyerAddress:   invoice.Payload.BuyerAddress,
-		MintHash:       invoice.Payload.MintHash,
-		Quantity:       invoice.Payload.Quantity,
-		Price:          invoice.Payload.Price,
-		SellerAddress:  invoice.Payload.SellerAddress,
+		PaymentAddress:  invoice.Payload.PaymentAddress,
+		BuyerAddress:    invoice.Payload.BuyerAddress,
+		MintHash:        invoice.Payload.MintHash,
+		Quantity:        invoice.Payload.Quantity,
+		Price:           invoice.Payload.Price,
+		SellerAddress:   invoice.Payload.SellerAddress,
+		Splits:          invoice.Payload.Splits,
+		ExpiresAtHeight: invoice.Payload.ExpiresAtHeight,
+		PriceKoinu:      invoice.Payload.PriceKoinu,
 	}
 
 	err = doge.ValidateSignature(invoiceSignaturePayload, envelope.PublicKey, envelope.Signature)
@@ -101,17 +113,62 @@ func (c *DogeNetClient) recvInvoice(msg dnet.Message) {
 	}
 
 	invoiceWithoutID := store.UnconfirmedInvoice{
-		PaymentAddress: invoice.Payload.PaymentAddress,
-		MintHash:       invoice.Payload.MintHash,
-		BuyerAddress:   invoice.Payload.BuyerAddress,
-		Quantity:       int(invoice.Payload.Quantity),
-		Price:          int(invoice.Payload.Price),
-		CreatedAt:      invoice.CreatedAt.AsTime(),
-		Hash:           invoice.Hash,
-		Id:             invoice.Id,
-		PublicKey:      envelope.PublicKey,
-		SellerAddress:  invoice.Payload.SellerAddress,
-		Signature:      envelope.Signature,
+		PaymentAddress:  invoice.Payload.PaymentAddress,
+		MintHash:        invoice.Payload.MintHash,
+		BuyerAddress:    invoice.Payload.BuyerAddress,
+		Quantity:        int(invoice.Payload.Quantity),
+		Price:           invoice.Payload.PriceKoinu,
+		CreatedAt:       invoice.CreatedAt.AsTime(),
+		Hash:            invoice.Hash,
+		Id:              invoice.Id,
+		PublicKey:       envelope.PublicKey,
+		SellerAddress:   invoice.Payload.SellerAddress,
+		Signature:       envelope.Signature,
+		Splits:          fromProtocolInvoiceSplits(invoice.Payload.Splits),
+		ExpiresAtHeight: invoice.Payload.ExpiresAtHeight,
+	}
+
+	// Peers from before koinu prices send whole DOGE per fraction
+	if invoice.Payload.PriceKoinu == 0 {
+		invoiceWithoutID.DogePrice = int(invoice.Payload.Price)
+		invoiceWithoutID.Price = int64(invoice.Payload.Price) * doge.KoinuPerDoge
+	}
+
+	if invoiceWithoutID.Price <= 0 {
+		log.Println("Invalid invoice price:", invoiceWithoutID.Price)
+		return
+	}
+
+	if invoiceWithoutID.ExpiresAtHeight < 0 {
+		log.Println("Invalid invoice expiry height:", invoiceWithoutID.ExpiresAtHeight)
+		return
+	}
+
+	if len(invoiceWithoutID.Splits) > 0 {
+		if err := invoiceWithoutID.Splits.Validate(); err != nil {
+			log.Println("Invalid invoice splits:", err)
+			return
+		}
+	}
+
+	// Invoices for mints this node has not confirmed yet start as drafts
+	mint, err := c.store.GetMintByHash(ctx, invoiceWithoutID.MintHash)
+	if err != nil {
+		log.Println("Error getting mint:", err)
+		return
+	}
+	invoiceWithoutID.Status = store.InitialInvoiceStatus(mint)
+
+	// Invoices for order book fills are already held unsigned by every matching node
+	signed, err := c.store.SignUnconfirmedInvoice(ctx, invoiceWithoutID)
+	if err != nil {
+		log.Println("Error signing matched invoice:", err)
+		return
+	}
+
+	if signed {
+		log.Printf("[FE] matched invoice signed: %s", invoiceWithoutID.Hash)
+		return
 	}
 
 	id, err := c.store.SaveUnconfirmedInvoice(ctx, &invoiceWithoutID)
@@ -122,3 +179,119 @@ func (c *DogeNetClient) recvInvoice(msg dnet.Message) {
 
 	log.Printf("[FE] unconfirmed invoice saved: %v", id)
 }
+
+func (c *DogeNetClient) GossipCancelInvoice(hash string, publicKey string, signature string) error {
+	message := protocol.CancelInvoiceMessage{
+		InvoiceHash: hash,
+	}
+
+	envelope := protocol.CancelInvoiceMessageEnvelope{
+		Type:      protocol.ACTION_CANCEL_INVOICE,
+		Version:   protocol.DEFAULT_VERSION,
+		Payload:   &message,
+		PublicKey: publicKey,
+		Signature: signature,
+	}
+
+	data, err := proto.Marshal(&envelope)
+	if err != nil {
+		log.Fatalf("Failed to marshal: %v", err)
+	}
+
+	err = c.send(TagCancelInvoice, data)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// recvCancelInvoice withdraws a gossiped invoice that has not been written on chain.
+// Confirmed invoices are only cancelled by the on-chain cancellation.
+func (c *DogeNetClient) recvCancelInvoice(msg dnet.Message) {
+	log.Printf("[FE] received cancel invoice message")
+	ctx := context.Background()
+
+	envelope := protocol.CancelInvoiceMessageEnvelope{}
+	err := proto.Unmarshal(msg.Payload, &envelope)
+	if err != nil {
+		log.Println("Error deserializing message envelope:", err)
+		return
+	}
+
+	if envelope.Type != protocol.ACTION_CANCEL_INVOICE {
+		log.Printf("[FE] unexpected action: [%s][%s][%d]", msg.Chan, msg.Tag, envelope.Type)
+		return
+	}
+
+	message := envelope.Payload
+
+	err = doge.ValidateSignature([]byte(message.InvoiceHash), envelope.PublicKey, envelope.Signature)
+	if err != nil {
+		log.Println("Error validating signature:", err)
+		return
+	}
+
+	invoice, err := c.store.GetUnconfirmedInvoiceByHash(ctx, message.InvoiceHash)
+	if err != nil {
+		log.Println("Error getting unconfirmed invoice:", err)
+		return
+	}
+
+	prefix, err := doge.GetPrefix(c.cfg.DogeNetChain)
+	if err != nil {
+		log.Println("Error getting prefix:", err)
+		return
+	}
+
+	address, err := doge.PublicKeyToDogeAddress(envelope.PublicKey, prefix)
+	if err != nil {
+		log.Println("Error converting public key to doge address:", err)
+		return
+	}
+
+	if address != invoice.BuyerAddress && address != invoice.SellerAddress {
+		log.Println("Cancelling address is not a party to the invoice")
+		return
+	}
+
+	err = c.store.CancelUnconfirmedInvoice(ctx, message.InvoiceHash)
+	if err != nil {
+		log.Println("Error cancelling unconfirmed invoice:", err)
+		return
+	}
+
+	log.Printf("[FE] unconfirmed invoice cancelled: %v", message.InvoiceHash)
+}
+
+func toProtocolInvoiceSplits(splits store.InvoiceSplits) []*protocol.InvoiceSplit {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	result := make([]*protocol.InvoiceSplit, 0, len(splits))
+	for _, split := range splits {
+		result = append(result, &protocol.InvoiceSplit{
+			Address:     split.Address,
+			BasisPoints: int32(split.BasisPoints),
+		})
+	}
+
+	return result
+}
+
+func fromProtocolInvoiceSplits(splits []*protocol.InvoiceSplit) store.InvoiceSplits {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	result := make(store.InvoiceSplits, 0, len(splits))
+	for _, split := range splits {
+		result = append(result, store.InvoiceSplit{
+			Address:     split.GetAddress(),
+			BasisPoints: int(split.GetBasisPoints()),
+		})
+	}
+
+	return result
+}
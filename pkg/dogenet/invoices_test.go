@@ -0,0 +1,209 @@
+package dogenet_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"code.dogecoin.org/gossip/dnet"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+
+	"dogecoin.org/fractal-engine/pkg/config"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/dogenet"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/assert"
+)
+
+func TestRecvInvoiceReadsDogeAndKoinuPrices(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+	cfg := config.NewConfig()
+	keyPair, err := dnet.GenerateKeyPair()
+	assert.NilError(t, err)
+	cfg.DogeNetKeyPair = keyPair
+
+	client := dogenet.NewDogeNetClient(cfg, tokenStore)
+
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	go func() {
+		defer func() { recover() }()
+		client.StartWithConn(serverConn)
+	}()
+
+	reader := bufio.NewReader(clientConn)
+	br_buf := [dnet.BindMessageSize]byte{}
+	_, err = io.ReadAtLeast(reader, br_buf[:], len(br_buf))
+	if err != nil {
+		t.Fatalf("Failed to read bind message: %v", err)
+	}
+	clientConn.Write(br_buf[:])
+
+	test_support.WaitForDogeNetClient(client)
+
+	privKey, pubKey, sellerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	mintHash := test_support.GenerateRandomHash()
+
+	sendInvoice := func(payload *protocol.InvoicePayload) {
+		signature, err := doge.SignPayload(payload, privKey, pubKey)
+		assert.NilError(t, err)
+
+		envelope := &protocol.InvoiceMessageEnvelope{
+			Type:    protocol.ACTION_INVOICE,
+			Version: protocol.DEFAULT_VERSION,
+			Payload: &protocol.InvoiceMessage{
+				Hash:      test_support.GenerateRandomHash(),
+				Payload:   payload,
+				CreatedAt: timestamppb.Now(),
+			},
+			PublicKey: pubKey,
+			Signature: signature,
+		}
+
+		data, err := proto.Marshal(envelope)
+		assert.NilError(t, err)
+
+		err = dnet.EncodeMessageRaw(dogenet.ChanFE, dogenet.TagInvoice, keyPair, data).Send(clientConn)
+		assert.NilError(t, err)
+	}
+
+	// A peer from before koinu prices sends whole DOGE per fraction
+	legacyBuyer := test_support.GenerateDogecoinAddress(true)
+	sendInvoice(&protocol.InvoicePayload{
+		PaymentAddress: sellerAddress,
+		BuyerAddress:   legacyBuyer,
+		MintHash:       mintHash,
+		Quantity:       2,
+		Price:          10,
+		SellerAddress:  sellerAddress,
+	})
+
+	buyer := test_support.GenerateDogecoinAddress(true)
+	sendInvoice(&protocol.InvoicePayload{
+		PaymentAddress: sellerAddress,
+		BuyerAddress:   buyer,
+		MintHash:       mintHash,
+		Quantity:       2,
+		SellerAddress:  sellerAddress,
+		PriceKoinu:     10,
+	})
+
+	time.Sleep(100 * time.Millisecond)
+
+	legacy, err := tokenStore.GetUnconfirmedInvoices(ctx, 0, 10, mintHash, legacyBuyer)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(legacy))
+	assert.Equal(t, int64(10*doge.KoinuPerDoge), legacy[0].Price)
+	assert.Equal(t, 10, legacy[0].DogePrice)
+
+	invoices, err := tokenStore.GetUnconfirmedInvoices(ctx, 0, 10, mintHash, buyer)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(invoices))
+	assert.Equal(t, int64(10), invoices[0].Price)
+	assert.Equal(t, 0, invoices[0].DogePrice)
+
+	client.Stop()
+}
+
+func TestRecvInvoiceSignsMatchedInvoice(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+	cfg := config.NewConfig()
+	keyPair, err := dnet.GenerateKeyPair()
+	assert.NilError(t, err)
+	cfg.DogeNetKeyPair = keyPair
+
+	client := dogenet.NewDogeNetClient(cfg, tokenStore)
+
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	go func() {
+		defer func() { recover() }()
+		client.StartWithConn(serverConn)
+	}()
+
+	reader := bufio.NewReader(clientConn)
+	br_buf := [dnet.BindMessageSize]byte{}
+	_, err = io.ReadAtLeast(reader, br_buf[:], len(br_buf))
+	if err != nil {
+		t.Fatalf("Failed to read bind message: %v", err)
+	}
+	clientConn.Write(br_buf[:])
+
+	test_support.WaitForDogeNetClient(client)
+
+	privKey, pubKey, sellerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	mintHash := test_support.GenerateRandomHash()
+	buyer := test_support.GenerateDogecoinAddress(true)
+
+	// This node matched the offers itself and holds the invoice unsigned
+	matched := &store.UnconfirmedInvoice{
+		MintHash:       mintHash,
+		Quantity:       2,
+		Price:          10,
+		BuyerAddress:   buyer,
+		PaymentAddress: sellerAddress,
+		SellerAddress:  sellerAddress,
+		PublicKey:      pubKey,
+		CreatedAt:      time.Now(),
+		Status:         store.InvoiceStatusDraft,
+	}
+	matched.Hash, err = matched.GenerateHash()
+	assert.NilError(t, err)
+
+	_, err = tokenStore.SaveUnconfirmedInvoice(ctx, matched)
+	assert.NilError(t, err)
+
+	payload := &protocol.InvoicePayload{
+		PaymentAddress: sellerAddress,
+		BuyerAddress:   buyer,
+		MintHash:       mintHash,
+		Quantity:       2,
+		SellerAddress:  sellerAddress,
+		PriceKoinu:     10,
+	}
+
+	signature, err := doge.SignPayload(payload, privKey, pubKey)
+	assert.NilError(t, err)
+
+	envelope := &protocol.InvoiceMessageEnvelope{
+		Type:    protocol.ACTION_INVOICE,
+		Version: protocol.DEFAULT_VERSION,
+		Payload: &protocol.InvoiceMessage{
+			Hash:      matched.Hash,
+			Payload:   payload,
+			CreatedAt: timestamppb.Now(),
+		},
+		PublicKey: pubKey,
+		Signature: signature,
+	}
+
+	data, err := proto.Marshal(envelope)
+	assert.NilError(t, err)
+
+	err = dnet.EncodeMessageRaw(dogenet.ChanFE, dogenet.TagInvoice, keyPair, data).Send(clientConn)
+	assert.NilError(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+
+	invoices, err := tokenStore.GetUnconfirmedInvoices(ctx, 0, 10, mintHash, buyer)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(invoices))
+	assert.Equal(t, signature, invoices[0].Signature)
+
+	client.Stop()
+}
@@ -54,9 +54,7 @@ func (c *DogeNetClient) GossipMint(record store.Mint) error {
 		log.Fatalf("Failed to marshal: %v", err)
 	}
 
-	encodedMsg := dnet.EncodeMessageRaw(ChanFE, TagMint, c.feKey, data)
-
-	err = encodedMsg.Send(c.sock)
+	err = c.send(TagMint, data)
 	if err != nil {
 		return err
 	}
@@ -112,6 +110,11 @@ func (c *DogeNetClient) recvMint(msg dnet.Message) {
 		MinSignatures:            int(mintMessage.MinSignatures),
 	}
 
+	if _, err := store.ParseMintRequirements(mintRecord.Requirements); err != nil {
+		log.Println("Error validating mint requirements:", err)
+		return
+	}
+
 	mintSignaturePayload := protocol.MintMessage{
 		Title:                    mintRecord.Title,
 		Description:              mintRecord.Description,
@@ -0,0 +1,189 @@
+package dogenet
+
+import (
+	"context"
+	"log"
+
+	"code.dogecoin.org/gossip/dnet"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *DogeNetClient) GossipMintAmendment(record store.MintAmendment) error {
+	message := protocol.MintAmendmentMessage{
+		Hash:           record.Hash,
+		MintHash:       record.MintHash,
+		Description:    record.Description,
+		Metadata:       &structpb.Struct{Fields: convertToStructPBMap(record.Metadata)},
+		FeedUrl:        record.FeedURL,
+		ContractOfSale: record.ContractOfSale,
+		CreatedAt:      timestamppb.New(record.CreatedAt),
+	}
+
+	envelope := protocol.MintAmendmentMessageEnvelope{
+		Type:      protocol.ACTION_AMEND_MINT,
+		Version:   protocol.DEFAULT_VERSION,
+		Payload:   &message,
+		PublicKey: record.PublicKey,
+		Signature: record.Signature,
+	}
+
+	data, err := proto.Marshal(&envelope)
+	if err != nil {
+		log.Fatalf("Failed to marshal: %v", err)
+	}
+
+	err = c.send(TagMintAmendment, data)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// recvMintAmendment saves a gossiped amendment signed by the owner of a confirmed
+// mint. It only takes effect once the owner writes it on chain.
+func (c *DogeNetClient) recvMintAmendment(msg dnet.Message) {
+	log.Printf("[FE] received mint amendment message")
+	ctx := context.Background()
+
+	envelope := protocol.MintAmendmentMessageEnvelope{}
+	err := proto.Unmarshal(msg.Payload, &envelope)
+	if err != nil {
+		log.Println("Error deserializing message envelope:", err)
+		return
+	}
+
+	if envelope.Type != protocol.ACTION_AMEND_MINT {
+		log.Printf("[FE] unexpected action: [%s][%s][%d]", msg.Chan, msg.Tag, envelope.Type)
+		return
+	}
+
+	message := envelope.Payload
+
+	record := store.MintAmendment{
+		Hash:           message.Hash,
+		MintHash:       message.MintHash,
+		Description:    message.Description,
+		Metadata:       message.Metadata.AsMap(),
+		FeedURL:        message.FeedUrl,
+		ContractOfSale: message.ContractOfSale,
+		PublicKey:      envelope.PublicKey,
+		Signature:      envelope.Signature,
+		CreatedAt:      message.CreatedAt.AsTime(),
+	}
+
+	if err := record.Validate(); err != nil {
+		log.Println("Invalid mint amendment:", err)
+		return
+	}
+
+	mint, err := c.store.GetMintByHash(ctx, record.MintHash)
+	if err != nil {
+		log.Println("Error getting mint:", err)
+		return
+	}
+
+	prefix, err := doge.GetPrefix(c.cfg.DogeNetChain)
+	if err != nil {
+		log.Println("Error getting prefix:", err)
+		return
+	}
+
+	address, err := doge.PublicKeyToDogeAddress(record.PublicKey, prefix)
+	if err != nil {
+		log.Println("Error converting public key to doge address:", err)
+		return
+	}
+
+	if mint.Hash == "" || address != mint.OwnerAddress {
+		log.Println("Mint amendment is not signed by the owner of a confirmed mint")
+		return
+	}
+
+	id, err := c.store.SaveMintAmendment(ctx, &record)
+	if err != nil {
+		log.Println("Error saving mint amendment:", err)
+		return
+	}
+
+	log.Printf("[FE] mint amendment saved: %v", id)
+}
+
+func (c *DogeNetClient) GossipMintAmendmentSignature(record store.MintAmendmentSignature) error {
+	message := protocol.MintAmendmentSignatureMessage{
+		AmendmentHash: record.AmendmentHash,
+		MintHash:      record.MintHash,
+		Signature:     record.Signature,
+		PublicKey:     record.PublicKey,
+		CreatedAt:     timestamppb.New(record.CreatedAt),
+	}
+
+	envelope := protocol.MintAmendmentSignatureMessageEnvelope{
+		Type:    protocol.ACTION_MINT_AMENDMENT_SIGNATURE,
+		Version: protocol.DEFAULT_VERSION,
+		Payload: &message,
+	}
+
+	data, err := proto.Marshal(&envelope)
+	if err != nil {
+		log.Fatalf("Failed to marshal: %v", err)
+	}
+
+	err = c.send(TagMintAmendmentSignature, data)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *DogeNetClient) recvMintAmendmentSignature(msg dnet.Message) {
+	log.Printf("[FE] received mint amendment signature message")
+	ctx := context.Background()
+
+	envelope := protocol.MintAmendmentSignatureMessageEnvelope{}
+	err := proto.Unmarshal(msg.Payload, &envelope)
+	if err != nil {
+		log.Println("Error deserializing message envelope:", err)
+		return
+	}
+
+	if envelope.Type != protocol.ACTION_MINT_AMENDMENT_SIGNATURE {
+		log.Printf("[FE] unexpected action: [%s][%s][%d]", msg.Chan, msg.Tag, envelope.Type)
+		return
+	}
+
+	message := envelope.Payload
+
+	record := store.MintAmendmentSignature{
+		AmendmentHash: message.AmendmentHash,
+		MintHash:      message.MintHash,
+		Signature:     message.Signature,
+		PublicKey:     message.PublicKey,
+		CreatedAt:     message.CreatedAt.AsTime(),
+	}
+
+	mint, err := c.store.GetMintByHash(ctx, record.MintHash)
+	if err != nil {
+		log.Println("Error getting mint:", err)
+		return
+	}
+
+	if err := record.Validate(mint); err != nil {
+		log.Println("Invalid mint amendment signature:", err)
+		return
+	}
+
+	id, err := c.store.SaveMintAmendmentSignature(ctx, &record)
+	if err != nil {
+		log.Println("Error saving mint amendment signature:", err)
+		return
+	}
+
+	log.Printf("[FE] mint amendment signature saved: %v", id)
+}
@@ -0,0 +1,148 @@
+package dogenet
+
+import (
+	"context"
+	"log"
+
+	"code.dogecoin.org/gossip/dnet"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *DogeNetClient) GossipMintOwnershipTransfer(record store.MintOwnershipTransfer) error {
+	var assetManagers []*protocol.AssetManager
+	for _, assetManager := range record.AssetManagers {
+		assetManagers = append(assetManagers, &protocol.AssetManager{
+			Name:      assetManager.Name,
+			PublicKey: assetManager.PublicKey,
+			Url:       assetManager.URL,
+		})
+	}
+
+	message := protocol.MintOwnershipTransferMessage{
+		Hash:                     record.Hash,
+		MintHash:                 record.MintHash,
+		NewOwnerAddress:          record.NewOwnerAddress,
+		NewPublicKey:             record.NewPublicKey,
+		SignatureRequirementType: string(record.SignatureRequirementType),
+		AssetManagers:            assetManagers,
+		MinSignatures:            int32(record.MinSignatures),
+		NewOwnerSignature:        record.NewOwnerSignature,
+		CreatedAt:                timestamppb.New(record.CreatedAt),
+	}
+
+	envelope := protocol.MintOwnershipTransferMessageEnvelope{
+		Type:      protocol.ACTION_TRANSFER_MINT_OWNERSHIP,
+		Version:   protocol.DEFAULT_VERSION,
+		Payload:   &message,
+		PublicKey: record.PublicKey,
+		Signature: record.Signature,
+	}
+
+	data, err := proto.Marshal(&envelope)
+	if err != nil {
+		log.Fatalf("Failed to marshal: %v", err)
+	}
+
+	err = c.send(TagMintOwnershipTransfer, data)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// recvMintOwnershipTransfer saves a gossiped transfer signed by the current owner of a
+// confirmed mint and by the new owner. It only takes effect once the current owner
+// writes it on chain.
+func (c *DogeNetClient) recvMintOwnershipTransfer(msg dnet.Message) {
+	log.Printf("[FE] received mint ownership transfer message")
+	ctx := context.Background()
+
+	envelope := protocol.MintOwnershipTransferMessageEnvelope{}
+	err := proto.Unmarshal(msg.Payload, &envelope)
+	if err != nil {
+		log.Println("Error deserializing message envelope:", err)
+		return
+	}
+
+	if envelope.Type != protocol.ACTION_TRANSFER_MINT_OWNERSHIP {
+		log.Printf("[FE] unexpected action: [%s][%s][%d]", msg.Chan, msg.Tag, envelope.Type)
+		return
+	}
+
+	message := envelope.Payload
+
+	var assetManagers store.AssetManagers
+	for _, assetManager := range message.AssetManagers {
+		assetManagers = append(assetManagers, store.AssetManager{
+			Name:      assetManager.Name,
+			PublicKey: assetManager.PublicKey,
+			URL:       assetManager.Url,
+		})
+	}
+
+	record := store.MintOwnershipTransfer{
+		Hash:                     message.Hash,
+		MintHash:                 message.MintHash,
+		NewOwnerAddress:          message.NewOwnerAddress,
+		NewPublicKey:             message.NewPublicKey,
+		SignatureRequirementType: store.SignatureRequirementType(message.SignatureRequirementType),
+		AssetManagers:            assetManagers,
+		MinSignatures:            int(message.MinSignatures),
+		PublicKey:                envelope.PublicKey,
+		Signature:                envelope.Signature,
+		NewOwnerSignature:        message.NewOwnerSignature,
+		CreatedAt:                message.CreatedAt.AsTime(),
+	}
+
+	if err := record.Validate(); err != nil {
+		log.Println("Invalid mint ownership transfer:", err)
+		return
+	}
+
+	mint, err := c.store.GetMintByHash(ctx, record.MintHash)
+	if err != nil {
+		log.Println("Error getting mint:", err)
+		return
+	}
+
+	prefix, err := doge.GetPrefix(c.cfg.DogeNetChain)
+	if err != nil {
+		log.Println("Error getting prefix:", err)
+		return
+	}
+
+	ownerAddress, err := doge.PublicKeyToDogeAddress(record.PublicKey, prefix)
+	if err != nil {
+		log.Println("Error converting public key to doge address:", err)
+		return
+	}
+
+	newOwnerAddress, err := doge.PublicKeyToDogeAddress(record.NewPublicKey, prefix)
+	if err != nil {
+		log.Println("Error converting public key to doge address:", err)
+		return
+	}
+
+	if mint.Hash == "" || ownerAddress != mint.OwnerAddress {
+		log.Println("Mint ownership transfer is not signed by the owner of a confirmed mint")
+		return
+	}
+
+	if newOwnerAddress != record.NewOwnerAddress {
+		log.Println("New owner address does not match public key")
+		return
+	}
+
+	id, err := c.store.SaveMintOwnershipTransfer(ctx, &record)
+	if err != nil {
+		log.Println("Error saving mint ownership transfer:", err)
+		return
+	}
+
+	log.Printf("[FE] mint ownership transfer saved: %v", id)
+}
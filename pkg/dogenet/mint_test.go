@@ -272,7 +272,7 @@ func TestRecvMintViaStartWithConn(t *testing.T) {
 		},
 		Requirements: &structpb.Struct{
 			Fields: map[string]*structpb.Value{
-				"req1": {Kind: &structpb.Value_StringValue{StringValue: "reqval1"}},
+				"max_holding": {Kind: &structpb.Value_NumberValue{NumberValue: 10}},
 			},
 		},
 		LockupOptions: &structpb.Struct{
@@ -322,7 +322,7 @@ func TestRecvMintViaStartWithConn(t *testing.T) {
 	assert.Equal(t, "value2", savedMint.Metadata["key2"])
 
 	// Verify requirements
-	assert.Equal(t, "reqval1", savedMint.Requirements["req1"])
+	assert.Equal(t, float64(10), savedMint.Requirements["max_holding"])
 
 	// Verify lockup options
 	assert.Equal(t, "lockupval1", savedMint.LockupOptions["lockup1"])
@@ -380,6 +380,83 @@ func TestRecvMintInvalidEnvelope(t *testing.T) {
 	client.Stop()
 }
 
+func TestRecvMintInvalidRequirements(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+	cfg := config.NewConfig()
+	keyPair, err := dnet.GenerateKeyPair()
+	assert.NilError(t, err)
+	cfg.DogeNetKeyPair = keyPair
+
+	client := dogenet.NewDogeNetClient(cfg, tokenStore)
+
+	// Create pipe for testing
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	// Start client
+	go func() {
+		defer func() { recover() }()
+		client.StartWithConn(serverConn)
+	}()
+
+	// Handle handshake
+	reader := bufio.NewReader(clientConn)
+	br_buf := [dnet.BindMessageSize]byte{}
+	_, err = io.ReadAtLeast(reader, br_buf[:], len(br_buf))
+	if err != nil {
+		t.Fatalf("Failed to read bind message: %v", err)
+	}
+	clientConn.Write(br_buf[:])
+
+	// Wait for ready
+	test_support.WaitForDogeNetClient(client)
+
+	privKey, dogePubKey, dogeAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	// A correctly signed mint whose requirements cannot be enforced
+	mintMessage := &protocol.MintMessage{
+		Title:         "Test Mint",
+		FractionCount: 100,
+		OwnerAddress:  dogeAddress,
+		Requirements: &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"req1": {Kind: &structpb.Value_StringValue{StringValue: "reqval1"}},
+			},
+		},
+	}
+
+	signature, err := doge.SignPayload(mintMessage, privKey, dogePubKey)
+	assert.NilError(t, err)
+
+	envelope := &protocol.MintMessageEnvelope{
+		Type:      protocol.ACTION_MINT,
+		Version:   protocol.DEFAULT_VERSION,
+		Payload:   mintMessage,
+		PublicKey: dogePubKey,
+		Signature: signature,
+	}
+
+	data, err := proto.Marshal(envelope)
+	assert.NilError(t, err)
+
+	encodedMsg := dnet.EncodeMessageRaw(dogenet.ChanFE, dogenet.TagMint, keyPair, data)
+	err = encodedMsg.Send(clientConn)
+	assert.NilError(t, err)
+
+	// Give time to process
+	time.Sleep(100 * time.Millisecond)
+
+	// Verify no mint was saved
+	mints, err := tokenStore.GetUnconfirmedMints(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(mints))
+
+	client.Stop()
+}
+
 func TestRecvMintWrongActionType(t *testing.T) {
 	tokenStore := test_support.SetupTestDB(t)
 	ctx := context.Background()
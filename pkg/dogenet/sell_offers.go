@@ -6,6 +6,7 @@ import (
 
 	"code.dogecoin.org/gossip/dnet"
 	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/events"
 	"dogecoin.org/fractal-engine/pkg/protocol"
 	"dogecoin.org/fractal-engine/pkg/store"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -39,9 +40,7 @@ func (c *DogeNetClient) GossipSellOffer(record store.SellOffer) error {
 		log.Fatalf("Failed to marshal: %v", err)
 	}
 
-	encodedMsg := dnet.EncodeMessageRaw(ChanFE, TagSellOffer, c.feKey, data)
-
-	err = encodedMsg.Send(c.sock)
+	err = c.send(TagSellOffer, data)
 	if err != nil {
 		return err
 	}
@@ -67,9 +66,7 @@ func (c *DogeNetClient) GossipDeleteSellOffer(hash string, publicKey string, sig
 		log.Fatalf("Failed to marshal: %v", err)
 	}
 
-	encodedMsg := dnet.EncodeMessageRaw(ChanFE, TagDeleteSellOffer, c.feKey, data)
-
-	err = encodedMsg.Send(c.sock)
+	err = c.send(TagDeleteSellOffer, data)
 	if err != nil {
 		return err
 	}
@@ -152,6 +149,14 @@ func (c *DogeNetClient) recvSellOffer(msg dnet.Message) {
 	}
 
 	log.Printf("[FE] sell offer saved: %v", id)
+
+	c.store.Events.Publish(events.Event{
+		Type:      events.EventOfferCreated,
+		MintHash:  offerWithoutID.MintHash,
+		Hash:      offerWithoutID.Hash,
+		Addresses: events.Addresses(offerWithoutID.OffererAddress),
+		Quantity:  offerWithoutID.Quantity,
+	})
 }
 
 func (c *DogeNetClient) recvDeleteSellOffer(msg dnet.Message) {
@@ -178,11 +183,24 @@ func (c *DogeNetClient) recvDeleteSellOffer(msg dnet.Message) {
 		return
 	}
 
-	err = c.store.DeleteSellOffer(ctx, message.Hash, envelope.PublicKey)
+	// Look the offer up before it is withdrawn so that the event carries its mint
+	offer, lookupErr := c.store.GetSellOfferByHash(ctx, message.Hash)
+
+	err = c.store.MarkSellOfferDeletePending(ctx, message.Hash, envelope.PublicKey)
 	if err != nil {
 		log.Println("Error deleting sell offer:", err)
 		return
 	}
 
-	log.Printf("[FE] sell offer deleted: %v", message.Hash)
+	log.Printf("[FE] sell offer pending deletion: %v", message.Hash)
+
+	if lookupErr == nil && offer.PublicKey == envelope.PublicKey {
+		c.store.Events.Publish(events.Event{
+			Type:      events.EventOfferDeleted,
+			MintHash:  offer.MintHash,
+			Hash:      offer.Hash,
+			Addresses: events.Addresses(offer.OffererAddress),
+			Quantity:  offer.Quantity,
+		})
+	}
 }
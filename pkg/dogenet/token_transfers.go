@@ -0,0 +1,98 @@
+package dogenet
+
+import (
+	"context"
+	"log"
+
+	"code.dogecoin.org/gossip/dnet"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"dogecoin.org/fractal-engine/pkg/validation"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *DogeNetClient) GossipTokenTransfer(record store.TokenTransfer) error {
+	message := protocol.TokenTransferMessage{
+		Hash:         record.Hash,
+		MintHash:     record.MintHash,
+		FromAddress:  record.FromAddress,
+		ToAddress:    record.ToAddress,
+		Quantity:     int32(record.Quantity),
+		Nonce:        record.Nonce,
+		RedeemScript: record.RedeemScript,
+		CreatedAt:    timestamppb.New(record.CreatedAt),
+	}
+
+	envelope := protocol.TokenTransferMessageEnvelope{
+		Type:      protocol.ACTION_TRANSFER,
+		Version:   protocol.DEFAULT_VERSION,
+		Payload:   &message,
+		PublicKey: record.PublicKey,
+		Signature: record.Signature,
+	}
+
+	data, err := proto.Marshal(&envelope)
+	if err != nil {
+		log.Fatalf("Failed to marshal: %v", err)
+	}
+
+	err = c.send(TagTokenTransfer, data)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// recvTokenTransfer saves a gossiped transfer signed for the sender address. It only
+// takes effect once the sender writes it on chain.
+func (c *DogeNetClient) recvTokenTransfer(msg dnet.Message) {
+	log.Printf("[FE] received token transfer message")
+	ctx := context.Background()
+
+	envelope := protocol.TokenTransferMessageEnvelope{}
+	err := proto.Unmarshal(msg.Payload, &envelope)
+	if err != nil {
+		log.Println("Error deserializing message envelope:", err)
+		return
+	}
+
+	if envelope.Type != protocol.ACTION_TRANSFER || envelope.Payload == nil {
+		log.Printf("[FE] unexpected action: [%s][%s][%d]", msg.Chan, msg.Tag, envelope.Type)
+		return
+	}
+
+	message := envelope.Payload
+
+	record := store.TokenTransfer{
+		Hash:         message.Hash,
+		MintHash:     message.MintHash,
+		FromAddress:  message.FromAddress,
+		ToAddress:    message.ToAddress,
+		Quantity:     int(message.Quantity),
+		Nonce:        message.Nonce,
+		PublicKey:    envelope.PublicKey,
+		Signature:    envelope.Signature,
+		RedeemScript: message.RedeemScript,
+		CreatedAt:    message.CreatedAt.AsTime(),
+	}
+
+	if err := record.Validate(); err != nil {
+		log.Println("Invalid token transfer:", err)
+		return
+	}
+
+	if err := validation.ValidateOwnerPublicKey(record.FromAddress, record.PublicKey, record.RedeemScript); err != nil {
+		log.Println("Token transfer is not signed for the sender:", err)
+		return
+	}
+
+	id, err := c.store.SaveTokenTransfer(ctx, &record)
+	if err != nil {
+		log.Println("Error saving token transfer:", err)
+		return
+	}
+
+	log.Printf("[FE] token transfer saved: %v", id)
+}
@@ -10,6 +10,14 @@ var TagInvoice = dnet.NewTag("Invo")
 var TagInvoiceSignature = dnet.NewTag("Sign")
 var TagDeleteBuyOffer = dnet.NewTag("DBuyO")
 var TagDeleteSellOffer = dnet.NewTag("DSell")
+var TagBurnSignature = dnet.NewTag("BSig")
+var TagAttestation = dnet.NewTag("Atst")
+var TagCancelInvoice = dnet.NewTag("CInv")
+var TagMintAmendment = dnet.NewTag("MAmd")
+var TagMintAmendmentSignature = dnet.NewTag("MASg")
+var TagMintOwnershipTransfer = dnet.NewTag("MOwn")
+var TagDistribution = dnet.NewTag("Dist")
+var TagTokenTransfer = dnet.NewTag("TTrn")
 
 type GossipMessage struct {
 	Topic string `json:"topic"`
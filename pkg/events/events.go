@@ -0,0 +1,151 @@
+package events
+
+import (
+	"log"
+	"slices"
+	"sync"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventMintConfirmed            EventType = "mint_confirmed"
+	EventInvoiceConfirmed         EventType = "invoice_confirmed"
+	EventPaymentMatched           EventType = "payment_matched"
+	EventBalanceChanged           EventType = "balance_changed"
+	EventOfferCreated             EventType = "offer_created"
+	EventOfferDeleted             EventType = "offer_deleted"
+	EventChainReorg               EventType = "chain_reorg"
+	EventInvoiceTimedOut          EventType = "invoice_timed_out"
+	EventPaymentReceived          EventType = "payment_received"
+	EventInvoiceExpired           EventType = "invoice_expired"
+	EventInvoiceCancelled         EventType = "invoice_cancelled"
+	EventMintAmended              EventType = "mint_amended"
+	EventMintOwnershipTransferred EventType = "mint_ownership_transferred"
+	EventDistributionPaid         EventType = "distribution_paid"
+)
+
+// Event is a state change pushed to subscribers. Addresses lists every address the
+// event concerns (e.g. both buyer and seller of a payment) so that subscribers can
+// filter on any of them.
+type Event struct {
+	Type        EventType `json:"type"`
+	MintHash    string    `json:"mint_hash"`
+	Hash        string    `json:"hash"`
+	TxHash      string    `json:"tx_hash"`
+	Addresses   []string  `json:"addresses"`
+	Quantity    int       `json:"quantity"`
+	BlockHeight int64     `json:"block_height"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Filter selects the events a subscriber receives. Empty fields match everything.
+// Chain reorgs concern every address and mint, so they always pass the address
+// and mint filters.
+type Filter struct {
+	Address  string
+	MintHash string
+	Types    []EventType
+}
+
+func (f Filter) Matches(event Event) bool {
+	if len(f.Types) > 0 && !slices.Contains(f.Types, event.Type) {
+		return false
+	}
+
+	if event.Type == EventChainReorg {
+		return true
+	}
+
+	if f.MintHash != "" && f.MintHash != event.MintHash {
+		return false
+	}
+
+	if f.Address != "" && !slices.Contains(event.Addresses, f.Address) {
+		return false
+	}
+
+	return true
+}
+
+type subscriber struct {
+	filter Filter
+	events chan Event
+}
+
+// Bus fans published events out to subscribers. Publishing never blocks: a
+// subscriber that falls behind by more than its buffer misses events.
+type Bus struct {
+	mu          sync.RWMutex
+	subscribers map[int]*subscriber
+	nextId      int
+	bufferSize  int
+}
+
+func NewBus(bufferSize int) *Bus {
+	return &Bus{subscribers: map[int]*subscriber{}, bufferSize: bufferSize}
+}
+
+// Subscribe registers a subscriber and returns its event channel together with a
+// function that unsubscribes and closes the channel.
+func (b *Bus) Subscribe(filter Filter) (<-chan Event, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextId
+	b.nextId++
+
+	sub := &subscriber{filter: filter, events: make(chan Event, b.bufferSize)}
+	b.subscribers[id] = sub
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			delete(b.subscribers, id)
+			close(sub.events)
+		})
+	}
+
+	return sub.events, unsubscribe
+}
+
+func (b *Bus) Publish(event Event) {
+	if b == nil {
+		return
+	}
+
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, sub := range b.subscribers {
+		if !sub.filter.Matches(event) {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			log.Println("Dropping event for slow subscriber:", event.Type)
+		}
+	}
+}
+
+// Addresses drops empty addresses, e.g. the seller of a buy offer open to anyone.
+func Addresses(addresses ...string) []string {
+	result := []string{}
+	for _, address := range addresses {
+		if address != "" {
+			result = append(result, address)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,57 @@
+package events_test
+
+import (
+	"testing"
+
+	"dogecoin.org/fractal-engine/pkg/events"
+	"gotest.tools/assert"
+)
+
+func TestFilterMatches(t *testing.T) {
+	event := events.Event{Type: events.EventPaymentMatched, MintHash: "mint1", Addresses: []string{"buyer", "seller"}}
+
+	assert.Assert(t, events.Filter{}.Matches(event))
+	assert.Assert(t, events.Filter{Address: "seller", MintHash: "mint1"}.Matches(event))
+	assert.Assert(t, events.Filter{Types: []events.EventType{events.EventPaymentMatched}}.Matches(event))
+
+	assert.Assert(t, !events.Filter{Address: "stranger"}.Matches(event))
+	assert.Assert(t, !events.Filter{MintHash: "mint2"}.Matches(event))
+	assert.Assert(t, !events.Filter{Types: []events.EventType{events.EventOfferCreated}}.Matches(event))
+
+	// Reorgs affect every subscriber
+	reorg := events.Event{Type: events.EventChainReorg, BlockHeight: 100}
+	assert.Assert(t, events.Filter{Address: "buyer", MintHash: "mint1"}.Matches(reorg))
+	assert.Assert(t, !events.Filter{Types: []events.EventType{events.EventOfferCreated}}.Matches(reorg))
+}
+
+func TestBusPublishAndUnsubscribe(t *testing.T) {
+	bus := events.NewBus(1)
+
+	buyerEvents, unsubscribeBuyer := bus.Subscribe(events.Filter{Address: "buyer"})
+	allEvents, unsubscribeAll := bus.Subscribe(events.Filter{})
+	defer unsubscribeAll()
+
+	bus.Publish(events.Event{Type: events.EventOfferCreated, Addresses: []string{"seller"}})
+	bus.Publish(events.Event{Type: events.EventOfferCreated, Addresses: []string{"buyer"}})
+
+	event := <-buyerEvents
+	assert.DeepEqual(t, []string{"buyer"}, event.Addresses)
+	assert.Assert(t, !event.CreatedAt.IsZero())
+
+	// The second event was dropped because the subscriber's buffer was full
+	event = <-allEvents
+	assert.DeepEqual(t, []string{"seller"}, event.Addresses)
+	select {
+	case <-allEvents:
+		t.Fatal("expected the second event to be dropped")
+	default:
+	}
+
+	unsubscribeBuyer()
+	unsubscribeBuyer()
+
+	_, ok := <-buyerEvents
+	assert.Assert(t, !ok)
+
+	bus.Publish(events.Event{Type: events.EventOfferCreated, Addresses: []string{"buyer"}})
+}
@@ -7,8 +7,12 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	fecfg "dogecoin.org/fractal-engine/pkg/config"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/events"
+	"dogecoin.org/fractal-engine/pkg/metrics"
 	"dogecoin.org/fractal-engine/pkg/protocol"
 	"dogecoin.org/fractal-engine/pkg/store"
 
@@ -20,6 +24,10 @@ import (
 	"github.com/dogecoinfoundation/chainfollower/pkg/types"
 )
 
+// retryInterval is how long the follower waits before retrying a block or rollback
+// that could not be applied.
+const retryInterval = time.Second
+
 type DogeFollower struct {
 	cfg           *fecfg.Config
 	store         *store.TokenisationStore
@@ -28,7 +36,7 @@ type DogeFollower struct {
 	msgChan       chan messages.Message
 	context       context.Context
 	cancel        context.CancelFunc
-	rpcClient     rpc.RpcTransportInterface
+	prevOuts      PrevOutResolver
 }
 
 func NewFollower(cfg *fecfg.Config, store *store.TokenisationStore) *DogeFollower {
@@ -42,7 +50,7 @@ func NewFollower(cfg *fecfg.Config, store *store.TokenisationStore) *DogeFollowe
 
 	ctx, cancel := context.WithCancel(context.Background())
 
-	return &DogeFollower{cfg: cfg, store: store, chainfollower: chainfollower, Running: false, context: ctx, cancel: cancel}
+	return &DogeFollower{cfg: cfg, store: store, chainfollower: chainfollower, Running: false, context: ctx, cancel: cancel, prevOuts: NewRpcPrevOutResolver(rpcClient)}
 }
 
 func NewFollowerWithCustomChainFollower(cfg *fecfg.Config, store *store.TokenisationStore, chainfollower chainfollower.ChainFollowerInterface) *DogeFollower {
@@ -53,9 +61,16 @@ func NewFollowerWithCustomChainFollower(cfg *fecfg.Config, store *store.Tokenisa
 func (f *DogeFollower) Start() error {
 	f.Running = true
 
-	blockHeight, blockHash, _, err := f.store.GetChainPosition(f.context)
-	if err != nil {
-		return err
+	// Without persistence the follower starts from the beginning of the chain, but the
+	// position is still recorded as it moves since confirmations are counted from it
+	var blockHeight int64
+	var blockHash string
+	if f.cfg.PersistFollower {
+		var err error
+		blockHeight, blockHash, _, err = f.store.GetChainPosition(f.context)
+		if err != nil {
+			return err
+		}
 	}
 
 	f.msgChan = f.chainfollower.Start(&state.ChainPos{
@@ -72,53 +87,35 @@ func (f *DogeFollower) Start() error {
 
 			switch msg := msg.(type) {
 			case messages.BlockMessage:
-				transactionNumber := 0
-				for _, tx := range msg.Block.Tx {
-					fractalMessage, err := GetFractalMessageFromVout(tx.VOut)
-					if err != nil {
-						continue
-					}
-
-					address, err := GetAddressFromVout(tx.VOut)
-					if err != nil {
-						continue
-					}
-
-					addressValues := make(map[string]interface{})
-					for _, vout := range tx.VOut {
-						if len(vout.ScriptPubKey.Addresses) == 1 {
-							addy := vout.ScriptPubKey.Addresses[0]
-							value := vout.Value.InexactFloat64()
-							if _, ok := addressValues[addy]; !ok {
-								addressValues[addy] = value
-							} else {
-								addressValues[addy] = addressValues[addy].(float64) + value
-							}
-						}
-					}
-
-					_, err = f.store.SaveOnChainTransaction(f.context, tx.Hash, msg.Block.Height, blockHash, transactionNumber, fractalMessage.Action, fractalMessage.Version, fractalMessage.Data, address, addressValues)
-					if err != nil {
+				// A block is only saved once every sender in it is known, so a node that
+				// cannot be reached holds the follower back
+				err := f.retry("resolving transaction senders", func() error {
+					err := SaveBlock(f.context, f.store, msg.Block, f.prevOuts, AddressPrefix(f.cfg.DogeNetChain))
+					if err != nil && !errors.Is(err, ErrPrevOutUnavailable) {
 						log.Println("Error saving on chain transaction:", err)
+						return nil
 					}
-
-					transactionNumber++
+					return err
+				})
+				if err != nil {
+					return err
 				}
 
-				if f.cfg.PersistFollower {
-					err := f.store.UpsertChainPosition(f.context, msg.ChainPos.BlockHeight, msg.ChainPos.BlockHash, msg.ChainPos.WaitingForNextHash)
-					if err != nil {
-						log.Println("Error setting chain position:", err)
-					}
+				metrics.BlocksProcessed.Inc()
+				metrics.FollowerHeight.Set(float64(msg.Block.Height))
+
+				err = f.store.UpsertChainPosition(f.context, msg.ChainPos.BlockHeight, msg.ChainPos.BlockHash, msg.ChainPos.WaitingForNextHash)
+				if err != nil {
+					log.Println("Error setting chain position:", err)
 				}
 
 			case messages.RollbackMessage:
-				log.Println("Received rollback message from chainfollower:")
-				if f.cfg.PersistFollower {
-					err := f.store.UpsertChainPosition(f.context, msg.NewChainPos.BlockHeight, msg.NewChainPos.BlockHash, msg.NewChainPos.WaitingForNextHash)
-					if err != nil {
-						log.Println("Error setting chain position:", err)
-					}
+				log.Println("Received rollback message from chainfollower:", msg.NewChainPos.BlockHeight)
+
+				err := f.rollback(msg.NewChainPos)
+				if err != nil {
+					log.Println("Error rolling back state:", err)
+					return err
 				}
 
 			default:
@@ -128,6 +125,146 @@ func (f *DogeFollower) Start() error {
 	}
 }
 
+/*
+* rollback rewinds the derived state to the fork point before the new chain is replayed.
+* A failed rollback is retried until it succeeds, holding back the blocks of the new
+* chain so that they are never applied on top of state from the orphaned one, and the
+* chain position only moves to the fork point once the state has been rolled back.
+ */
+func (f *DogeFollower) rollback(chainPos *state.ChainPos) error {
+	err := f.retry("rolling back state", func() error {
+		return f.store.RollbackToHeight(f.context, chainPos.BlockHeight)
+	})
+	if err != nil {
+		return err
+	}
+
+	metrics.FollowerHeight.Set(float64(chainPos.BlockHeight))
+	f.store.Events.Publish(events.Event{
+		Type:        events.EventChainReorg,
+		Hash:        chainPos.BlockHash,
+		BlockHeight: chainPos.BlockHeight,
+	})
+
+	err = f.store.UpsertChainPosition(f.context, chainPos.BlockHeight, chainPos.BlockHash, chainPos.WaitingForNextHash)
+	if err != nil {
+		log.Println("Error setting chain position:", err)
+	}
+
+	return nil
+}
+
+// CheckTxIndex returns ErrTxIndexDisabled if the node cannot look up the outputs spent by
+// transactions, which the follower needs to attribute senders. Failures to reach the node
+// are retried.
+func (f *DogeFollower) CheckTxIndex() error {
+	resolver, ok := f.prevOuts.(*RpcPrevOutResolver)
+	if !ok {
+		return nil
+	}
+
+	var indexErr error
+	err := f.retry("checking the transaction index", func() error {
+		err := resolver.CheckTxIndex()
+		if errors.Is(err, ErrTxIndexDisabled) {
+			indexErr = err
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return indexErr
+}
+
+// retry runs an operation until it succeeds or the follower is stopped. Messages from
+// the chainfollower are held back in the meantime.
+func (f *DogeFollower) retry(operation string, fn func() error) error {
+	for {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		log.Printf("Error %s, retrying: %v", operation, err)
+
+		select {
+		case <-f.context.Done():
+			return err
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+/*
+* SaveBlock records the fractal engine actions carried by a block as on-chain transactions
+* for the processor. Transactions are numbered by their order among the block's fractal
+* engine transactions, so a block that is saved again after a rollback or a reindex is
+* numbered exactly as it was the first time. Every sender is resolved before anything is
+* saved: if a spent output cannot be looked up for now nothing is saved and
+* ErrPrevOutUnavailable is returned, so the block can be retried as a whole. A transaction
+* whose sender can never be resolved is skipped. A transaction that cannot be saved
+* does not stop the rest of the block from being saved, and the errors are returned
+* together.
+ */
+func SaveBlock(ctx context.Context, tokenStore *store.TokenisationStore, block *types.Block, prevOuts PrevOutResolver, prefix byte) error {
+	type blockTransaction struct {
+		hash    string
+		batch   protocol.BatchEnvelope
+		address string
+		values  map[string]interface{}
+	}
+
+	var transactions []blockTransaction
+	for _, tx := range block.Tx {
+		batch, err := GetFractalBatchFromVout(tx.VOut)
+		if err != nil {
+			continue
+		}
+
+		address, err := GetSenderFromVin(tx.VIn, prevOuts, prefix)
+		if errors.Is(err, ErrPrevOutUnavailable) {
+			return err
+		}
+		if err != nil {
+			log.Println("Error resolving sender of transaction:", tx.Hash, err)
+			continue
+		}
+
+		// Output values are summed per address in koinu so that no rounding is introduced
+		koinuValues := make(map[string]int64)
+		for _, vout := range tx.VOut {
+			if len(vout.ScriptPubKey.Addresses) == 1 {
+				koinu, err := doge.ToKoinu(vout.Value)
+				if err != nil {
+					log.Println("Error reading output value of transaction:", tx.Hash, err)
+					continue
+				}
+				koinuValues[vout.ScriptPubKey.Addresses[0]] += koinu
+			}
+		}
+
+		addressValues := make(map[string]interface{}, len(koinuValues))
+		for addy, koinu := range koinuValues {
+			addressValues[addy] = koinu
+		}
+
+		transactions = append(transactions, blockTransaction{hash: tx.Hash, batch: batch, address: address, values: addressValues})
+	}
+
+	var saveErrors []error
+	for transactionNumber, tx := range transactions {
+		_, err := tokenStore.SaveOnChainBatchAtTime(ctx, tx.hash, block.Height, block.Hash, transactionNumber, tx.batch, tx.address, tx.values, time.Unix(int64(block.Time), 0))
+		if err != nil {
+			saveErrors = append(saveErrors, fmt.Errorf("transaction %s: %w", tx.hash, err))
+		}
+	}
+
+	return errors.Join(saveErrors...)
+}
+
 func GetFractalMessageFromVout(vout []types.RawTxnVOut) (protocol.MessageEnvelope, error) {
 	var bytes []byte
 	for _, vout := range vout {
@@ -151,14 +288,27 @@ func GetFractalMessageFromVout(vout []types.RawTxnVOut) (protocol.MessageEnvelop
 	return protocol.MessageEnvelope{}, errors.New("no fractal engine message")
 }
 
-func GetAddressFromVout(vout []types.RawTxnVOut) (string, error) {
-	for _, vout := range vout {
-		if vout.ScriptPubKey.Type == "pubkeyhash" {
-			return vout.ScriptPubKey.Addresses[0], nil
-		}
+// GetFractalBatchFromVout returns the actions carried by a transaction's fractal engine
+// envelope. A batch envelope is unpacked into its actions, any other envelope becomes a
+// batch of one independent action.
+func GetFractalBatchFromVout(vout []types.RawTxnVOut) (protocol.BatchEnvelope, error) {
+	message, err := GetFractalMessageFromVout(vout)
+	if err != nil {
+		return protocol.BatchEnvelope{}, err
 	}
 
-	return "", errors.New("no address found")
+	if !message.IsBatch() {
+		return protocol.NewBatchEnvelope(false, message), nil
+	}
+
+	batch := protocol.BatchEnvelope{}
+	err = batch.Deserialize(message.Data)
+	if err != nil {
+		log.Println("Error deserializing batch envelope:", err)
+		return protocol.BatchEnvelope{}, err
+	}
+
+	return batch, nil
 }
 
 func ParseOpReturnData(vout types.RawTxnVOut) []byte {
@@ -181,6 +331,22 @@ func ParseOpReturnData(vout types.RawTxnVOut) []byte {
 	return nil
 }
 
+// SetPrevOutResolver overrides how the outputs spent by transaction inputs are looked up.
+func (f *DogeFollower) SetPrevOutResolver(resolver PrevOutResolver) {
+	f.prevOuts = resolver
+}
+
+// AddressPrefix returns the address prefix of a Dogecoin chain, falling back to mainnet
+// for a chain it does not know.
+func AddressPrefix(chain string) byte {
+	prefix, err := doge.GetPrefix(chain)
+	if err != nil {
+		return doge.PrefixMainnet
+	}
+
+	return prefix
+}
+
 func (f *DogeFollower) Stop() {
 	fmt.Println("Stopping follower")
 	if f.Running {
@@ -3,11 +3,16 @@ package followerer_test
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
 	test_support "dogecoin.org/fractal-engine/internal/test/support"
 	"dogecoin.org/fractal-engine/pkg/config"
+	"dogecoin.org/fractal-engine/pkg/doge"
 	"dogecoin.org/fractal-engine/pkg/followerer"
 	"dogecoin.org/fractal-engine/pkg/protocol"
 	"dogecoin.org/fractal-engine/pkg/store"
@@ -32,6 +37,31 @@ func (f *FakeChainFollower) Stop() {
 	close(f.Messages)
 }
 
+type FakePrevOutResolver struct {
+	PrevOuts map[string]types.RawTxnVOut
+}
+
+func (r *FakePrevOutResolver) GetPrevOut(txId string, vout int) (types.RawTxnVOut, error) {
+	prevOut, ok := r.PrevOuts[txId+":"+strconv.Itoa(vout)]
+	if !ok {
+		return types.RawTxnVOut{}, fmt.Errorf("prevout not found: %s:%d", txId, vout)
+	}
+
+	return prevOut, nil
+}
+
+func NewFakePrevOutResolver() *FakePrevOutResolver {
+	return &FakePrevOutResolver{PrevOuts: map[string]types.RawTxnVOut{
+		"PREVTX:0": {
+			N: 0,
+			ScriptPubKey: types.RawTxnScriptPubKey{
+				Type:      "pubkeyhash",
+				Addresses: []string{"1234567890"},
+			},
+		},
+	}}
+}
+
 func TestDogeFollower(t *testing.T) {
 	tokenisationStore := test_support.SetupTestDB(t)
 	ctx := context.Background()
@@ -41,6 +71,7 @@ func TestDogeFollower(t *testing.T) {
 	}
 
 	dogeFollower := followerer.NewFollowerWithCustomChainFollower(&config.Config{}, tokenisationStore, chainFollower)
+	dogeFollower.SetPrevOutResolver(NewFakePrevOutResolver())
 	go dogeFollower.Start()
 
 	hash := "MyMintHash123"
@@ -54,6 +85,7 @@ func TestDogeFollower(t *testing.T) {
 			Tx: []types.RawTxn{
 				{
 					Hash: "TX123213123123",
+					VIn:  []types.RawTxnVIn{{TxID: "PREVTX", VOut: 0}},
 					VOut: []types.RawTxnVOut{
 						{
 							ScriptPubKey: types.RawTxnScriptPubKey{
@@ -93,6 +125,451 @@ func TestDogeFollower(t *testing.T) {
 	assert.Equal(t, uint8(protocol.DEFAULT_VERSION), transactions[0].ActionVersion)
 	assert.Equal(t, hex.EncodeToString(mintMessage.Data), hex.EncodeToString(transactions[0].ActionData))
 	assert.Equal(t, "1234567890", transactions[0].Address)
-	assert.Assert(t, transactions[0].Values.Equal(store.StringInterfaceMap{"1234567890": 100}))
+	assert.Assert(t, transactions[0].Values.Equal(store.StringInterfaceMap{"1234567890": 100 * doge.KoinuPerDoge}))
+
+	// The position is recorded without persistence since confirmations are counted from it
+	blockHeight, blockHash, _, err := tokenisationStore.GetChainPosition(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(100), blockHeight)
+	assert.Equal(t, "1234567890", blockHash)
+}
+
+func TestDogeFollowerRollback(t *testing.T) {
+	tokenisationStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	chainFollower := &FakeChainFollower{
+		Messages: make(chan messages.Message),
+	}
+
+	dogeFollower := followerer.NewFollowerWithCustomChainFollower(&config.Config{PersistFollower: true}, tokenisationStore, chainFollower)
+	dogeFollower.SetPrevOutResolver(NewFakePrevOutResolver())
+	go dogeFollower.Start()
+
+	envelope := protocol.NewMintTransactionEnvelope("MyMintHash123", protocol.ACTION_MINT)
+	encodedTransactionBody := envelope.Serialize()
+
+	for height := int64(99); height <= 100; height++ {
+		chainFollower.Messages <- messages.BlockMessage{
+			Block: &types.Block{
+				Hash:   "block" + strconv.FormatInt(height, 10),
+				Height: height,
+				Tx: []types.RawTxn{
+					{
+						Hash: "TX" + strconv.FormatInt(height, 10),
+						VIn:  []types.RawTxnVIn{{TxID: "PREVTX", VOut: 0}},
+						VOut: []types.RawTxnVOut{
+							{
+								ScriptPubKey: types.RawTxnScriptPubKey{
+									Type:      "pubkeyhash",
+									Addresses: []string{"1234567890"},
+									Asm:       "OP_RETURN " + hex.EncodeToString(encodedTransactionBody),
+								},
+								Value: decimal.NewFromInt(100),
+							},
+						},
+					},
+				},
+			},
+			ChainPos: &state.ChainPos{
+				BlockHash:   "block" + strconv.FormatInt(height, 10),
+				BlockHeight: height,
+			},
+		}
+	}
+
+	chainFollower.Messages <- messages.RollbackMessage{
+		OldChainPos: &state.ChainPos{BlockHash: "block100", BlockHeight: 100},
+		NewChainPos: &state.ChainPos{BlockHash: "block99", BlockHeight: 99},
+	}
+
+	time.Sleep(1 * time.Second)
+
+	transactions, err := tokenisationStore.GetOnChainTransactions(ctx, 0, 100)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(transactions))
+	assert.Equal(t, "TX99", transactions[0].TxHash)
+	assert.Equal(t, "block99", transactions[0].BlockHash)
+
+	blockHeight, blockHash, _, err := tokenisationStore.GetChainPosition(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(99), blockHeight)
+	assert.Equal(t, "block99", blockHash)
+}
+
+func TestDogeFollowerRetriesFailedRollback(t *testing.T) {
+	tokenisationStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	chainFollower := &FakeChainFollower{
+		Messages: make(chan messages.Message),
+	}
+
+	dogeFollower := followerer.NewFollowerWithCustomChainFollower(&config.Config{PersistFollower: true}, tokenisationStore, chainFollower)
+	dogeFollower.SetPrevOutResolver(NewFakePrevOutResolver())
+	go dogeFollower.Start()
+
+	envelope := protocol.NewMintTransactionEnvelope("MyMintHash123", protocol.ACTION_MINT)
+
+	for height := int64(99); height <= 100; height++ {
+		chainFollower.Messages <- messages.BlockMessage{
+			Block: &types.Block{
+				Hash:   "block" + strconv.FormatInt(height, 10),
+				Height: height,
+				Tx: []types.RawTxn{
+					{
+						Hash: "TX" + strconv.FormatInt(height, 10),
+						VIn:  []types.RawTxnVIn{{TxID: "PREVTX", VOut: 0}},
+						VOut: []types.RawTxnVOut{
+							{
+								ScriptPubKey: types.RawTxnScriptPubKey{
+									Type: "nulldata",
+									Asm:  "OP_RETURN " + hex.EncodeToString(envelope.Serialize()),
+								},
+							},
+						},
+					},
+				},
+			},
+			ChainPos: &state.ChainPos{
+				BlockHash:   "block" + strconv.FormatInt(height, 10),
+				BlockHeight: height,
+			},
+		}
+	}
+
+	// Rollbacks fail while one of the tables they rewind is unavailable
+	_, err := tokenisationStore.DB.Exec("ALTER TABLE invoice_history RENAME TO invoice_history_unavailable")
+	assert.NilError(t, err)
+
+	chainFollower.Messages <- messages.RollbackMessage{
+		OldChainPos: &state.ChainPos{BlockHash: "block100", BlockHeight: 100},
+		NewChainPos: &state.ChainPos{BlockHash: "block99", BlockHeight: 99},
+	}
+
+	time.Sleep(500 * time.Millisecond)
+
+	blockHeight, blockHash, _, err := tokenisationStore.GetChainPosition(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(100), blockHeight)
+	assert.Equal(t, "block100", blockHash)
+
+	_, err = tokenisationStore.DB.Exec("ALTER TABLE invoice_history_unavailable RENAME TO invoice_history")
+	assert.NilError(t, err)
+
+	time.Sleep(1500 * time.Millisecond)
+
+	transactions, err := tokenisationStore.GetOnChainTransactions(ctx, 0, 100)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(transactions))
+	assert.Equal(t, "TX99", transactions[0].TxHash)
+
+	blockHeight, blockHash, _, err = tokenisationStore.GetChainPosition(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(99), blockHeight)
+	assert.Equal(t, "block99", blockHash)
+}
+
+func TestDogeFollowerAttributesSenderFromSpentOutput(t *testing.T) {
+	tokenisationStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	chainFollower := &FakeChainFollower{
+		Messages: make(chan messages.Message),
+	}
+
+	// The sender is a P2SH multisig wallet paying a P2PKH recipient first
+	resolver := &FakePrevOutResolver{PrevOuts: map[string]types.RawTxnVOut{
+		"MULTISIGTX:1": {
+			N: 1,
+			ScriptPubKey: types.RawTxnScriptPubKey{
+				Type:      "scripthash",
+				Addresses: []string{"2N1SP7r92ZZJvYKG2oNtzPwYnzw62up7mTo"},
+			},
+		},
+	}}
+
+	dogeFollower := followerer.NewFollowerWithCustomChainFollower(&config.Config{}, tokenisationStore, chainFollower)
+	dogeFollower.SetPrevOutResolver(resolver)
+	go dogeFollower.Start()
+
+	envelope := protocol.NewMintTransactionEnvelope("MyMintHash123", protocol.ACTION_MINT)
+
+	chainFollower.Messages <- messages.BlockMessage{
+		Block: &types.Block{
+			Hash:   "block99",
+			Height: 99,
+			Tx: []types.RawTxn{
+				{
+					Hash: "TXMULTISIG",
+					VIn:  []types.RawTxnVIn{{TxID: "MULTISIGTX", VOut: 1}},
+					VOut: []types.RawTxnVOut{
+						{
+							ScriptPubKey: types.RawTxnScriptPubKey{
+								Type:      "pubkeyhash",
+								Addresses: []string{"mqN47x4t1VXYhYPysGL2Tp89nyNMd7ERWw"},
+							},
+							Value: decimal.NewFromInt(5),
+						},
+						{
+							ScriptPubKey: types.RawTxnScriptPubKey{
+								Type: "nulldata",
+								Asm:  "OP_RETURN " + hex.EncodeToString(envelope.Serialize()),
+							},
+						},
+					},
+				},
+			},
+		},
+		ChainPos: &state.ChainPos{BlockHash: "block99", BlockHeight: 99},
+	}
+
+	time.Sleep(1 * time.Second)
+
+	transactions, err := tokenisationStore.GetOnChainTransactions(ctx, 0, 100)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(transactions))
+	assert.Equal(t, "2N1SP7r92ZZJvYKG2oNtzPwYnzw62up7mTo", transactions[0].Address)
+}
+
+func TestGetSenderFromVinOnlyFallsBackForUnsupportedScripts(t *testing.T) {
+	_, pubKey, address, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	pubKeyBytes, err := hex.DecodeString(pubKey)
+	assert.NilError(t, err)
+
+	// P2PKH spend: <sig> <pubkey>
+	signature := make([]byte, 71)
+	scriptSig := append([]byte{byte(len(signature))}, signature...)
+	scriptSig = append(scriptSig, byte(len(pubKeyBytes)))
+	scriptSig = append(scriptSig, pubKeyBytes...)
+
+	vin := []types.RawTxnVIn{{TxID: "SPENTTX", VOut: 0, ScriptSig: types.RawTxnScriptSig{Hex: hex.EncodeToString(scriptSig)}}}
+
+	// A failed lookup is not papered over with the scriptSig
+	_, err = followerer.GetSenderFromVin(vin, &FakePrevOutResolver{PrevOuts: map[string]types.RawTxnVOut{}}, doge.PrefixRegtest)
+	assert.Assert(t, errors.Is(err, followerer.ErrPrevOutUnavailable))
+
+	// An output the resolver cannot attribute falls back to the scriptSig
+	resolver := &FakePrevOutResolver{PrevOuts: map[string]types.RawTxnVOut{
+		"SPENTTX:0": {N: 0, ScriptPubKey: types.RawTxnScriptPubKey{Type: "pubkey"}},
+	}}
+
+	sender, err := followerer.GetSenderFromVin(vin, resolver, doge.PrefixRegtest)
+	assert.NilError(t, err)
+	assert.Equal(t, address, sender)
+
+	// So does an output the node has no record of
+	missing := followerer.NewRpcPrevOutResolver(&FakeRpcRequester{Errors: map[string]error{
+		"getrawtransaction": errors.New(`json-rpc: error from Core Node: {"code":-5,"message":"No such mempool or blockchain transaction"}`),
+	}})
+
+	sender, err = followerer.GetSenderFromVin(vin, missing, doge.PrefixRegtest)
+	assert.NilError(t, err)
+	assert.Equal(t, address, sender)
+
+	// Without a scriptSig there is nothing to fall back to
+	vin[0].ScriptSig.Hex = ""
+	_, err = followerer.GetSenderFromVin(vin, resolver, doge.PrefixRegtest)
+	assert.Assert(t, errors.Is(err, followerer.ErrUnsupportedScript))
+
+	_, err = followerer.GetSenderFromVin(vin, missing, doge.PrefixRegtest)
+	assert.Assert(t, errors.Is(err, followerer.ErrPrevOutNotFound))
+	assert.Assert(t, !errors.Is(err, followerer.ErrPrevOutUnavailable))
+}
+
+// FakeRpcRequester answers requests by method with a canned result or error.
+type FakeRpcRequester struct {
+	Results map[string]string
+	Errors  map[string]error
+}
+
+func (r *FakeRpcRequester) Request(method string, params []any) (*json.RawMessage, error) {
+	if err, ok := r.Errors[method]; ok {
+		return nil, err
+	}
+
+	result := json.RawMessage(r.Results[method])
+	return &result, nil
+}
+
+func TestRpcPrevOutResolverSeparatesMissingOutputsFromFailedLookups(t *testing.T) {
+	// The node does not know the transaction
+	resolver := followerer.NewRpcPrevOutResolver(&FakeRpcRequester{Errors: map[string]error{
+		"getrawtransaction": errors.New(`json-rpc error status: 500 | {"result":null,"error":{"code":-5,"message":"No such mempool or blockchain transaction"},"id":1}`),
+	}})
+	_, err := resolver.GetPrevOut("SPENTTX", 0)
+	assert.Assert(t, errors.Is(err, followerer.ErrPrevOutNotFound))
+
+	// The transaction has no such output
+	resolver = followerer.NewRpcPrevOutResolver(&FakeRpcRequester{Results: map[string]string{
+		"getrawtransaction": `{"txid":"SPENTTX","vout":[{"n":0}]}`,
+	}})
+	_, err = resolver.GetPrevOut("SPENTTX", 1)
+	assert.Assert(t, errors.Is(err, followerer.ErrPrevOutNotFound))
+
+	// The node could not be reached
+	resolver = followerer.NewRpcPrevOutResolver(&FakeRpcRequester{Errors: map[string]error{
+		"getrawtransaction": errors.New("json-rpc transport: connection refused"),
+	}})
+	_, err = resolver.GetPrevOut("SPENTTX", 0)
+	assert.Assert(t, err != nil)
+	assert.Assert(t, !errors.Is(err, followerer.ErrPrevOutNotFound))
+
+	_, err = followerer.GetSenderFromVin([]types.RawTxnVIn{{TxID: "SPENTTX", VOut: 0}}, resolver, doge.PrefixRegtest)
+	assert.Assert(t, errors.Is(err, followerer.ErrPrevOutUnavailable))
+}
+
+func TestRpcPrevOutResolverCheckTxIndex(t *testing.T) {
+	results := map[string]string{
+		"getblockhash":      `"block1"`,
+		"getblock":          `{"hash":"block1","tx":["coinbase1"]}`,
+		"getrawtransaction": `{"txid":"coinbase1","vout":[]}`,
+	}
+
+	resolver := followerer.NewRpcPrevOutResolver(&FakeRpcRequester{Results: results})
+	assert.NilError(t, resolver.CheckTxIndex())
+
+	// Without txindex=1 the node cannot find the confirmed coinbase
+	resolver = followerer.NewRpcPrevOutResolver(&FakeRpcRequester{Results: results, Errors: map[string]error{
+		"getrawtransaction": errors.New(`json-rpc: error from Core Node: {"code":-5,"message":"No such mempool transaction. Use -txindex to enable blockchain transaction queries"}`),
+	}})
+	assert.Assert(t, errors.Is(resolver.CheckTxIndex(), followerer.ErrTxIndexDisabled))
+
+	// A chain without blocks has nothing to look up
+	resolver = followerer.NewRpcPrevOutResolver(&FakeRpcRequester{Errors: map[string]error{
+		"getblockhash": errors.New(`json-rpc error status: 500 | {"result":null,"error":{"code":-8,"message":"Block height out of range"},"id":1}`),
+	}})
+	assert.NilError(t, resolver.CheckTxIndex())
+
+	// An unreachable node is not mistaken for a missing index
+	resolver = followerer.NewRpcPrevOutResolver(&FakeRpcRequester{Errors: map[string]error{
+		"getblockhash": errors.New("json-rpc transport: connection refused"),
+	}})
+	err := resolver.CheckTxIndex()
+	assert.Assert(t, err != nil)
+	assert.Assert(t, !errors.Is(err, followerer.ErrTxIndexDisabled))
+}
+
+func TestDogeFollowerFansOutBatchEnvelope(t *testing.T) {
+	tokenisationStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	chainFollower := &FakeChainFollower{
+		Messages: make(chan messages.Message),
+	}
+
+	dogeFollower := followerer.NewFollowerWithCustomChainFollower(&config.Config{}, tokenisationStore, chainFollower)
+	dogeFollower.SetPrevOutResolver(NewFakePrevOutResolver())
+	go dogeFollower.Start()
+
+	batch := protocol.NewBatchEnvelope(true,
+		protocol.NewInvoiceTransactionEnvelope("aa01", "bb02", 10, protocol.ACTION_INVOICE),
+		protocol.NewPaymentTransactionEnvelope("aa01", protocol.ACTION_PAYMENT),
+	)
+	envelope, err := batch.Envelope()
+	assert.NilError(t, err)
+
+	chainFollower.Messages <- messages.BlockMessage{
+		Block: &types.Block{
+			Hash:   "block7",
+			Height: 7,
+			Tx: []types.RawTxn{
+				{
+					Hash: "TXBATCH",
+					VIn:  []types.RawTxnVIn{{TxID: "PREVTX", VOut: 0}},
+					VOut: []types.RawTxnVOut{
+						{
+							ScriptPubKey: types.RawTxnScriptPubKey{
+								Type: "nulldata",
+								Asm:  "OP_RETURN " + hex.EncodeToString(envelope.Serialize()),
+							},
+						},
+					},
+				},
+			},
+		},
+		ChainPos: &state.ChainPos{BlockHash: "block7", BlockHeight: 7},
+	}
+
+	time.Sleep(1 * time.Second)
+
+	transactions, err := tokenisationStore.GetOnChainTransactionsByTxHash(ctx, "TXBATCH")
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(transactions))
+
+	assert.Equal(t, uint8(protocol.ACTION_INVOICE), transactions[0].ActionType)
+	assert.Equal(t, 0, transactions[0].SubIndex)
+	assert.Equal(t, uint8(protocol.ACTION_PAYMENT), transactions[1].ActionType)
+	assert.Equal(t, 1, transactions[1].SubIndex)
+
+	for _, transaction := range transactions {
+		assert.Equal(t, "1234567890", transaction.Address)
+		assert.Equal(t, int64(7), transaction.Height)
+		assert.Equal(t, 0, transaction.TransactionNumber)
+		assert.Equal(t, true, transaction.BatchAtomic)
+	}
+}
+
+func TestDogeFollowerRecordsOutputValuesInKoinu(t *testing.T) {
+	tokenisationStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	chainFollower := &FakeChainFollower{
+		Messages: make(chan messages.Message),
+	}
+
+	dogeFollower := followerer.NewFollowerWithCustomChainFollower(&config.Config{}, tokenisationStore, chainFollower)
+	dogeFollower.SetPrevOutResolver(NewFakePrevOutResolver())
+	go dogeFollower.Start()
+
+	envelope := protocol.NewPaymentTransactionEnvelope("aa01", protocol.ACTION_PAYMENT)
+
+	// 0.1 + 0.2 is not 0.3 in float64
+	chainFollower.Messages <- messages.BlockMessage{
+		Block: &types.Block{
+			Hash:   "block8",
+			Height: 8,
+			Tx: []types.RawTxn{
+				{
+					Hash: "TXKOINU",
+					VIn:  []types.RawTxnVIn{{TxID: "PREVTX", VOut: 0}},
+					VOut: []types.RawTxnVOut{
+						{
+							ScriptPubKey: types.RawTxnScriptPubKey{
+								Type:      "pubkeyhash",
+								Addresses: []string{"seller"},
+							},
+							Value: decimal.RequireFromString("0.1"),
+						},
+						{
+							ScriptPubKey: types.RawTxnScriptPubKey{
+								Type:      "pubkeyhash",
+								Addresses: []string{"seller"},
+							},
+							Value: decimal.RequireFromString("0.2"),
+						},
+						{
+							ScriptPubKey: types.RawTxnScriptPubKey{
+								Type: "nulldata",
+								Asm:  "OP_RETURN " + hex.EncodeToString(envelope.Serialize()),
+							},
+						},
+					},
+				},
+			},
+		},
+		ChainPos: &state.ChainPos{BlockHash: "block8", BlockHeight: 8},
+	}
+
+	time.Sleep(1 * time.Second)
+
+	transactions, err := tokenisationStore.GetOnChainTransactionsByTxHash(ctx, "TXKOINU")
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(transactions))
 
+	koinu, err := transactions[0].Values.Koinu("seller")
+	assert.NilError(t, err)
+	assert.Equal(t, int64(30_000_000), koinu)
 }
@@ -0,0 +1,194 @@
+package followerer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"github.com/dogecoinfoundation/chainfollower/pkg/types"
+)
+
+// ErrPrevOutUnavailable is returned when the output spent by a transaction input could
+// not be looked up, e.g. because the node is unreachable. The sender is unknown rather
+// than missing, so the transaction has to be retried instead of skipped.
+var ErrPrevOutUnavailable = errors.New("previous output unavailable")
+
+// ErrPrevOutNotFound is returned when the node has no record of the output spent by a
+// transaction input. Unlike ErrPrevOutUnavailable, retrying will not change the answer.
+var ErrPrevOutNotFound = errors.New("previous output not found")
+
+// ErrTxIndexDisabled is returned when the node cannot look up confirmed transactions
+// because it is not running with txindex=1.
+var ErrTxIndexDisabled = errors.New("node is not running with txindex=1")
+
+// ErrUnsupportedScript is returned for an output whose owner cannot be read from its
+// scriptPubKey.
+var ErrUnsupportedScript = errors.New("unsupported script type")
+
+// PrevOutResolver looks up the output a transaction input spends.
+type PrevOutResolver interface {
+	GetPrevOut(txId string, vout int) (types.RawTxnVOut, error)
+}
+
+// JSON-RPC error codes of the Dogecoin node
+const (
+	rpcInvalidAddressOrKey = -5
+	rpcInvalidParameter    = -8
+)
+
+// rpcErrorCodePattern finds the code of an error response echoed in a transport error.
+var rpcErrorCodePattern = regexp.MustCompile(`"code"\s*:\s*(-?\d+)`)
+
+type rpcRequester interface {
+	Request(method string, params []any) (*json.RawMessage, error)
+}
+
+// RpcPrevOutResolver resolves previous outputs with getrawtransaction, which needs
+// the node to run with txindex=1.
+type RpcPrevOutResolver struct {
+	rpc rpcRequester
+}
+
+func NewRpcPrevOutResolver(rpc rpcRequester) *RpcPrevOutResolver {
+	return &RpcPrevOutResolver{rpc: rpc}
+}
+
+// GetPrevOut returns ErrPrevOutNotFound if the node does not know the transaction or
+// it has no such output. Any other failure may be temporary.
+func (r *RpcPrevOutResolver) GetPrevOut(txId string, vout int) (types.RawTxnVOut, error) {
+	res, err := r.rpc.Request("getrawtransaction", []any{txId, 1})
+	if isRpcError(err, rpcInvalidAddressOrKey) {
+		return types.RawTxnVOut{}, fmt.Errorf("%w: %v", ErrPrevOutNotFound, err)
+	}
+	if err != nil {
+		return types.RawTxnVOut{}, err
+	}
+
+	var tx types.RawTxn
+	err = json.Unmarshal(*res, &tx)
+	if err != nil {
+		return types.RawTxnVOut{}, err
+	}
+
+	for _, out := range tx.VOut {
+		if out.N == vout {
+			return out, nil
+		}
+	}
+
+	return types.RawTxnVOut{}, fmt.Errorf("%w: output %d not found in %s", ErrPrevOutNotFound, vout, txId)
+}
+
+/*
+* CheckTxIndex returns ErrTxIndexDisabled if the node cannot look up the previous outputs
+* of confirmed transactions. The coinbase of block 1 is looked up, which only a node with
+* txindex=1 can do, so on a chain without blocks the check passes.
+ */
+func (r *RpcPrevOutResolver) CheckTxIndex() error {
+	res, err := r.rpc.Request("getblockhash", []any{1})
+	if isRpcError(err, rpcInvalidParameter) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var blockHash string
+	err = json.Unmarshal(*res, &blockHash)
+	if err != nil {
+		return err
+	}
+
+	res, err = r.rpc.Request("getblock", []any{blockHash})
+	if err != nil {
+		return err
+	}
+
+	var block struct {
+		Tx []string `json:"tx"`
+	}
+	err = json.Unmarshal(*res, &block)
+	if err != nil {
+		return err
+	}
+
+	if len(block.Tx) == 0 {
+		return fmt.Errorf("block %s has no transactions", blockHash)
+	}
+
+	_, err = r.rpc.Request("getrawtransaction", []any{block.Tx[0], 1})
+	if isRpcError(err, rpcInvalidAddressOrKey) {
+		return fmt.Errorf("%w: %v", ErrTxIndexDisabled, err)
+	}
+
+	return err
+}
+
+// isRpcError reports whether the node answered a request with the given error code. The
+// transport only returns errors as text, so the code is read from the response it echoes.
+func isRpcError(err error, code int) bool {
+	if err == nil {
+		return false
+	}
+
+	match := rpcErrorCodePattern.FindStringSubmatch(err.Error())
+	if match == nil {
+		return false
+	}
+
+	found, convErr := strconv.Atoi(match[1])
+	return convErr == nil && found == code
+}
+
+// GetSenderFromVin returns the address that authorised a transaction: the owner of
+// the output spent by its first input. The previous output is looked up with the
+// resolver when one is given, otherwise the address is derived from the scriptSig.
+// Both P2PKH and P2SH (multisig) owners are supported.
+// The scriptSig is only used in place of the resolver for outputs the node has no record
+// of or whose script the resolver cannot attribute, so that a transaction is always given
+// the same sender. A lookup that may succeed later is returned as ErrPrevOutUnavailable.
+func GetSenderFromVin(vin []types.RawTxnVIn, resolver PrevOutResolver, prefix byte) (string, error) {
+	if len(vin) == 0 || vin[0].TxID == "" {
+		return "", errors.New("no spendable input found")
+	}
+
+	input := vin[0]
+
+	if resolver == nil {
+		return doge.AddressFromScriptSig(input.ScriptSig.Hex, prefix)
+	}
+
+	prevOut, err := resolver.GetPrevOut(input.TxID, input.VOut)
+	if errors.Is(err, ErrPrevOutNotFound) {
+		if input.ScriptSig.Hex != "" {
+			return doge.AddressFromScriptSig(input.ScriptSig.Hex, prefix)
+		}
+		return "", err
+	}
+	if err != nil {
+		return "", fmt.Errorf("%w: %s:%d: %v", ErrPrevOutUnavailable, input.TxID, input.VOut, err)
+	}
+
+	address, err := GetAddressFromScriptPubKey(prevOut.ScriptPubKey)
+	if errors.Is(err, ErrUnsupportedScript) && input.ScriptSig.Hex != "" {
+		return doge.AddressFromScriptSig(input.ScriptSig.Hex, prefix)
+	}
+
+	return address, err
+}
+
+// GetAddressFromScriptPubKey returns the single owner of a P2PKH or P2SH output.
+func GetAddressFromScriptPubKey(scriptPubKey types.RawTxnScriptPubKey) (string, error) {
+	if scriptPubKey.Type != "pubkeyhash" && scriptPubKey.Type != "scripthash" {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedScript, scriptPubKey.Type)
+	}
+
+	if len(scriptPubKey.Addresses) != 1 {
+		return "", errors.New("no address found")
+	}
+
+	return scriptPubKey.Addresses[0], nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/metrics"
 	"dogecoin.org/fractal-engine/pkg/store"
 )
 
@@ -54,6 +55,16 @@ func (h *HealthService) Start() {
 			continue
 		}
 
+		metrics.FollowerHeight.Set(float64(currentBlockHeight))
+		metrics.ChainTipHeight.Set(float64(latestBlockHeight))
+
+		backlog, err := h.tokenStore.CountAllOnChainTransactions(ctx)
+		if err != nil {
+			log.Println("Error counting onchain transactions:", err)
+		} else {
+			metrics.OnChainTransactionBacklog.Set(float64(backlog))
+		}
+
 		_, err = h.dogeClient.GetWalletInfo(ctx)
 
 		err = h.tokenStore.UpsertHealth(ctx, int64(currentBlockHeight), int64(latestBlockHeight), chain, err == nil)
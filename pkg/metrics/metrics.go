@@ -0,0 +1,194 @@
+package metrics
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "fractal_engine"
+
+// Processor outcomes for an on-chain transaction
+const (
+	OutcomeMatched   = "matched"
+	OutcomeDiscarded = "discarded"
+	OutcomeDeferred  = "deferred"
+	OutcomeError     = "error"
+)
+
+// Gossip directions
+const (
+	GossipSent     = "sent"
+	GossipReceived = "received"
+)
+
+var (
+	// Registry holds every fractal engine collector together with the Go runtime
+	// and process collectors. It is served by Handler.
+	Registry = prometheus.NewRegistry()
+
+	FollowerHeight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "follower_block_height",
+		Help:      "Block height the chain follower has reached.",
+	})
+
+	ChainTipHeight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "chain_tip_block_height",
+		Help:      "Block height of the Dogecoin node's best block.",
+	})
+
+	BlocksProcessed = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "blocks_processed_total",
+		Help:      "Blocks read by the chain follower.",
+	})
+
+	OnChainTransactionBacklog = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "onchain_transactions_backlog",
+		Help:      "On-chain transactions waiting to be processed.",
+	})
+
+	AuditViolations = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "audit_violations",
+		Help:      "Invariant violations found by the last state audit.",
+	})
+
+	ProcessorOutcomes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "processor_outcomes_total",
+		Help:      "On-chain transactions handled by the processor by action and outcome.",
+	}, []string{"action", "outcome"})
+
+	GossipMessages = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "gossip_messages_total",
+		Help:      "Dogenet gossip messages by direction and tag.",
+	}, []string{"direction", "tag"})
+
+	RpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rpc_duration_seconds",
+		Help:      "RPC handling latency by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	RpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rpc_requests_total",
+		Help:      "RPC requests by method and result code.",
+	}, []string{"method", "code"})
+
+	RateLimitRejections = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rate_limit_rejections_total",
+		Help:      "Requests rejected by the RPC rate limiter.",
+	})
+
+	DbQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "Database statement latency by operation.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"operation"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		FollowerHeight,
+		ChainTipHeight,
+		BlocksProcessed,
+		OnChainTransactionBacklog,
+		AuditViolations,
+		ProcessorOutcomes,
+		GossipMessages,
+		RpcDuration,
+		RpcRequests,
+		RateLimitRejections,
+		DbQueryDuration,
+	)
+}
+
+// Handler serves the registry in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+var actionNames = map[uint8]string{
+	protocol.ACTION_MINT:                     "mint",
+	protocol.ACTION_BUY_OFFER:                "buy_offer",
+	protocol.ACTION_SELL_OFFER:               "sell_offer",
+	protocol.ACTION_INVOICE:                  "invoice",
+	protocol.ACTION_PAYMENT:                  "payment",
+	protocol.ACTION_DELETE_BUY_OFFER:         "delete_buy_offer",
+	protocol.ACTION_DELETE_SELL_OFFER:        "delete_sell_offer",
+	protocol.ACTION_INVOICE_SIGNATURE:        "invoice_signature",
+	protocol.ACTION_TRANSFER:                 "transfer",
+	protocol.ACTION_BURN:                     "burn",
+	protocol.ACTION_BURN_SIGNATURE:           "burn_signature",
+	protocol.ACTION_ATTESTATION:              "attestation",
+	protocol.ACTION_BATCH:                    "batch",
+	protocol.ACTION_CANCEL_INVOICE:           "cancel_invoice",
+	protocol.ACTION_AMEND_MINT:               "amend_mint",
+	protocol.ACTION_MINT_AMENDMENT_SIGNATURE: "mint_amendment_signature",
+	protocol.ACTION_TRANSFER_MINT_OWNERSHIP:  "transfer_mint_ownership",
+	protocol.ACTION_DISTRIBUTION:             "distribution",
+	protocol.ACTION_DISTRIBUTION_PAYMENT:     "distribution_payment",
+}
+
+// ActionName is the label used for a protocol action type.
+func ActionName(actionType uint8) string {
+	if name, ok := actionNames[actionType]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// IsActionName reports whether a name is the label of a protocol action type.
+func IsActionName(name string) bool {
+	for _, actionName := range actionNames {
+		if actionName == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RecordProcessorOutcome counts one processed on-chain transaction.
+func RecordProcessorOutcome(actionType uint8, outcome string) {
+	ProcessorOutcomes.WithLabelValues(ActionName(actionType), outcome).Inc()
+}
+
+// ObserveDbQuery records how long a statement took, labelled by its leading keyword.
+func ObserveDbQuery(query string, start time.Time) {
+	DbQueryDuration.WithLabelValues(QueryOperation(query)).Observe(time.Since(start).Seconds())
+}
+
+// QueryOperation returns the lower-cased leading SQL keyword of a statement, or
+// "other" for anything that is not a plain select, insert, update or delete.
+func QueryOperation(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return "other"
+	}
+
+	operation := strings.ToLower(fields[0])
+	switch operation {
+	case "select", "insert", "update", "delete", "with":
+		return operation
+	}
+
+	return "other"
+}
@@ -0,0 +1,29 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"dogecoin.org/fractal-engine/pkg/metrics"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gotest.tools/assert"
+)
+
+func TestQueryOperation(t *testing.T) {
+	assert.Equal(t, metrics.QueryOperation("SELECT id FROM mints"), "select")
+	assert.Equal(t, metrics.QueryOperation("\n\tINSERT INTO mints (id) VALUES ($1)"), "insert")
+	assert.Equal(t, metrics.QueryOperation("update mints SET title = $1"), "update")
+	assert.Equal(t, metrics.QueryOperation("DELETE FROM mints"), "delete")
+	assert.Equal(t, metrics.QueryOperation("CREATE TABLE foo (id int)"), "other")
+	assert.Equal(t, metrics.QueryOperation(""), "other")
+}
+
+func TestRecordProcessorOutcome(t *testing.T) {
+	counter := metrics.ProcessorOutcomes.WithLabelValues("transfer", metrics.OutcomeDiscarded)
+	before := testutil.ToFloat64(counter)
+
+	metrics.RecordProcessorOutcome(protocol.ACTION_TRANSFER, metrics.OutcomeDiscarded)
+
+	assert.Equal(t, testutil.ToFloat64(counter)-before, float64(1))
+	assert.Equal(t, metrics.ActionName(0xFF), "unknown")
+}
@@ -0,0 +1,230 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.1
+// source: pkg/protocol/attestation.proto
+
+package protocol
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Asset manager attestation that an address may hold a mint, gossiped so every node can verify it
+type AttestationMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintHash      string                 `protobuf:"bytes,1,opt,name=mint_hash,json=mintHash,proto3" json:"mint_hash,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Signature     string                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttestationMessage) Reset() {
+	*x = AttestationMessage{}
+	mi := &file_pkg_protocol_attestation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttestationMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationMessage) ProtoMessage() {}
+
+func (x *AttestationMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_attestation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationMessage.ProtoReflect.Descriptor instead.
+func (*AttestationMessage) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_attestation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AttestationMessage) GetMintHash() string {
+	if x != nil {
+		return x.MintHash
+	}
+	return ""
+}
+
+func (x *AttestationMessage) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AttestationMessage) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *AttestationMessage) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *AttestationMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AttestationMessageEnvelope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Payload       *AttestationMessage    `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttestationMessageEnvelope) Reset() {
+	*x = AttestationMessageEnvelope{}
+	mi := &file_pkg_protocol_attestation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttestationMessageEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationMessageEnvelope) ProtoMessage() {}
+
+func (x *AttestationMessageEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_attestation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationMessageEnvelope.ProtoReflect.Descriptor instead.
+func (*AttestationMessageEnvelope) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_attestation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttestationMessageEnvelope) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *AttestationMessageEnvelope) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *AttestationMessageEnvelope) GetPayload() *AttestationMessage {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+var File_pkg_protocol_attestation_proto protoreflect.FileDescriptor
+
+const file_pkg_protocol_attestation_proto_rawDesc = "" +
+	"\n" +
+	"\x1epkg/protocol/attestation.proto\x12\rfractalengine\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc3\x01\n" +
+	"\x12AttestationMessage\x12\x1b\n" +
+	"\tmint_hash\x18\x01 \x01(\tR\bmintHash\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x04 \x01(\tR\tpublicKey\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x87\x01\n" +
+	"\x1aAttestationMessageEnvelope\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\x05R\x04type\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12;\n" +
+	"\apayload\x18\x03 \x01(\v2!.fractalengine.AttestationMessageR\apayloadB\x0eZ\fpkg/protocolb\x06proto3"
+
+var (
+	file_pkg_protocol_attestation_proto_rawDescOnce sync.Once
+	file_pkg_protocol_attestation_proto_rawDescData []byte
+)
+
+func file_pkg_protocol_attestation_proto_rawDescGZIP() []byte {
+	file_pkg_protocol_attestation_proto_rawDescOnce.Do(func() {
+		file_pkg_protocol_attestation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_protocol_attestation_proto_rawDesc), len(file_pkg_protocol_attestation_proto_rawDesc)))
+	})
+	return file_pkg_protocol_attestation_proto_rawDescData
+}
+
+var file_pkg_protocol_attestation_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_protocol_attestation_proto_goTypes = []any{
+	(*AttestationMessage)(nil),         // 0: fractalengine.AttestationMessage
+	(*AttestationMessageEnvelope)(nil), // 1: fractalengine.AttestationMessageEnvelope
+	(*timestamppb.Timestamp)(nil),      // 2: google.protobuf.Timestamp
+}
+var file_pkg_protocol_attestation_proto_depIdxs = []int32{
+	2, // 0: fractalengine.AttestationMessage.created_at:type_name -> google.protobuf.Timestamp
+	0, // 1: fractalengine.AttestationMessageEnvelope.payload:type_name -> fractalengine.AttestationMessage
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_pkg_protocol_attestation_proto_init() }
+func file_pkg_protocol_attestation_proto_init() {
+	if File_pkg_protocol_attestation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_protocol_attestation_proto_rawDesc), len(file_pkg_protocol_attestation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_protocol_attestation_proto_goTypes,
+		DependencyIndexes: file_pkg_protocol_attestation_proto_depIdxs,
+		MessageInfos:      file_pkg_protocol_attestation_proto_msgTypes,
+	}.Build()
+	File_pkg_protocol_attestation_proto = out.File
+	file_pkg_protocol_attestation_proto_goTypes = nil
+	file_pkg_protocol_attestation_proto_depIdxs = nil
+}
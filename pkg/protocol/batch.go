@@ -0,0 +1,134 @@
+package protocol
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	BATCH_FLAG_ATOMIC = 0x01
+	MAX_BATCH_ACTIONS = 0xFF
+)
+
+/*
+* A batch envelope is a MessageEnvelope with action ACTION_BATCH whose data packs
+* several actions into one Dogecoin transaction:
+*
+*	flags (1 byte) | count (1 byte) | count x [action (1) | version (1) | length (2, big endian) | data]
+*
+* When BATCH_FLAG_ATOMIC is set the actions are applied all-or-nothing, otherwise
+* each action is processed independently. Batches cannot be nested.
+ */
+type BatchEnvelope struct {
+	Atomic  bool
+	Actions []MessageEnvelope
+}
+
+func NewBatchEnvelope(atomic bool, actions ...MessageEnvelope) BatchEnvelope {
+	return BatchEnvelope{
+		Atomic:  atomic,
+		Actions: actions,
+	}
+}
+
+// Envelope wraps the batch in an ACTION_BATCH message envelope.
+func (b *BatchEnvelope) Envelope() (MessageEnvelope, error) {
+	data, err := b.Serialize()
+	if err != nil {
+		return MessageEnvelope{}, err
+	}
+
+	return NewMessageEnvelope(ACTION_BATCH, DEFAULT_VERSION, data), nil
+}
+
+func (b *BatchEnvelope) Serialize() ([]byte, error) {
+	if len(b.Actions) == 0 {
+		return nil, errors.New("batch has no actions")
+	}
+
+	if len(b.Actions) > MAX_BATCH_ACTIONS {
+		return nil, fmt.Errorf("batch has %d actions, at most %d are allowed", len(b.Actions), MAX_BATCH_ACTIONS)
+	}
+
+	var flags byte
+	if b.Atomic {
+		flags |= BATCH_FLAG_ATOMIC
+	}
+
+	buf := new(bytes.Buffer)
+	buf.WriteByte(flags)
+	buf.WriteByte(byte(len(b.Actions)))
+
+	for i, action := range b.Actions {
+		if action.IsBatch() {
+			return nil, fmt.Errorf("batch action %d is a nested batch", i)
+		}
+
+		if len(action.Data) > 0xFFFF {
+			return nil, fmt.Errorf("batch action %d is too large", i)
+		}
+
+		bufLength := make([]byte, 2)
+		binary.BigEndian.PutUint16(bufLength, uint16(len(action.Data)))
+
+		buf.WriteByte(action.Action)
+		buf.WriteByte(action.Version)
+		buf.Write(bufLength)
+		buf.Write(action.Data)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (b *BatchEnvelope) Deserialize(data []byte) error {
+	buf := bytes.NewBuffer(data)
+
+	flags, err := buf.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	if flags&^BATCH_FLAG_ATOMIC != 0 {
+		return fmt.Errorf("unknown batch flags: %x", flags)
+	}
+
+	count, err := buf.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return errors.New("batch has no actions")
+	}
+
+	actions := make([]MessageEnvelope, 0, count)
+	for i := 0; i < int(count); i++ {
+		header := buf.Next(4)
+		if len(header) != 4 {
+			return fmt.Errorf("batch action %d is truncated", i)
+		}
+
+		length := int(binary.BigEndian.Uint16(header[2:]))
+		actionData := buf.Next(length)
+		if len(actionData) != length {
+			return fmt.Errorf("batch action %d is truncated", i)
+		}
+
+		action := NewMessageEnvelope(header[0], header[1], bytes.Clone(actionData))
+		if action.IsBatch() {
+			return fmt.Errorf("batch action %d is a nested batch", i)
+		}
+
+		actions = append(actions, action)
+	}
+
+	if buf.Len() != 0 {
+		return errors.New("trailing data after batch actions")
+	}
+
+	b.Atomic = flags&BATCH_FLAG_ATOMIC != 0
+	b.Actions = actions
+	return nil
+}
@@ -0,0 +1,71 @@
+package protocol_test
+
+import (
+	"testing"
+
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"gotest.tools/assert"
+)
+
+func TestBatchEnvelopeRoundTrip(t *testing.T) {
+	batch := protocol.NewBatchEnvelope(true,
+		protocol.NewMessageEnvelope(protocol.ACTION_INVOICE, protocol.DEFAULT_VERSION, []byte{0x0a, 0x01, 0x41}),
+		protocol.NewMessageEnvelope(protocol.ACTION_PAYMENT, 2, []byte{}),
+	)
+
+	envelope, err := batch.Envelope()
+	assert.NilError(t, err)
+	assert.Assert(t, envelope.IsBatch())
+
+	message := protocol.MessageEnvelope{}
+	err = message.Deserialize(envelope.Serialize())
+	assert.NilError(t, err)
+	assert.Assert(t, message.IsFractalEngineMessage())
+	assert.Equal(t, message.Action, uint8(protocol.ACTION_BATCH))
+
+	decoded := protocol.BatchEnvelope{}
+	err = decoded.Deserialize(message.Data)
+	assert.NilError(t, err)
+	assert.Equal(t, decoded.Atomic, true)
+	assert.Equal(t, len(decoded.Actions), 2)
+
+	assert.Equal(t, decoded.Actions[0].Action, uint8(protocol.ACTION_INVOICE))
+	assert.Equal(t, decoded.Actions[0].Version, uint8(protocol.DEFAULT_VERSION))
+	assert.DeepEqual(t, decoded.Actions[0].Data, []byte{0x0a, 0x01, 0x41})
+	assert.Assert(t, decoded.Actions[0].IsFractalEngineMessage())
+
+	assert.Equal(t, decoded.Actions[1].Action, uint8(protocol.ACTION_PAYMENT))
+	assert.Equal(t, decoded.Actions[1].Version, uint8(2))
+	assert.Equal(t, len(decoded.Actions[1].Data), 0)
+}
+
+func TestBatchEnvelopeRejectsMalformedData(t *testing.T) {
+	cases := map[string][]byte{
+		"empty":         {},
+		"no actions":    {0x00, 0x00},
+		"unknown flags": {0x02, 0x01, protocol.ACTION_PAYMENT, 1, 0x00, 0x00},
+		"truncated":     {0x00, 0x01, protocol.ACTION_PAYMENT, 1, 0x00, 0x05, 0x01},
+		"nested batch":  {0x00, 0x01, protocol.ACTION_BATCH, 1, 0x00, 0x00},
+		"trailing data": {0x00, 0x01, protocol.ACTION_PAYMENT, 1, 0x00, 0x00, 0xFF},
+	}
+
+	for name, data := range cases {
+		batch := protocol.BatchEnvelope{}
+		err := batch.Deserialize(data)
+		assert.Assert(t, err != nil, name)
+	}
+}
+
+func TestBatchEnvelopeSerializeRejectsNestedBatch(t *testing.T) {
+	inner := protocol.NewBatchEnvelope(false, protocol.NewMessageEnvelope(protocol.ACTION_PAYMENT, 1, nil))
+	innerEnvelope, err := inner.Envelope()
+	assert.NilError(t, err)
+
+	outer := protocol.NewBatchEnvelope(false, innerEnvelope)
+	_, err = outer.Serialize()
+	assert.ErrorContains(t, err, "nested batch")
+
+	empty := protocol.NewBatchEnvelope(false)
+	_, err = empty.Serialize()
+	assert.ErrorContains(t, err, "no actions")
+}
@@ -0,0 +1,36 @@
+package protocol
+
+import (
+	"encoding/hex"
+	"log"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func NewBurnTransactionEnvelope(hash string, mintHash string, quantity int32, action uint8) MessageEnvelope {
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		log.Println("Failed to decode hash:", err)
+		return MessageEnvelope{}
+	}
+
+	mintHashBytes, err := hex.DecodeString(mintHash)
+	if err != nil {
+		log.Println("Failed to decode hash:", err)
+		return MessageEnvelope{}
+	}
+
+	message := &OnChainBurnMessage{
+		Version:  DEFAULT_VERSION,
+		BurnHash: hashBytes,
+		MintHash: mintHashBytes,
+		Quantity: quantity,
+	}
+
+	protoBytes, err := proto.Marshal(message)
+	if err != nil {
+		return MessageEnvelope{}
+	}
+
+	return NewMessageEnvelope(action, DEFAULT_VERSION, protoBytes)
+}
@@ -0,0 +1,323 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.1
+// source: pkg/protocol/burn.proto
+
+package protocol
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is what gets written to the OP_RETURN on the L1
+type OnChainBurnMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	BurnHash      []byte                 `protobuf:"bytes,2,opt,name=burn_hash,json=burnHash,proto3" json:"burn_hash,omitempty"`
+	MintHash      []byte                 `protobuf:"bytes,3,opt,name=mint_hash,json=mintHash,proto3" json:"mint_hash,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnChainBurnMessage) Reset() {
+	*x = OnChainBurnMessage{}
+	mi := &file_pkg_protocol_burn_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnChainBurnMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnChainBurnMessage) ProtoMessage() {}
+
+func (x *OnChainBurnMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_burn_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnChainBurnMessage.ProtoReflect.Descriptor instead.
+func (*OnChainBurnMessage) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_burn_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OnChainBurnMessage) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *OnChainBurnMessage) GetBurnHash() []byte {
+	if x != nil {
+		return x.BurnHash
+	}
+	return nil
+}
+
+func (x *OnChainBurnMessage) GetMintHash() []byte {
+	if x != nil {
+		return x.MintHash
+	}
+	return nil
+}
+
+func (x *OnChainBurnMessage) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Asset manager co-signature for a burn, gossiped so every node can verify it
+type BurnSignatureMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BurnHash      string                 `protobuf:"bytes,1,opt,name=burn_hash,json=burnHash,proto3" json:"burn_hash,omitempty"`
+	MintHash      string                 `protobuf:"bytes,2,opt,name=mint_hash,json=mintHash,proto3" json:"mint_hash,omitempty"`
+	OwnerAddress  string                 `protobuf:"bytes,3,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Signature     string                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,6,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BurnSignatureMessage) Reset() {
+	*x = BurnSignatureMessage{}
+	mi := &file_pkg_protocol_burn_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BurnSignatureMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurnSignatureMessage) ProtoMessage() {}
+
+func (x *BurnSignatureMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_burn_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BurnSignatureMessage.ProtoReflect.Descriptor instead.
+func (*BurnSignatureMessage) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_burn_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BurnSignatureMessage) GetBurnHash() string {
+	if x != nil {
+		return x.BurnHash
+	}
+	return ""
+}
+
+func (x *BurnSignatureMessage) GetMintHash() string {
+	if x != nil {
+		return x.MintHash
+	}
+	return ""
+}
+
+func (x *BurnSignatureMessage) GetOwnerAddress() string {
+	if x != nil {
+		return x.OwnerAddress
+	}
+	return ""
+}
+
+func (x *BurnSignatureMessage) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BurnSignatureMessage) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *BurnSignatureMessage) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *BurnSignatureMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type BurnSignatureMessageEnvelope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Payload       *BurnSignatureMessage  `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BurnSignatureMessageEnvelope) Reset() {
+	*x = BurnSignatureMessageEnvelope{}
+	mi := &file_pkg_protocol_burn_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BurnSignatureMessageEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurnSignatureMessageEnvelope) ProtoMessage() {}
+
+func (x *BurnSignatureMessageEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_burn_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BurnSignatureMessageEnvelope.ProtoReflect.Descriptor instead.
+func (*BurnSignatureMessageEnvelope) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_burn_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BurnSignatureMessageEnvelope) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *BurnSignatureMessageEnvelope) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *BurnSignatureMessageEnvelope) GetPayload() *BurnSignatureMessage {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+var File_pkg_protocol_burn_proto protoreflect.FileDescriptor
+
+const file_pkg_protocol_burn_proto_rawDesc = "" +
+	"\n" +
+	"\x17pkg/protocol/burn.proto\x12\rfractalengine\x1a\x1fgoogle/protobuf/timestamp.proto\"\x84\x01\n" +
+	"\x12OnChainBurnMessage\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1b\n" +
+	"\tburn_hash\x18\x02 \x01(\fR\bburnHash\x12\x1b\n" +
+	"\tmint_hash\x18\x03 \x01(\fR\bmintHash\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\"\x89\x02\n" +
+	"\x14BurnSignatureMessage\x12\x1b\n" +
+	"\tburn_hash\x18\x01 \x01(\tR\bburnHash\x12\x1b\n" +
+	"\tmint_hash\x18\x02 \x01(\tR\bmintHash\x12#\n" +
+	"\rowner_address\x18\x03 \x01(\tR\fownerAddress\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\tR\tsignature\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x06 \x01(\tR\tpublicKey\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8b\x01\n" +
+	"\x1cBurnSignatureMessageEnvelope\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\x05R\x04type\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12=\n" +
+	"\apayload\x18\x03 \x01(\v2#.fractalengine.BurnSignatureMessageR\apayloadB\x0eZ\fpkg/protocolb\x06proto3"
+
+var (
+	file_pkg_protocol_burn_proto_rawDescOnce sync.Once
+	file_pkg_protocol_burn_proto_rawDescData []byte
+)
+
+func file_pkg_protocol_burn_proto_rawDescGZIP() []byte {
+	file_pkg_protocol_burn_proto_rawDescOnce.Do(func() {
+		file_pkg_protocol_burn_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_protocol_burn_proto_rawDesc), len(file_pkg_protocol_burn_proto_rawDesc)))
+	})
+	return file_pkg_protocol_burn_proto_rawDescData
+}
+
+var file_pkg_protocol_burn_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pkg_protocol_burn_proto_goTypes = []any{
+	(*OnChainBurnMessage)(nil),           // 0: fractalengine.OnChainBurnMessage
+	(*BurnSignatureMessage)(nil),         // 1: fractalengine.BurnSignatureMessage
+	(*BurnSignatureMessageEnvelope)(nil), // 2: fractalengine.BurnSignatureMessageEnvelope
+	(*timestamppb.Timestamp)(nil),        // 3: google.protobuf.Timestamp
+}
+var file_pkg_protocol_burn_proto_depIdxs = []int32{
+	3, // 0: fractalengine.BurnSignatureMessage.created_at:type_name -> google.protobuf.Timestamp
+	1, // 1: fractalengine.BurnSignatureMessageEnvelope.payload:type_name -> fractalengine.BurnSignatureMessage
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_pkg_protocol_burn_proto_init() }
+func file_pkg_protocol_burn_proto_init() {
+	if File_pkg_protocol_burn_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_protocol_burn_proto_rawDesc), len(file_pkg_protocol_burn_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_protocol_burn_proto_goTypes,
+		DependencyIndexes: file_pkg_protocol_burn_proto_depIdxs,
+		MessageInfos:      file_pkg_protocol_burn_proto_msgTypes,
+	}.Build()
+	File_pkg_protocol_burn_proto = out.File
+	file_pkg_protocol_burn_proto_goTypes = nil
+	file_pkg_protocol_burn_proto_depIdxs = nil
+}
@@ -0,0 +1,36 @@
+package protocol
+
+import (
+	"encoding/hex"
+	"log"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// NewDistributionPaymentTransactionEnvelope is written on chain with every payout of a
+// distribution, whichever holders the transaction pays.
+func NewDistributionPaymentTransactionEnvelope(distributionHash string, mintHash string, action uint8) MessageEnvelope {
+	distributionHashBytes, err := hex.DecodeString(distributionHash)
+	if err != nil {
+		log.Println("Failed to decode hash:", err)
+		return MessageEnvelope{}
+	}
+
+	mintHashBytes, err := hex.DecodeString(mintHash)
+	if err != nil {
+		log.Println("Failed to decode hash:", err)
+		return MessageEnvelope{}
+	}
+
+	message := &OnChainDistributionPaymentMessage{
+		DistributionHash: distributionHashBytes,
+		MintHash:         mintHashBytes,
+	}
+
+	protoBytes, err := proto.Marshal(message)
+	if err != nil {
+		return MessageEnvelope{}
+	}
+
+	return NewMessageEnvelope(action, DEFAULT_VERSION, protoBytes)
+}
@@ -0,0 +1,307 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.1
+// source: pkg/protocol/distribution.proto
+
+package protocol
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is what gets written to the OP_RETURN of a payout to fraction holders
+type OnChainDistributionPaymentMessage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DistributionHash []byte                 `protobuf:"bytes,1,opt,name=distribution_hash,json=distributionHash,proto3" json:"distribution_hash,omitempty"`
+	MintHash         []byte                 `protobuf:"bytes,2,opt,name=mint_hash,json=mintHash,proto3" json:"mint_hash,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OnChainDistributionPaymentMessage) Reset() {
+	*x = OnChainDistributionPaymentMessage{}
+	mi := &file_pkg_protocol_distribution_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnChainDistributionPaymentMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnChainDistributionPaymentMessage) ProtoMessage() {}
+
+func (x *OnChainDistributionPaymentMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_distribution_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnChainDistributionPaymentMessage.ProtoReflect.Descriptor instead.
+func (*OnChainDistributionPaymentMessage) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_distribution_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OnChainDistributionPaymentMessage) GetDistributionHash() []byte {
+	if x != nil {
+		return x.DistributionHash
+	}
+	return nil
+}
+
+func (x *OnChainDistributionPaymentMessage) GetMintHash() []byte {
+	if x != nil {
+		return x.MintHash
+	}
+	return nil
+}
+
+// Distribution declared by the mint owner or an asset manager, gossiped so every node
+// can compute the same entitlements
+type DistributionMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	MintHash      string                 `protobuf:"bytes,2,opt,name=mint_hash,json=mintHash,proto3" json:"mint_hash,omitempty"`
+	TotalKoinu    int64                  `protobuf:"varint,3,opt,name=total_koinu,json=totalKoinu,proto3" json:"total_koinu,omitempty"`
+	RecordHeight  int64                  `protobuf:"varint,4,opt,name=record_height,json=recordHeight,proto3" json:"record_height,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DistributionMessage) Reset() {
+	*x = DistributionMessage{}
+	mi := &file_pkg_protocol_distribution_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DistributionMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistributionMessage) ProtoMessage() {}
+
+func (x *DistributionMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_distribution_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistributionMessage.ProtoReflect.Descriptor instead.
+func (*DistributionMessage) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_distribution_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DistributionMessage) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *DistributionMessage) GetMintHash() string {
+	if x != nil {
+		return x.MintHash
+	}
+	return ""
+}
+
+func (x *DistributionMessage) GetTotalKoinu() int64 {
+	if x != nil {
+		return x.TotalKoinu
+	}
+	return 0
+}
+
+func (x *DistributionMessage) GetRecordHeight() int64 {
+	if x != nil {
+		return x.RecordHeight
+	}
+	return 0
+}
+
+func (x *DistributionMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type DistributionMessageEnvelope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Payload       *DistributionMessage   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature     string                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DistributionMessageEnvelope) Reset() {
+	*x = DistributionMessageEnvelope{}
+	mi := &file_pkg_protocol_distribution_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DistributionMessageEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistributionMessageEnvelope) ProtoMessage() {}
+
+func (x *DistributionMessageEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_distribution_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistributionMessageEnvelope.ProtoReflect.Descriptor instead.
+func (*DistributionMessageEnvelope) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_distribution_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DistributionMessageEnvelope) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *DistributionMessageEnvelope) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DistributionMessageEnvelope) GetPayload() *DistributionMessage {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DistributionMessageEnvelope) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *DistributionMessageEnvelope) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+var File_pkg_protocol_distribution_proto protoreflect.FileDescriptor
+
+const file_pkg_protocol_distribution_proto_rawDesc = "" +
+	"\n" +
+	"\x1fpkg/protocol/distribution.proto\x12\rfractalengine\x1a\x1fgoogle/protobuf/timestamp.proto\"m\n" +
+	"!OnChainDistributionPaymentMessage\x12+\n" +
+	"\x11distribution_hash\x18\x01 \x01(\fR\x10distributionHash\x12\x1b\n" +
+	"\tmint_hash\x18\x02 \x01(\fR\bmintHash\"\xc7\x01\n" +
+	"\x13DistributionMessage\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x1b\n" +
+	"\tmint_hash\x18\x02 \x01(\tR\bmintHash\x12\x1f\n" +
+	"\vtotal_koinu\x18\x03 \x01(\x03R\n" +
+	"totalKoinu\x12#\n" +
+	"\rrecord_height\x18\x04 \x01(\x03R\frecordHeight\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc6\x01\n" +
+	"\x1bDistributionMessageEnvelope\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\x05R\x04type\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12<\n" +
+	"\apayload\x18\x03 \x01(\v2\".fractalengine.DistributionMessageR\apayload\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x04 \x01(\tR\tpublicKey\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\tR\tsignatureB\x0eZ\fpkg/protocolb\x06proto3"
+
+var (
+	file_pkg_protocol_distribution_proto_rawDescOnce sync.Once
+	file_pkg_protocol_distribution_proto_rawDescData []byte
+)
+
+func file_pkg_protocol_distribution_proto_rawDescGZIP() []byte {
+	file_pkg_protocol_distribution_proto_rawDescOnce.Do(func() {
+		file_pkg_protocol_distribution_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_protocol_distribution_proto_rawDesc), len(file_pkg_protocol_distribution_proto_rawDesc)))
+	})
+	return file_pkg_protocol_distribution_proto_rawDescData
+}
+
+var file_pkg_protocol_distribution_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pkg_protocol_distribution_proto_goTypes = []any{
+	(*OnChainDistributionPaymentMessage)(nil), // 0: fractalengine.OnChainDistributionPaymentMessage
+	(*DistributionMessage)(nil),               // 1: fractalengine.DistributionMessage
+	(*DistributionMessageEnvelope)(nil),       // 2: fractalengine.DistributionMessageEnvelope
+	(*timestamppb.Timestamp)(nil),             // 3: google.protobuf.Timestamp
+}
+var file_pkg_protocol_distribution_proto_depIdxs = []int32{
+	3, // 0: fractalengine.DistributionMessage.created_at:type_name -> google.protobuf.Timestamp
+	1, // 1: fractalengine.DistributionMessageEnvelope.payload:type_name -> fractalengine.DistributionMessage
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_pkg_protocol_distribution_proto_init() }
+func file_pkg_protocol_distribution_proto_init() {
+	if File_pkg_protocol_distribution_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_protocol_distribution_proto_rawDesc), len(file_pkg_protocol_distribution_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_protocol_distribution_proto_goTypes,
+		DependencyIndexes: file_pkg_protocol_distribution_proto_depIdxs,
+		MessageInfos:      file_pkg_protocol_distribution_proto_msgTypes,
+	}.Build()
+	File_pkg_protocol_distribution_proto = out.File
+	file_pkg_protocol_distribution_proto_goTypes = nil
+	file_pkg_protocol_distribution_proto_depIdxs = nil
+}
@@ -3,7 +3,7 @@ package protocol
 import (
 	"bytes"
 	"encoding/hex"
-	"fmt"
+	"log"
 
 	"google.golang.org/protobuf/proto"
 )
@@ -13,23 +13,55 @@ type InvoiceTransaction struct {
 }
 
 func NewInvoiceTransactionEnvelope(hash string, mintHash string, quantity int32, action uint8) MessageEnvelope {
+	return NewExpiringInvoiceTransactionEnvelope(hash, mintHash, quantity, 0, action)
+}
+
+// NewExpiringInvoiceTransactionEnvelope writes an invoice that can no longer be paid
+// after the expiry height. An expiry height of 0 means the invoice does not expire.
+func NewExpiringInvoiceTransactionEnvelope(hash string, mintHash string, quantity int32, expiresAtHeight int64, action uint8) MessageEnvelope {
 	hashBytes, err := hex.DecodeString(hash)
 	if err != nil {
-		fmt.Errorf("Failed to decode hash: %s", err.Error())
+		log.Println("Failed to decode hash:", err)
 		return MessageEnvelope{}
 	}
 
 	mintHashBytes, err := hex.DecodeString(mintHash)
 	if err != nil {
-		fmt.Errorf("Failed to decode hash: %s", err.Error())
+		log.Println("Failed to decode hash:", err)
 		return MessageEnvelope{}
 	}
 
+	version := uint8(DEFAULT_VERSION)
+	if expiresAtHeight > 0 {
+		version = INVOICE_EXPIRY_VERSION
+	}
+
 	message := &OnChainInvoiceMessage{
+		Version:         int32(version),
+		InvoiceHash:     hashBytes,
+		MintHash:        mintHashBytes,
+		Quantity:        quantity,
+		ExpiresAtHeight: expiresAtHeight,
+	}
+
+	protoBytes, err := proto.Marshal(message)
+	if err != nil {
+		return MessageEnvelope{}
+	}
+
+	return NewMessageEnvelope(action, version, protoBytes)
+}
+
+func NewCancelInvoiceTransactionEnvelope(invoiceHash string, action uint8) MessageEnvelope {
+	invoiceHashBytes, err := hex.DecodeString(invoiceHash)
+	if err != nil {
+		log.Println("Failed to decode hash:", err)
+		return MessageEnvelope{}
+	}
+
+	message := &OnChainCancelInvoiceMessage{
 		Version:     DEFAULT_VERSION,
-		InvoiceHash: hashBytes,
-		MintHash:    mintHashBytes,
-		Quantity:    quantity,
+		InvoiceHash: invoiceHashBytes,
 	}
 
 	protoBytes, err := proto.Marshal(message)
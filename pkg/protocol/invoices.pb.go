@@ -24,13 +24,14 @@ const (
 
 // This is what gets written to the OP_RETURN on the L1
 type OnChainInvoiceMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	InvoiceHash   []byte                 `protobuf:"bytes,2,opt,name=invoice_hash,json=invoiceHash,proto3" json:"invoice_hash,omitempty"`
-	MintHash      []byte                 `protobuf:"bytes,3,opt,name=mint_hash,json=mintHash,proto3" json:"mint_hash,omitempty"`
-	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Version         int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	InvoiceHash     []byte                 `protobuf:"bytes,2,opt,name=invoice_hash,json=invoiceHash,proto3" json:"invoice_hash,omitempty"`
+	MintHash        []byte                 `protobuf:"bytes,3,opt,name=mint_hash,json=mintHash,proto3" json:"mint_hash,omitempty"`
+	Quantity        int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ExpiresAtHeight int64                  `protobuf:"varint,5,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *OnChainInvoiceMessage) Reset() {
@@ -91,6 +92,13 @@ func (x *OnChainInvoiceMessage) GetQuantity() int32 {
 	return 0
 }
 
+func (x *OnChainInvoiceMessage) GetExpiresAtHeight() int64 {
+	if x != nil {
+		return x.ExpiresAtHeight
+	}
+	return 0
+}
+
 // This is what gets gossiped + stored in the gossip mempool + confirmed_transactions
 type InvoiceMessageEnvelope struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -174,10 +182,15 @@ type InvoicePayload struct {
 	BuyerAddress   string                 `protobuf:"bytes,2,opt,name=buyer_address,json=buyerAddress,proto3" json:"buyer_address,omitempty"`
 	MintHash       string                 `protobuf:"bytes,4,opt,name=mint_hash,json=mintHash,proto3" json:"mint_hash,omitempty"`
 	Quantity       int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Price          int32                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
-	SellerAddress  string                 `protobuf:"bytes,7,opt,name=seller_address,json=sellerAddress,proto3" json:"seller_address,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Whole DOGE per fraction, only set by peers from before prices were in koinu
+	Price           int32           `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+	SellerAddress   string          `protobuf:"bytes,7,opt,name=seller_address,json=sellerAddress,proto3" json:"seller_address,omitempty"`
+	Splits          []*InvoiceSplit `protobuf:"bytes,8,rep,name=splits,proto3" json:"splits,omitempty"`
+	ExpiresAtHeight int64           `protobuf:"varint,9,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+	// Koinu per fraction
+	PriceKoinu    int64 `protobuf:"varint,10,opt,name=price_koinu,json=priceKoinu,proto3" json:"price_koinu,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InvoicePayload) Reset() {
@@ -252,6 +265,79 @@ func (x *InvoicePayload) GetSellerAddress() string {
 	return ""
 }
 
+func (x *InvoicePayload) GetSplits() []*InvoiceSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *InvoicePayload) GetExpiresAtHeight() int64 {
+	if x != nil {
+		return x.ExpiresAtHeight
+	}
+	return 0
+}
+
+func (x *InvoicePayload) GetPriceKoinu() int64 {
+	if x != nil {
+		return x.PriceKoinu
+	}
+	return 0
+}
+
+type InvoiceSplit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	BasisPoints   int32                  `protobuf:"varint,2,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvoiceSplit) Reset() {
+	*x = InvoiceSplit{}
+	mi := &file_pkg_protocol_invoices_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceSplit) ProtoMessage() {}
+
+func (x *InvoiceSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_invoices_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceSplit.ProtoReflect.Descriptor instead.
+func (*InvoiceSplit) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_invoices_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InvoiceSplit) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *InvoiceSplit) GetBasisPoints() int32 {
+	if x != nil {
+		return x.BasisPoints
+	}
+	return 0
+}
+
 type InvoiceMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -264,7 +350,7 @@ type InvoiceMessage struct {
 
 func (x *InvoiceMessage) Reset() {
 	*x = InvoiceMessage{}
-	mi := &file_pkg_protocol_invoices_proto_msgTypes[3]
+	mi := &file_pkg_protocol_invoices_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -276,7 +362,7 @@ func (x *InvoiceMessage) String() string {
 func (*InvoiceMessage) ProtoMessage() {}
 
 func (x *InvoiceMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_protocol_invoices_proto_msgTypes[3]
+	mi := &file_pkg_protocol_invoices_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -289,7 +375,7 @@ func (x *InvoiceMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvoiceMessage.ProtoReflect.Descriptor instead.
 func (*InvoiceMessage) Descriptor() ([]byte, []int) {
-	return file_pkg_protocol_invoices_proto_rawDescGZIP(), []int{3}
+	return file_pkg_protocol_invoices_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *InvoiceMessage) GetId() string {
@@ -332,7 +418,7 @@ type InvoiceSignatureMessage struct {
 
 func (x *InvoiceSignatureMessage) Reset() {
 	*x = InvoiceSignatureMessage{}
-	mi := &file_pkg_protocol_invoices_proto_msgTypes[4]
+	mi := &file_pkg_protocol_invoices_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -344,7 +430,7 @@ func (x *InvoiceSignatureMessage) String() string {
 func (*InvoiceSignatureMessage) ProtoMessage() {}
 
 func (x *InvoiceSignatureMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_protocol_invoices_proto_msgTypes[4]
+	mi := &file_pkg_protocol_invoices_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -357,7 +443,7 @@ func (x *InvoiceSignatureMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvoiceSignatureMessage.ProtoReflect.Descriptor instead.
 func (*InvoiceSignatureMessage) Descriptor() ([]byte, []int) {
-	return file_pkg_protocol_invoices_proto_rawDescGZIP(), []int{4}
+	return file_pkg_protocol_invoices_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *InvoiceSignatureMessage) GetInvoiceHash() string {
@@ -399,7 +485,7 @@ type InvoiceSignatureMessageEnvelope struct {
 
 func (x *InvoiceSignatureMessageEnvelope) Reset() {
 	*x = InvoiceSignatureMessageEnvelope{}
-	mi := &file_pkg_protocol_invoices_proto_msgTypes[5]
+	mi := &file_pkg_protocol_invoices_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -411,7 +497,7 @@ func (x *InvoiceSignatureMessageEnvelope) String() string {
 func (*InvoiceSignatureMessageEnvelope) ProtoMessage() {}
 
 func (x *InvoiceSignatureMessageEnvelope) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_protocol_invoices_proto_msgTypes[5]
+	mi := &file_pkg_protocol_invoices_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -424,7 +510,7 @@ func (x *InvoiceSignatureMessageEnvelope) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvoiceSignatureMessageEnvelope.ProtoReflect.Descriptor instead.
 func (*InvoiceSignatureMessageEnvelope) Descriptor() ([]byte, []int) {
-	return file_pkg_protocol_invoices_proto_rawDescGZIP(), []int{5}
+	return file_pkg_protocol_invoices_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *InvoiceSignatureMessageEnvelope) GetType() int32 {
@@ -448,30 +534,212 @@ func (x *InvoiceSignatureMessageEnvelope) GetPayload() *InvoiceSignatureMessage
 	return nil
 }
 
+// This is what gets written to the OP_RETURN on the L1 to cancel an invoice
+type OnChainCancelInvoiceMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	InvoiceHash   []byte                 `protobuf:"bytes,2,opt,name=invoice_hash,json=invoiceHash,proto3" json:"invoice_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnChainCancelInvoiceMessage) Reset() {
+	*x = OnChainCancelInvoiceMessage{}
+	mi := &file_pkg_protocol_invoices_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnChainCancelInvoiceMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnChainCancelInvoiceMessage) ProtoMessage() {}
+
+func (x *OnChainCancelInvoiceMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_invoices_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnChainCancelInvoiceMessage.ProtoReflect.Descriptor instead.
+func (*OnChainCancelInvoiceMessage) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_invoices_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OnChainCancelInvoiceMessage) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *OnChainCancelInvoiceMessage) GetInvoiceHash() []byte {
+	if x != nil {
+		return x.InvoiceHash
+	}
+	return nil
+}
+
+type CancelInvoiceMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceHash   string                 `protobuf:"bytes,1,opt,name=invoice_hash,json=invoiceHash,proto3" json:"invoice_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelInvoiceMessage) Reset() {
+	*x = CancelInvoiceMessage{}
+	mi := &file_pkg_protocol_invoices_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelInvoiceMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInvoiceMessage) ProtoMessage() {}
+
+func (x *CancelInvoiceMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_invoices_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelInvoiceMessage.ProtoReflect.Descriptor instead.
+func (*CancelInvoiceMessage) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_invoices_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelInvoiceMessage) GetInvoiceHash() string {
+	if x != nil {
+		return x.InvoiceHash
+	}
+	return ""
+}
+
+type CancelInvoiceMessageEnvelope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Payload       *CancelInvoiceMessage  `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature     string                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelInvoiceMessageEnvelope) Reset() {
+	*x = CancelInvoiceMessageEnvelope{}
+	mi := &file_pkg_protocol_invoices_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelInvoiceMessageEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInvoiceMessageEnvelope) ProtoMessage() {}
+
+func (x *CancelInvoiceMessageEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_invoices_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelInvoiceMessageEnvelope.ProtoReflect.Descriptor instead.
+func (*CancelInvoiceMessageEnvelope) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_invoices_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelInvoiceMessageEnvelope) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *CancelInvoiceMessageEnvelope) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CancelInvoiceMessageEnvelope) GetPayload() *CancelInvoiceMessage {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *CancelInvoiceMessageEnvelope) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *CancelInvoiceMessageEnvelope) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
 var File_pkg_protocol_invoices_proto protoreflect.FileDescriptor
 
 const file_pkg_protocol_invoices_proto_rawDesc = "" +
 	"\n" +
-	"\x1bpkg/protocol/invoices.proto\x12\rfractalengine\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8d\x01\n" +
+	"\x1bpkg/protocol/invoices.proto\x12\rfractalengine\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb9\x01\n" +
 	"\x15OnChainInvoiceMessage\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\x05R\aversion\x12!\n" +
 	"\finvoice_hash\x18\x02 \x01(\fR\vinvoiceHash\x12\x1b\n" +
 	"\tmint_hash\x18\x03 \x01(\fR\bmintHash\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x05R\bquantity\"\xbc\x01\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12*\n" +
+	"\x11expires_at_height\x18\x05 \x01(\x03R\x0fexpiresAtHeight\"\xbc\x01\n" +
 	"\x16InvoiceMessageEnvelope\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\x05R\x04type\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\x05R\aversion\x127\n" +
 	"\apayload\x18\x03 \x01(\v2\x1d.fractalengine.InvoiceMessageR\apayload\x12\x1d\n" +
 	"\n" +
 	"public_key\x18\x04 \x01(\tR\tpublicKey\x12\x1c\n" +
-	"\tsignature\x18\x05 \x01(\tR\tsignature\"\xd4\x01\n" +
+	"\tsignature\x18\x05 \x01(\tR\tsignature\"\xd6\x02\n" +
 	"\x0eInvoicePayload\x12'\n" +
 	"\x0fpayment_address\x18\x01 \x01(\tR\x0epaymentAddress\x12#\n" +
 	"\rbuyer_address\x18\x02 \x01(\tR\fbuyerAddress\x12\x1b\n" +
 	"\tmint_hash\x18\x04 \x01(\tR\bmintHash\x12\x1a\n" +
 	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x14\n" +
 	"\x05price\x18\x06 \x01(\x05R\x05price\x12%\n" +
-	"\x0eseller_address\x18\a \x01(\tR\rsellerAddress\"\xa8\x01\n" +
+	"\x0eseller_address\x18\a \x01(\tR\rsellerAddress\x123\n" +
+	"\x06splits\x18\b \x03(\v2\x1b.fractalengine.InvoiceSplitR\x06splits\x12*\n" +
+	"\x11expires_at_height\x18\t \x01(\x03R\x0fexpiresAtHeight\x12\x1f\n" +
+	"\vprice_koinu\x18\n" +
+	" \x01(\x03R\n" +
+	"priceKoinu\"K\n" +
+	"\fInvoiceSplit\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12!\n" +
+	"\fbasis_points\x18\x02 \x01(\x05R\vbasisPoints\"\xa8\x01\n" +
 	"\x0eInvoiceMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04hash\x18\x02 \x01(\tR\x04hash\x127\n" +
@@ -488,7 +756,19 @@ const file_pkg_protocol_invoices_proto_rawDesc = "" +
 	"\x1fInvoiceSignatureMessageEnvelope\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\x05R\x04type\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\x05R\aversion\x12@\n" +
-	"\apayload\x18\x03 \x01(\v2&.fractalengine.InvoiceSignatureMessageR\apayloadB\x0eZ\fpkg/protocolb\x06proto3"
+	"\apayload\x18\x03 \x01(\v2&.fractalengine.InvoiceSignatureMessageR\apayload\"Z\n" +
+	"\x1bOnChainCancelInvoiceMessage\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12!\n" +
+	"\finvoice_hash\x18\x02 \x01(\fR\vinvoiceHash\"9\n" +
+	"\x14CancelInvoiceMessage\x12!\n" +
+	"\finvoice_hash\x18\x01 \x01(\tR\vinvoiceHash\"\xc8\x01\n" +
+	"\x1cCancelInvoiceMessageEnvelope\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\x05R\x04type\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12=\n" +
+	"\apayload\x18\x03 \x01(\v2#.fractalengine.CancelInvoiceMessageR\apayload\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x04 \x01(\tR\tpublicKey\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\tR\tsignatureB\x0eZ\fpkg/protocolb\x06proto3"
 
 var (
 	file_pkg_protocol_invoices_proto_rawDescOnce sync.Once
@@ -502,27 +782,33 @@ func file_pkg_protocol_invoices_proto_rawDescGZIP() []byte {
 	return file_pkg_protocol_invoices_proto_rawDescData
 }
 
-var file_pkg_protocol_invoices_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_pkg_protocol_invoices_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_pkg_protocol_invoices_proto_goTypes = []any{
 	(*OnChainInvoiceMessage)(nil),           // 0: fractalengine.OnChainInvoiceMessage
 	(*InvoiceMessageEnvelope)(nil),          // 1: fractalengine.InvoiceMessageEnvelope
 	(*InvoicePayload)(nil),                  // 2: fractalengine.InvoicePayload
-	(*InvoiceMessage)(nil),                  // 3: fractalengine.InvoiceMessage
-	(*InvoiceSignatureMessage)(nil),         // 4: fractalengine.InvoiceSignatureMessage
-	(*InvoiceSignatureMessageEnvelope)(nil), // 5: fractalengine.InvoiceSignatureMessageEnvelope
-	(*timestamppb.Timestamp)(nil),           // 6: google.protobuf.Timestamp
+	(*InvoiceSplit)(nil),                    // 3: fractalengine.InvoiceSplit
+	(*InvoiceMessage)(nil),                  // 4: fractalengine.InvoiceMessage
+	(*InvoiceSignatureMessage)(nil),         // 5: fractalengine.InvoiceSignatureMessage
+	(*InvoiceSignatureMessageEnvelope)(nil), // 6: fractalengine.InvoiceSignatureMessageEnvelope
+	(*OnChainCancelInvoiceMessage)(nil),     // 7: fractalengine.OnChainCancelInvoiceMessage
+	(*CancelInvoiceMessage)(nil),            // 8: fractalengine.CancelInvoiceMessage
+	(*CancelInvoiceMessageEnvelope)(nil),    // 9: fractalengine.CancelInvoiceMessageEnvelope
+	(*timestamppb.Timestamp)(nil),           // 10: google.protobuf.Timestamp
 }
 var file_pkg_protocol_invoices_proto_depIdxs = []int32{
-	3, // 0: fractalengine.InvoiceMessageEnvelope.payload:type_name -> fractalengine.InvoiceMessage
-	2, // 1: fractalengine.InvoiceMessage.payload:type_name -> fractalengine.InvoicePayload
-	6, // 2: fractalengine.InvoiceMessage.created_at:type_name -> google.protobuf.Timestamp
-	6, // 3: fractalengine.InvoiceSignatureMessage.created_at:type_name -> google.protobuf.Timestamp
-	4, // 4: fractalengine.InvoiceSignatureMessageEnvelope.payload:type_name -> fractalengine.InvoiceSignatureMessage
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	4,  // 0: fractalengine.InvoiceMessageEnvelope.payload:type_name -> fractalengine.InvoiceMessage
+	3,  // 1: fractalengine.InvoicePayload.splits:type_name -> fractalengine.InvoiceSplit
+	2,  // 2: fractalengine.InvoiceMessage.payload:type_name -> fractalengine.InvoicePayload
+	10, // 3: fractalengine.InvoiceMessage.created_at:type_name -> google.protobuf.Timestamp
+	10, // 4: fractalengine.InvoiceSignatureMessage.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 5: fractalengine.InvoiceSignatureMessageEnvelope.payload:type_name -> fractalengine.InvoiceSignatureMessage
+	8,  // 6: fractalengine.CancelInvoiceMessageEnvelope.payload:type_name -> fractalengine.CancelInvoiceMessage
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_pkg_protocol_invoices_proto_init() }
@@ -536,7 +822,7 @@ func file_pkg_protocol_invoices_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_protocol_invoices_proto_rawDesc), len(file_pkg_protocol_invoices_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
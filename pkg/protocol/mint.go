@@ -1,6 +1,9 @@
 package protocol
 
 import (
+	"encoding/hex"
+	"log"
+
 	"google.golang.org/protobuf/proto"
 )
 
@@ -16,3 +19,55 @@ func NewMintTransactionEnvelope(hash string, action uint8) MessageEnvelope {
 
 	return NewMessageEnvelope(action, DEFAULT_VERSION, protoBytes)
 }
+
+func NewMintAmendmentTransactionEnvelope(amendmentHash string, mintHash string, action uint8) MessageEnvelope {
+	amendmentHashBytes, err := hex.DecodeString(amendmentHash)
+	if err != nil {
+		log.Println("Failed to decode hash:", err)
+		return MessageEnvelope{}
+	}
+
+	mintHashBytes, err := hex.DecodeString(mintHash)
+	if err != nil {
+		log.Println("Failed to decode hash:", err)
+		return MessageEnvelope{}
+	}
+
+	message := &OnChainMintAmendmentMessage{
+		AmendmentHash: amendmentHashBytes,
+		MintHash:      mintHashBytes,
+	}
+
+	protoBytes, err := proto.Marshal(message)
+	if err != nil {
+		return MessageEnvelope{}
+	}
+
+	return NewMessageEnvelope(action, DEFAULT_VERSION, protoBytes)
+}
+
+func NewMintOwnershipTransferTransactionEnvelope(transferHash string, mintHash string, action uint8) MessageEnvelope {
+	transferHashBytes, err := hex.DecodeString(transferHash)
+	if err != nil {
+		log.Println("Failed to decode hash:", err)
+		return MessageEnvelope{}
+	}
+
+	mintHashBytes, err := hex.DecodeString(mintHash)
+	if err != nil {
+		log.Println("Failed to decode hash:", err)
+		return MessageEnvelope{}
+	}
+
+	message := &OnChainMintOwnershipTransferMessage{
+		TransferHash: transferHashBytes,
+		MintHash:     mintHashBytes,
+	}
+
+	protoBytes, err := proto.Marshal(message)
+	if err != nil {
+		return MessageEnvelope{}
+	}
+
+	return NewMessageEnvelope(action, DEFAULT_VERSION, protoBytes)
+}
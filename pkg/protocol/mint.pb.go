@@ -378,6 +378,603 @@ func (x *MintMessage) GetMinSignatures() int32 {
 	return 0
 }
 
+// This is what gets written to the OP_RETURN on the L1 to apply a mint amendment
+type OnChainMintAmendmentMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AmendmentHash []byte                 `protobuf:"bytes,1,opt,name=amendment_hash,json=amendmentHash,proto3" json:"amendment_hash,omitempty"`
+	MintHash      []byte                 `protobuf:"bytes,2,opt,name=mint_hash,json=mintHash,proto3" json:"mint_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnChainMintAmendmentMessage) Reset() {
+	*x = OnChainMintAmendmentMessage{}
+	mi := &file_pkg_protocol_mint_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnChainMintAmendmentMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnChainMintAmendmentMessage) ProtoMessage() {}
+
+func (x *OnChainMintAmendmentMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_mint_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnChainMintAmendmentMessage.ProtoReflect.Descriptor instead.
+func (*OnChainMintAmendmentMessage) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_mint_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OnChainMintAmendmentMessage) GetAmendmentHash() []byte {
+	if x != nil {
+		return x.AmendmentHash
+	}
+	return nil
+}
+
+func (x *OnChainMintAmendmentMessage) GetMintHash() []byte {
+	if x != nil {
+		return x.MintHash
+	}
+	return nil
+}
+
+// Amended fields of a confirmed mint, signed by the mint owner
+type MintAmendmentMessage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Hash           string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	MintHash       string                 `protobuf:"bytes,2,opt,name=mint_hash,json=mintHash,proto3" json:"mint_hash,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Metadata       *structpb.Struct       `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	FeedUrl        string                 `protobuf:"bytes,5,opt,name=feed_url,json=feedUrl,proto3" json:"feed_url,omitempty"`
+	ContractOfSale string                 `protobuf:"bytes,6,opt,name=contract_of_sale,json=contractOfSale,proto3" json:"contract_of_sale,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MintAmendmentMessage) Reset() {
+	*x = MintAmendmentMessage{}
+	mi := &file_pkg_protocol_mint_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintAmendmentMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintAmendmentMessage) ProtoMessage() {}
+
+func (x *MintAmendmentMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_mint_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintAmendmentMessage.ProtoReflect.Descriptor instead.
+func (*MintAmendmentMessage) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_mint_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MintAmendmentMessage) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *MintAmendmentMessage) GetMintHash() string {
+	if x != nil {
+		return x.MintHash
+	}
+	return ""
+}
+
+func (x *MintAmendmentMessage) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MintAmendmentMessage) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *MintAmendmentMessage) GetFeedUrl() string {
+	if x != nil {
+		return x.FeedUrl
+	}
+	return ""
+}
+
+func (x *MintAmendmentMessage) GetContractOfSale() string {
+	if x != nil {
+		return x.ContractOfSale
+	}
+	return ""
+}
+
+func (x *MintAmendmentMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type MintAmendmentMessageEnvelope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Payload       *MintAmendmentMessage  `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature     string                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintAmendmentMessageEnvelope) Reset() {
+	*x = MintAmendmentMessageEnvelope{}
+	mi := &file_pkg_protocol_mint_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintAmendmentMessageEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintAmendmentMessageEnvelope) ProtoMessage() {}
+
+func (x *MintAmendmentMessageEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_mint_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintAmendmentMessageEnvelope.ProtoReflect.Descriptor instead.
+func (*MintAmendmentMessageEnvelope) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_mint_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MintAmendmentMessageEnvelope) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *MintAmendmentMessageEnvelope) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *MintAmendmentMessageEnvelope) GetPayload() *MintAmendmentMessage {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *MintAmendmentMessageEnvelope) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *MintAmendmentMessageEnvelope) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+// Asset manager co-signature for a mint amendment, gossiped so every node can verify it
+type MintAmendmentSignatureMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AmendmentHash string                 `protobuf:"bytes,1,opt,name=amendment_hash,json=amendmentHash,proto3" json:"amendment_hash,omitempty"`
+	MintHash      string                 `protobuf:"bytes,2,opt,name=mint_hash,json=mintHash,proto3" json:"mint_hash,omitempty"`
+	Signature     string                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintAmendmentSignatureMessage) Reset() {
+	*x = MintAmendmentSignatureMessage{}
+	mi := &file_pkg_protocol_mint_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintAmendmentSignatureMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintAmendmentSignatureMessage) ProtoMessage() {}
+
+func (x *MintAmendmentSignatureMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_mint_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintAmendmentSignatureMessage.ProtoReflect.Descriptor instead.
+func (*MintAmendmentSignatureMessage) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_mint_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MintAmendmentSignatureMessage) GetAmendmentHash() string {
+	if x != nil {
+		return x.AmendmentHash
+	}
+	return ""
+}
+
+func (x *MintAmendmentSignatureMessage) GetMintHash() string {
+	if x != nil {
+		return x.MintHash
+	}
+	return ""
+}
+
+func (x *MintAmendmentSignatureMessage) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *MintAmendmentSignatureMessage) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *MintAmendmentSignatureMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type MintAmendmentSignatureMessageEnvelope struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Type          int32                          `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Version       int32                          `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Payload       *MintAmendmentSignatureMessage `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintAmendmentSignatureMessageEnvelope) Reset() {
+	*x = MintAmendmentSignatureMessageEnvelope{}
+	mi := &file_pkg_protocol_mint_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintAmendmentSignatureMessageEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintAmendmentSignatureMessageEnvelope) ProtoMessage() {}
+
+func (x *MintAmendmentSignatureMessageEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_mint_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintAmendmentSignatureMessageEnvelope.ProtoReflect.Descriptor instead.
+func (*MintAmendmentSignatureMessageEnvelope) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_mint_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MintAmendmentSignatureMessageEnvelope) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *MintAmendmentSignatureMessageEnvelope) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *MintAmendmentSignatureMessageEnvelope) GetPayload() *MintAmendmentSignatureMessage {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// This is what gets written to the OP_RETURN on the L1 to hand a mint over to a new owner
+type OnChainMintOwnershipTransferMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferHash  []byte                 `protobuf:"bytes,1,opt,name=transfer_hash,json=transferHash,proto3" json:"transfer_hash,omitempty"`
+	MintHash      []byte                 `protobuf:"bytes,2,opt,name=mint_hash,json=mintHash,proto3" json:"mint_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnChainMintOwnershipTransferMessage) Reset() {
+	*x = OnChainMintOwnershipTransferMessage{}
+	mi := &file_pkg_protocol_mint_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnChainMintOwnershipTransferMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnChainMintOwnershipTransferMessage) ProtoMessage() {}
+
+func (x *OnChainMintOwnershipTransferMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_mint_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnChainMintOwnershipTransferMessage.ProtoReflect.Descriptor instead.
+func (*OnChainMintOwnershipTransferMessage) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_mint_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OnChainMintOwnershipTransferMessage) GetTransferHash() []byte {
+	if x != nil {
+		return x.TransferHash
+	}
+	return nil
+}
+
+func (x *OnChainMintOwnershipTransferMessage) GetMintHash() []byte {
+	if x != nil {
+		return x.MintHash
+	}
+	return nil
+}
+
+// New owner and asset managers of a confirmed mint, signed by the current and the new owner
+type MintOwnershipTransferMessage struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Hash                     string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	MintHash                 string                 `protobuf:"bytes,2,opt,name=mint_hash,json=mintHash,proto3" json:"mint_hash,omitempty"`
+	NewOwnerAddress          string                 `protobuf:"bytes,3,opt,name=new_owner_address,json=newOwnerAddress,proto3" json:"new_owner_address,omitempty"`
+	NewPublicKey             string                 `protobuf:"bytes,4,opt,name=new_public_key,json=newPublicKey,proto3" json:"new_public_key,omitempty"`
+	SignatureRequirementType string                 `protobuf:"bytes,5,opt,name=signature_requirement_type,json=signatureRequirementType,proto3" json:"signature_requirement_type,omitempty"`
+	AssetManagers            []*AssetManager        `protobuf:"bytes,6,rep,name=asset_managers,json=assetManagers,proto3" json:"asset_managers,omitempty"`
+	MinSignatures            int32                  `protobuf:"varint,7,opt,name=min_signatures,json=minSignatures,proto3" json:"min_signatures,omitempty"`
+	NewOwnerSignature        string                 `protobuf:"bytes,8,opt,name=new_owner_signature,json=newOwnerSignature,proto3" json:"new_owner_signature,omitempty"`
+	CreatedAt                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *MintOwnershipTransferMessage) Reset() {
+	*x = MintOwnershipTransferMessage{}
+	mi := &file_pkg_protocol_mint_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintOwnershipTransferMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintOwnershipTransferMessage) ProtoMessage() {}
+
+func (x *MintOwnershipTransferMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_mint_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintOwnershipTransferMessage.ProtoReflect.Descriptor instead.
+func (*MintOwnershipTransferMessage) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_mint_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MintOwnershipTransferMessage) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *MintOwnershipTransferMessage) GetMintHash() string {
+	if x != nil {
+		return x.MintHash
+	}
+	return ""
+}
+
+func (x *MintOwnershipTransferMessage) GetNewOwnerAddress() string {
+	if x != nil {
+		return x.NewOwnerAddress
+	}
+	return ""
+}
+
+func (x *MintOwnershipTransferMessage) GetNewPublicKey() string {
+	if x != nil {
+		return x.NewPublicKey
+	}
+	return ""
+}
+
+func (x *MintOwnershipTransferMessage) GetSignatureRequirementType() string {
+	if x != nil {
+		return x.SignatureRequirementType
+	}
+	return ""
+}
+
+func (x *MintOwnershipTransferMessage) GetAssetManagers() []*AssetManager {
+	if x != nil {
+		return x.AssetManagers
+	}
+	return nil
+}
+
+func (x *MintOwnershipTransferMessage) GetMinSignatures() int32 {
+	if x != nil {
+		return x.MinSignatures
+	}
+	return 0
+}
+
+func (x *MintOwnershipTransferMessage) GetNewOwnerSignature() string {
+	if x != nil {
+		return x.NewOwnerSignature
+	}
+	return ""
+}
+
+func (x *MintOwnershipTransferMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type MintOwnershipTransferMessageEnvelope struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Type          int32                         `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Version       int32                         `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Payload       *MintOwnershipTransferMessage `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	PublicKey     string                        `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature     string                        `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintOwnershipTransferMessageEnvelope) Reset() {
+	*x = MintOwnershipTransferMessageEnvelope{}
+	mi := &file_pkg_protocol_mint_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintOwnershipTransferMessageEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintOwnershipTransferMessageEnvelope) ProtoMessage() {}
+
+func (x *MintOwnershipTransferMessageEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_mint_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintOwnershipTransferMessageEnvelope.ProtoReflect.Descriptor instead.
+func (*MintOwnershipTransferMessageEnvelope) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_mint_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MintOwnershipTransferMessageEnvelope) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *MintOwnershipTransferMessageEnvelope) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *MintOwnershipTransferMessageEnvelope) GetPayload() *MintOwnershipTransferMessage {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *MintOwnershipTransferMessageEnvelope) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *MintOwnershipTransferMessageEnvelope) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
 var File_pkg_protocol_mint_proto protoreflect.FileDescriptor
 
 const file_pkg_protocol_mint_proto_rawDesc = "" +
@@ -416,7 +1013,59 @@ const file_pkg_protocol_mint_proto_rawDesc = "" +
 	"\rowner_address\x18\x0e \x01(\tR\fownerAddress\x12<\n" +
 	"\x1asignature_requirement_type\x18\x0f \x01(\tR\x18signatureRequirementType\x12B\n" +
 	"\x0easset_managers\x18\x10 \x03(\v2\x1b.fractalengine.AssetManagerR\rassetManagers\x12%\n" +
-	"\x0emin_signatures\x18\x11 \x01(\x05R\rminSignaturesB\x0eZ\fpkg/protocolb\x06proto3"
+	"\x0emin_signatures\x18\x11 \x01(\x05R\rminSignatures\"a\n" +
+	"\x1bOnChainMintAmendmentMessage\x12%\n" +
+	"\x0eamendment_hash\x18\x01 \x01(\fR\ramendmentHash\x12\x1b\n" +
+	"\tmint_hash\x18\x02 \x01(\fR\bmintHash\"\x9e\x02\n" +
+	"\x14MintAmendmentMessage\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x1b\n" +
+	"\tmint_hash\x18\x02 \x01(\tR\bmintHash\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x123\n" +
+	"\bmetadata\x18\x04 \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12\x19\n" +
+	"\bfeed_url\x18\x05 \x01(\tR\afeedUrl\x12(\n" +
+	"\x10contract_of_sale\x18\x06 \x01(\tR\x0econtractOfSale\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc8\x01\n" +
+	"\x1cMintAmendmentMessageEnvelope\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\x05R\x04type\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12=\n" +
+	"\apayload\x18\x03 \x01(\v2#.fractalengine.MintAmendmentMessageR\apayload\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x04 \x01(\tR\tpublicKey\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\tR\tsignature\"\xdb\x01\n" +
+	"\x1dMintAmendmentSignatureMessage\x12%\n" +
+	"\x0eamendment_hash\x18\x01 \x01(\tR\ramendmentHash\x12\x1b\n" +
+	"\tmint_hash\x18\x02 \x01(\tR\bmintHash\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x04 \x01(\tR\tpublicKey\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9d\x01\n" +
+	"%MintAmendmentSignatureMessageEnvelope\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\x05R\x04type\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12F\n" +
+	"\apayload\x18\x03 \x01(\v2,.fractalengine.MintAmendmentSignatureMessageR\apayload\"g\n" +
+	"#OnChainMintOwnershipTransferMessage\x12#\n" +
+	"\rtransfer_hash\x18\x01 \x01(\fR\ftransferHash\x12\x1b\n" +
+	"\tmint_hash\x18\x02 \x01(\fR\bmintHash\"\xb5\x03\n" +
+	"\x1cMintOwnershipTransferMessage\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x1b\n" +
+	"\tmint_hash\x18\x02 \x01(\tR\bmintHash\x12*\n" +
+	"\x11new_owner_address\x18\x03 \x01(\tR\x0fnewOwnerAddress\x12$\n" +
+	"\x0enew_public_key\x18\x04 \x01(\tR\fnewPublicKey\x12<\n" +
+	"\x1asignature_requirement_type\x18\x05 \x01(\tR\x18signatureRequirementType\x12B\n" +
+	"\x0easset_managers\x18\x06 \x03(\v2\x1b.fractalengine.AssetManagerR\rassetManagers\x12%\n" +
+	"\x0emin_signatures\x18\a \x01(\x05R\rminSignatures\x12.\n" +
+	"\x13new_owner_signature\x18\b \x01(\tR\x11newOwnerSignature\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd8\x01\n" +
+	"$MintOwnershipTransferMessageEnvelope\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\x05R\x04type\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12E\n" +
+	"\apayload\x18\x03 \x01(\v2+.fractalengine.MintOwnershipTransferMessageR\apayload\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x04 \x01(\tR\tpublicKey\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\tR\tsignatureB\x0eZ\fpkg/protocolb\x06proto3"
 
 var (
 	file_pkg_protocol_mint_proto_rawDescOnce sync.Once
@@ -430,27 +1079,43 @@ func file_pkg_protocol_mint_proto_rawDescGZIP() []byte {
 	return file_pkg_protocol_mint_proto_rawDescData
 }
 
-var file_pkg_protocol_mint_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_pkg_protocol_mint_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_pkg_protocol_mint_proto_goTypes = []any{
-	(*OnChainMintMessage)(nil),    // 0: fractalengine.OnChainMintMessage
-	(*MintMessageEnvelope)(nil),   // 1: fractalengine.MintMessageEnvelope
-	(*AssetManager)(nil),          // 2: fractalengine.AssetManager
-	(*MintMessage)(nil),           // 3: fractalengine.MintMessage
-	(*structpb.Struct)(nil),       // 4: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*OnChainMintMessage)(nil),                    // 0: fractalengine.OnChainMintMessage
+	(*MintMessageEnvelope)(nil),                   // 1: fractalengine.MintMessageEnvelope
+	(*AssetManager)(nil),                          // 2: fractalengine.AssetManager
+	(*MintMessage)(nil),                           // 3: fractalengine.MintMessage
+	(*OnChainMintAmendmentMessage)(nil),           // 4: fractalengine.OnChainMintAmendmentMessage
+	(*MintAmendmentMessage)(nil),                  // 5: fractalengine.MintAmendmentMessage
+	(*MintAmendmentMessageEnvelope)(nil),          // 6: fractalengine.MintAmendmentMessageEnvelope
+	(*MintAmendmentSignatureMessage)(nil),         // 7: fractalengine.MintAmendmentSignatureMessage
+	(*MintAmendmentSignatureMessageEnvelope)(nil), // 8: fractalengine.MintAmendmentSignatureMessageEnvelope
+	(*OnChainMintOwnershipTransferMessage)(nil),   // 9: fractalengine.OnChainMintOwnershipTransferMessage
+	(*MintOwnershipTransferMessage)(nil),          // 10: fractalengine.MintOwnershipTransferMessage
+	(*MintOwnershipTransferMessageEnvelope)(nil),  // 11: fractalengine.MintOwnershipTransferMessageEnvelope
+	(*structpb.Struct)(nil),                       // 12: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),                 // 13: google.protobuf.Timestamp
 }
 var file_pkg_protocol_mint_proto_depIdxs = []int32{
-	3, // 0: fractalengine.MintMessageEnvelope.payload:type_name -> fractalengine.MintMessage
-	4, // 1: fractalengine.MintMessage.metadata:type_name -> google.protobuf.Struct
-	4, // 2: fractalengine.MintMessage.requirements:type_name -> google.protobuf.Struct
-	4, // 3: fractalengine.MintMessage.lockup_options:type_name -> google.protobuf.Struct
-	5, // 4: fractalengine.MintMessage.created_at:type_name -> google.protobuf.Timestamp
-	2, // 5: fractalengine.MintMessage.asset_managers:type_name -> fractalengine.AssetManager
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	3,  // 0: fractalengine.MintMessageEnvelope.payload:type_name -> fractalengine.MintMessage
+	12, // 1: fractalengine.MintMessage.metadata:type_name -> google.protobuf.Struct
+	12, // 2: fractalengine.MintMessage.requirements:type_name -> google.protobuf.Struct
+	12, // 3: fractalengine.MintMessage.lockup_options:type_name -> google.protobuf.Struct
+	13, // 4: fractalengine.MintMessage.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 5: fractalengine.MintMessage.asset_managers:type_name -> fractalengine.AssetManager
+	12, // 6: fractalengine.MintAmendmentMessage.metadata:type_name -> google.protobuf.Struct
+	13, // 7: fractalengine.MintAmendmentMessage.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 8: fractalengine.MintAmendmentMessageEnvelope.payload:type_name -> fractalengine.MintAmendmentMessage
+	13, // 9: fractalengine.MintAmendmentSignatureMessage.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 10: fractalengine.MintAmendmentSignatureMessageEnvelope.payload:type_name -> fractalengine.MintAmendmentSignatureMessage
+	2,  // 11: fractalengine.MintOwnershipTransferMessage.asset_managers:type_name -> fractalengine.AssetManager
+	13, // 12: fractalengine.MintOwnershipTransferMessage.created_at:type_name -> google.protobuf.Timestamp
+	10, // 13: fractalengine.MintOwnershipTransferMessageEnvelope.payload:type_name -> fractalengine.MintOwnershipTransferMessage
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_pkg_protocol_mint_proto_init() }
@@ -464,7 +1129,7 @@ func file_pkg_protocol_mint_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_protocol_mint_proto_rawDesc), len(file_pkg_protocol_mint_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,48 @@
+package protocol
+
+import (
+	"encoding/hex"
+	"log"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func NewDeleteBuyOfferTransactionEnvelope(offerHash string, action uint8) MessageEnvelope {
+	offerHashBytes, err := hex.DecodeString(offerHash)
+	if err != nil {
+		log.Println("Failed to decode hash:", err)
+		return MessageEnvelope{}
+	}
+
+	message := &OnChainDeleteBuyOfferMessage{
+		Version:   DEFAULT_VERSION,
+		OfferHash: offerHashBytes,
+	}
+
+	protoBytes, err := proto.Marshal(message)
+	if err != nil {
+		return MessageEnvelope{}
+	}
+
+	return NewMessageEnvelope(action, DEFAULT_VERSION, protoBytes)
+}
+
+func NewDeleteSellOfferTransactionEnvelope(offerHash string, action uint8) MessageEnvelope {
+	offerHashBytes, err := hex.DecodeString(offerHash)
+	if err != nil {
+		log.Println("Failed to decode hash:", err)
+		return MessageEnvelope{}
+	}
+
+	message := &OnChainDeleteSellOfferMessage{
+		Version:   DEFAULT_VERSION,
+		OfferHash: offerHashBytes,
+	}
+
+	protoBytes, err := proto.Marshal(message)
+	if err != nil {
+		return MessageEnvelope{}
+	}
+
+	return NewMessageEnvelope(action, DEFAULT_VERSION, protoBytes)
+}
@@ -12,18 +12,33 @@ import (
 // 1.0.0
 
 const (
-	FRACTAL_ENGINE_IDENTIFIER = 0xFE0001FE
-	DEFAULT_VERSION           = 1
-	ACTION_MINT               = 0x01
-	ACTION_BUY_OFFER          = 0x02
-	ACTION_SELL_OFFER         = 0x03
-	ACTION_INVOICE            = 0x04
-	ACTION_PAYMENT            = 0x05
-	ACTION_DELETE_BUY_OFFER   = 0x06
-	ACTION_DELETE_SELL_OFFER  = 0x07
-	ACTION_INVOICE_SIGNATURE  = 0x08
+	FRACTAL_ENGINE_IDENTIFIER       = 0xFE0001FE
+	DEFAULT_VERSION                 = 1
+	ACTION_MINT                     = 0x01
+	ACTION_BUY_OFFER                = 0x02
+	ACTION_SELL_OFFER               = 0x03
+	ACTION_INVOICE                  = 0x04
+	ACTION_PAYMENT                  = 0x05
+	ACTION_DELETE_BUY_OFFER         = 0x06
+	ACTION_DELETE_SELL_OFFER        = 0x07
+	ACTION_INVOICE_SIGNATURE        = 0x08
+	ACTION_TRANSFER                 = 0x09
+	ACTION_BURN                     = 0x0A
+	ACTION_BURN_SIGNATURE           = 0x0B
+	ACTION_ATTESTATION              = 0x0C
+	ACTION_BATCH                    = 0x0D
+	ACTION_CANCEL_INVOICE           = 0x0E
+	ACTION_AMEND_MINT               = 0x0F
+	ACTION_MINT_AMENDMENT_SIGNATURE = 0x10
+	ACTION_TRANSFER_MINT_OWNERSHIP  = 0x11
+	ACTION_DISTRIBUTION             = 0x12
+	ACTION_DISTRIBUTION_PAYMENT     = 0x13
 )
 
+// Invoices with an expiry height are written as version 2, so that nodes which do not
+// enforce expiry reject them rather than accept payments after the invoice expired.
+const INVOICE_EXPIRY_VERSION = 2
+
 type MessageEnvelope struct {
 	EngineIdentifier uint32
 	Action           uint8
@@ -44,6 +59,11 @@ func (m *MessageEnvelope) IsFractalEngineMessage() bool {
 	return m.EngineIdentifier == FRACTAL_ENGINE_IDENTIFIER
 }
 
+// IsBatch reports whether the envelope packs several actions, see BatchEnvelope.
+func (m *MessageEnvelope) IsBatch() bool {
+	return m.Action == ACTION_BATCH
+}
+
 func (m *MessageEnvelope) Serialize() []byte {
 	bufIdentifier := make([]byte, 4)
 	binary.BigEndian.PutUint32(bufIdentifier, m.EngineIdentifier)
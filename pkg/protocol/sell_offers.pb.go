@@ -354,6 +354,59 @@ func (x *DeleteSellOfferMessageEnvelope) GetSignature() string {
 	return ""
 }
 
+// This is what gets written to the OP_RETURN on the L1
+type OnChainDeleteSellOfferMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	OfferHash     []byte                 `protobuf:"bytes,2,opt,name=offer_hash,json=offerHash,proto3" json:"offer_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnChainDeleteSellOfferMessage) Reset() {
+	*x = OnChainDeleteSellOfferMessage{}
+	mi := &file_pkg_protocol_sell_offers_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnChainDeleteSellOfferMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnChainDeleteSellOfferMessage) ProtoMessage() {}
+
+func (x *OnChainDeleteSellOfferMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_sell_offers_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnChainDeleteSellOfferMessage.ProtoReflect.Descriptor instead.
+func (*OnChainDeleteSellOfferMessage) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_sell_offers_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OnChainDeleteSellOfferMessage) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *OnChainDeleteSellOfferMessage) GetOfferHash() []byte {
+	if x != nil {
+		return x.OfferHash
+	}
+	return nil
+}
+
 var File_pkg_protocol_sell_offers_proto protoreflect.FileDescriptor
 
 const file_pkg_protocol_sell_offers_proto_rawDesc = "" +
@@ -385,7 +438,11 @@ const file_pkg_protocol_sell_offers_proto_rawDesc = "" +
 	"\apayload\x18\x03 \x01(\v2%.fractalengine.DeleteSellOfferMessageR\apayload\x12\x1d\n" +
 	"\n" +
 	"public_key\x18\x04 \x01(\tR\tpublicKey\x12\x1c\n" +
-	"\tsignature\x18\x05 \x01(\tR\tsignatureB\x0eZ\fpkg/protocolb\x06proto3"
+	"\tsignature\x18\x05 \x01(\tR\tsignature\"X\n" +
+	"\x1dOnChainDeleteSellOfferMessage\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1d\n" +
+	"\n" +
+	"offer_hash\x18\x02 \x01(\fR\tofferHashB\x0eZ\fpkg/protocolb\x06proto3"
 
 var (
 	file_pkg_protocol_sell_offers_proto_rawDescOnce sync.Once
@@ -399,19 +456,20 @@ func file_pkg_protocol_sell_offers_proto_rawDescGZIP() []byte {
 	return file_pkg_protocol_sell_offers_proto_rawDescData
 }
 
-var file_pkg_protocol_sell_offers_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pkg_protocol_sell_offers_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_pkg_protocol_sell_offers_proto_goTypes = []any{
 	(*SellOfferMessageEnvelope)(nil),       // 0: fractalengine.SellOfferMessageEnvelope
 	(*SellOfferMessage)(nil),               // 1: fractalengine.SellOfferMessage
 	(*SellOfferPayload)(nil),               // 2: fractalengine.SellOfferPayload
 	(*DeleteSellOfferMessage)(nil),         // 3: fractalengine.DeleteSellOfferMessage
 	(*DeleteSellOfferMessageEnvelope)(nil), // 4: fractalengine.DeleteSellOfferMessageEnvelope
-	(*timestamppb.Timestamp)(nil),          // 5: google.protobuf.Timestamp
+	(*OnChainDeleteSellOfferMessage)(nil),  // 5: fractalengine.OnChainDeleteSellOfferMessage
+	(*timestamppb.Timestamp)(nil),          // 6: google.protobuf.Timestamp
 }
 var file_pkg_protocol_sell_offers_proto_depIdxs = []int32{
 	1, // 0: fractalengine.SellOfferMessageEnvelope.payload:type_name -> fractalengine.SellOfferMessage
 	2, // 1: fractalengine.SellOfferMessage.payload:type_name -> fractalengine.SellOfferPayload
-	5, // 2: fractalengine.SellOfferMessage.created_at:type_name -> google.protobuf.Timestamp
+	6, // 2: fractalengine.SellOfferMessage.created_at:type_name -> google.protobuf.Timestamp
 	3, // 3: fractalengine.DeleteSellOfferMessageEnvelope.payload:type_name -> fractalengine.DeleteSellOfferMessage
 	4, // [4:4] is the sub-list for method output_type
 	4, // [4:4] is the sub-list for method input_type
@@ -431,7 +489,7 @@ func file_pkg_protocol_sell_offers_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_protocol_sell_offers_proto_rawDesc), len(file_pkg_protocol_sell_offers_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,34 @@
+package protocol
+
+import (
+	"encoding/hex"
+	"log"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func NewTransferTransactionEnvelope(transferHash string, mintHash string, action uint8) MessageEnvelope {
+	transferHashBytes, err := hex.DecodeString(transferHash)
+	if err != nil {
+		log.Println("Failed to decode hash:", err)
+		return MessageEnvelope{}
+	}
+
+	mintHashBytes, err := hex.DecodeString(mintHash)
+	if err != nil {
+		log.Println("Failed to decode hash:", err)
+		return MessageEnvelope{}
+	}
+
+	message := &OnChainTransferMessage{
+		TransferHash: transferHashBytes,
+		MintHash:     mintHashBytes,
+	}
+
+	protoBytes, err := proto.Marshal(message)
+	if err != nil {
+		return MessageEnvelope{}
+	}
+
+	return NewMessageEnvelope(action, DEFAULT_VERSION, protoBytes)
+}
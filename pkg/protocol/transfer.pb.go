@@ -0,0 +1,333 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.1
+// source: pkg/protocol/transfer.proto
+
+package protocol
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is what gets written to the OP_RETURN on the L1 to move fractions of a mint
+type OnChainTransferMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferHash  []byte                 `protobuf:"bytes,1,opt,name=transfer_hash,json=transferHash,proto3" json:"transfer_hash,omitempty"`
+	MintHash      []byte                 `protobuf:"bytes,2,opt,name=mint_hash,json=mintHash,proto3" json:"mint_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnChainTransferMessage) Reset() {
+	*x = OnChainTransferMessage{}
+	mi := &file_pkg_protocol_transfer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnChainTransferMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnChainTransferMessage) ProtoMessage() {}
+
+func (x *OnChainTransferMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_transfer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnChainTransferMessage.ProtoReflect.Descriptor instead.
+func (*OnChainTransferMessage) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_transfer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OnChainTransferMessage) GetTransferHash() []byte {
+	if x != nil {
+		return x.TransferHash
+	}
+	return nil
+}
+
+func (x *OnChainTransferMessage) GetMintHash() []byte {
+	if x != nil {
+		return x.MintHash
+	}
+	return nil
+}
+
+// Fractions of a mint moved from one address to another, signed by the sender
+type TokenTransferMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	MintHash      string                 `protobuf:"bytes,2,opt,name=mint_hash,json=mintHash,proto3" json:"mint_hash,omitempty"`
+	FromAddress   string                 `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress     string                 `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Nonce         int64                  `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	RedeemScript  string                 `protobuf:"bytes,7,opt,name=redeem_script,json=redeemScript,proto3" json:"redeem_script,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenTransferMessage) Reset() {
+	*x = TokenTransferMessage{}
+	mi := &file_pkg_protocol_transfer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenTransferMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenTransferMessage) ProtoMessage() {}
+
+func (x *TokenTransferMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_transfer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenTransferMessage.ProtoReflect.Descriptor instead.
+func (*TokenTransferMessage) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_transfer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TokenTransferMessage) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *TokenTransferMessage) GetMintHash() string {
+	if x != nil {
+		return x.MintHash
+	}
+	return ""
+}
+
+func (x *TokenTransferMessage) GetFromAddress() string {
+	if x != nil {
+		return x.FromAddress
+	}
+	return ""
+}
+
+func (x *TokenTransferMessage) GetToAddress() string {
+	if x != nil {
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *TokenTransferMessage) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TokenTransferMessage) GetNonce() int64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *TokenTransferMessage) GetRedeemScript() string {
+	if x != nil {
+		return x.RedeemScript
+	}
+	return ""
+}
+
+func (x *TokenTransferMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type TokenTransferMessageEnvelope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Payload       *TokenTransferMessage  `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature     string                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenTransferMessageEnvelope) Reset() {
+	*x = TokenTransferMessageEnvelope{}
+	mi := &file_pkg_protocol_transfer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenTransferMessageEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenTransferMessageEnvelope) ProtoMessage() {}
+
+func (x *TokenTransferMessageEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_protocol_transfer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenTransferMessageEnvelope.ProtoReflect.Descriptor instead.
+func (*TokenTransferMessageEnvelope) Descriptor() ([]byte, []int) {
+	return file_pkg_protocol_transfer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TokenTransferMessageEnvelope) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *TokenTransferMessageEnvelope) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TokenTransferMessageEnvelope) GetPayload() *TokenTransferMessage {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *TokenTransferMessageEnvelope) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *TokenTransferMessageEnvelope) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+var File_pkg_protocol_transfer_proto protoreflect.FileDescriptor
+
+const file_pkg_protocol_transfer_proto_rawDesc = "" +
+	"\n" +
+	"\x1bpkg/protocol/transfer.proto\x12\rfractalengine\x1a\x1fgoogle/protobuf/timestamp.proto\"Z\n" +
+	"\x16OnChainTransferMessage\x12#\n" +
+	"\rtransfer_hash\x18\x01 \x01(\fR\ftransferHash\x12\x1b\n" +
+	"\tmint_hash\x18\x02 \x01(\fR\bmintHash\"\x9b\x02\n" +
+	"\x14TokenTransferMessage\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x1b\n" +
+	"\tmint_hash\x18\x02 \x01(\tR\bmintHash\x12!\n" +
+	"\ffrom_address\x18\x03 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x04 \x01(\tR\ttoAddress\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x14\n" +
+	"\x05nonce\x18\x06 \x01(\x03R\x05nonce\x12#\n" +
+	"\rredeem_script\x18\a \x01(\tR\fredeemScript\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc8\x01\n" +
+	"\x1cTokenTransferMessageEnvelope\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\x05R\x04type\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12=\n" +
+	"\apayload\x18\x03 \x01(\v2#.fractalengine.TokenTransferMessageR\apayload\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x04 \x01(\tR\tpublicKey\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\tR\tsignatureB\x0eZ\fpkg/protocolb\x06proto3"
+
+var (
+	file_pkg_protocol_transfer_proto_rawDescOnce sync.Once
+	file_pkg_protocol_transfer_proto_rawDescData []byte
+)
+
+func file_pkg_protocol_transfer_proto_rawDescGZIP() []byte {
+	file_pkg_protocol_transfer_proto_rawDescOnce.Do(func() {
+		file_pkg_protocol_transfer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_protocol_transfer_proto_rawDesc), len(file_pkg_protocol_transfer_proto_rawDesc)))
+	})
+	return file_pkg_protocol_transfer_proto_rawDescData
+}
+
+var file_pkg_protocol_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pkg_protocol_transfer_proto_goTypes = []any{
+	(*OnChainTransferMessage)(nil),       // 0: fractalengine.OnChainTransferMessage
+	(*TokenTransferMessage)(nil),         // 1: fractalengine.TokenTransferMessage
+	(*TokenTransferMessageEnvelope)(nil), // 2: fractalengine.TokenTransferMessageEnvelope
+	(*timestamppb.Timestamp)(nil),        // 3: google.protobuf.Timestamp
+}
+var file_pkg_protocol_transfer_proto_depIdxs = []int32{
+	3, // 0: fractalengine.TokenTransferMessage.created_at:type_name -> google.protobuf.Timestamp
+	1, // 1: fractalengine.TokenTransferMessageEnvelope.payload:type_name -> fractalengine.TokenTransferMessage
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_pkg_protocol_transfer_proto_init() }
+func file_pkg_protocol_transfer_proto_init() {
+	if File_pkg_protocol_transfer_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_protocol_transfer_proto_rawDesc), len(file_pkg_protocol_transfer_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_protocol_transfer_proto_goTypes,
+		DependencyIndexes: file_pkg_protocol_transfer_proto_depIdxs,
+		MessageInfos:      file_pkg_protocol_transfer_proto_msgTypes,
+	}.Build()
+	File_pkg_protocol_transfer_proto = out.File
+	file_pkg_protocol_transfer_proto_goTypes = nil
+	file_pkg_protocol_transfer_proto_depIdxs = nil
+}
@@ -0,0 +1,67 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	connect "connectrpc.com/connect"
+	protocol "dogecoin.org/fractal-engine/pkg/rpc/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+)
+
+func (s *ConnectRpcService) CreateAttestation(ctx context.Context, req *connect.Request[protocol.CreateAttestationRequest]) (*connect.Response[protocol.CreateAttestationResponse], error) {
+	payload := req.Msg.GetPayload()
+	if payload == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("payload is required"))
+	}
+
+	newAttestation := &store.Attestation{
+		MintHash:  payload.GetMintHash().GetValue(),
+		Address:   payload.GetAddress().GetValue(),
+		Signature: payload.GetSignature(),
+		PublicKey: payload.GetPublicKey(),
+		CreatedAt: time.Now(),
+	}
+
+	mint, err := s.store.GetMintByHash(ctx, newAttestation.MintHash)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if mint.Hash == "" {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("mint not found"))
+	}
+
+	if err := newAttestation.Validate(mint); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	id, err := s.store.SaveAttestation(ctx, newAttestation)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.gossipClient.GossipAttestation(*newAttestation); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &protocol.CreateAttestationResponse{}
+	resp.SetId(id)
+	return connect.NewResponse(resp), nil
+}
+
+// checkMintRequirements rejects requests that would leave fractions with an address
+// that is not eligible to hold them.
+func (s *ConnectRpcService) checkMintRequirements(ctx context.Context, mint store.Mint, address string, quantity int) error {
+	err := s.store.CheckMintRequirements(ctx, mint, address, quantity, nil)
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, store.ErrMintRequirementsNotMet) {
+		return connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	return connect.NewError(connect.CodeInternal, err)
+}
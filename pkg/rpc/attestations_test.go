@@ -0,0 +1,135 @@
+package rpc_test
+
+import (
+	"context"
+	"testing"
+
+	connect "connectrpc.com/connect"
+	"dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/rpc"
+	"dogecoin.org/fractal-engine/pkg/rpc/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func TestCreateBuyOfferRequiresAttestation(t *testing.T) {
+	tokenisationStore, dogenetClient, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	managerPrivHex, managerPubHex, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	buyerPrivHex, buyerPubHex, buyerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	sellerAddress := support.GenerateDogecoinAddress(true)
+	mintHash := support.GenerateRandomHash()
+
+	_, err = tokenisationStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "Test Mint",
+		Description:   "Test Description",
+		FractionCount: 1000,
+		Hash:          mintHash,
+		Requirements:  store.StringInterfaceMap{"attestation_required": true},
+		AssetManagers: store.AssetManagers{{Name: "KYC Provider", PublicKey: managerPubHex}},
+	}, sellerAddress)
+	assert.NilError(t, err)
+
+	buyOfferPayload := rpc.CreateBuyOfferRequestPayload{
+		OffererAddress: buyerAddress,
+		SellerAddress:  sellerAddress,
+		MintHash:       mintHash,
+		Quantity:       10,
+		Price:          50,
+	}
+
+	signature, err := doge.SignPayload(buyOfferPayload, buyerPrivHex, buyerPubHex)
+	assert.NilError(t, err)
+
+	offererAddressProto := &protocol.Address{}
+	offererAddressProto.SetValue(buyerAddress)
+	sellerAddressProto := &protocol.Address{}
+	sellerAddressProto.SetValue(sellerAddress)
+	mintHashProto := &protocol.Hash{}
+	mintHashProto.SetValue(mintHash)
+
+	protoPayload := &protocol.CreateBuyOfferRequestPayload{}
+	protoPayload.SetOffererAddress(offererAddressProto)
+	protoPayload.SetSellerAddress(sellerAddressProto)
+	protoPayload.SetMintHash(mintHashProto)
+	protoPayload.SetQuantity(10)
+	protoPayload.SetPrice(50)
+
+	buyOfferRequest := &protocol.CreateBuyOfferRequest{}
+	buyOfferRequest.SetPayload(protoPayload)
+	buyOfferRequest.SetPublicKey(buyerPubHex)
+	buyOfferRequest.SetSignature(signature)
+
+	_, err = feClient.CreateBuyOffer(ctx, connect.NewRequest(buyOfferRequest))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assert.ErrorContains(t, err, "no attestation from an asset manager")
+
+	// The asset manager attests the buyer
+	attestationSignature, err := doge.SignPayload(store.AttestationBody{MintHash: mintHash, Address: buyerAddress}, managerPrivHex, managerPubHex)
+	assert.NilError(t, err)
+
+	attestationPayload := &protocol.CreateAttestationRequestPayload{}
+	attestationPayload.SetMintHash(mintHashProto)
+	attestationPayload.SetAddress(offererAddressProto)
+	attestationPayload.SetPublicKey(managerPubHex)
+	attestationPayload.SetSignature(attestationSignature)
+
+	attestationRequest := &protocol.CreateAttestationRequest{}
+	attestationRequest.SetPayload(attestationPayload)
+
+	attestationResponse, err := feClient.CreateAttestation(ctx, connect.NewRequest(attestationRequest))
+	assert.NilError(t, err)
+	assert.Assert(t, attestationResponse.Msg.GetId() != "")
+	assert.Equal(t, 1, len(dogenetClient.attestations))
+
+	response, err := feClient.CreateBuyOffer(ctx, connect.NewRequest(buyOfferRequest))
+	assert.NilError(t, err)
+	assert.Assert(t, response.Msg.GetId() != "")
+}
+
+func TestCreateAttestationRejectsUnknownSigner(t *testing.T) {
+	tokenisationStore, _, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	_, managerPubHex, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	otherPrivHex, otherPubHex, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	buyerAddress := support.GenerateDogecoinAddress(true)
+	mintHash := support.GenerateRandomHash()
+
+	_, err = tokenisationStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "Test Mint",
+		Description:   "Test Description",
+		FractionCount: 1000,
+		Hash:          mintHash,
+		AssetManagers: store.AssetManagers{{Name: "KYC Provider", PublicKey: managerPubHex}},
+	}, "owner")
+	assert.NilError(t, err)
+
+	signature, err := doge.SignPayload(store.AttestationBody{MintHash: mintHash, Address: buyerAddress}, otherPrivHex, otherPubHex)
+	assert.NilError(t, err)
+
+	addressProto := &protocol.Address{}
+	addressProto.SetValue(buyerAddress)
+	mintHashProto := &protocol.Hash{}
+	mintHashProto.SetValue(mintHash)
+
+	payload := &protocol.CreateAttestationRequestPayload{}
+	payload.SetMintHash(mintHashProto)
+	payload.SetAddress(addressProto)
+	payload.SetPublicKey(otherPubHex)
+	payload.SetSignature(signature)
+
+	request := &protocol.CreateAttestationRequest{}
+	request.SetPayload(payload)
+
+	_, err = feClient.CreateAttestation(ctx, connect.NewRequest(request))
+	assert.ErrorContains(t, err, "public key does not match any asset managers")
+}
@@ -0,0 +1,107 @@
+package rpc
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	connect "connectrpc.com/connect"
+	engineprotocol "dogecoin.org/fractal-engine/pkg/protocol"
+	protocol "dogecoin.org/fractal-engine/pkg/rpc/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+)
+
+func (s *ConnectRpcService) CreateBurn(ctx context.Context, req *connect.Request[protocol.CreateBurnRequest]) (*connect.Response[protocol.CreateBurnResponse], error) {
+	request, err := toCreateBurnRequest(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	mint, err := s.store.GetMintByHash(ctx, request.Payload.MintHash)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if mint.Hash == "" {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("mint not found"))
+	}
+
+	available, err := s.store.GetAvailableTokenBalance(ctx, request.Payload.OwnerAddress, request.Payload.MintHash, nil)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if available < request.Payload.Quantity {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("insufficient available balance: %d < %d", available, request.Payload.Quantity))
+	}
+
+	burn := &store.BurnWithoutID{
+		MintHash:     request.Payload.MintHash,
+		OwnerAddress: request.Payload.OwnerAddress,
+		Quantity:     request.Payload.Quantity,
+		PublicKey:    request.PublicKey,
+		CreatedAt:    time.Now(),
+	}
+
+	burn.Hash, err = burn.GenerateHash()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	envelope := engineprotocol.NewBurnTransactionEnvelope(burn.Hash, burn.MintHash, int32(burn.Quantity), engineprotocol.ACTION_BURN)
+	encodedTransactionBody := envelope.Serialize()
+
+	resp := &protocol.CreateBurnResponse{}
+	resp.SetHash(toProtoHash(burn.Hash))
+	resp.SetEncodedTransactionBody(hex.EncodeToString(encodedTransactionBody))
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectRpcService) CreateBurnSignature(ctx context.Context, req *connect.Request[protocol.CreateBurnSignatureRequest]) (*connect.Response[protocol.CreateBurnSignatureResponse], error) {
+	payload := req.Msg.GetPayload()
+	if payload == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("payload is required"))
+	}
+
+	newBurnSignature := &store.BurnSignature{
+		BurnHash:     payload.GetBurnHash(),
+		MintHash:     payload.GetMintHash().GetValue(),
+		OwnerAddress: payload.GetOwnerAddress().GetValue(),
+		Quantity:     int(payload.GetQuantity()),
+		Signature:    payload.GetSignature(),
+		PublicKey:    payload.GetPublicKey(),
+		CreatedAt:    time.Now(),
+	}
+
+	mint, err := s.store.GetMintByHash(ctx, newBurnSignature.MintHash)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if !mint.SignatureRequired() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("mint does not require burn signatures"))
+	}
+
+	if err := newBurnSignature.Validate(mint); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	id, err := s.store.SaveBurnSignature(ctx, newBurnSignature)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.gossipClient.GossipBurnSignature(*newBurnSignature); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &protocol.CreateBurnSignatureResponse{}
+	resp.SetId(id)
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,119 @@
+package rpc_test
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	connect "connectrpc.com/connect"
+	"dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	engineprotocol "dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/rpc"
+	"dogecoin.org/fractal-engine/pkg/rpc/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+	"gotest.tools/assert"
+)
+
+func newCreateBurnRequest(t *testing.T, privHex string, pubHex string, ownerAddress string, mintHash string, quantity int) *protocol.CreateBurnRequest {
+	payload := rpc.CreateBurnRequestPayload{
+		OwnerAddress: ownerAddress,
+		MintHash:     mintHash,
+		Quantity:     quantity,
+	}
+
+	signature, err := doge.SignPayload(payload, privHex, pubHex)
+	assert.NilError(t, err)
+
+	ownerAddressProto := &protocol.Address{}
+	ownerAddressProto.SetValue(ownerAddress)
+	mintHashProto := &protocol.Hash{}
+	mintHashProto.SetValue(mintHash)
+
+	protoPayload := &protocol.CreateBurnRequestPayload{}
+	protoPayload.SetOwnerAddress(ownerAddressProto)
+	protoPayload.SetMintHash(mintHashProto)
+	protoPayload.SetQuantity(int32(quantity))
+
+	request := &protocol.CreateBurnRequest{}
+	request.SetPayload(protoPayload)
+	request.SetPublicKey(pubHex)
+	request.SetSignature(signature)
+	return request
+}
+
+func TestCreateBurn(t *testing.T) {
+	tokenisationStore, _, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	privHex, pubHex, ownerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	mintHash := support.GenerateRandomHash()
+	_, err = tokenisationStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "Test Mint",
+		Description:   "Test Description",
+		FractionCount: 1000,
+		Hash:          mintHash,
+	}, ownerAddress)
+	assert.NilError(t, err)
+
+	assert.NilError(t, tokenisationStore.UpsertTokenBalance(ctx, ownerAddress, mintHash, 100))
+
+	response, err := feClient.CreateBurn(ctx, connect.NewRequest(newCreateBurnRequest(t, privHex, pubHex, ownerAddress, mintHash, 40)))
+	assert.NilError(t, err)
+
+	encodedTransactionBody, err := hex.DecodeString(response.Msg.GetEncodedTransactionBody())
+	assert.NilError(t, err)
+
+	envelope := engineprotocol.MessageEnvelope{}
+	assert.NilError(t, envelope.Deserialize(encodedTransactionBody))
+	assert.Equal(t, uint8(engineprotocol.ACTION_BURN), envelope.Action)
+
+	message := engineprotocol.OnChainBurnMessage{}
+	assert.NilError(t, proto.Unmarshal(envelope.Data, &message))
+	assert.Equal(t, response.Msg.GetHash().GetValue(), hex.EncodeToString(message.BurnHash))
+	assert.Equal(t, mintHash, hex.EncodeToString(message.MintHash))
+	assert.Equal(t, int32(40), message.Quantity)
+
+	// Burning more than the available balance is rejected
+	_, err = feClient.CreateBurn(ctx, connect.NewRequest(newCreateBurnRequest(t, privHex, pubHex, ownerAddress, mintHash, 101)))
+	assert.ErrorContains(t, err, "insufficient available balance")
+}
+
+func TestGetMintReportsBurnedSupply(t *testing.T) {
+	tokenisationStore, _, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	ownerAddress := support.GenerateDogecoinAddress(true)
+	mintHash := support.GenerateRandomHash()
+	_, err := tokenisationStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "Test Mint",
+		Description:   "Test Description",
+		FractionCount: 1000,
+		Hash:          mintHash,
+	}, ownerAddress)
+	assert.NilError(t, err)
+
+	assert.NilError(t, tokenisationStore.UpsertTokenBalance(ctx, ownerAddress, mintHash, 1000))
+
+	envelope := engineprotocol.NewBurnTransactionEnvelope(support.GenerateRandomHash(), mintHash, 250, engineprotocol.ACTION_BURN)
+	txId, err := tokenisationStore.SaveOnChainTransaction(ctx, "burnTx", 5, "blockHash", 0, engineprotocol.ACTION_BURN, engineprotocol.DEFAULT_VERSION, envelope.Data, ownerAddress, store.StringInterfaceMap{})
+	assert.NilError(t, err)
+
+	txs, err := tokenisationStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, txId, txs[0].Id)
+	assert.NilError(t, tokenisationStore.ProcessBurn(ctx, txs[0]))
+
+	hashProto := &protocol.Hash{}
+	hashProto.SetValue(mintHash)
+	request := &protocol.GetMintRequest{}
+	request.SetHash(hashProto)
+
+	response, err := feClient.GetMint(ctx, connect.NewRequest(request))
+	assert.NilError(t, err)
+	assert.Equal(t, int32(250), response.Msg.GetBurnedSupply())
+	assert.Equal(t, int32(750), response.Msg.GetCirculatingSupply())
+}
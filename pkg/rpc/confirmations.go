@@ -0,0 +1,108 @@
+package rpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"dogecoin.org/fractal-engine/pkg/config"
+	engineprotocol "dogecoin.org/fractal-engine/pkg/protocol"
+	protocol "dogecoin.org/fractal-engine/pkg/rpc/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+)
+
+/*
+* confirmationTracker works out how many more blocks an action needs before the
+* engine applies it. Actions that have been seen on chain but are still waiting are
+* measured from their block height to the chain tip. An action that has not been seen
+* yet needs the full depth. The pending actions and mints are loaded once per request.
+ */
+type confirmationTracker struct {
+	store   *store.TokenisationStore
+	policy  config.ConfirmationPolicy
+	tip     int64
+	pending map[uint8]map[string]int64
+	mints   map[string]store.Mint
+}
+
+func (s *ConnectRpcService) newConfirmationTracker(ctx context.Context) (*confirmationTracker, error) {
+	_, tip, _, _, _, err := s.store.GetHealth(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		tip, _, _, err = s.store.GetChainPosition(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &confirmationTracker{
+		store:   s.store,
+		policy:  s.cfg.Confirmations,
+		tip:     tip,
+		pending: make(map[uint8]map[string]int64),
+		mints:   make(map[string]store.Mint),
+	}, nil
+}
+
+// remaining returns the confirmations an action on a subject still needs. When the
+// same subject has several pending actions the most recent one is used.
+func (c *confirmationTracker) remaining(ctx context.Context, actionType uint8, subjectHash string, mint store.Mint) (int, error) {
+	required := store.RequiredConfirmations(c.policy, actionType, mint)
+	if required == 0 {
+		return 0, nil
+	}
+
+	heights, ok := c.pending[actionType]
+	if !ok {
+		txs, err := c.store.GetOnChainTransactionsByAction(ctx, actionType)
+		if err != nil {
+			return 0, err
+		}
+
+		heights = make(map[string]int64)
+		for _, tx := range txs {
+			hash := tx.SubjectHash()
+			heights[hash] = max(heights[hash], tx.Height)
+		}
+		c.pending[actionType] = heights
+	}
+
+	return store.ConfirmationsRemaining(required, heights[subjectHash], c.tip), nil
+}
+
+func (c *confirmationTracker) mint(ctx context.Context, hash string) (store.Mint, error) {
+	if mint, ok := c.mints[hash]; ok {
+		return mint, nil
+	}
+
+	mint, err := c.store.GetMintByHash(ctx, hash)
+	if err != nil {
+		return store.Mint{}, err
+	}
+
+	c.mints[hash] = mint
+	return mint, nil
+}
+
+// setInvoiceConfirmations fills in how far each unpaid invoice's payment is from
+// settling. Confirmed invoices have no confirmations remaining themselves.
+func (c *confirmationTracker) setInvoiceConfirmations(ctx context.Context, invoices []store.Invoice, protoInvoices []*protocol.Invoice) error {
+	for i, invoice := range invoices {
+		if invoice.PaidAt.Valid {
+			continue
+		}
+
+		mint, err := c.mint(ctx, invoice.MintHash)
+		if err != nil {
+			return err
+		}
+
+		remaining, err := c.remaining(ctx, engineprotocol.ACTION_PAYMENT, invoice.Hash, mint)
+		if err != nil {
+			return err
+		}
+
+		protoInvoices[i].SetPaymentConfirmationsRemaining(int32(remaining))
+	}
+
+	return nil
+}
@@ -18,8 +18,9 @@ func toCreateMintRequest(req *protocol.CreateMintRequest) (*CreateMintRequest, e
 	payload := req.GetPayload()
 	return &CreateMintRequest{
 		SignedRequest: SignedRequest{
-			PublicKey: req.GetPublicKey(),
-			Signature: req.GetSignature(),
+			PublicKey:    req.GetPublicKey(),
+			Signature:    req.GetSignature(),
+			RedeemScript: req.GetRedeemScript(),
 		},
 		Payload: CreateMintRequestPayload{
 			Title:                    payload.GetTitle(),
@@ -47,16 +48,171 @@ func toCreateInvoiceRequest(req *protocol.CreateInvoiceRequest) (*CreateInvoiceR
 	payload := req.GetPayload()
 	return &CreateInvoiceRequest{
 		SignedRequest: SignedRequest{
-			PublicKey: req.GetPublicKey(),
-			Signature: req.GetSignature(),
+			PublicKey:    req.GetPublicKey(),
+			Signature:    req.GetSignature(),
+			RedeemScript: req.GetRedeemScript(),
 		},
 		Payload: CreateInvoiceRequestPayload{
-			PaymentAddress: payload.GetPaymentAddress().GetValue(),
-			BuyerAddress:   payload.GetBuyerAddress().GetValue(),
+			PaymentAddress:  payload.GetPaymentAddress().GetValue(),
+			BuyerAddress:    payload.GetBuyerAddress().GetValue(),
+			MintHash:        payload.GetMintHash().GetValue(),
+			Quantity:        int(payload.GetQuantity()),
+			PriceKoinu:      payload.GetPriceKoinu(),
+			SellerAddress:   payload.GetSellerAddress().GetValue(),
+			Splits:          fromProtoInvoiceSplits(payload.GetSplits()),
+			ExpiresAtHeight: payload.GetExpiresAtHeight(),
+		},
+	}, nil
+}
+
+func toCancelInvoiceRequest(req *protocol.CancelInvoiceRequest) (*CancelInvoiceRequest, error) {
+	if req == nil || req.GetPayload() == nil {
+		return nil, errors.New("payload is required")
+	}
+
+	payload := req.GetPayload()
+	return &CancelInvoiceRequest{
+		SignedRequest: SignedRequest{
+			PublicKey:    req.GetPublicKey(),
+			Signature:    req.GetSignature(),
+			RedeemScript: req.GetRedeemScript(),
+		},
+		Payload: CancelInvoiceRequestPayload{
+			InvoiceHash: payload.GetInvoiceHash().GetValue(),
+		},
+	}, nil
+}
+
+func fromProtoInvoiceSplits(splits []*protocol.InvoiceSplit) store.InvoiceSplits {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	result := make(store.InvoiceSplits, 0, len(splits))
+	for _, split := range splits {
+		result = append(result, store.InvoiceSplit{
+			Address:     split.GetAddress().GetValue(),
+			BasisPoints: int(split.GetBasisPoints()),
+		})
+	}
+	return result
+}
+
+func toProtoInvoiceSplits(splits store.InvoiceSplits) []*protocol.InvoiceSplit {
+	result := make([]*protocol.InvoiceSplit, 0, len(splits))
+	for _, split := range splits {
+		protoSplit := &protocol.InvoiceSplit{}
+		protoSplit.SetAddress(toProtoAddress(split.Address))
+		protoSplit.SetBasisPoints(int32(split.BasisPoints))
+		result = append(result, protoSplit)
+	}
+	return result
+}
+
+func toTransferTokensRequest(req *protocol.TransferTokensRequest) (*TransferTokensRequest, error) {
+	if req == nil || req.GetPayload() == nil {
+		return nil, errors.New("payload is required")
+	}
+
+	payload := req.GetPayload()
+	return &TransferTokensRequest{
+		SignedRequest: SignedRequest{
+			PublicKey:    req.GetPublicKey(),
+			Signature:    req.GetSignature(),
+			RedeemScript: req.GetRedeemScript(),
+		},
+		Payload: store.TokenTransferBody{
+			FromAddress: payload.GetFromAddress().GetValue(),
+			ToAddress:   payload.GetToAddress().GetValue(),
+			MintHash:    payload.GetMintHash().GetValue(),
+			Quantity:    int(payload.GetQuantity()),
+			Nonce:       payload.GetNonce(),
+		},
+	}, nil
+}
+
+func toAmendMintRequest(req *protocol.AmendMintRequest) (*AmendMintRequest, error) {
+	if req == nil || req.GetPayload() == nil {
+		return nil, errors.New("payload is required")
+	}
+
+	payload := req.GetPayload()
+	return &AmendMintRequest{
+		SignedRequest: SignedRequest{
+			PublicKey:    req.GetPublicKey(),
+			Signature:    req.GetSignature(),
+			RedeemScript: req.GetRedeemScript(),
+		},
+		Payload: store.MintAmendmentBody{
 			MintHash:       payload.GetMintHash().GetValue(),
-			Quantity:       int(payload.GetQuantity()),
-			Price:          int(payload.GetPrice()),
-			SellerAddress:  payload.GetSellerAddress().GetValue(),
+			Description:    payload.GetDescription(),
+			Metadata:       toStoreStringInterfaceMap(payload.GetMetadata()),
+			FeedURL:        payload.GetFeedUrl(),
+			ContractOfSale: payload.GetContractOfSale(),
+		},
+	}, nil
+}
+
+func toTransferMintOwnershipRequest(req *protocol.TransferMintOwnershipRequest) (*TransferMintOwnershipRequest, error) {
+	if req == nil || req.GetPayload() == nil {
+		return nil, errors.New("payload is required")
+	}
+
+	payload := req.GetPayload()
+	return &TransferMintOwnershipRequest{
+		SignedRequest: SignedRequest{
+			PublicKey:    req.GetPublicKey(),
+			Signature:    req.GetSignature(),
+			RedeemScript: req.GetRedeemScript(),
+		},
+		NewOwnerSignature: req.GetNewOwnerSignature(),
+		Payload: store.MintOwnershipTransferBody{
+			MintHash:                 payload.GetMintHash().GetValue(),
+			NewOwnerAddress:          payload.GetNewOwnerAddress().GetValue(),
+			NewPublicKey:             payload.GetNewPublicKey(),
+			SignatureRequirementType: toStoreSignatureRequirementType(payload.GetSignatureRequirementType()),
+			AssetManagers:            toStoreAssetManagers(payload.GetAssetManagers()),
+			MinSignatures:            int(payload.GetMinSignatures()),
+		},
+	}, nil
+}
+
+func toCreateDistributionRequest(req *protocol.CreateDistributionRequest) (*CreateDistributionRequest, error) {
+	if req == nil || req.GetPayload() == nil {
+		return nil, errors.New("payload is required")
+	}
+
+	payload := req.GetPayload()
+	return &CreateDistributionRequest{
+		SignedRequest: SignedRequest{
+			PublicKey:    req.GetPublicKey(),
+			Signature:    req.GetSignature(),
+			RedeemScript: req.GetRedeemScript(),
+		},
+		Payload: store.DistributionBody{
+			MintHash:     payload.GetMintHash().GetValue(),
+			TotalKoinu:   payload.GetTotalKoinu(),
+			RecordHeight: payload.GetRecordHeight(),
+		},
+	}, nil
+}
+
+func toCreateBurnRequest(req *protocol.CreateBurnRequest) (*CreateBurnRequest, error) {
+	if req == nil || req.GetPayload() == nil {
+		return nil, errors.New("payload is required")
+	}
+
+	payload := req.GetPayload()
+	return &CreateBurnRequest{
+		SignedRequest: SignedRequest{
+			PublicKey:    req.GetPublicKey(),
+			Signature:    req.GetSignature(),
+			RedeemScript: req.GetRedeemScript(),
+		},
+		Payload: CreateBurnRequestPayload{
+			OwnerAddress: payload.GetOwnerAddress().GetValue(),
+			MintHash:     payload.GetMintHash().GetValue(),
+			Quantity:     int(payload.GetQuantity()),
 		},
 	}, nil
 }
@@ -309,15 +465,74 @@ func toProtoInvoice(invoice store.Invoice) *protocol.Invoice {
 	protoInvoice.SetPaidAt(paidAt)
 	protoInvoice.SetPaymentAddress(toProtoAddress(invoice.PaymentAddress))
 	protoInvoice.SetPendingTokenBalanceId(invoice.PendingTokenBalanceId)
-	protoInvoice.SetPrice(int32(invoice.Price))
+	protoInvoice.SetPriceKoinu(invoice.Price)
 	protoInvoice.SetPublicKey(invoice.PublicKey)
 	protoInvoice.SetQuantity(int32(invoice.Quantity))
 	protoInvoice.SetSellerAddress(toProtoAddress(invoice.SellerAddress))
 	protoInvoice.SetSignature(invoice.Signature)
 	protoInvoice.SetTransactionHash(toProtoHash(invoice.TransactionHash))
+	protoInvoice.SetSplits(toProtoInvoiceSplits(invoice.Splits))
+	protoInvoice.SetStatus(invoice.Status)
+	protoInvoice.SetExpiresAtHeight(invoice.ExpiresAtHeight)
 	return protoInvoice
 }
 
+func toProtoInvoiceTransition(transition store.InvoiceTransition) *protocol.InvoiceTransition {
+	protoTransition := &protocol.InvoiceTransition{}
+	protoTransition.SetFromStatus(transition.FromStatus)
+	protoTransition.SetToStatus(transition.ToStatus)
+	protoTransition.SetBlockHeight(transition.BlockHeight.Int64)
+	protoTransition.SetTransactionHash(toProtoHash(transition.TransactionHash))
+	protoTransition.SetCreatedAt(transition.CreatedAt.Format(time.RFC3339Nano))
+	return protoTransition
+}
+
+// toProtoDistribution converts a distribution together with the amounts paid out of
+// it to its holders.
+func toProtoDistribution(distribution store.Distribution, entitlements []store.DistributionEntitlement) *protocol.Distribution {
+	claimed := int64(0)
+	for _, entitlement := range entitlements {
+		claimed += min(entitlement.ClaimedKoinu, entitlement.EntitlementKoinu)
+	}
+
+	protoDistribution := &protocol.Distribution{}
+	protoDistribution.SetHash(toProtoHash(distribution.Hash))
+	protoDistribution.SetMintHash(toProtoHash(distribution.MintHash))
+	protoDistribution.SetTotalKoinu(distribution.TotalKoinu)
+	protoDistribution.SetRecordHeight(distribution.RecordHeight)
+	protoDistribution.SetPublicKey(distribution.PublicKey)
+	protoDistribution.SetSignature(distribution.Signature)
+	protoDistribution.SetCreatedAt(distribution.CreatedAt.Format(time.RFC3339Nano))
+	protoDistribution.SetClaimedKoinu(claimed)
+	protoDistribution.SetUnclaimedKoinu(distribution.TotalKoinu - claimed)
+	return protoDistribution
+}
+
+func toProtoDistributionEntitlement(entitlement store.DistributionEntitlement) *protocol.DistributionEntitlement {
+	claimed := min(entitlement.ClaimedKoinu, entitlement.EntitlementKoinu)
+
+	protoEntitlement := &protocol.DistributionEntitlement{}
+	protoEntitlement.SetAddress(toProtoAddress(entitlement.Address))
+	protoEntitlement.SetQuantity(int32(entitlement.Quantity))
+	protoEntitlement.SetEntitlementKoinu(entitlement.EntitlementKoinu)
+	protoEntitlement.SetClaimedKoinu(claimed)
+	protoEntitlement.SetUnclaimedKoinu(entitlement.EntitlementKoinu - claimed)
+	return protoEntitlement
+}
+
+func toProtoAuditViolations(violations store.AuditViolations) []*protocol.AuditViolation {
+	result := make([]*protocol.AuditViolation, 0, len(violations))
+	for _, violation := range violations {
+		protoViolation := &protocol.AuditViolation{}
+		protoViolation.SetCheck(violation.Check)
+		protoViolation.SetMintHash(violation.MintHash)
+		protoViolation.SetSubject(violation.Subject)
+		protoViolation.SetMessage(violation.Message)
+		result = append(result, protoViolation)
+	}
+	return result
+}
+
 func toProtoInvoices(invoices []store.Invoice) ([]*protocol.Invoice, error) {
 	result := make([]*protocol.Invoice, 0, len(invoices))
 	for _, invoice := range invoices {
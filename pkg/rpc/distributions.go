@@ -0,0 +1,148 @@
+package rpc
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"time"
+
+	connect "connectrpc.com/connect"
+	engineprotocol "dogecoin.org/fractal-engine/pkg/protocol"
+	protocol "dogecoin.org/fractal-engine/pkg/rpc/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"dogecoin.org/fractal-engine/pkg/validation"
+)
+
+// CreateDistribution declares a distribution to the holders of a mint. The response
+// carries the transaction body to write on chain with every payout of it.
+func (s *ConnectRpcService) CreateDistribution(ctx context.Context, req *connect.Request[protocol.CreateDistributionRequest]) (*connect.Response[protocol.CreateDistributionResponse], error) {
+	request, err := toCreateDistributionRequest(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	mint, err := s.store.GetMintByHash(ctx, request.Payload.MintHash)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if mint.Hash == "" {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("mint not found"))
+	}
+
+	isAssetManager := slices.ContainsFunc(mint.AssetManagers, func(am store.AssetManager) bool { return am.PublicKey == request.PublicKey })
+	if !isAssetManager {
+		if err := validation.ValidateOwnerPublicKey(mint.OwnerAddress, request.PublicKey, request.RedeemScript); err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.New("public key is not the owner or an asset manager of the mint"))
+		}
+	}
+
+	distribution := &store.Distribution{
+		MintHash:     request.Payload.MintHash,
+		TotalKoinu:   request.Payload.TotalKoinu,
+		RecordHeight: request.Payload.RecordHeight,
+		PublicKey:    request.PublicKey,
+		Signature:    request.Signature,
+		CreatedAt:    time.Now(),
+	}
+
+	distribution.Hash, err = distribution.GenerateHash()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	distribution.Id, err = s.store.SaveDistribution(ctx, distribution)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.gossipClient.GossipDistribution(*distribution); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	envelope := engineprotocol.NewDistributionPaymentTransactionEnvelope(distribution.Hash, distribution.MintHash, engineprotocol.ACTION_DISTRIBUTION_PAYMENT)
+	encodedTransactionBody := envelope.Serialize()
+
+	resp := &protocol.CreateDistributionResponse{}
+	resp.SetHash(toProtoHash(distribution.Hash))
+	resp.SetEncodedTransactionBody(hex.EncodeToString(encodedTransactionBody))
+	return connect.NewResponse(resp), nil
+}
+
+// GetDistribution returns a distribution with what each holder at the record height is
+// entitled to and how much of it has been paid out.
+func (s *ConnectRpcService) GetDistribution(ctx context.Context, req *connect.Request[protocol.GetDistributionRequest]) (*connect.Response[protocol.GetDistributionResponse], error) {
+	hash := req.Msg.GetHash().GetValue()
+	if err := validation.ValidateHash(hash); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	distribution, err := s.store.GetDistribution(ctx, hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("distribution not found"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	entitlements, err := s.store.GetDistributionEntitlements(ctx, distribution)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoEntitlements := make([]*protocol.DistributionEntitlement, 0, len(entitlements))
+	for _, entitlement := range entitlements {
+		protoEntitlements = append(protoEntitlements, toProtoDistributionEntitlement(entitlement))
+	}
+
+	resp := &protocol.GetDistributionResponse{}
+	resp.SetDistribution(toProtoDistribution(distribution, entitlements))
+	resp.SetEntitlements(protoEntitlements)
+	return connect.NewResponse(resp), nil
+}
+
+// GetDistributions returns the distributions declared for a mint, latest record
+// height first, with the amounts claimed and unclaimed on each.
+func (s *ConnectRpcService) GetDistributions(ctx context.Context, req *connect.Request[protocol.GetDistributionsRequest]) (*connect.Response[protocol.GetDistributionsResponse], error) {
+	mintHash := req.Msg.GetMintHash().GetValue()
+	if err := validation.ValidateHash(mintHash); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	limit := int32(100)
+	if req.Msg.GetLimit() != nil && req.Msg.GetLimit().GetValue() > 0 && req.Msg.GetLimit().GetValue() <= limit {
+		limit = req.Msg.GetLimit().GetValue()
+	}
+
+	page := int32(0)
+	if req.Msg.GetPage() != nil && req.Msg.GetPage().GetValue() > 0 && req.Msg.GetPage().GetValue() <= 1000 {
+		page = req.Msg.GetPage().GetValue()
+	}
+
+	distributions, err := s.store.GetDistributions(ctx, mintHash, int(page*limit), int(limit))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoDistributions := make([]*protocol.Distribution, 0, len(distributions))
+	for _, distribution := range distributions {
+		entitlements, err := s.store.GetDistributionEntitlements(ctx, distribution)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		protoDistributions = append(protoDistributions, toProtoDistribution(distribution, entitlements))
+	}
+
+	resp := &protocol.GetDistributionsResponse{}
+	resp.SetDistributions(protoDistributions)
+	resp.SetLimit(limit)
+	resp.SetPage(page)
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,123 @@
+package rpc_test
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	connect "connectrpc.com/connect"
+	"dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	engineprotocol "dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/rpc/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+	"gotest.tools/assert"
+)
+
+func newCreateDistributionRequest(t *testing.T, privHex string, pubHex string, mintHash string, totalKoinu int64, recordHeight int64) *protocol.CreateDistributionRequest {
+	payload := store.DistributionBody{
+		MintHash:     mintHash,
+		TotalKoinu:   totalKoinu,
+		RecordHeight: recordHeight,
+	}
+
+	signature, err := doge.SignPayload(payload, privHex, pubHex)
+	assert.NilError(t, err)
+
+	mintHashProto := &protocol.Hash{}
+	mintHashProto.SetValue(mintHash)
+
+	protoPayload := &protocol.CreateDistributionRequestPayload{}
+	protoPayload.SetMintHash(mintHashProto)
+	protoPayload.SetTotalKoinu(totalKoinu)
+	protoPayload.SetRecordHeight(recordHeight)
+
+	request := &protocol.CreateDistributionRequest{}
+	request.SetPayload(protoPayload)
+	request.SetPublicKey(pubHex)
+	request.SetSignature(signature)
+	return request
+}
+
+func TestCreateDistribution(t *testing.T) {
+	tokenisationStore, dogenetClient, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	ownerPrivHex, ownerPubHex, ownerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	managerPrivHex, managerPubHex, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	strangerPrivHex, strangerPubHex, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	mintHash := support.GenerateRandomHash()
+	_, err = tokenisationStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "Rental Property",
+		FractionCount: 100,
+		Hash:          mintHash,
+		PublicKey:     ownerPubHex,
+		AssetManagers: store.AssetManagers{{Name: "Manager", PublicKey: managerPubHex, URL: "https://example.com"}},
+	}, ownerAddress)
+	assert.NilError(t, err)
+
+	holderAddress := support.GenerateDogecoinAddress(true)
+	assert.NilError(t, tokenisationStore.UpsertTokenBalance(ctx, ownerAddress, mintHash, 80))
+	assert.NilError(t, tokenisationStore.UpsertTokenBalance(ctx, holderAddress, mintHash, 20))
+
+	// Only the owner or an asset manager can declare a distribution
+	_, err = feClient.CreateDistribution(ctx, connect.NewRequest(newCreateDistributionRequest(t, strangerPrivHex, strangerPubHex, mintHash, 1000, 10)))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	response, err := feClient.CreateDistribution(ctx, connect.NewRequest(newCreateDistributionRequest(t, managerPrivHex, managerPubHex, mintHash, 1000, 10)))
+	assert.NilError(t, err)
+
+	distributionHash := response.Msg.GetHash().GetValue()
+	assert.Equal(t, 1, len(dogenetClient.distributions))
+	assert.Equal(t, distributionHash, dogenetClient.distributions[0].Hash)
+	assert.NilError(t, dogenetClient.distributions[0].Validate())
+
+	encodedTransactionBody, err := hex.DecodeString(response.Msg.GetEncodedTransactionBody())
+	assert.NilError(t, err)
+
+	envelope := engineprotocol.MessageEnvelope{}
+	assert.NilError(t, envelope.Deserialize(encodedTransactionBody))
+	assert.Equal(t, uint8(engineprotocol.ACTION_DISTRIBUTION_PAYMENT), envelope.Action)
+
+	message := engineprotocol.OnChainDistributionPaymentMessage{}
+	assert.NilError(t, proto.Unmarshal(envelope.Data, &message))
+	assert.Equal(t, distributionHash, hex.EncodeToString(message.DistributionHash))
+	assert.Equal(t, mintHash, hex.EncodeToString(message.MintHash))
+
+	_, err = feClient.CreateDistribution(ctx, connect.NewRequest(newCreateDistributionRequest(t, ownerPrivHex, ownerPubHex, mintHash, 500, 20)))
+	assert.NilError(t, err)
+
+	hashProto := &protocol.Hash{}
+	hashProto.SetValue(distributionHash)
+	getRequest := &protocol.GetDistributionRequest{}
+	getRequest.SetHash(hashProto)
+
+	distribution, err := feClient.GetDistribution(ctx, connect.NewRequest(getRequest))
+	assert.NilError(t, err)
+	assert.Equal(t, int64(1000), distribution.Msg.GetDistribution().GetTotalKoinu())
+	assert.Equal(t, int64(0), distribution.Msg.GetDistribution().GetClaimedKoinu())
+	assert.Equal(t, int64(1000), distribution.Msg.GetDistribution().GetUnclaimedKoinu())
+
+	entitlements := distribution.Msg.GetEntitlements()
+	assert.Equal(t, 2, len(entitlements))
+	assert.Equal(t, ownerAddress, entitlements[0].GetAddress().GetValue())
+	assert.Equal(t, int64(800), entitlements[0].GetEntitlementKoinu())
+	assert.Equal(t, holderAddress, entitlements[1].GetAddress().GetValue())
+	assert.Equal(t, int64(200), entitlements[1].GetUnclaimedKoinu())
+
+	mintHashProto := &protocol.Hash{}
+	mintHashProto.SetValue(mintHash)
+	listRequest := &protocol.GetDistributionsRequest{}
+	listRequest.SetMintHash(mintHashProto)
+
+	distributions, err := feClient.GetDistributions(ctx, connect.NewRequest(listRequest))
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(distributions.Msg.GetDistributions()))
+	assert.Equal(t, int64(20), distributions.Msg.GetDistributions()[0].GetRecordHeight())
+	assert.Equal(t, int64(10), distributions.Msg.GetDistributions()[1].GetRecordHeight())
+}
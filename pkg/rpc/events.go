@@ -0,0 +1,93 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	connect "connectrpc.com/connect"
+	"dogecoin.org/fractal-engine/pkg/events"
+	protocol "dogecoin.org/fractal-engine/pkg/rpc/protocol"
+)
+
+var eventTypes = map[events.EventType]protocol.EventType{
+	events.EventMintConfirmed:            protocol.EventType_EVENT_TYPE_MINT_CONFIRMED,
+	events.EventInvoiceConfirmed:         protocol.EventType_EVENT_TYPE_INVOICE_CONFIRMED,
+	events.EventPaymentMatched:           protocol.EventType_EVENT_TYPE_PAYMENT_MATCHED,
+	events.EventBalanceChanged:           protocol.EventType_EVENT_TYPE_BALANCE_CHANGED,
+	events.EventOfferCreated:             protocol.EventType_EVENT_TYPE_OFFER_CREATED,
+	events.EventOfferDeleted:             protocol.EventType_EVENT_TYPE_OFFER_DELETED,
+	events.EventChainReorg:               protocol.EventType_EVENT_TYPE_CHAIN_REORG,
+	events.EventInvoiceTimedOut:          protocol.EventType_EVENT_TYPE_INVOICE_TIMED_OUT,
+	events.EventPaymentReceived:          protocol.EventType_EVENT_TYPE_PAYMENT_RECEIVED,
+	events.EventInvoiceExpired:           protocol.EventType_EVENT_TYPE_INVOICE_EXPIRED,
+	events.EventInvoiceCancelled:         protocol.EventType_EVENT_TYPE_INVOICE_CANCELLED,
+	events.EventMintAmended:              protocol.EventType_EVENT_TYPE_MINT_AMENDED,
+	events.EventMintOwnershipTransferred: protocol.EventType_EVENT_TYPE_MINT_OWNERSHIP_TRANSFERRED,
+	events.EventDistributionPaid:         protocol.EventType_EVENT_TYPE_DISTRIBUTION_PAID,
+}
+
+// SubscribeEvents streams engine events until the client disconnects. Events are
+// filtered by address, mint hash and type; an empty filter receives everything.
+func (s *ConnectRpcService) SubscribeEvents(ctx context.Context, req *connect.Request[protocol.SubscribeEventsRequest], stream *connect.ServerStream[protocol.SubscribeEventsResponse]) error {
+	filter := events.Filter{}
+
+	if req.Msg.GetAddress() != nil {
+		filter.Address = req.Msg.GetAddress().GetValue()
+	}
+
+	if req.Msg.GetMintHash() != nil {
+		filter.MintHash = req.Msg.GetMintHash().GetValue()
+	}
+
+	for _, protoType := range req.Msg.GetTypes() {
+		eventType, ok := fromProtoEventType(protoType)
+		if !ok {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid event type: %v", protoType))
+		}
+		filter.Types = append(filter.Types, eventType)
+	}
+
+	subscription, unsubscribe := s.store.Events.Subscribe(filter)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-subscription:
+			if !ok {
+				return nil
+			}
+
+			resp := &protocol.SubscribeEventsResponse{}
+			resp.SetEvent(toProtoEvent(event))
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func fromProtoEventType(protoType protocol.EventType) (events.EventType, bool) {
+	for eventType, candidate := range eventTypes {
+		if candidate == protoType {
+			return eventType, true
+		}
+	}
+
+	return "", false
+}
+
+func toProtoEvent(event events.Event) *protocol.Event {
+	protoEvent := &protocol.Event{}
+	protoEvent.SetType(eventTypes[event.Type])
+	protoEvent.SetMintHash(event.MintHash)
+	protoEvent.SetHash(event.Hash)
+	protoEvent.SetTxHash(event.TxHash)
+	protoEvent.SetAddresses(event.Addresses)
+	protoEvent.SetQuantity(int32(event.Quantity))
+	protoEvent.SetBlockHeight(event.BlockHeight)
+	protoEvent.SetCreatedAt(event.CreatedAt.Format(time.RFC3339Nano))
+	return protoEvent
+}
@@ -0,0 +1,70 @@
+package rpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	connect "connectrpc.com/connect"
+	"dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/events"
+	"dogecoin.org/fractal-engine/pkg/rpc/protocol"
+	"gotest.tools/assert"
+)
+
+func TestSubscribeEventsFiltersByMint(t *testing.T) {
+	tokenisationStore, _, feClient := SetupRpcTest(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	mintHash := support.GenerateRandomHash()
+	otherMintHash := support.GenerateRandomHash()
+
+	mintHashProto := &protocol.Hash{}
+	mintHashProto.SetValue(mintHash)
+
+	request := &protocol.SubscribeEventsRequest{}
+	request.SetMintHash(mintHashProto)
+
+	// Keep publishing until the subscription is registered on the server
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(10 * time.Millisecond):
+				tokenisationStore.Events.Publish(events.Event{Type: events.EventOfferCreated, MintHash: otherMintHash, Addresses: []string{"seller"}})
+				tokenisationStore.Events.Publish(events.Event{Type: events.EventPaymentMatched, MintHash: mintHash, Hash: "invoiceHash", Addresses: []string{"buyer", "seller"}, Quantity: 5})
+			}
+		}
+	}()
+
+	stream, err := feClient.SubscribeEvents(ctx, connect.NewRequest(request))
+	assert.NilError(t, err)
+	defer stream.Close()
+
+	assert.Assert(t, stream.Receive())
+	event := stream.Msg().GetEvent()
+	assert.Equal(t, protocol.EventType_EVENT_TYPE_PAYMENT_MATCHED, event.GetType())
+	assert.Equal(t, mintHash, event.GetMintHash())
+	assert.Equal(t, "invoiceHash", event.GetHash())
+	assert.DeepEqual(t, []string{"buyer", "seller"}, event.GetAddresses())
+	assert.Equal(t, int32(5), event.GetQuantity())
+}
+
+func TestSubscribeEventsRejectsUnknownType(t *testing.T) {
+	_, _, feClient := SetupRpcTest(t)
+
+	request := &protocol.SubscribeEventsRequest{}
+	request.SetTypes([]protocol.EventType{protocol.EventType_EVENT_TYPE_UNSPECIFIED})
+
+	stream, err := feClient.SubscribeEvents(context.Background(), connect.NewRequest(request))
+	assert.NilError(t, err)
+	defer stream.Close()
+
+	assert.Assert(t, !stream.Receive())
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(stream.Err()))
+}
@@ -27,5 +27,18 @@ func (s *ConnectRpcService) GetHealth(ctx context.Context, _ *connect.Request[pr
 	resp.SetUpdatedAt(updatedAt.Format(time.RFC3339Nano))
 	resp.SetVersion(version.Version)
 	resp.SetWalletsEnabled(walletsEnabled)
+
+	report, err := s.store.GetAuditReport(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err == nil {
+		resp.SetAuditedAt(report.CheckedAt.Format(time.RFC3339Nano))
+		resp.SetAuditBlockHeight(int32(report.BlockHeight))
+		resp.SetAuditMintsChecked(int32(report.MintsChecked))
+		resp.SetAuditViolations(toProtoAuditViolations(report.Violations))
+	}
+
 	return connect.NewResponse(resp), nil
 }
@@ -3,9 +3,11 @@ package rpc_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	connect "connectrpc.com/connect"
 	"dogecoin.org/fractal-engine/pkg/rpc/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
 	"gotest.tools/assert"
 )
 
@@ -25,4 +27,24 @@ func TestGetHealth(t *testing.T) {
 	assert.Equal(t, healthResponse.Msg.GetUpdatedAt() != "", true)
 	assert.Equal(t, healthResponse.Msg.GetChain(), "test")
 	assert.Equal(t, healthResponse.Msg.GetWalletsEnabled(), true)
+	assert.Equal(t, healthResponse.Msg.GetAuditedAt(), "")
+
+	err = tokenisationStore.SaveAuditReport(ctx, store.AuditReport{
+		CheckedAt:    time.Now(),
+		BlockHeight:  90,
+		MintsChecked: 3,
+		Violations: store.AuditViolations{
+			{Check: store.AuditCheckNegativeBalance, MintHash: "mintHash", Subject: "address", Message: "balance of address is -1"},
+		},
+	})
+	assert.NilError(t, err)
+
+	healthResponse, err = feClient.GetHealth(t.Context(), connect.NewRequest(&protocol.GetHealthRequest{}))
+	assert.NilError(t, err)
+	assert.Equal(t, healthResponse.Msg.GetAuditedAt() != "", true)
+	assert.Equal(t, healthResponse.Msg.GetAuditBlockHeight(), int32(90))
+	assert.Equal(t, healthResponse.Msg.GetAuditMintsChecked(), int32(3))
+	assert.Equal(t, len(healthResponse.Msg.GetAuditViolations()), 1)
+	assert.Equal(t, healthResponse.Msg.GetAuditViolations()[0].GetCheck(), store.AuditCheckNegativeBalance)
+	assert.Equal(t, healthResponse.Msg.GetAuditViolations()[0].GetSubject(), "address")
 }
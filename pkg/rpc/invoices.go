@@ -2,8 +2,11 @@ package rpc
 
 import (
 	"context"
+	"database/sql"
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"math"
 	"time"
 
 	connect "connectrpc.com/connect"
@@ -78,6 +81,15 @@ func (s *ConnectRpcService) GetInvoices(ctx context.Context, req *connect.Reques
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	confirmations, err := s.newConfirmationTracker(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := confirmations.setInvoiceConfirmations(ctx, invoices[start:end], responseInvoices); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
 	resp := &protocol.GetInvoicesResponse{}
 	resp.SetInvoices(responseInvoices)
 	resp.SetTotal(int32(len(invoices)))
@@ -122,6 +134,15 @@ func (s *ConnectRpcService) GetAllInvoices(ctx context.Context, req *connect.Req
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	confirmations, err := s.newConfirmationTracker(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := confirmations.setInvoiceConfirmations(ctx, invoices[start:end], responseInvoices); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
 	resp := &protocol.GetAllInvoicesResponse{}
 	resp.SetInvoices(responseInvoices)
 	resp.SetTotal(int32(len(invoices)))
@@ -140,6 +161,15 @@ func (s *ConnectRpcService) CreateInvoice(ctx context.Context, req *connect.Requ
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
+	matched, signed, err := s.signMatchedInvoice(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if signed {
+		return invoiceResponse(matched), nil
+	}
+
 	count, err := s.store.CountUnconfirmedInvoices(ctx, request.Payload.MintHash, request.Payload.BuyerAddress)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
@@ -154,22 +184,39 @@ func (s *ConnectRpcService) CreateInvoice(ctx context.Context, req *connect.Requ
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	initialStatus := "pending_signatures"
-	if mint.SignatureRequirementType == store.SignatureRequirementType_NONE || mint.SignatureRequirementType == "" {
-		initialStatus = "draft"
+	if err := s.checkMintRequirements(ctx, mint, request.Payload.BuyerAddress, request.Payload.Quantity); err != nil {
+		return nil, err
+	}
+
+	lockedTokenBalance, err := s.getLockedTokenBalance(ctx, request.Payload.SellerAddress, request.Payload.MintHash)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if lockedTokenBalance > 0 {
+		available, err := s.store.GetAvailableTokenBalance(ctx, request.Payload.SellerAddress, request.Payload.MintHash, nil)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		if available-lockedTokenBalance < request.Payload.Quantity {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("insufficient unlocked balance: %d < %d", available-lockedTokenBalance, request.Payload.Quantity))
+		}
 	}
 
 	newInvoiceWithoutId := &store.UnconfirmedInvoice{
-		MintHash:       request.Payload.MintHash,
-		Quantity:       request.Payload.Quantity,
-		Price:          request.Payload.Price,
-		BuyerAddress:   request.Payload.BuyerAddress,
-		PaymentAddress: request.Payload.PaymentAddress,
-		CreatedAt:      time.Now(),
-		SellerAddress:  request.Payload.SellerAddress,
-		PublicKey:      request.PublicKey,
-		Signature:      request.Signature,
-		Status:         initialStatus,
+		MintHash:        request.Payload.MintHash,
+		Quantity:        request.Payload.Quantity,
+		Price:           request.Payload.PriceKoinu,
+		BuyerAddress:    request.Payload.BuyerAddress,
+		PaymentAddress:  request.Payload.PaymentAddress,
+		CreatedAt:       time.Now(),
+		SellerAddress:   request.Payload.SellerAddress,
+		PublicKey:       request.PublicKey,
+		Signature:       request.Signature,
+		Status:          store.InitialInvoiceStatus(mint),
+		Splits:          request.Payload.Splits,
+		ExpiresAtHeight: request.Payload.ExpiresAtHeight,
 	}
 
 	newInvoiceWithoutId.Hash, err = newInvoiceWithoutId.GenerateHash()
@@ -188,13 +235,70 @@ func (s *ConnectRpcService) CreateInvoice(ctx context.Context, req *connect.Requ
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	envelope := engineprotocol.NewInvoiceTransactionEnvelope(newInvoiceWithoutId.Hash, newInvoiceWithoutId.MintHash, int32(newInvoiceWithoutId.Quantity), engineprotocol.ACTION_INVOICE)
+	return invoiceResponse(*newInvoiceWithoutId), nil
+}
+
+// signMatchedInvoice looks for an invoice the order book produced with the same terms
+// as the request. The seller's signature completes such an invoice, which is then
+// gossiped like one created here. It reports false when there is no matched invoice.
+func (s *ConnectRpcService) signMatchedInvoice(ctx context.Context, request *CreateInvoiceRequest) (store.UnconfirmedInvoice, bool, error) {
+	invoice := store.UnconfirmedInvoice{
+		MintHash:        request.Payload.MintHash,
+		Quantity:        request.Payload.Quantity,
+		Price:           request.Payload.PriceKoinu,
+		BuyerAddress:    request.Payload.BuyerAddress,
+		PaymentAddress:  request.Payload.PaymentAddress,
+		SellerAddress:   request.Payload.SellerAddress,
+		PublicKey:       request.PublicKey,
+		Signature:       request.Signature,
+		Splits:          request.Payload.Splits,
+		ExpiresAtHeight: request.Payload.ExpiresAtHeight,
+	}
+
+	hash, err := invoice.GenerateHash()
+	if err != nil {
+		return store.UnconfirmedInvoice{}, false, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	invoice.Hash = hash
+
+	matched, err := s.store.GetUnconfirmedInvoiceByHash(ctx, hash)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && matched.Signature != "") {
+		return store.UnconfirmedInvoice{}, false, nil
+	}
+	if err != nil {
+		return store.UnconfirmedInvoice{}, false, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if matched.PaymentAddress != invoice.PaymentAddress {
+		return store.UnconfirmedInvoice{}, false, connect.NewError(connect.CodeInvalidArgument, errors.New("payment address does not match the matched invoice"))
+	}
+
+	signed, err := s.store.SignUnconfirmedInvoice(ctx, invoice)
+	if err != nil {
+		return store.UnconfirmedInvoice{}, false, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if !signed {
+		return store.UnconfirmedInvoice{}, false, nil
+	}
+
+	matched.Signature = invoice.Signature
+
+	if err := s.gossipClient.GossipUnconfirmedInvoice(matched); err != nil {
+		return store.UnconfirmedInvoice{}, false, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return matched, true, nil
+}
+
+func invoiceResponse(invoice store.UnconfirmedInvoice) *connect.Response[protocol.CreateInvoiceResponse] {
+	envelope := engineprotocol.NewExpiringInvoiceTransactionEnvelope(invoice.Hash, invoice.MintHash, int32(invoice.Quantity), invoice.ExpiresAtHeight, engineprotocol.ACTION_INVOICE)
 	encodedTransactionBody := envelope.Serialize()
 
 	resp := &protocol.CreateInvoiceResponse{}
-	resp.SetHash(toProtoHash(newInvoiceWithoutId.Hash))
+	resp.SetHash(toProtoHash(invoice.Hash))
 	resp.SetEncodedTransactionBody(hex.EncodeToString(encodedTransactionBody))
-	return connect.NewResponse(resp), nil
+	return connect.NewResponse(resp)
 }
 
 func (s *ConnectRpcService) CreateInvoiceSignature(ctx context.Context, req *connect.Request[protocol.CreateInvoiceSignatureRequest]) (*connect.Response[protocol.CreateInvoiceSignatureResponse], error) {
@@ -237,3 +341,130 @@ func (s *ConnectRpcService) CreateInvoiceSignature(ctx context.Context, req *con
 	resp.SetId(id)
 	return connect.NewResponse(resp), nil
 }
+
+/*
+* CancelInvoice withdraws an invoice on behalf of its buyer or seller and returns the
+* transaction that cancels it on chain. A gossiped invoice is withdrawn straight away.
+* A confirmed invoice keeps its reserved fractions until the cancellation is matched on
+* chain, and cannot be cancelled once a payment towards it has been made.
+ */
+func (s *ConnectRpcService) CancelInvoice(ctx context.Context, req *connect.Request[protocol.CancelInvoiceRequest]) (*connect.Response[protocol.CancelInvoiceResponse], error) {
+	request, err := toCancelInvoiceRequest(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	confirmed := false
+	buyerAddress, sellerAddress := "", ""
+	invoice, err := s.store.GetInvoiceByHash(ctx, request.Payload.InvoiceHash)
+	switch {
+	case err == nil:
+		if invoice.Status != store.InvoiceStatusOnChain {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("invoice is %s", invoice.Status))
+		}
+		confirmed = true
+		buyerAddress, sellerAddress = invoice.BuyerAddress, invoice.SellerAddress
+	case errors.Is(err, sql.ErrNoRows):
+		unconfirmedInvoice, err := s.store.GetUnconfirmedInvoiceByHash(ctx, request.Payload.InvoiceHash)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("invoice not found"))
+		}
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		buyerAddress, sellerAddress = unconfirmedInvoice.BuyerAddress, unconfirmedInvoice.SellerAddress
+	default:
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if validation.ValidateOwnerPublicKey(buyerAddress, request.PublicKey, request.RedeemScript) != nil && validation.ValidateOwnerPublicKey(sellerAddress, request.PublicKey, request.RedeemScript) != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("public key is not the buyer or seller of the invoice"))
+	}
+
+	value := "Invoice cancelled"
+	if confirmed {
+		paid, err := s.store.HasInvoicePayments(ctx, request.Payload.InvoiceHash, math.MaxInt64)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		if paid {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("invoice has payments"))
+		}
+
+		value = "Invoice cancellation pending on chain"
+	} else {
+		err = s.store.CancelUnconfirmedInvoice(ctx, request.Payload.InvoiceHash)
+		if errors.Is(err, store.ErrInvalidInvoiceTransition) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		if err := s.gossipClient.GossipCancelInvoice(request.Payload.InvoiceHash, request.PublicKey, request.Signature); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	envelope := engineprotocol.NewCancelInvoiceTransactionEnvelope(request.Payload.InvoiceHash, engineprotocol.ACTION_CANCEL_INVOICE)
+	encodedTransactionBody := envelope.Serialize()
+
+	resp := &protocol.CancelInvoiceResponse{}
+	resp.SetValue(value)
+	resp.SetEncodedTransactionBody(hex.EncodeToString(encodedTransactionBody))
+	return connect.NewResponse(resp), nil
+}
+
+// GetInvoiceHistory returns the status an invoice is in and the transitions that led
+// there, oldest first.
+func (s *ConnectRpcService) GetInvoiceHistory(ctx context.Context, req *connect.Request[protocol.GetInvoiceHistoryRequest]) (*connect.Response[protocol.GetInvoiceHistoryResponse], error) {
+	hash := req.Msg.GetInvoiceHash().GetValue()
+	if err := validation.ValidateHash(hash); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	transitions, err := s.store.GetInvoiceHistory(ctx, hash)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	status := ""
+	if len(transitions) > 0 {
+		status = transitions[len(transitions)-1].ToStatus
+	}
+
+	invoice, err := s.store.GetInvoiceByHash(ctx, hash)
+	switch {
+	case err == nil:
+		status = invoice.Status
+	case errors.Is(err, sql.ErrNoRows):
+		unconfirmedInvoice, err := s.store.GetUnconfirmedInvoiceByHash(ctx, hash)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if err == nil {
+			status = unconfirmedInvoice.Status
+		}
+	default:
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if status == "" {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("invoice not found"))
+	}
+
+	protoTransitions := make([]*protocol.InvoiceTransition, 0, len(transitions))
+	for _, transition := range transitions {
+		protoTransitions = append(protoTransitions, toProtoInvoiceTransition(transition))
+	}
+
+	resp := &protocol.GetInvoiceHistoryResponse{}
+	resp.SetStatus(status)
+	resp.SetTransitions(protoTransitions)
+	return connect.NewResponse(resp), nil
+}
@@ -2,15 +2,18 @@ package rpc_test
 
 import (
 	"context"
+	"encoding/hex"
 	"testing"
 	"time"
 
 	connect "connectrpc.com/connect"
 	"dogecoin.org/fractal-engine/internal/test/support"
 	"dogecoin.org/fractal-engine/pkg/doge"
+	engineprotocol "dogecoin.org/fractal-engine/pkg/protocol"
 	"dogecoin.org/fractal-engine/pkg/rpc"
 	"dogecoin.org/fractal-engine/pkg/rpc/protocol"
 	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
 	"gotest.tools/assert"
 )
 
@@ -36,7 +39,7 @@ func TestInvoices(t *testing.T) {
 		BuyerAddress:   buyerAddress,
 		MintHash:       mintHash,
 		Quantity:       10,
-		Price:          100,
+		PriceKoinu:     100,
 		SellerAddress:  sellOfferAddress,
 	}
 
@@ -60,7 +63,7 @@ func TestInvoices(t *testing.T) {
 	protoPayload.SetBuyerAddress(buyerAddressProto)
 	protoPayload.SetMintHash(mintHashProto)
 	protoPayload.SetQuantity(10)
-	protoPayload.SetPrice(100)
+	protoPayload.SetPriceKoinu(100)
 	protoPayload.SetSellerAddress(sellerAddressProto)
 
 	invoice := &protocol.CreateInvoiceRequest{}
@@ -84,7 +87,7 @@ func TestInvoices(t *testing.T) {
 	assert.Equal(t, invoices[0].BuyerAddress, invoicePayload.BuyerAddress)
 	assert.Equal(t, invoices[0].MintHash, invoicePayload.MintHash)
 	assert.Equal(t, invoices[0].Quantity, invoicePayload.Quantity)
-	assert.Equal(t, invoices[0].Price, invoicePayload.Price)
+	assert.Equal(t, invoices[0].Price, invoicePayload.PriceKoinu)
 	assert.Equal(t, invoices[0].SellerAddress, invoicePayload.SellerAddress)
 	assert.Equal(t, invoices[0].Status, "draft")
 
@@ -94,7 +97,7 @@ func TestInvoices(t *testing.T) {
 	assert.Equal(t, dogenetClient.invoices[0].BuyerAddress, invoicePayload.BuyerAddress)
 	assert.Equal(t, dogenetClient.invoices[0].MintHash, invoicePayload.MintHash)
 	assert.Equal(t, dogenetClient.invoices[0].Quantity, invoicePayload.Quantity)
-	assert.Equal(t, dogenetClient.invoices[0].Price, invoicePayload.Price)
+	assert.Equal(t, dogenetClient.invoices[0].Price, invoicePayload.PriceKoinu)
 	assert.Equal(t, dogenetClient.invoices[0].SellerAddress, invoicePayload.SellerAddress)
 }
 
@@ -140,7 +143,7 @@ func TestInvoicesWithSignatureRequired(t *testing.T) {
 			BuyerAddress:   buyerAddress,
 			MintHash:       confirmedMint.Hash,
 			Quantity:       10,
-			Price:          100,
+			PriceKoinu:     100,
 			SellerAddress:  sellOfferAddress,
 		},
 	}
@@ -165,7 +168,7 @@ func TestInvoicesWithSignatureRequired(t *testing.T) {
 	protoPayload.SetBuyerAddress(buyerAddressProto)
 	protoPayload.SetMintHash(mintHashProto)
 	protoPayload.SetQuantity(10)
-	protoPayload.SetPrice(100)
+	protoPayload.SetPriceKoinu(100)
 	protoPayload.SetSellerAddress(sellerAddressProto)
 
 	protoInvoice := &protocol.CreateInvoiceRequest{}
@@ -189,7 +192,7 @@ func TestInvoicesWithSignatureRequired(t *testing.T) {
 	assert.Equal(t, invoices[0].BuyerAddress, invoice.Payload.BuyerAddress)
 	assert.Equal(t, invoices[0].MintHash, invoice.Payload.MintHash)
 	assert.Equal(t, invoices[0].Quantity, invoice.Payload.Quantity)
-	assert.Equal(t, invoices[0].Price, invoice.Payload.Price)
+	assert.Equal(t, invoices[0].Price, invoice.Payload.PriceKoinu)
 	assert.Equal(t, invoices[0].SellerAddress, invoice.Payload.SellerAddress)
 	assert.Equal(t, invoices[0].Status, "pending_signatures")
 
@@ -199,7 +202,7 @@ func TestInvoicesWithSignatureRequired(t *testing.T) {
 	assert.Equal(t, dogenetClient.invoices[0].BuyerAddress, invoice.Payload.BuyerAddress)
 	assert.Equal(t, dogenetClient.invoices[0].MintHash, invoice.Payload.MintHash)
 	assert.Equal(t, dogenetClient.invoices[0].Quantity, invoice.Payload.Quantity)
-	assert.Equal(t, dogenetClient.invoices[0].Price, invoice.Payload.Price)
+	assert.Equal(t, dogenetClient.invoices[0].Price, invoice.Payload.PriceKoinu)
 	assert.Equal(t, dogenetClient.invoices[0].SellerAddress, invoice.Payload.SellerAddress)
 }
 
@@ -227,7 +230,7 @@ func TestInvoiceBuyerCantCreateOwnInvoiceWithBuyerSignature(t *testing.T) {
 		BuyerAddress:   buyerAddress,
 		MintHash:       mintHash,
 		Quantity:       10,
-		Price:          100,
+		PriceKoinu:     100,
 		SellerAddress:  sellOfferAddress,
 	}
 
@@ -249,7 +252,7 @@ func TestInvoiceBuyerCantCreateOwnInvoiceWithBuyerSignature(t *testing.T) {
 	protoPayload.SetBuyerAddress(buyerAddressProto)
 	protoPayload.SetMintHash(mintHashProto)
 	protoPayload.SetQuantity(10)
-	protoPayload.SetPrice(100)
+	protoPayload.SetPriceKoinu(100)
 	protoPayload.SetSellerAddress(sellerAddressProto)
 
 	invoice := &protocol.CreateInvoiceRequest{}
@@ -336,7 +339,7 @@ func TestCreateInvoiceSignature(t *testing.T) {
 	invoiceBody := store.InvoiceSignatureBody{
 		Hash:           invoice.Hash,
 		MintHash:       invoice.MintHash,
-		Price:          invoice.Price,
+		PriceKoinu:     invoice.Price,
 		Quantity:       invoice.Quantity,
 		BuyerAddress:   invoice.BuyerAddress,
 		PaymentAddress: invoice.PaymentAddress,
@@ -367,3 +370,485 @@ func TestCreateInvoiceSignature(t *testing.T) {
 
 	assert.Equal(t, savedInvoiceHash, invoice.Hash)
 }
+
+func TestCreateInvoiceWithSplits(t *testing.T) {
+	tokenisationStore, dogenetClient, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	buyerAddress := support.GenerateDogecoinAddress(true)
+	royaltyAddress := support.GenerateDogecoinAddress(true)
+	platformAddress := support.GenerateDogecoinAddress(true)
+	mintHash := support.GenerateRandomHash()
+
+	_, err := tokenisationStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "mint-splits",
+		Description:   "splits",
+		FractionCount: 100,
+		Hash:          mintHash,
+	}, "owner")
+	assert.NilError(t, err)
+
+	sellerPrivKey, sellerPubKey, sellerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	createInvoice := func(splits store.InvoiceSplits) (*connect.Response[protocol.CreateInvoiceResponse], error) {
+		invoicePayload := rpc.CreateInvoiceRequestPayload{
+			PaymentAddress: sellerAddress,
+			BuyerAddress:   buyerAddress,
+			MintHash:       mintHash,
+			Quantity:       10,
+			PriceKoinu:     100,
+			SellerAddress:  sellerAddress,
+			Splits:         splits,
+		}
+
+		signature, err := doge.SignPayload(invoicePayload, sellerPrivKey, sellerPubKey)
+		assert.NilError(t, err)
+
+		paymentAddressProto := &protocol.Address{}
+		paymentAddressProto.SetValue(sellerAddress)
+		buyerAddressProto := &protocol.Address{}
+		buyerAddressProto.SetValue(buyerAddress)
+		mintHashProto := &protocol.Hash{}
+		mintHashProto.SetValue(mintHash)
+
+		protoSplits := []*protocol.InvoiceSplit{}
+		for _, split := range splits {
+			addressProto := &protocol.Address{}
+			addressProto.SetValue(split.Address)
+			protoSplit := &protocol.InvoiceSplit{}
+			protoSplit.SetAddress(addressProto)
+			protoSplit.SetBasisPoints(int32(split.BasisPoints))
+			protoSplits = append(protoSplits, protoSplit)
+		}
+
+		protoPayload := &protocol.CreateInvoiceRequestPayload{}
+		protoPayload.SetPaymentAddress(paymentAddressProto)
+		protoPayload.SetBuyerAddress(buyerAddressProto)
+		protoPayload.SetMintHash(mintHashProto)
+		protoPayload.SetQuantity(10)
+		protoPayload.SetPriceKoinu(100)
+		protoPayload.SetSellerAddress(paymentAddressProto)
+		protoPayload.SetSplits(protoSplits)
+
+		invoice := &protocol.CreateInvoiceRequest{}
+		invoice.SetPayload(protoPayload)
+		invoice.SetPublicKey(sellerPubKey)
+		invoice.SetSignature(signature)
+
+		return feClient.CreateInvoice(ctx, connect.NewRequest(invoice))
+	}
+
+	// Splits must add up to the whole amount
+	_, err = createInvoice(store.InvoiceSplits{
+		{Address: sellerAddress, BasisPoints: 9500},
+		{Address: royaltyAddress, BasisPoints: 300},
+	})
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	// The payment address must be one of the recipients
+	_, err = createInvoice(store.InvoiceSplits{
+		{Address: royaltyAddress, BasisPoints: 5000},
+		{Address: platformAddress, BasisPoints: 5000},
+	})
+	assert.ErrorContains(t, err, "payment_address must be one of the split addresses")
+
+	splits := store.InvoiceSplits{
+		{Address: sellerAddress, BasisPoints: 9500},
+		{Address: royaltyAddress, BasisPoints: 300},
+		{Address: platformAddress, BasisPoints: 200},
+	}
+	invoiceResponse, err := createInvoice(splits)
+	assert.NilError(t, err)
+
+	invoices, err := tokenisationStore.GetUnconfirmedInvoices(ctx, 0, 10, mintHash, buyerAddress)
+	assert.NilError(t, err)
+	assert.Equal(t, len(invoices), 1)
+	assert.Equal(t, invoices[0].Hash, invoiceResponse.Msg.GetHash().GetValue())
+	assert.DeepEqual(t, invoices[0].Splits, splits)
+
+	assert.Equal(t, len(dogenetClient.invoices), 1)
+	assert.DeepEqual(t, dogenetClient.invoices[0].Splits, splits)
+}
+
+func TestCreateInvoiceSignsMatchedInvoice(t *testing.T) {
+	tokenisationStore, dogenetClient, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	buyerAddress := support.GenerateDogecoinAddress(true)
+	otherAddress := support.GenerateDogecoinAddress(true)
+	mintHash := support.GenerateRandomHash()
+
+	_, err := tokenisationStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "mint-matched",
+		Description:   "matched",
+		FractionCount: 100,
+		Hash:          mintHash,
+	}, "owner")
+	assert.NilError(t, err)
+
+	sellerPrivKey, sellerPubKey, sellerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	// The order book leaves the invoice for a fill unsigned
+	matched := &store.UnconfirmedInvoice{
+		MintHash:       mintHash,
+		Quantity:       10,
+		Price:          100,
+		BuyerAddress:   buyerAddress,
+		PaymentAddress: sellerAddress,
+		SellerAddress:  sellerAddress,
+		PublicKey:      sellerPubKey,
+		CreatedAt:      time.Now(),
+		Status:         "draft",
+	}
+	matched.Hash, err = matched.GenerateHash()
+	assert.NilError(t, err)
+
+	_, err = tokenisationStore.SaveUnconfirmedInvoice(ctx, matched)
+	assert.NilError(t, err)
+
+	createInvoice := func(paymentAddress string) (*connect.Response[protocol.CreateInvoiceResponse], error) {
+		invoicePayload := rpc.CreateInvoiceRequestPayload{
+			PaymentAddress: paymentAddress,
+			BuyerAddress:   buyerAddress,
+			MintHash:       mintHash,
+			Quantity:       10,
+			PriceKoinu:     100,
+			SellerAddress:  sellerAddress,
+		}
+
+		signature, err := doge.SignPayload(invoicePayload, sellerPrivKey, sellerPubKey)
+		assert.NilError(t, err)
+
+		paymentAddressProto := &protocol.Address{}
+		paymentAddressProto.SetValue(paymentAddress)
+		buyerAddressProto := &protocol.Address{}
+		buyerAddressProto.SetValue(buyerAddress)
+		sellerAddressProto := &protocol.Address{}
+		sellerAddressProto.SetValue(sellerAddress)
+		mintHashProto := &protocol.Hash{}
+		mintHashProto.SetValue(mintHash)
+
+		protoPayload := &protocol.CreateInvoiceRequestPayload{}
+		protoPayload.SetPaymentAddress(paymentAddressProto)
+		protoPayload.SetBuyerAddress(buyerAddressProto)
+		protoPayload.SetMintHash(mintHashProto)
+		protoPayload.SetQuantity(10)
+		protoPayload.SetPriceKoinu(100)
+		protoPayload.SetSellerAddress(sellerAddressProto)
+
+		invoice := &protocol.CreateInvoiceRequest{}
+		invoice.SetPayload(protoPayload)
+		invoice.SetPublicKey(sellerPubKey)
+		invoice.SetSignature(signature)
+
+		return feClient.CreateInvoice(ctx, connect.NewRequest(invoice))
+	}
+
+	// The payment address is not part of the hash, so it must match the fill
+	_, err = createInvoice(otherAddress)
+	assert.ErrorContains(t, err, "payment address does not match the matched invoice")
+
+	invoiceResponse, err := createInvoice(sellerAddress)
+	assert.NilError(t, err)
+	assert.Equal(t, matched.Hash, invoiceResponse.Msg.GetHash().GetValue())
+
+	invoices, err := tokenisationStore.GetUnconfirmedInvoices(ctx, 0, 10, mintHash, buyerAddress)
+	assert.NilError(t, err)
+	assert.Equal(t, len(invoices), 1)
+	assert.Assert(t, invoices[0].Signature != "")
+
+	assert.Equal(t, len(dogenetClient.invoices), 1)
+	assert.Equal(t, dogenetClient.invoices[0].Hash, matched.Hash)
+	assert.Equal(t, dogenetClient.invoices[0].Signature, invoices[0].Signature)
+}
+
+func TestGetAllInvoicesReportsPaymentConfirmationsRemaining(t *testing.T) {
+	tokenisationStore, _, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	assert.NilError(t, tokenisationStore.UpsertHealth(ctx, 100, 100, "regtest", false))
+
+	mintHash := support.GenerateRandomHash()
+	_, err := tokenisationStore.SaveMint(ctx, &store.MintWithoutID{Title: "Test Mint", FractionCount: 100, Hash: mintHash}, "sellerAddress")
+	assert.NilError(t, err)
+
+	saveInvoice := func() string {
+		invoice := &store.Invoice{
+			Hash:          support.GenerateRandomHash(),
+			MintHash:      mintHash,
+			Quantity:      1,
+			Price:         10,
+			BuyerAddress:  "buyerAddress",
+			SellerAddress: "sellerAddress",
+			CreatedAt:     time.Now(),
+		}
+		_, err := tokenisationStore.SaveInvoice(ctx, invoice)
+		assert.NilError(t, err)
+		return invoice.Hash
+	}
+
+	unpaidHash := saveInvoice()
+	pendingHash := saveInvoice()
+
+	// A payment mined at the tip has one of the six confirmations payments need
+	data, err := proto.Marshal(&engineprotocol.OnChainPaymentMessage{Hash: pendingHash})
+	assert.NilError(t, err)
+	_, err = tokenisationStore.SaveOnChainTransaction(ctx, "paymentTx", 100, "blockHash", 1, engineprotocol.ACTION_PAYMENT, engineprotocol.DEFAULT_VERSION, data, "buyerAddress", map[string]interface{}{})
+	assert.NilError(t, err)
+
+	mintHashProto := &protocol.Hash{}
+	mintHashProto.SetValue(mintHash)
+	request := &protocol.GetAllInvoicesRequest{}
+	request.SetMintHash(mintHashProto)
+
+	response, err := feClient.GetAllInvoices(ctx, connect.NewRequest(request))
+	assert.NilError(t, err)
+
+	remaining := map[string]int32{}
+	for _, invoice := range response.Msg.GetInvoices() {
+		remaining[invoice.GetHash().GetValue()] = invoice.GetPaymentConfirmationsRemaining()
+	}
+	assert.DeepEqual(t, remaining, map[string]int32{unpaidHash: 6, pendingHash: 5})
+}
+
+func TestCancelInvoice(t *testing.T) {
+	tokenisationStore, dogenetClient, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	mintHash := support.GenerateRandomHash()
+	_, err := tokenisationStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "mint-cancel",
+		Description:   "cancel",
+		FractionCount: 100,
+		Hash:          mintHash,
+	}, "owner")
+	assert.NilError(t, err)
+
+	sellerPrivKey, sellerPubKey, sellerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	buyerPrivKey, buyerPubKey, buyerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	strangerPrivKey, strangerPubKey, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	invoicePayload := rpc.CreateInvoiceRequestPayload{
+		PaymentAddress:  sellerAddress,
+		BuyerAddress:    buyerAddress,
+		MintHash:        mintHash,
+		Quantity:        10,
+		PriceKoinu:      100,
+		SellerAddress:   sellerAddress,
+		ExpiresAtHeight: 500,
+	}
+	signature, err := doge.SignPayload(invoicePayload, sellerPrivKey, sellerPubKey)
+	assert.NilError(t, err)
+
+	sellerAddressProto := &protocol.Address{}
+	sellerAddressProto.SetValue(sellerAddress)
+	buyerAddressProto := &protocol.Address{}
+	buyerAddressProto.SetValue(buyerAddress)
+	mintHashProto := &protocol.Hash{}
+	mintHashProto.SetValue(mintHash)
+
+	protoPayload := &protocol.CreateInvoiceRequestPayload{}
+	protoPayload.SetPaymentAddress(sellerAddressProto)
+	protoPayload.SetBuyerAddress(buyerAddressProto)
+	protoPayload.SetMintHash(mintHashProto)
+	protoPayload.SetQuantity(10)
+	protoPayload.SetPriceKoinu(100)
+	protoPayload.SetSellerAddress(sellerAddressProto)
+	protoPayload.SetExpiresAtHeight(500)
+
+	createRequest := &protocol.CreateInvoiceRequest{}
+	createRequest.SetPayload(protoPayload)
+	createRequest.SetPublicKey(sellerPubKey)
+	createRequest.SetSignature(signature)
+
+	createResponse, err := feClient.CreateInvoice(ctx, connect.NewRequest(createRequest))
+	assert.NilError(t, err)
+	invoiceHash := createResponse.Msg.GetHash().GetValue()
+
+	// Invoices that expire are written on chain as version 2
+	body, err := hex.DecodeString(createResponse.Msg.GetEncodedTransactionBody())
+	assert.NilError(t, err)
+	envelope := engineprotocol.MessageEnvelope{}
+	assert.NilError(t, envelope.Deserialize(body))
+	assert.Equal(t, envelope.Version, uint8(engineprotocol.INVOICE_EXPIRY_VERSION))
+
+	onChainInvoice := engineprotocol.OnChainInvoiceMessage{}
+	assert.NilError(t, proto.Unmarshal(envelope.Data, &onChainInvoice))
+	assert.Equal(t, onChainInvoice.ExpiresAtHeight, int64(500))
+
+	unconfirmedInvoice, err := tokenisationStore.GetUnconfirmedInvoiceByHash(ctx, invoiceHash)
+	assert.NilError(t, err)
+	assert.Equal(t, unconfirmedInvoice.ExpiresAtHeight, int64(500))
+
+	cancelInvoice := func(privKey string, pubKey string) (*connect.Response[protocol.CancelInvoiceResponse], error) {
+		cancelPayload := rpc.CancelInvoiceRequestPayload{InvoiceHash: invoiceHash}
+		signature, err := doge.SignPayload(cancelPayload, privKey, pubKey)
+		assert.NilError(t, err)
+
+		invoiceHashProto := &protocol.Hash{}
+		invoiceHashProto.SetValue(invoiceHash)
+		payload := &protocol.CancelInvoiceRequestPayload{}
+		payload.SetInvoiceHash(invoiceHashProto)
+
+		request := &protocol.CancelInvoiceRequest{}
+		request.SetPayload(payload)
+		request.SetPublicKey(pubKey)
+		request.SetSignature(signature)
+
+		return feClient.CancelInvoice(ctx, connect.NewRequest(request))
+	}
+
+	// Only the buyer or seller can cancel an invoice
+	_, err = cancelInvoice(strangerPrivKey, strangerPubKey)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assert.Equal(t, len(dogenetClient.cancelledInvoices), 0)
+
+	cancelResponse, err := cancelInvoice(buyerPrivKey, buyerPubKey)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, dogenetClient.cancelledInvoices, []string{invoiceHash})
+
+	var status string
+	err = tokenisationStore.DB.QueryRow("SELECT status FROM unconfirmed_invoices WHERE hash = $1", invoiceHash).Scan(&status)
+	assert.NilError(t, err)
+	assert.Equal(t, status, store.InvoiceStatusCancelled)
+
+	body, err = hex.DecodeString(cancelResponse.Msg.GetEncodedTransactionBody())
+	assert.NilError(t, err)
+	assert.NilError(t, envelope.Deserialize(body))
+	assert.Equal(t, envelope.Action, uint8(engineprotocol.ACTION_CANCEL_INVOICE))
+
+	cancelMessage := engineprotocol.OnChainCancelInvoiceMessage{}
+	assert.NilError(t, proto.Unmarshal(envelope.Data, &cancelMessage))
+	assert.Equal(t, hex.EncodeToString(cancelMessage.InvoiceHash), invoiceHash)
+
+	// A cancelled invoice cannot be cancelled again
+	_, err = cancelInvoice(sellerPrivKey, sellerPubKey)
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+}
+
+func TestCancelConfirmedInvoice(t *testing.T) {
+	tokenisationStore, dogenetClient, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	sellerPrivKey, sellerPubKey, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	_, cosignerPubKey, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	buyerPrivKey, buyerPubKey, buyerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	// The seller holds the fractions in a 1-of-2 multisig wallet
+	script := []byte{0x51}
+	for _, pubKey := range []string{sellerPubKey, cosignerPubKey} {
+		pubKeyBytes, err := hex.DecodeString(pubKey)
+		assert.NilError(t, err)
+		script = append(script, byte(len(pubKeyBytes)))
+		script = append(script, pubKeyBytes...)
+	}
+	script = append(script, 0x52, 0xae)
+	sellerAddress := doge.ScriptToP2SHAddress(script, doge.ScriptPrefixRegtest)
+
+	invoiceHash := support.GenerateRandomHash()
+	_, err = tokenisationStore.SaveInvoice(ctx, &store.Invoice{
+		Hash:           invoiceHash,
+		PaymentAddress: sellerAddress,
+		BuyerAddress:   buyerAddress,
+		MintHash:       support.GenerateRandomHash(),
+		Quantity:       10,
+		Price:          100,
+		CreatedAt:      time.Now(),
+		SellerAddress:  sellerAddress,
+	})
+	assert.NilError(t, err)
+
+	cancelInvoice := func(privKey string, pubKey string, redeemScript string) (*connect.Response[protocol.CancelInvoiceResponse], error) {
+		cancelPayload := rpc.CancelInvoiceRequestPayload{InvoiceHash: invoiceHash}
+		signature, err := doge.SignPayload(cancelPayload, privKey, pubKey)
+		assert.NilError(t, err)
+
+		invoiceHashProto := &protocol.Hash{}
+		invoiceHashProto.SetValue(invoiceHash)
+		payload := &protocol.CancelInvoiceRequestPayload{}
+		payload.SetInvoiceHash(invoiceHashProto)
+
+		request := &protocol.CancelInvoiceRequest{}
+		request.SetPayload(payload)
+		request.SetPublicKey(pubKey)
+		request.SetSignature(signature)
+		request.SetRedeemScript(redeemScript)
+
+		return feClient.CancelInvoice(ctx, connect.NewRequest(request))
+	}
+
+	_, err = cancelInvoice(sellerPrivKey, sellerPubKey, "")
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	// The reserved fractions are only released once the cancellation is mined
+	cancelResponse, err := cancelInvoice(sellerPrivKey, sellerPubKey, hex.EncodeToString(script))
+	assert.NilError(t, err)
+	assert.Equal(t, cancelResponse.Msg.GetValue(), "Invoice cancellation pending on chain")
+	assert.Assert(t, cancelResponse.Msg.GetEncodedTransactionBody() != "")
+	assert.Equal(t, len(dogenetClient.cancelledInvoices), 0)
+
+	invoice, err := tokenisationStore.GetInvoiceByHash(ctx, invoiceHash)
+	assert.NilError(t, err)
+	assert.Equal(t, invoice.Status, store.InvoiceStatusOnChain)
+
+	// Once the buyer has paid towards the invoice it can no longer be cancelled
+	paymentData, err := proto.Marshal(&engineprotocol.OnChainPaymentMessage{Hash: invoiceHash})
+	assert.NilError(t, err)
+	_, err = tokenisationStore.SaveOnChainTransaction(ctx, support.GenerateRandomHash(), 5, "blockHash", 1, engineprotocol.ACTION_PAYMENT, engineprotocol.DEFAULT_VERSION, paymentData, buyerAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	_, err = cancelInvoice(buyerPrivKey, buyerPubKey, "")
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+}
+
+func TestGetInvoiceHistory(t *testing.T) {
+	tokenisationStore, _, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	getInvoiceHistory := func(invoiceHash string) (*connect.Response[protocol.GetInvoiceHistoryResponse], error) {
+		invoiceHashProto := &protocol.Hash{}
+		invoiceHashProto.SetValue(invoiceHash)
+		request := &protocol.GetInvoiceHistoryRequest{}
+		request.SetInvoiceHash(invoiceHashProto)
+		return feClient.GetInvoiceHistory(ctx, connect.NewRequest(request))
+	}
+
+	_, err := getInvoiceHistory(support.GenerateRandomHash())
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = getInvoiceHistory("not-a-hash")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	invoiceHash := support.GenerateRandomHash()
+	_, err = tokenisationStore.SaveUnconfirmedInvoice(ctx, &store.UnconfirmedInvoice{
+		Hash:          invoiceHash,
+		MintHash:      support.GenerateRandomHash(),
+		Quantity:      10,
+		Price:         100,
+		BuyerAddress:  support.GenerateDogecoinAddress(true),
+		SellerAddress: support.GenerateDogecoinAddress(true),
+		CreatedAt:     time.Now(),
+	})
+	assert.NilError(t, err)
+	assert.NilError(t, tokenisationStore.CancelUnconfirmedInvoice(ctx, invoiceHash))
+
+	response, err := getInvoiceHistory(invoiceHash)
+	assert.NilError(t, err)
+	assert.Equal(t, response.Msg.GetStatus(), store.InvoiceStatusCancelled)
+
+	transitions := response.Msg.GetTransitions()
+	assert.Equal(t, len(transitions), 2)
+	assert.Equal(t, transitions[0].GetFromStatus(), "")
+	assert.Equal(t, transitions[0].GetToStatus(), store.InvoiceStatusDraft)
+	assert.Equal(t, transitions[1].GetFromStatus(), store.InvoiceStatusDraft)
+	assert.Equal(t, transitions[1].GetToStatus(), store.InvoiceStatusCancelled)
+	assert.Equal(t, transitions[1].GetBlockHeight(), int64(0))
+}
@@ -0,0 +1,45 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	connect "connectrpc.com/connect"
+	"dogecoin.org/fractal-engine/pkg/metrics"
+)
+
+// metricsInterceptor records latency and result code for every RPC, labelled by
+// the procedure name.
+type metricsInterceptor struct{}
+
+func (metricsInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		start := time.Now()
+		resp, err := next(ctx, req)
+		observeRpc(req.Spec().Procedure, start, err)
+		return resp, err
+	}
+}
+
+func (metricsInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (metricsInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		err := next(ctx, conn)
+		observeRpc(conn.Spec().Procedure, start, err)
+		return err
+	}
+}
+
+func observeRpc(procedure string, start time.Time, err error) {
+	code := "ok"
+	if err != nil {
+		code = connect.CodeOf(err).String()
+	}
+
+	metrics.RpcDuration.WithLabelValues(procedure).Observe(time.Since(start).Seconds())
+	metrics.RpcRequests.WithLabelValues(procedure, code).Inc()
+}
@@ -2,7 +2,9 @@ package rpc
 
 import (
 	"context"
+	"database/sql"
 	"encoding/hex"
+	"errors"
 	"time"
 
 	connect "connectrpc.com/connect"
@@ -58,18 +60,57 @@ func (s *ConnectRpcService) GetMint(ctx context.Context, req *connect.Request[pr
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	mint, err := s.store.GetMintByHash(ctx, hash.GetValue())
+	// Confirmed mints can be read at any of their versions
+	mint, latestVersion, err := s.store.GetMintVersion(ctx, hash.GetValue(), int(req.Msg.GetVersion()))
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
+	version := int(req.Msg.GetVersion())
+	if version == 0 {
+		version = latestVersion
+	}
+
+	// A mint that has not been confirmed yet is reported with its settlement progress
+	confirmed := mint.Hash != ""
+	if !confirmed {
+		unconfirmedMint, err := s.store.GetUnconfirmedMintByHash(ctx, hash.GetValue())
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		mint = unconfirmedMint
+		version, latestVersion = 0, 0
+	}
+
 	protoMint, err := toProtoMint(mint)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	if !confirmed && mint.Hash != "" {
+		confirmations, err := s.newConfirmationTracker(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		remaining, err := confirmations.remaining(ctx, engineprotocol.ACTION_MINT, mint.Hash, mint)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		protoMint.SetConfirmationsRemaining(int32(remaining))
+	}
+
+	burnedSupply, err := s.store.GetBurnedSupply(ctx, mint.Hash)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
 	resp := &protocol.GetMintResponse{}
 	resp.SetMint(protoMint)
+	resp.SetBurnedSupply(int32(burnedSupply))
+	resp.SetCirculatingSupply(int32(mint.FractionCount - burnedSupply))
+	resp.SetVersion(int32(version))
+	resp.SetLatestVersion(int32(latestVersion))
 	return connect.NewResponse(resp), nil
 }
 
@@ -129,3 +170,160 @@ func (s *ConnectRpcService) CreateMint(ctx context.Context, req *connect.Request
 	resp.SetEncodedTransactionBody(hex.EncodeToString(encodedTransactionBody))
 	return connect.NewResponse(resp), nil
 }
+
+func (s *ConnectRpcService) AmendMint(ctx context.Context, req *connect.Request[protocol.AmendMintRequest]) (*connect.Response[protocol.AmendMintResponse], error) {
+	request, err := toAmendMintRequest(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	mint, err := s.store.GetMintByHash(ctx, request.Payload.MintHash)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if mint.Hash == "" {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("mint not found"))
+	}
+
+	if err := validation.ValidateOwnerPublicKey(mint.OwnerAddress, request.PublicKey, request.RedeemScript); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	amendment := &store.MintAmendment{
+		MintHash:       request.Payload.MintHash,
+		Description:    request.Payload.Description,
+		Metadata:       request.Payload.Metadata,
+		FeedURL:        request.Payload.FeedURL,
+		ContractOfSale: request.Payload.ContractOfSale,
+		PublicKey:      request.PublicKey,
+		Signature:      request.Signature,
+		CreatedAt:      time.Now(),
+	}
+
+	amendment.Hash, err = amendment.GenerateHash()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	amendment.Id, err = s.store.SaveMintAmendment(ctx, amendment)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.gossipClient.GossipMintAmendment(*amendment); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	envelope := engineprotocol.NewMintAmendmentTransactionEnvelope(amendment.Hash, amendment.MintHash, engineprotocol.ACTION_AMEND_MINT)
+	encodedTransactionBody := envelope.Serialize()
+
+	resp := &protocol.AmendMintResponse{}
+	resp.SetHash(toProtoHash(amendment.Hash))
+	resp.SetEncodedTransactionBody(hex.EncodeToString(encodedTransactionBody))
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectRpcService) CreateMintAmendmentSignature(ctx context.Context, req *connect.Request[protocol.CreateMintAmendmentSignatureRequest]) (*connect.Response[protocol.CreateMintAmendmentSignatureResponse], error) {
+	payload := req.Msg.GetPayload()
+	if payload == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("payload is required"))
+	}
+
+	newSignature := &store.MintAmendmentSignature{
+		AmendmentHash: payload.GetAmendmentHash(),
+		MintHash:      payload.GetMintHash().GetValue(),
+		Signature:     payload.GetSignature(),
+		PublicKey:     payload.GetPublicKey(),
+		CreatedAt:     time.Now(),
+	}
+
+	mint, err := s.store.GetMintByHash(ctx, newSignature.MintHash)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if !mint.SignatureRequired() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("mint does not require amendment signatures"))
+	}
+
+	if err := newSignature.Validate(mint); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	id, err := s.store.SaveMintAmendmentSignature(ctx, newSignature)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.gossipClient.GossipMintAmendmentSignature(*newSignature); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &protocol.CreateMintAmendmentSignatureResponse{}
+	resp.SetId(id)
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectRpcService) TransferMintOwnership(ctx context.Context, req *connect.Request[protocol.TransferMintOwnershipRequest]) (*connect.Response[protocol.TransferMintOwnershipResponse], error) {
+	request, err := toTransferMintOwnershipRequest(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	mint, err := s.store.GetMintByHash(ctx, request.Payload.MintHash)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if mint.Hash == "" {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("mint not found"))
+	}
+
+	if err := validation.ValidateOwnerPublicKey(mint.OwnerAddress, request.PublicKey, request.RedeemScript); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	transfer := &store.MintOwnershipTransfer{
+		MintHash:                 request.Payload.MintHash,
+		NewOwnerAddress:          request.Payload.NewOwnerAddress,
+		NewPublicKey:             request.Payload.NewPublicKey,
+		SignatureRequirementType: request.Payload.SignatureRequirementType,
+		AssetManagers:            request.Payload.AssetManagers,
+		MinSignatures:            request.Payload.MinSignatures,
+		PublicKey:                request.PublicKey,
+		Signature:                request.Signature,
+		NewOwnerSignature:        request.NewOwnerSignature,
+		CreatedAt:                time.Now(),
+	}
+
+	transfer.Hash, err = transfer.GenerateHash()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	transfer.Id, err = s.store.SaveMintOwnershipTransfer(ctx, transfer)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.gossipClient.GossipMintOwnershipTransfer(*transfer); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	envelope := engineprotocol.NewMintOwnershipTransferTransactionEnvelope(transfer.Hash, transfer.MintHash, engineprotocol.ACTION_TRANSFER_MINT_OWNERSHIP)
+	encodedTransactionBody := envelope.Serialize()
+
+	resp := &protocol.TransferMintOwnershipResponse{}
+	resp.SetHash(toProtoHash(transfer.Hash))
+	resp.SetEncodedTransactionBody(hex.EncodeToString(encodedTransactionBody))
+	return connect.NewResponse(resp), nil
+}
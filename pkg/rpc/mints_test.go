@@ -2,12 +2,17 @@ package rpc_test
 
 import (
 	"context"
+	"encoding/hex"
 	"testing"
 
 	connect "connectrpc.com/connect"
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
 	"dogecoin.org/fractal-engine/pkg/doge"
+	engineprotocol "dogecoin.org/fractal-engine/pkg/protocol"
 	"dogecoin.org/fractal-engine/pkg/rpc"
 	"dogecoin.org/fractal-engine/pkg/rpc/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"gotest.tools/assert"
 )
@@ -101,3 +106,236 @@ func TestMints(t *testing.T) {
 	assert.DeepEqual(t, dogenetClient.mints[0].Metadata, payload.Metadata)
 	assert.Equal(t, dogenetClient.mints[0].FeedURL, payload.FeedURL)
 }
+
+func TestGetMintReportsConfirmationsRemaining(t *testing.T) {
+	tokenisationStore, _, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	assert.NilError(t, tokenisationStore.UpsertHealth(ctx, 100, 100, "regtest", false))
+
+	saveMint := func() string {
+		mint := &store.MintWithoutID{
+			Title:         "Test Mint",
+			FractionCount: 100,
+			Hash:          test_support.GenerateRandomHash(),
+			Requirements:  store.StringInterfaceMap{"min_confirmations": float64(3)},
+		}
+		_, err := tokenisationStore.SaveUnconfirmedMint(ctx, mint)
+		assert.NilError(t, err)
+		return mint.Hash
+	}
+
+	getMint := func(hash string) *protocol.Mint {
+		hashProto := &protocol.Hash{}
+		hashProto.SetValue(hash)
+		request := &protocol.GetMintRequest{}
+		request.SetHash(hashProto)
+		response, err := feClient.GetMint(ctx, connect.NewRequest(request))
+		assert.NilError(t, err)
+		return response.Msg.GetMint()
+	}
+
+	// A mint that has not been seen on chain needs every confirmation
+	unseenHash := saveMint()
+	assert.Equal(t, getMint(unseenHash).GetHash().GetValue(), unseenHash)
+	assert.Equal(t, getMint(unseenHash).GetConfirmationsRemaining(), int32(3))
+
+	// A mint mined one block below the tip has two confirmations
+	minedHash := saveMint()
+	data, err := proto.Marshal(&engineprotocol.OnChainMintMessage{Hash: minedHash})
+	assert.NilError(t, err)
+	_, err = tokenisationStore.SaveOnChainTransaction(ctx, "mintTx", 99, "blockHash", 1, engineprotocol.ACTION_MINT, engineprotocol.DEFAULT_VERSION, data, "ownerAddress", map[string]interface{}{})
+	assert.NilError(t, err)
+
+	assert.Equal(t, getMint(minedHash).GetConfirmationsRemaining(), int32(1))
+}
+
+func newAmendMintRequest(t *testing.T, privHex string, pubHex string, payload store.MintAmendmentBody) *protocol.AmendMintRequest {
+	signature, err := doge.SignPayload(payload, privHex, pubHex)
+	assert.NilError(t, err)
+
+	mintHashProto := &protocol.Hash{}
+	mintHashProto.SetValue(payload.MintHash)
+
+	metadata, err := structpb.NewStruct(payload.Metadata)
+	assert.NilError(t, err)
+	metadataProto := &protocol.StringInterfaceMap{}
+	metadataProto.SetValue(metadata)
+
+	protoPayload := &protocol.AmendMintRequestPayload{}
+	protoPayload.SetMintHash(mintHashProto)
+	protoPayload.SetDescription(payload.Description)
+	protoPayload.SetMetadata(metadataProto)
+	protoPayload.SetFeedUrl(payload.FeedURL)
+	protoPayload.SetContractOfSale(payload.ContractOfSale)
+
+	request := &protocol.AmendMintRequest{}
+	request.SetPayload(protoPayload)
+	request.SetPublicKey(pubHex)
+	request.SetSignature(signature)
+	return request
+}
+
+func TestAmendMint(t *testing.T) {
+	tokenisationStore, dogenetClient, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	privHex, pubHex, ownerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	mintHash := test_support.GenerateRandomHash()
+	_, err = tokenisationStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "Test Mint",
+		Description:   "Original description",
+		FractionCount: 1000,
+		Hash:          mintHash,
+	}, ownerAddress)
+	assert.NilError(t, err)
+
+	payload := store.MintAmendmentBody{
+		MintHash:    mintHash,
+		Description: "Amended description",
+		Metadata:    store.StringInterfaceMap{"valuation": "1000000"},
+		FeedURL:     "https://example.com/feed",
+	}
+
+	// Only the mint owner can amend the mint
+	strangerPrivHex, strangerPubHex, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	_, err = feClient.AmendMint(ctx, connect.NewRequest(newAmendMintRequest(t, strangerPrivHex, strangerPubHex, payload)))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	response, err := feClient.AmendMint(ctx, connect.NewRequest(newAmendMintRequest(t, privHex, pubHex, payload)))
+	assert.NilError(t, err)
+
+	amendmentHash := response.Msg.GetHash().GetValue()
+	assert.Equal(t, 1, len(dogenetClient.mintAmendments))
+	assert.Equal(t, amendmentHash, dogenetClient.mintAmendments[0].Hash)
+	assert.NilError(t, dogenetClient.mintAmendments[0].Validate())
+
+	encodedTransactionBody, err := hex.DecodeString(response.Msg.GetEncodedTransactionBody())
+	assert.NilError(t, err)
+
+	envelope := engineprotocol.MessageEnvelope{}
+	assert.NilError(t, envelope.Deserialize(encodedTransactionBody))
+	assert.Equal(t, uint8(engineprotocol.ACTION_AMEND_MINT), envelope.Action)
+
+	message := engineprotocol.OnChainMintAmendmentMessage{}
+	assert.NilError(t, proto.Unmarshal(envelope.Data, &message))
+	assert.Equal(t, amendmentHash, hex.EncodeToString(message.AmendmentHash))
+	assert.Equal(t, mintHash, hex.EncodeToString(message.MintHash))
+
+	amendment, err := tokenisationStore.GetMintAmendment(ctx, amendmentHash)
+	assert.NilError(t, err)
+	assert.NilError(t, tokenisationStore.ApplyMintAmendment(ctx, amendment, store.OnChainTransaction{Id: "amendTx", TxHash: "amendTx", Height: 10}))
+
+	getMint := func(version int32) (*protocol.GetMintResponse, error) {
+		hashProto := &protocol.Hash{}
+		hashProto.SetValue(mintHash)
+		request := &protocol.GetMintRequest{}
+		request.SetHash(hashProto)
+		request.SetVersion(version)
+		response, err := feClient.GetMint(ctx, connect.NewRequest(request))
+		if err != nil {
+			return nil, err
+		}
+		return response.Msg, nil
+	}
+
+	current, err := getMint(0)
+	assert.NilError(t, err)
+	assert.Equal(t, int32(2), current.GetVersion())
+	assert.Equal(t, int32(2), current.GetLatestVersion())
+	assert.Equal(t, "Amended description", current.GetMint().GetDescription())
+	assert.Equal(t, "https://example.com/feed", current.GetMint().GetFeedUrl())
+
+	original, err := getMint(1)
+	assert.NilError(t, err)
+	assert.Equal(t, int32(1), original.GetVersion())
+	assert.Equal(t, int32(2), original.GetLatestVersion())
+	assert.Equal(t, "Original description", original.GetMint().GetDescription())
+
+	_, err = getMint(3)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestTransferMintOwnership(t *testing.T) {
+	tokenisationStore, dogenetClient, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	ownerPrivHex, ownerPubHex, ownerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	newOwnerPrivHex, newOwnerPubHex, newOwnerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	mintHash := test_support.GenerateRandomHash()
+	_, err = tokenisationStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "Test Mint",
+		FractionCount: 1000,
+		Hash:          mintHash,
+		PublicKey:     ownerPubHex,
+	}, ownerAddress)
+	assert.NilError(t, err)
+
+	payload := store.MintOwnershipTransferBody{
+		MintHash:                 mintHash,
+		NewOwnerAddress:          newOwnerAddress,
+		NewPublicKey:             newOwnerPubHex,
+		SignatureRequirementType: store.SignatureRequirementType_ONE_SIGNATURE,
+		AssetManagers:            store.AssetManagers{{Name: "Manager", PublicKey: newOwnerPubHex, URL: "https://example.com"}},
+	}
+
+	newRequest := func(newOwnerPrivHex string) *protocol.TransferMintOwnershipRequest {
+		signature, err := doge.SignPayload(payload, ownerPrivHex, ownerPubHex)
+		assert.NilError(t, err)
+		newOwnerSignature, err := doge.SignPayload(payload, newOwnerPrivHex, newOwnerPubHex)
+		assert.NilError(t, err)
+
+		mintHashProto := &protocol.Hash{}
+		mintHashProto.SetValue(mintHash)
+		newOwnerAddressProto := &protocol.Address{}
+		newOwnerAddressProto.SetValue(newOwnerAddress)
+		assetManager := &protocol.AssetManager{}
+		assetManager.SetName("Manager")
+		assetManager.SetPublicKey(newOwnerPubHex)
+		assetManager.SetUrl("https://example.com")
+
+		protoPayload := &protocol.TransferMintOwnershipRequestPayload{}
+		protoPayload.SetMintHash(mintHashProto)
+		protoPayload.SetNewOwnerAddress(newOwnerAddressProto)
+		protoPayload.SetNewPublicKey(newOwnerPubHex)
+		protoPayload.SetSignatureRequirementType(protocol.SignatureRequirementType_SIGNATURE_REQUIREMENT_TYPE_REQUIRES_ONE_SIGNATURE)
+		protoPayload.SetAssetManagers([]*protocol.AssetManager{assetManager})
+
+		request := &protocol.TransferMintOwnershipRequest{}
+		request.SetPayload(protoPayload)
+		request.SetPublicKey(ownerPubHex)
+		request.SetSignature(signature)
+		request.SetNewOwnerSignature(newOwnerSignature)
+		return request
+	}
+
+	// The new owner must sign the handover too
+	_, err = feClient.TransferMintOwnership(ctx, connect.NewRequest(newRequest(ownerPrivHex)))
+	assert.ErrorContains(t, err, "invalid new_owner_signature")
+
+	response, err := feClient.TransferMintOwnership(ctx, connect.NewRequest(newRequest(newOwnerPrivHex)))
+	assert.NilError(t, err)
+
+	transferHash := response.Msg.GetHash().GetValue()
+	assert.Equal(t, 1, len(dogenetClient.mintOwnershipTransfers))
+	assert.Equal(t, transferHash, dogenetClient.mintOwnershipTransfers[0].Hash)
+	assert.NilError(t, dogenetClient.mintOwnershipTransfers[0].Validate())
+
+	encodedTransactionBody, err := hex.DecodeString(response.Msg.GetEncodedTransactionBody())
+	assert.NilError(t, err)
+
+	envelope := engineprotocol.MessageEnvelope{}
+	assert.NilError(t, envelope.Deserialize(encodedTransactionBody))
+	assert.Equal(t, uint8(engineprotocol.ACTION_TRANSFER_MINT_OWNERSHIP), envelope.Action)
+
+	message := engineprotocol.OnChainMintOwnershipTransferMessage{}
+	assert.NilError(t, proto.Unmarshal(envelope.Data, &message))
+	assert.Equal(t, transferHash, hex.EncodeToString(message.TransferHash))
+	assert.Equal(t, mintHash, hex.EncodeToString(message.MintHash))
+}
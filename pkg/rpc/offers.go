@@ -2,10 +2,13 @@ package rpc
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"time"
 
 	connect "connectrpc.com/connect"
+	"dogecoin.org/fractal-engine/pkg/events"
+	engineprotocol "dogecoin.org/fractal-engine/pkg/protocol"
 	protocol "dogecoin.org/fractal-engine/pkg/rpc/protocol"
 	"dogecoin.org/fractal-engine/pkg/store"
 )
@@ -108,7 +111,12 @@ func (s *ConnectRpcService) CreateSellOffer(ctx context.Context, req *connect.Re
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	availableBalance := totalTokenBalance - pendingTokenBalance - existingSellOffersQuantity
+	lockedTokenBalance, err := s.getLockedTokenBalance(ctx, request.Payload.OffererAddress, request.Payload.MintHash)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	availableBalance := totalTokenBalance - pendingTokenBalance - existingSellOffersQuantity - lockedTokenBalance
 
 	if request.Payload.Quantity > availableBalance {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("insufficient token balance to create sell offer"))
@@ -142,6 +150,14 @@ func (s *ConnectRpcService) CreateSellOffer(ctx context.Context, req *connect.Re
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	s.store.Events.Publish(events.Event{
+		Type:      events.EventOfferCreated,
+		MintHash:  newOffer.MintHash,
+		Hash:      newOffer.Hash,
+		Addresses: events.Addresses(newOffer.OffererAddress),
+		Quantity:  newOffer.Quantity,
+	})
+
 	resp := &protocol.CreateSellOfferResponse{}
 	resp.SetId(id)
 	resp.SetHash(toProtoHash(newOfferWithoutId.Hash))
@@ -158,16 +174,32 @@ func (s *ConnectRpcService) DeleteSellOffer(ctx context.Context, req *connect.Re
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	if err := s.store.DeleteSellOffer(ctx, request.Payload.OfferHash, request.PublicKey); err != nil {
+	offer, lookupErr := s.store.GetSellOfferByHash(ctx, request.Payload.OfferHash)
+
+	if err := s.store.MarkSellOfferDeletePending(ctx, request.Payload.OfferHash, request.PublicKey); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
+	if lookupErr == nil && offer.PublicKey == request.PublicKey {
+		s.store.Events.Publish(events.Event{
+			Type:      events.EventOfferDeleted,
+			MintHash:  offer.MintHash,
+			Hash:      offer.Hash,
+			Addresses: events.Addresses(offer.OffererAddress),
+			Quantity:  offer.Quantity,
+		})
+	}
+
 	if err := s.gossipClient.GossipDeleteSellOffer(request.Payload.OfferHash, request.PublicKey, request.Signature); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	envelope := engineprotocol.NewDeleteSellOfferTransactionEnvelope(request.Payload.OfferHash, engineprotocol.ACTION_DELETE_SELL_OFFER)
+	encodedTransactionBody := envelope.Serialize()
+
 	resp := &protocol.DeleteSellOfferResponse{}
 	resp.SetValue("Sell offer deleted")
+	resp.SetEncodedTransactionBody(hex.EncodeToString(encodedTransactionBody))
 	return connect.NewResponse(resp), nil
 }
 
@@ -248,6 +280,15 @@ func (s *ConnectRpcService) CreateBuyOffer(ctx context.Context, req *connect.Req
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("buy offer limit reached"))
 	}
 
+	mint, err := s.store.GetMintByHash(ctx, request.Payload.MintHash)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := s.checkMintRequirements(ctx, mint, request.Payload.OffererAddress, request.Payload.Quantity); err != nil {
+		return nil, err
+	}
+
 	newOfferWithoutId := &store.BuyOfferWithoutID{
 		OffererAddress: request.Payload.OffererAddress,
 		MintHash:       request.Payload.MintHash,
@@ -276,6 +317,14 @@ func (s *ConnectRpcService) CreateBuyOffer(ctx context.Context, req *connect.Req
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	s.store.Events.Publish(events.Event{
+		Type:      events.EventOfferCreated,
+		MintHash:  newOffer.MintHash,
+		Hash:      newOffer.Hash,
+		Addresses: events.Addresses(newOffer.OffererAddress, newOffer.SellerAddress),
+		Quantity:  newOffer.Quantity,
+	})
+
 	resp := &protocol.CreateBuyOfferResponse{}
 	resp.SetId(id)
 	resp.SetHash(toProtoHash(newOfferWithoutId.Hash))
@@ -292,15 +341,60 @@ func (s *ConnectRpcService) DeleteBuyOffer(ctx context.Context, req *connect.Req
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	if err := s.store.DeleteBuyOffer(ctx, request.Payload.OfferHash, request.PublicKey); err != nil {
+	offer, lookupErr := s.store.GetBuyOfferByHash(ctx, request.Payload.OfferHash)
+
+	if err := s.store.MarkBuyOfferDeletePending(ctx, request.Payload.OfferHash, request.PublicKey); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
+	if lookupErr == nil && offer.PublicKey == request.PublicKey {
+		s.store.Events.Publish(events.Event{
+			Type:      events.EventOfferDeleted,
+			MintHash:  offer.MintHash,
+			Hash:      offer.Hash,
+			Addresses: events.Addresses(offer.OffererAddress, offer.SellerAddress),
+			Quantity:  offer.Quantity,
+		})
+	}
+
 	if err := s.gossipClient.GossipDeleteBuyOffer(request.Payload.OfferHash, request.PublicKey, request.Signature); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	envelope := engineprotocol.NewDeleteBuyOfferTransactionEnvelope(request.Payload.OfferHash, engineprotocol.ACTION_DELETE_BUY_OFFER)
+	encodedTransactionBody := envelope.Serialize()
+
 	resp := &protocol.DeleteBuyOfferResponse{}
 	resp.SetValue("Buy offer deleted")
+	resp.SetEncodedTransactionBody(hex.EncodeToString(encodedTransactionBody))
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectRpcService) GetOrderBook(ctx context.Context, req *connect.Request[protocol.GetOrderBookRequest]) (*connect.Response[protocol.GetOrderBookResponse], error) {
+	if req.Msg.GetMintHash() == nil || req.Msg.GetMintHash().GetValue() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("mint_hash is required"))
+	}
+
+	bids, asks, err := s.store.GetOrderBookDepth(ctx, req.Msg.GetMintHash().GetValue())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &protocol.GetOrderBookResponse{}
+	resp.SetBids(toProtoOrderBookLevels(bids))
+	resp.SetAsks(toProtoOrderBookLevels(asks))
 	return connect.NewResponse(resp), nil
 }
+
+func toProtoOrderBookLevels(levels []store.OrderBookLevel) []*protocol.OrderBookLevel {
+	protoLevels := make([]*protocol.OrderBookLevel, 0, len(levels))
+	for _, level := range levels {
+		protoLevel := &protocol.OrderBookLevel{}
+		protoLevel.SetPrice(int32(level.Price))
+		protoLevel.SetQuantity(int32(level.Quantity))
+		protoLevel.SetOrders(int32(level.Orders))
+		protoLevels = append(protoLevels, protoLevel)
+	}
+
+	return protoLevels
+}
@@ -2,14 +2,17 @@ package rpc_test
 
 import (
 	"context"
+	"encoding/hex"
 	"testing"
 
 	connect "connectrpc.com/connect"
 	"dogecoin.org/fractal-engine/internal/test/support"
 	"dogecoin.org/fractal-engine/pkg/doge"
+	engineprotocol "dogecoin.org/fractal-engine/pkg/protocol"
 	"dogecoin.org/fractal-engine/pkg/rpc"
 	"dogecoin.org/fractal-engine/pkg/rpc/protocol"
 	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
 	"gotest.tools/assert"
 )
 
@@ -299,3 +302,179 @@ func TestCreateSellOfferAccountsForExistingOffers(t *testing.T) {
 	assert.NilError(t, err)
 	assert.Equal(t, len(offers), 2)
 }
+
+func TestDeleteSellOfferReturnsEncodedTransactionBody(t *testing.T) {
+	tokenisationStore, _, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	privHex, pubHex, sellerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	offerHash := support.GenerateRandomHash()
+	mintHash := support.GenerateRandomHash()
+	_, err = tokenisationStore.SaveSellOffer(ctx, &store.SellOfferWithoutID{
+		OffererAddress: sellerAddress,
+		Hash:           offerHash,
+		MintHash:       mintHash,
+		Quantity:       10,
+		Price:          50,
+		PublicKey:      pubHex,
+	})
+	assert.NilError(t, err)
+
+	signature, err := doge.SignPayload(rpc.DeleteSellOfferRequestPayload{OfferHash: offerHash}, privHex, pubHex)
+	assert.NilError(t, err)
+
+	offerHashProto := &protocol.Hash{}
+	offerHashProto.SetValue(offerHash)
+
+	protoPayload := &protocol.DeleteSellOfferRequestPayload{}
+	protoPayload.SetOfferHash(offerHashProto)
+
+	deleteRequest := &protocol.DeleteSellOfferRequest{}
+	deleteRequest.SetPayload(protoPayload)
+	deleteRequest.SetPublicKey(pubHex)
+	deleteRequest.SetSignature(signature)
+
+	offers, err := tokenisationStore.GetSellOffers(ctx, 0, 10, mintHash, sellerAddress)
+	assert.NilError(t, err)
+	assert.Equal(t, len(offers), 1)
+
+	response, err := feClient.DeleteSellOffer(ctx, connect.NewRequest(deleteRequest))
+	assert.NilError(t, err)
+
+	offers, err = tokenisationStore.GetSellOffers(ctx, 0, 10, mintHash, sellerAddress)
+	assert.NilError(t, err)
+	assert.Equal(t, len(offers), 0)
+
+	// The offer is kept until the cancellation is matched on chain
+	_, err = tokenisationStore.GetSellOfferByHash(ctx, offerHash)
+	assert.NilError(t, err)
+
+	encodedTransactionBody, err := hex.DecodeString(response.Msg.GetEncodedTransactionBody())
+	assert.NilError(t, err)
+
+	envelope := engineprotocol.MessageEnvelope{}
+	assert.NilError(t, envelope.Deserialize(encodedTransactionBody))
+	assert.Equal(t, uint8(engineprotocol.ACTION_DELETE_SELL_OFFER), envelope.Action)
+
+	message := engineprotocol.OnChainDeleteSellOfferMessage{}
+	assert.NilError(t, proto.Unmarshal(envelope.Data, &message))
+	assert.Equal(t, offerHash, hex.EncodeToString(message.OfferHash))
+}
+
+func TestCreateSellOfferRejectsLockedTokens(t *testing.T) {
+	tokenisationStore, _, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	sellerAddress := support.GenerateDogecoinAddress(true)
+	mintHash := support.GenerateRandomHash()
+
+	// Fractions cannot be resold until 50 blocks after the mint
+	_, err := tokenisationStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "Test Mint",
+		Description:   "Test Description",
+		FractionCount: 1000,
+		Hash:          mintHash,
+		BlockHeight:   100,
+		LockupOptions: store.StringInterfaceMap{"type": "block_height", "blocks": float64(50)},
+	}, "owner")
+	assert.NilError(t, err)
+
+	err = tokenisationStore.UpsertTokenBalance(ctx, sellerAddress, mintHash, 200)
+	assert.NilError(t, err)
+
+	err = tokenisationStore.UpsertChainPosition(ctx, 120, "blockHash", false)
+	assert.NilError(t, err)
+
+	privHex, pubHex, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	sellOfferPayload := rpc.CreateSellOfferRequestPayload{
+		OffererAddress: sellerAddress,
+		MintHash:       mintHash,
+		Quantity:       100,
+		Price:          50,
+	}
+
+	signature, err := doge.SignPayload(sellOfferPayload, privHex, pubHex)
+	assert.NilError(t, err)
+
+	offererAddressProto := &protocol.Address{}
+	offererAddressProto.SetValue(sellerAddress)
+	mintHashProto := &protocol.Hash{}
+	mintHashProto.SetValue(mintHash)
+
+	protoPayload := &protocol.CreateSellOfferRequestPayload{}
+	protoPayload.SetOffererAddress(offererAddressProto)
+	protoPayload.SetMintHash(mintHashProto)
+	protoPayload.SetQuantity(100)
+	protoPayload.SetPrice(50)
+
+	sellOfferRequest := &protocol.CreateSellOfferRequest{}
+	sellOfferRequest.SetPayload(protoPayload)
+	sellOfferRequest.SetPublicKey(pubHex)
+	sellOfferRequest.SetSignature(signature)
+
+	_, err = feClient.CreateSellOffer(ctx, connect.NewRequest(sellOfferRequest))
+	assert.ErrorContains(t, err, "insufficient token balance")
+
+	// The lockup has elapsed at height 150
+	err = tokenisationStore.UpsertChainPosition(ctx, 150, "blockHash", false)
+	assert.NilError(t, err)
+
+	response, err := feClient.CreateSellOffer(ctx, connect.NewRequest(sellOfferRequest))
+	assert.NilError(t, err)
+	assert.Assert(t, response.Msg.GetId() != "")
+}
+
+func TestGetOrderBook(t *testing.T) {
+	tokenisationStore, _, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	mintHash := support.GenerateRandomHash()
+
+	for _, price := range []int{50, 50, 45} {
+		_, err := tokenisationStore.SaveBuyOffer(ctx, &store.BuyOfferWithoutID{
+			OffererAddress: "buyer",
+			Hash:           support.GenerateRandomHash(),
+			MintHash:       mintHash,
+			Quantity:       10,
+			Price:          price,
+		})
+		assert.NilError(t, err)
+	}
+
+	_, err := tokenisationStore.SaveSellOffer(ctx, &store.SellOfferWithoutID{
+		OffererAddress: "seller",
+		Hash:           support.GenerateRandomHash(),
+		MintHash:       mintHash,
+		Quantity:       7,
+		Price:          60,
+	})
+	assert.NilError(t, err)
+
+	mintHashProto := &protocol.Hash{}
+	mintHashProto.SetValue(mintHash)
+
+	request := &protocol.GetOrderBookRequest{}
+	request.SetMintHash(mintHashProto)
+
+	response, err := feClient.GetOrderBook(ctx, connect.NewRequest(request))
+	assert.NilError(t, err)
+
+	bids := response.Msg.GetBids()
+	assert.Equal(t, 2, len(bids))
+	assert.Equal(t, int32(50), bids[0].GetPrice())
+	assert.Equal(t, int32(20), bids[0].GetQuantity())
+	assert.Equal(t, int32(2), bids[0].GetOrders())
+	assert.Equal(t, int32(45), bids[1].GetPrice())
+
+	asks := response.Msg.GetAsks()
+	assert.Equal(t, 1, len(asks))
+	assert.Equal(t, int32(60), asks[0].GetPrice())
+	assert.Equal(t, int32(7), asks[0].GetQuantity())
+
+	_, err = feClient.GetOrderBook(ctx, connect.NewRequest(&protocol.GetOrderBookRequest{}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
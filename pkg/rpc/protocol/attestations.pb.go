@@ -0,0 +1,389 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: attestations.proto
+
+package protocol
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateAttestationRequest struct {
+	state              protoimpl.MessageState           `protogen:"opaque.v1"`
+	xxx_hidden_Payload *CreateAttestationRequestPayload `protobuf:"bytes,1,opt,name=payload"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateAttestationRequest) Reset() {
+	*x = CreateAttestationRequest{}
+	mi := &file_attestations_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttestationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttestationRequest) ProtoMessage() {}
+
+func (x *CreateAttestationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attestations_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateAttestationRequest) GetPayload() *CreateAttestationRequestPayload {
+	if x != nil {
+		return x.xxx_hidden_Payload
+	}
+	return nil
+}
+
+func (x *CreateAttestationRequest) SetPayload(v *CreateAttestationRequestPayload) {
+	x.xxx_hidden_Payload = v
+}
+
+func (x *CreateAttestationRequest) HasPayload() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Payload != nil
+}
+
+func (x *CreateAttestationRequest) ClearPayload() {
+	x.xxx_hidden_Payload = nil
+}
+
+type CreateAttestationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Payload *CreateAttestationRequestPayload
+}
+
+func (b0 CreateAttestationRequest_builder) Build() *CreateAttestationRequest {
+	m0 := &CreateAttestationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Payload = b.Payload
+	return m0
+}
+
+type CreateAttestationRequestPayload struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MintHash    *Hash                  `protobuf:"bytes,1,opt,name=mint_hash,json=mintHash"`
+	xxx_hidden_Address     *Address               `protobuf:"bytes,2,opt,name=address"`
+	xxx_hidden_PublicKey   *string                `protobuf:"bytes,3,opt,name=public_key,json=publicKey"`
+	xxx_hidden_Signature   *string                `protobuf:"bytes,4,opt,name=signature"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateAttestationRequestPayload) Reset() {
+	*x = CreateAttestationRequestPayload{}
+	mi := &file_attestations_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttestationRequestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttestationRequestPayload) ProtoMessage() {}
+
+func (x *CreateAttestationRequestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_attestations_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateAttestationRequestPayload) GetMintHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_MintHash
+	}
+	return nil
+}
+
+func (x *CreateAttestationRequestPayload) GetAddress() *Address {
+	if x != nil {
+		return x.xxx_hidden_Address
+	}
+	return nil
+}
+
+func (x *CreateAttestationRequestPayload) GetPublicKey() string {
+	if x != nil {
+		if x.xxx_hidden_PublicKey != nil {
+			return *x.xxx_hidden_PublicKey
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateAttestationRequestPayload) GetSignature() string {
+	if x != nil {
+		if x.xxx_hidden_Signature != nil {
+			return *x.xxx_hidden_Signature
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateAttestationRequestPayload) SetMintHash(v *Hash) {
+	x.xxx_hidden_MintHash = v
+}
+
+func (x *CreateAttestationRequestPayload) SetAddress(v *Address) {
+	x.xxx_hidden_Address = v
+}
+
+func (x *CreateAttestationRequestPayload) SetPublicKey(v string) {
+	x.xxx_hidden_PublicKey = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *CreateAttestationRequestPayload) SetSignature(v string) {
+	x.xxx_hidden_Signature = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *CreateAttestationRequestPayload) HasMintHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MintHash != nil
+}
+
+func (x *CreateAttestationRequestPayload) HasAddress() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Address != nil
+}
+
+func (x *CreateAttestationRequestPayload) HasPublicKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *CreateAttestationRequestPayload) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *CreateAttestationRequestPayload) ClearMintHash() {
+	x.xxx_hidden_MintHash = nil
+}
+
+func (x *CreateAttestationRequestPayload) ClearAddress() {
+	x.xxx_hidden_Address = nil
+}
+
+func (x *CreateAttestationRequestPayload) ClearPublicKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_PublicKey = nil
+}
+
+func (x *CreateAttestationRequestPayload) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Signature = nil
+}
+
+type CreateAttestationRequestPayload_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MintHash  *Hash
+	Address   *Address
+	PublicKey *string
+	Signature *string
+}
+
+func (b0 CreateAttestationRequestPayload_builder) Build() *CreateAttestationRequestPayload {
+	m0 := &CreateAttestationRequestPayload{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_MintHash = b.MintHash
+	x.xxx_hidden_Address = b.Address
+	if b.PublicKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_PublicKey = b.PublicKey
+	}
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	return m0
+}
+
+type CreateAttestationResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateAttestationResponse) Reset() {
+	*x = CreateAttestationResponse{}
+	mi := &file_attestations_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttestationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttestationResponse) ProtoMessage() {}
+
+func (x *CreateAttestationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_attestations_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateAttestationResponse) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateAttestationResponse) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *CreateAttestationResponse) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CreateAttestationResponse) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type CreateAttestationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 CreateAttestationResponse_builder) Build() *CreateAttestationResponse {
+	m0 := &CreateAttestationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+var File_attestations_proto protoreflect.FileDescriptor
+
+const file_attestations_proto_rawDesc = "" +
+	"\n" +
+	"\x12attestations.proto\x12\x14fractalengine.rpc.v1\x1a\x1bbuf/validate/validate.proto\x1a\vtypes.proto\"k\n" +
+	"\x18CreateAttestationRequest\x12O\n" +
+	"\apayload\x18\x01 \x01(\v25.fractalengine.rpc.v1.CreateAttestationRequestPayloadR\apayload\"\xe2\x01\n" +
+	"\x1fCreateAttestationRequestPayload\x127\n" +
+	"\tmint_hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\bmintHash\x127\n" +
+	"\aaddress\x18\x02 \x01(\v2\x1d.fractalengine.rpc.v1.AddressR\aaddress\x12&\n" +
+	"\n" +
+	"public_key\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tpublicKey\x12%\n" +
+	"\tsignature\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tsignature\"+\n" +
+	"\x19CreateAttestationResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02idB.Z,dogecoin.org/fractal-engine/pkg/rpc/protocolb\beditionsp\xe8\a"
+
+var file_attestations_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_attestations_proto_goTypes = []any{
+	(*CreateAttestationRequest)(nil),        // 0: fractalengine.rpc.v1.CreateAttestationRequest
+	(*CreateAttestationRequestPayload)(nil), // 1: fractalengine.rpc.v1.CreateAttestationRequestPayload
+	(*CreateAttestationResponse)(nil),       // 2: fractalengine.rpc.v1.CreateAttestationResponse
+	(*Hash)(nil),                            // 3: fractalengine.rpc.v1.Hash
+	(*Address)(nil),                         // 4: fractalengine.rpc.v1.Address
+}
+var file_attestations_proto_depIdxs = []int32{
+	1, // 0: fractalengine.rpc.v1.CreateAttestationRequest.payload:type_name -> fractalengine.rpc.v1.CreateAttestationRequestPayload
+	3, // 1: fractalengine.rpc.v1.CreateAttestationRequestPayload.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	4, // 2: fractalengine.rpc.v1.CreateAttestationRequestPayload.address:type_name -> fractalengine.rpc.v1.Address
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_attestations_proto_init() }
+func file_attestations_proto_init() {
+	if File_attestations_proto != nil {
+		return
+	}
+	file_types_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_attestations_proto_rawDesc), len(file_attestations_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_attestations_proto_goTypes,
+		DependencyIndexes: file_attestations_proto_depIdxs,
+		MessageInfos:      file_attestations_proto_msgTypes,
+	}.Build()
+	File_attestations_proto = out.File
+	file_attestations_proto_goTypes = nil
+	file_attestations_proto_depIdxs = nil
+}
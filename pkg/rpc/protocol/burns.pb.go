@@ -0,0 +1,871 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: burns.proto
+
+package protocol
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateBurnRequest struct {
+	state                   protoimpl.MessageState    `protogen:"opaque.v1"`
+	xxx_hidden_Payload      *CreateBurnRequestPayload `protobuf:"bytes,1,opt,name=payload"`
+	xxx_hidden_PublicKey    *string                   `protobuf:"bytes,2,opt,name=public_key,json=publicKey"`
+	xxx_hidden_Signature    *string                   `protobuf:"bytes,3,opt,name=signature"`
+	xxx_hidden_RedeemScript *string                   `protobuf:"bytes,4,opt,name=redeem_script,json=redeemScript"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CreateBurnRequest) Reset() {
+	*x = CreateBurnRequest{}
+	mi := &file_burns_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBurnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBurnRequest) ProtoMessage() {}
+
+func (x *CreateBurnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_burns_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateBurnRequest) GetPayload() *CreateBurnRequestPayload {
+	if x != nil {
+		return x.xxx_hidden_Payload
+	}
+	return nil
+}
+
+func (x *CreateBurnRequest) GetPublicKey() string {
+	if x != nil {
+		if x.xxx_hidden_PublicKey != nil {
+			return *x.xxx_hidden_PublicKey
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateBurnRequest) GetSignature() string {
+	if x != nil {
+		if x.xxx_hidden_Signature != nil {
+			return *x.xxx_hidden_Signature
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateBurnRequest) GetRedeemScript() string {
+	if x != nil {
+		if x.xxx_hidden_RedeemScript != nil {
+			return *x.xxx_hidden_RedeemScript
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateBurnRequest) SetPayload(v *CreateBurnRequestPayload) {
+	x.xxx_hidden_Payload = v
+}
+
+func (x *CreateBurnRequest) SetPublicKey(v string) {
+	x.xxx_hidden_PublicKey = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *CreateBurnRequest) SetSignature(v string) {
+	x.xxx_hidden_Signature = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *CreateBurnRequest) SetRedeemScript(v string) {
+	x.xxx_hidden_RedeemScript = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *CreateBurnRequest) HasPayload() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Payload != nil
+}
+
+func (x *CreateBurnRequest) HasPublicKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CreateBurnRequest) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *CreateBurnRequest) HasRedeemScript() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *CreateBurnRequest) ClearPayload() {
+	x.xxx_hidden_Payload = nil
+}
+
+func (x *CreateBurnRequest) ClearPublicKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_PublicKey = nil
+}
+
+func (x *CreateBurnRequest) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Signature = nil
+}
+
+func (x *CreateBurnRequest) ClearRedeemScript() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_RedeemScript = nil
+}
+
+type CreateBurnRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Payload      *CreateBurnRequestPayload
+	PublicKey    *string
+	Signature    *string
+	RedeemScript *string
+}
+
+func (b0 CreateBurnRequest_builder) Build() *CreateBurnRequest {
+	m0 := &CreateBurnRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Payload = b.Payload
+	if b.PublicKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_PublicKey = b.PublicKey
+	}
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	if b.RedeemScript != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_RedeemScript = b.RedeemScript
+	}
+	return m0
+}
+
+type CreateBurnRequestPayload struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OwnerAddress *Address               `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress"`
+	xxx_hidden_MintHash     *Hash                  `protobuf:"bytes,2,opt,name=mint_hash,json=mintHash"`
+	xxx_hidden_Quantity     int32                  `protobuf:"varint,3,opt,name=quantity"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CreateBurnRequestPayload) Reset() {
+	*x = CreateBurnRequestPayload{}
+	mi := &file_burns_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBurnRequestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBurnRequestPayload) ProtoMessage() {}
+
+func (x *CreateBurnRequestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_burns_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateBurnRequestPayload) GetOwnerAddress() *Address {
+	if x != nil {
+		return x.xxx_hidden_OwnerAddress
+	}
+	return nil
+}
+
+func (x *CreateBurnRequestPayload) GetMintHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_MintHash
+	}
+	return nil
+}
+
+func (x *CreateBurnRequestPayload) GetQuantity() int32 {
+	if x != nil {
+		return x.xxx_hidden_Quantity
+	}
+	return 0
+}
+
+func (x *CreateBurnRequestPayload) SetOwnerAddress(v *Address) {
+	x.xxx_hidden_OwnerAddress = v
+}
+
+func (x *CreateBurnRequestPayload) SetMintHash(v *Hash) {
+	x.xxx_hidden_MintHash = v
+}
+
+func (x *CreateBurnRequestPayload) SetQuantity(v int32) {
+	x.xxx_hidden_Quantity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *CreateBurnRequestPayload) HasOwnerAddress() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_OwnerAddress != nil
+}
+
+func (x *CreateBurnRequestPayload) HasMintHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MintHash != nil
+}
+
+func (x *CreateBurnRequestPayload) HasQuantity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *CreateBurnRequestPayload) ClearOwnerAddress() {
+	x.xxx_hidden_OwnerAddress = nil
+}
+
+func (x *CreateBurnRequestPayload) ClearMintHash() {
+	x.xxx_hidden_MintHash = nil
+}
+
+func (x *CreateBurnRequestPayload) ClearQuantity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Quantity = 0
+}
+
+type CreateBurnRequestPayload_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OwnerAddress *Address
+	MintHash     *Hash
+	Quantity     *int32
+}
+
+func (b0 CreateBurnRequestPayload_builder) Build() *CreateBurnRequestPayload {
+	m0 := &CreateBurnRequestPayload{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_OwnerAddress = b.OwnerAddress
+	x.xxx_hidden_MintHash = b.MintHash
+	if b.Quantity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Quantity = *b.Quantity
+	}
+	return m0
+}
+
+type CreateBurnResponse struct {
+	state                             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Hash                   *Hash                  `protobuf:"bytes,1,opt,name=hash"`
+	xxx_hidden_EncodedTransactionBody *string                `protobuf:"bytes,2,opt,name=encoded_transaction_body,json=encodedTransactionBody"`
+	XXX_raceDetectHookData            protoimpl.RaceDetectHookData
+	XXX_presence                      [1]uint32
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *CreateBurnResponse) Reset() {
+	*x = CreateBurnResponse{}
+	mi := &file_burns_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBurnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBurnResponse) ProtoMessage() {}
+
+func (x *CreateBurnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_burns_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateBurnResponse) GetHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_Hash
+	}
+	return nil
+}
+
+func (x *CreateBurnResponse) GetEncodedTransactionBody() string {
+	if x != nil {
+		if x.xxx_hidden_EncodedTransactionBody != nil {
+			return *x.xxx_hidden_EncodedTransactionBody
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateBurnResponse) SetHash(v *Hash) {
+	x.xxx_hidden_Hash = v
+}
+
+func (x *CreateBurnResponse) SetEncodedTransactionBody(v string) {
+	x.xxx_hidden_EncodedTransactionBody = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *CreateBurnResponse) HasHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Hash != nil
+}
+
+func (x *CreateBurnResponse) HasEncodedTransactionBody() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CreateBurnResponse) ClearHash() {
+	x.xxx_hidden_Hash = nil
+}
+
+func (x *CreateBurnResponse) ClearEncodedTransactionBody() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_EncodedTransactionBody = nil
+}
+
+type CreateBurnResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Hash                   *Hash
+	EncodedTransactionBody *string
+}
+
+func (b0 CreateBurnResponse_builder) Build() *CreateBurnResponse {
+	m0 := &CreateBurnResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Hash = b.Hash
+	if b.EncodedTransactionBody != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_EncodedTransactionBody = b.EncodedTransactionBody
+	}
+	return m0
+}
+
+type CreateBurnSignatureRequest struct {
+	state              protoimpl.MessageState             `protogen:"opaque.v1"`
+	xxx_hidden_Payload *CreateBurnSignatureRequestPayload `protobuf:"bytes,1,opt,name=payload"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateBurnSignatureRequest) Reset() {
+	*x = CreateBurnSignatureRequest{}
+	mi := &file_burns_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBurnSignatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBurnSignatureRequest) ProtoMessage() {}
+
+func (x *CreateBurnSignatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_burns_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateBurnSignatureRequest) GetPayload() *CreateBurnSignatureRequestPayload {
+	if x != nil {
+		return x.xxx_hidden_Payload
+	}
+	return nil
+}
+
+func (x *CreateBurnSignatureRequest) SetPayload(v *CreateBurnSignatureRequestPayload) {
+	x.xxx_hidden_Payload = v
+}
+
+func (x *CreateBurnSignatureRequest) HasPayload() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Payload != nil
+}
+
+func (x *CreateBurnSignatureRequest) ClearPayload() {
+	x.xxx_hidden_Payload = nil
+}
+
+type CreateBurnSignatureRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Payload *CreateBurnSignatureRequestPayload
+}
+
+func (b0 CreateBurnSignatureRequest_builder) Build() *CreateBurnSignatureRequest {
+	m0 := &CreateBurnSignatureRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Payload = b.Payload
+	return m0
+}
+
+type CreateBurnSignatureRequestPayload struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_BurnHash     *string                `protobuf:"bytes,1,opt,name=burn_hash,json=burnHash"`
+	xxx_hidden_MintHash     *Hash                  `protobuf:"bytes,2,opt,name=mint_hash,json=mintHash"`
+	xxx_hidden_OwnerAddress *Address               `protobuf:"bytes,3,opt,name=owner_address,json=ownerAddress"`
+	xxx_hidden_Quantity     int32                  `protobuf:"varint,4,opt,name=quantity"`
+	xxx_hidden_PublicKey    *string                `protobuf:"bytes,5,opt,name=public_key,json=publicKey"`
+	xxx_hidden_Signature    *string                `protobuf:"bytes,6,opt,name=signature"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CreateBurnSignatureRequestPayload) Reset() {
+	*x = CreateBurnSignatureRequestPayload{}
+	mi := &file_burns_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBurnSignatureRequestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBurnSignatureRequestPayload) ProtoMessage() {}
+
+func (x *CreateBurnSignatureRequestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_burns_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateBurnSignatureRequestPayload) GetBurnHash() string {
+	if x != nil {
+		if x.xxx_hidden_BurnHash != nil {
+			return *x.xxx_hidden_BurnHash
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateBurnSignatureRequestPayload) GetMintHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_MintHash
+	}
+	return nil
+}
+
+func (x *CreateBurnSignatureRequestPayload) GetOwnerAddress() *Address {
+	if x != nil {
+		return x.xxx_hidden_OwnerAddress
+	}
+	return nil
+}
+
+func (x *CreateBurnSignatureRequestPayload) GetQuantity() int32 {
+	if x != nil {
+		return x.xxx_hidden_Quantity
+	}
+	return 0
+}
+
+func (x *CreateBurnSignatureRequestPayload) GetPublicKey() string {
+	if x != nil {
+		if x.xxx_hidden_PublicKey != nil {
+			return *x.xxx_hidden_PublicKey
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateBurnSignatureRequestPayload) GetSignature() string {
+	if x != nil {
+		if x.xxx_hidden_Signature != nil {
+			return *x.xxx_hidden_Signature
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateBurnSignatureRequestPayload) SetBurnHash(v string) {
+	x.xxx_hidden_BurnHash = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+}
+
+func (x *CreateBurnSignatureRequestPayload) SetMintHash(v *Hash) {
+	x.xxx_hidden_MintHash = v
+}
+
+func (x *CreateBurnSignatureRequestPayload) SetOwnerAddress(v *Address) {
+	x.xxx_hidden_OwnerAddress = v
+}
+
+func (x *CreateBurnSignatureRequestPayload) SetQuantity(v int32) {
+	x.xxx_hidden_Quantity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+}
+
+func (x *CreateBurnSignatureRequestPayload) SetPublicKey(v string) {
+	x.xxx_hidden_PublicKey = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+}
+
+func (x *CreateBurnSignatureRequestPayload) SetSignature(v string) {
+	x.xxx_hidden_Signature = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 6)
+}
+
+func (x *CreateBurnSignatureRequestPayload) HasBurnHash() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CreateBurnSignatureRequestPayload) HasMintHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MintHash != nil
+}
+
+func (x *CreateBurnSignatureRequestPayload) HasOwnerAddress() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_OwnerAddress != nil
+}
+
+func (x *CreateBurnSignatureRequestPayload) HasQuantity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *CreateBurnSignatureRequestPayload) HasPublicKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *CreateBurnSignatureRequestPayload) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *CreateBurnSignatureRequestPayload) ClearBurnHash() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_BurnHash = nil
+}
+
+func (x *CreateBurnSignatureRequestPayload) ClearMintHash() {
+	x.xxx_hidden_MintHash = nil
+}
+
+func (x *CreateBurnSignatureRequestPayload) ClearOwnerAddress() {
+	x.xxx_hidden_OwnerAddress = nil
+}
+
+func (x *CreateBurnSignatureRequestPayload) ClearQuantity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Quantity = 0
+}
+
+func (x *CreateBurnSignatureRequestPayload) ClearPublicKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_PublicKey = nil
+}
+
+func (x *CreateBurnSignatureRequestPayload) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Signature = nil
+}
+
+type CreateBurnSignatureRequestPayload_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	BurnHash     *string
+	MintHash     *Hash
+	OwnerAddress *Address
+	Quantity     *int32
+	PublicKey    *string
+	Signature    *string
+}
+
+func (b0 CreateBurnSignatureRequestPayload_builder) Build() *CreateBurnSignatureRequestPayload {
+	m0 := &CreateBurnSignatureRequestPayload{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.BurnHash != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		x.xxx_hidden_BurnHash = b.BurnHash
+	}
+	x.xxx_hidden_MintHash = b.MintHash
+	x.xxx_hidden_OwnerAddress = b.OwnerAddress
+	if b.Quantity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		x.xxx_hidden_Quantity = *b.Quantity
+	}
+	if b.PublicKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		x.xxx_hidden_PublicKey = b.PublicKey
+	}
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 6)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	return m0
+}
+
+type CreateBurnSignatureResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateBurnSignatureResponse) Reset() {
+	*x = CreateBurnSignatureResponse{}
+	mi := &file_burns_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBurnSignatureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBurnSignatureResponse) ProtoMessage() {}
+
+func (x *CreateBurnSignatureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_burns_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateBurnSignatureResponse) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateBurnSignatureResponse) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *CreateBurnSignatureResponse) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CreateBurnSignatureResponse) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type CreateBurnSignatureResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 CreateBurnSignatureResponse_builder) Build() *CreateBurnSignatureResponse {
+	m0 := &CreateBurnSignatureResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+var File_burns_proto protoreflect.FileDescriptor
+
+const file_burns_proto_rawDesc = "" +
+	"\n" +
+	"\vburns.proto\x12\x14fractalengine.rpc.v1\x1a\x1bbuf/validate/validate.proto\x1a\vtypes.proto\"\xd1\x01\n" +
+	"\x11CreateBurnRequest\x12H\n" +
+	"\apayload\x18\x01 \x01(\v2..fractalengine.rpc.v1.CreateBurnRequestPayloadR\apayload\x12&\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tpublicKey\x12%\n" +
+	"\tsignature\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tsignature\x12#\n" +
+	"\rredeem_script\x18\x04 \x01(\tR\fredeemScript\"\xbc\x01\n" +
+	"\x18CreateBurnRequestPayload\x12B\n" +
+	"\rowner_address\x18\x01 \x01(\v2\x1d.fractalengine.rpc.v1.AddressR\fownerAddress\x127\n" +
+	"\tmint_hash\x18\x02 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\bmintHash\x12#\n" +
+	"\bquantity\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bquantity\"~\n" +
+	"\x12CreateBurnResponse\x12.\n" +
+	"\x04hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\x04hash\x128\n" +
+	"\x18encoded_transaction_body\x18\x02 \x01(\tR\x16encodedTransactionBody\"o\n" +
+	"\x1aCreateBurnSignatureRequest\x12Q\n" +
+	"\apayload\x18\x01 \x01(\v27.fractalengine.rpc.v1.CreateBurnSignatureRequestPayloadR\apayload\"\xcd\x02\n" +
+	"!CreateBurnSignatureRequestPayload\x127\n" +
+	"\tburn_hash\x18\x01 \x01(\tB\x1a\xbaH\x17r\x15\x10\x012\x11^[a-fA-F0-9]{64}$R\bburnHash\x127\n" +
+	"\tmint_hash\x18\x02 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\bmintHash\x12B\n" +
+	"\rowner_address\x18\x03 \x01(\v2\x1d.fractalengine.rpc.v1.AddressR\fownerAddress\x12#\n" +
+	"\bquantity\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bquantity\x12&\n" +
+	"\n" +
+	"public_key\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tpublicKey\x12%\n" +
+	"\tsignature\x18\x06 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tsignature\"-\n" +
+	"\x1bCreateBurnSignatureResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02idB.Z,dogecoin.org/fractal-engine/pkg/rpc/protocolb\beditionsp\xe8\a"
+
+var file_burns_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_burns_proto_goTypes = []any{
+	(*CreateBurnRequest)(nil),                 // 0: fractalengine.rpc.v1.CreateBurnRequest
+	(*CreateBurnRequestPayload)(nil),          // 1: fractalengine.rpc.v1.CreateBurnRequestPayload
+	(*CreateBurnResponse)(nil),                // 2: fractalengine.rpc.v1.CreateBurnResponse
+	(*CreateBurnSignatureRequest)(nil),        // 3: fractalengine.rpc.v1.CreateBurnSignatureRequest
+	(*CreateBurnSignatureRequestPayload)(nil), // 4: fractalengine.rpc.v1.CreateBurnSignatureRequestPayload
+	(*CreateBurnSignatureResponse)(nil),       // 5: fractalengine.rpc.v1.CreateBurnSignatureResponse
+	(*Address)(nil),                           // 6: fractalengine.rpc.v1.Address
+	(*Hash)(nil),                              // 7: fractalengine.rpc.v1.Hash
+}
+var file_burns_proto_depIdxs = []int32{
+	1, // 0: fractalengine.rpc.v1.CreateBurnRequest.payload:type_name -> fractalengine.rpc.v1.CreateBurnRequestPayload
+	6, // 1: fractalengine.rpc.v1.CreateBurnRequestPayload.owner_address:type_name -> fractalengine.rpc.v1.Address
+	7, // 2: fractalengine.rpc.v1.CreateBurnRequestPayload.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	7, // 3: fractalengine.rpc.v1.CreateBurnResponse.hash:type_name -> fractalengine.rpc.v1.Hash
+	4, // 4: fractalengine.rpc.v1.CreateBurnSignatureRequest.payload:type_name -> fractalengine.rpc.v1.CreateBurnSignatureRequestPayload
+	7, // 5: fractalengine.rpc.v1.CreateBurnSignatureRequestPayload.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	6, // 6: fractalengine.rpc.v1.CreateBurnSignatureRequestPayload.owner_address:type_name -> fractalengine.rpc.v1.Address
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_burns_proto_init() }
+func file_burns_proto_init() {
+	if File_burns_proto != nil {
+		return
+	}
+	file_types_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_burns_proto_rawDesc), len(file_burns_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_burns_proto_goTypes,
+		DependencyIndexes: file_burns_proto_depIdxs,
+		MessageInfos:      file_burns_proto_msgTypes,
+	}.Build()
+	File_burns_proto = out.File
+	file_burns_proto_goTypes = nil
+	file_burns_proto_depIdxs = nil
+}
@@ -549,6 +549,7 @@ type Mint struct {
 	xxx_hidden_Tags                     []string                 `protobuf:"bytes,18,rep,name=tags"`
 	xxx_hidden_Title                    *string                  `protobuf:"bytes,19,opt,name=title"`
 	xxx_hidden_TransactionHash          *Hash                    `protobuf:"bytes,20,opt,name=transaction_hash,json=transactionHash"`
+	xxx_hidden_ConfirmationsRemaining   int32                    `protobuf:"varint,21,opt,name=confirmations_remaining,json=confirmationsRemaining"`
 	XXX_raceDetectHookData              protoimpl.RaceDetectHookData
 	XXX_presence                        [1]uint32
 	unknownFields                       protoimpl.UnknownFields
@@ -748,38 +749,45 @@ func (x *Mint) GetTransactionHash() *Hash {
 	return nil
 }
 
+func (x *Mint) GetConfirmationsRemaining() int32 {
+	if x != nil {
+		return x.xxx_hidden_ConfirmationsRemaining
+	}
+	return 0
+}
+
 func (x *Mint) SetAssetManagers(v []*AssetManager) {
 	x.xxx_hidden_AssetManagers = &v
 }
 
 func (x *Mint) SetBlockHeight(v int32) {
 	x.xxx_hidden_BlockHeight = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 20)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 21)
 }
 
 func (x *Mint) SetContractOfSale(v string) {
 	x.xxx_hidden_ContractOfSale = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 20)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 21)
 }
 
 func (x *Mint) SetCreatedAt(v string) {
 	x.xxx_hidden_CreatedAt = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 20)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 21)
 }
 
 func (x *Mint) SetDescription(v string) {
 	x.xxx_hidden_Description = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 20)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 21)
 }
 
 func (x *Mint) SetFeedUrl(v string) {
 	x.xxx_hidden_FeedUrl = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 20)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 21)
 }
 
 func (x *Mint) SetFractionCount(v int32) {
 	x.xxx_hidden_FractionCount = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 20)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 21)
 }
 
 func (x *Mint) SetHash(v *Hash) {
@@ -788,7 +796,7 @@ func (x *Mint) SetHash(v *Hash) {
 
 func (x *Mint) SetId(v string) {
 	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 20)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 21)
 }
 
 func (x *Mint) SetLockupOptions(v *StringInterfaceMap) {
@@ -801,7 +809,7 @@ func (x *Mint) SetMetadata(v *StringInterfaceMap) {
 
 func (x *Mint) SetMinSignatures(v int32) {
 	x.xxx_hidden_MinSignatures = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 20)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 21)
 }
 
 func (x *Mint) SetOwnerAddress(v *Address) {
@@ -810,7 +818,7 @@ func (x *Mint) SetOwnerAddress(v *Address) {
 
 func (x *Mint) SetPublicKey(v string) {
 	x.xxx_hidden_PublicKey = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 20)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 21)
 }
 
 func (x *Mint) SetRequirements(v *StringInterfaceMap) {
@@ -819,12 +827,12 @@ func (x *Mint) SetRequirements(v *StringInterfaceMap) {
 
 func (x *Mint) SetSignature(v string) {
 	x.xxx_hidden_Signature = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 20)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 21)
 }
 
 func (x *Mint) SetSignatureRequirementType(v SignatureRequirementType) {
 	x.xxx_hidden_SignatureRequirementType = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 16, 20)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 16, 21)
 }
 
 func (x *Mint) SetTags(v []string) {
@@ -833,13 +841,18 @@ func (x *Mint) SetTags(v []string) {
 
 func (x *Mint) SetTitle(v string) {
 	x.xxx_hidden_Title = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 18, 20)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 18, 21)
 }
 
 func (x *Mint) SetTransactionHash(v *Hash) {
 	x.xxx_hidden_TransactionHash = v
 }
 
+func (x *Mint) SetConfirmationsRemaining(v int32) {
+	x.xxx_hidden_ConfirmationsRemaining = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 20, 21)
+}
+
 func (x *Mint) HasBlockHeight() bool {
 	if x == nil {
 		return false
@@ -966,6 +979,13 @@ func (x *Mint) HasTransactionHash() bool {
 	return x.xxx_hidden_TransactionHash != nil
 }
 
+func (x *Mint) HasConfirmationsRemaining() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 20)
+}
+
 func (x *Mint) ClearBlockHeight() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
 	x.xxx_hidden_BlockHeight = 0
@@ -1050,6 +1070,11 @@ func (x *Mint) ClearTransactionHash() {
 	x.xxx_hidden_TransactionHash = nil
 }
 
+func (x *Mint) ClearConfirmationsRemaining() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 20)
+	x.xxx_hidden_ConfirmationsRemaining = 0
+}
+
 type Mint_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -1073,6 +1098,7 @@ type Mint_builder struct {
 	Tags                     []string
 	Title                    *string
 	TransactionHash          *Hash
+	ConfirmationsRemaining   *int32
 }
 
 func (b0 Mint_builder) Build() *Mint {
@@ -1081,84 +1107,92 @@ func (b0 Mint_builder) Build() *Mint {
 	_, _ = b, x
 	x.xxx_hidden_AssetManagers = &b.AssetManagers
 	if b.BlockHeight != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 20)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 21)
 		x.xxx_hidden_BlockHeight = *b.BlockHeight
 	}
 	if b.ContractOfSale != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 20)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 21)
 		x.xxx_hidden_ContractOfSale = b.ContractOfSale
 	}
 	if b.CreatedAt != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 20)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 21)
 		x.xxx_hidden_CreatedAt = b.CreatedAt
 	}
 	if b.Description != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 20)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 21)
 		x.xxx_hidden_Description = b.Description
 	}
 	if b.FeedUrl != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 20)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 21)
 		x.xxx_hidden_FeedUrl = b.FeedUrl
 	}
 	if b.FractionCount != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 20)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 21)
 		x.xxx_hidden_FractionCount = *b.FractionCount
 	}
 	x.xxx_hidden_Hash = b.Hash
 	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 20)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 21)
 		x.xxx_hidden_Id = b.Id
 	}
 	x.xxx_hidden_LockupOptions = b.LockupOptions
 	x.xxx_hidden_Metadata = b.Metadata
 	if b.MinSignatures != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 20)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 21)
 		x.xxx_hidden_MinSignatures = *b.MinSignatures
 	}
 	x.xxx_hidden_OwnerAddress = b.OwnerAddress
 	if b.PublicKey != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 20)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 21)
 		x.xxx_hidden_PublicKey = b.PublicKey
 	}
 	x.xxx_hidden_Requirements = b.Requirements
 	if b.Signature != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 20)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 21)
 		x.xxx_hidden_Signature = b.Signature
 	}
 	if b.SignatureRequirementType != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 16, 20)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 16, 21)
 		x.xxx_hidden_SignatureRequirementType = *b.SignatureRequirementType
 	}
 	x.xxx_hidden_Tags = b.Tags
 	if b.Title != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 18, 20)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 18, 21)
 		x.xxx_hidden_Title = b.Title
 	}
 	x.xxx_hidden_TransactionHash = b.TransactionHash
+	if b.ConfirmationsRemaining != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 20, 21)
+		x.xxx_hidden_ConfirmationsRemaining = *b.ConfirmationsRemaining
+	}
 	return m0
 }
 
 type Invoice struct {
-	state                            protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_BlockHeight           int32                  `protobuf:"varint,1,opt,name=block_height,json=blockHeight"`
-	xxx_hidden_BuyerAddress          *Address               `protobuf:"bytes,2,opt,name=buyer_address,json=buyerAddress"`
-	xxx_hidden_CreatedAt             *string                `protobuf:"bytes,3,opt,name=created_at,json=createdAt"`
-	xxx_hidden_Hash                  *Hash                  `protobuf:"bytes,4,opt,name=hash"`
-	xxx_hidden_Id                    *string                `protobuf:"bytes,5,opt,name=id"`
-	xxx_hidden_MintHash              *Hash                  `protobuf:"bytes,6,opt,name=mint_hash,json=mintHash"`
-	xxx_hidden_PaidAt                *SqlNullTime           `protobuf:"bytes,7,opt,name=paid_at,json=paidAt"`
-	xxx_hidden_PaymentAddress        *Address               `protobuf:"bytes,8,opt,name=payment_address,json=paymentAddress"`
-	xxx_hidden_PendingTokenBalanceId *string                `protobuf:"bytes,9,opt,name=pending_token_balance_id,json=pendingTokenBalanceId"`
-	xxx_hidden_Price                 int32                  `protobuf:"varint,10,opt,name=price"`
-	xxx_hidden_PublicKey             *string                `protobuf:"bytes,11,opt,name=public_key,json=publicKey"`
-	xxx_hidden_Quantity              int32                  `protobuf:"varint,12,opt,name=quantity"`
-	xxx_hidden_SellerAddress         *Address               `protobuf:"bytes,13,opt,name=seller_address,json=sellerAddress"`
-	xxx_hidden_Signature             *string                `protobuf:"bytes,14,opt,name=signature"`
-	xxx_hidden_TransactionHash       *Hash                  `protobuf:"bytes,15,opt,name=transaction_hash,json=transactionHash"`
-	XXX_raceDetectHookData           protoimpl.RaceDetectHookData
-	XXX_presence                     [1]uint32
-	unknownFields                    protoimpl.UnknownFields
-	sizeCache                        protoimpl.SizeCache
+	state                                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_BlockHeight                   int32                  `protobuf:"varint,1,opt,name=block_height,json=blockHeight"`
+	xxx_hidden_BuyerAddress                  *Address               `protobuf:"bytes,2,opt,name=buyer_address,json=buyerAddress"`
+	xxx_hidden_CreatedAt                     *string                `protobuf:"bytes,3,opt,name=created_at,json=createdAt"`
+	xxx_hidden_Hash                          *Hash                  `protobuf:"bytes,4,opt,name=hash"`
+	xxx_hidden_Id                            *string                `protobuf:"bytes,5,opt,name=id"`
+	xxx_hidden_MintHash                      *Hash                  `protobuf:"bytes,6,opt,name=mint_hash,json=mintHash"`
+	xxx_hidden_PaidAt                        *SqlNullTime           `protobuf:"bytes,7,opt,name=paid_at,json=paidAt"`
+	xxx_hidden_PaymentAddress                *Address               `protobuf:"bytes,8,opt,name=payment_address,json=paymentAddress"`
+	xxx_hidden_PendingTokenBalanceId         *string                `protobuf:"bytes,9,opt,name=pending_token_balance_id,json=pendingTokenBalanceId"`
+	xxx_hidden_PublicKey                     *string                `protobuf:"bytes,11,opt,name=public_key,json=publicKey"`
+	xxx_hidden_Quantity                      int32                  `protobuf:"varint,12,opt,name=quantity"`
+	xxx_hidden_SellerAddress                 *Address               `protobuf:"bytes,13,opt,name=seller_address,json=sellerAddress"`
+	xxx_hidden_Signature                     *string                `protobuf:"bytes,14,opt,name=signature"`
+	xxx_hidden_TransactionHash               *Hash                  `protobuf:"bytes,15,opt,name=transaction_hash,json=transactionHash"`
+	xxx_hidden_Splits                        *[]*InvoiceSplit       `protobuf:"bytes,16,rep,name=splits"`
+	xxx_hidden_PaymentConfirmationsRemaining int32                  `protobuf:"varint,17,opt,name=payment_confirmations_remaining,json=paymentConfirmationsRemaining"`
+	xxx_hidden_Status                        *string                `protobuf:"bytes,18,opt,name=status"`
+	xxx_hidden_ExpiresAtHeight               int64                  `protobuf:"varint,19,opt,name=expires_at_height,json=expiresAtHeight"`
+	xxx_hidden_PriceKoinu                    int64                  `protobuf:"varint,20,opt,name=price_koinu,json=priceKoinu"`
+	XXX_raceDetectHookData                   protoimpl.RaceDetectHookData
+	XXX_presence                             [1]uint32
+	unknownFields                            protoimpl.UnknownFields
+	sizeCache                                protoimpl.SizeCache
 }
 
 func (x *Invoice) Reset() {
@@ -1258,13 +1292,6 @@ func (x *Invoice) GetPendingTokenBalanceId() string {
 	return ""
 }
 
-func (x *Invoice) GetPrice() int32 {
-	if x != nil {
-		return x.xxx_hidden_Price
-	}
-	return 0
-}
-
 func (x *Invoice) GetPublicKey() string {
 	if x != nil {
 		if x.xxx_hidden_PublicKey != nil {
@@ -1306,9 +1333,49 @@ func (x *Invoice) GetTransactionHash() *Hash {
 	return nil
 }
 
+func (x *Invoice) GetSplits() []*InvoiceSplit {
+	if x != nil {
+		if x.xxx_hidden_Splits != nil {
+			return *x.xxx_hidden_Splits
+		}
+	}
+	return nil
+}
+
+func (x *Invoice) GetPaymentConfirmationsRemaining() int32 {
+	if x != nil {
+		return x.xxx_hidden_PaymentConfirmationsRemaining
+	}
+	return 0
+}
+
+func (x *Invoice) GetStatus() string {
+	if x != nil {
+		if x.xxx_hidden_Status != nil {
+			return *x.xxx_hidden_Status
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Invoice) GetExpiresAtHeight() int64 {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAtHeight
+	}
+	return 0
+}
+
+func (x *Invoice) GetPriceKoinu() int64 {
+	if x != nil {
+		return x.xxx_hidden_PriceKoinu
+	}
+	return 0
+}
+
 func (x *Invoice) SetBlockHeight(v int32) {
 	x.xxx_hidden_BlockHeight = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 15)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 19)
 }
 
 func (x *Invoice) SetBuyerAddress(v *Address) {
@@ -1317,7 +1384,7 @@ func (x *Invoice) SetBuyerAddress(v *Address) {
 
 func (x *Invoice) SetCreatedAt(v string) {
 	x.xxx_hidden_CreatedAt = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 15)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 19)
 }
 
 func (x *Invoice) SetHash(v *Hash) {
@@ -1326,7 +1393,7 @@ func (x *Invoice) SetHash(v *Hash) {
 
 func (x *Invoice) SetId(v string) {
 	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 15)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 19)
 }
 
 func (x *Invoice) SetMintHash(v *Hash) {
@@ -1343,22 +1410,17 @@ func (x *Invoice) SetPaymentAddress(v *Address) {
 
 func (x *Invoice) SetPendingTokenBalanceId(v string) {
 	x.xxx_hidden_PendingTokenBalanceId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 15)
-}
-
-func (x *Invoice) SetPrice(v int32) {
-	x.xxx_hidden_Price = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 15)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 19)
 }
 
 func (x *Invoice) SetPublicKey(v string) {
 	x.xxx_hidden_PublicKey = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 15)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 19)
 }
 
 func (x *Invoice) SetQuantity(v int32) {
 	x.xxx_hidden_Quantity = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 15)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 19)
 }
 
 func (x *Invoice) SetSellerAddress(v *Address) {
@@ -1367,13 +1429,37 @@ func (x *Invoice) SetSellerAddress(v *Address) {
 
 func (x *Invoice) SetSignature(v string) {
 	x.xxx_hidden_Signature = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 15)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 19)
 }
 
 func (x *Invoice) SetTransactionHash(v *Hash) {
 	x.xxx_hidden_TransactionHash = v
 }
 
+func (x *Invoice) SetSplits(v []*InvoiceSplit) {
+	x.xxx_hidden_Splits = &v
+}
+
+func (x *Invoice) SetPaymentConfirmationsRemaining(v int32) {
+	x.xxx_hidden_PaymentConfirmationsRemaining = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 19)
+}
+
+func (x *Invoice) SetStatus(v string) {
+	x.xxx_hidden_Status = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 16, 19)
+}
+
+func (x *Invoice) SetExpiresAtHeight(v int64) {
+	x.xxx_hidden_ExpiresAtHeight = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 17, 19)
+}
+
+func (x *Invoice) SetPriceKoinu(v int64) {
+	x.xxx_hidden_PriceKoinu = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 18, 19)
+}
+
 func (x *Invoice) HasBlockHeight() bool {
 	if x == nil {
 		return false
@@ -1437,179 +1523,1165 @@ func (x *Invoice) HasPendingTokenBalanceId() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
 }
 
-func (x *Invoice) HasPrice() bool {
+func (x *Invoice) HasPublicKey() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
 }
 
-func (x *Invoice) HasPublicKey() bool {
+func (x *Invoice) HasQuantity() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
 }
 
-func (x *Invoice) HasQuantity() bool {
+func (x *Invoice) HasSellerAddress() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+	return x.xxx_hidden_SellerAddress != nil
 }
 
-func (x *Invoice) HasSellerAddress() bool {
+func (x *Invoice) HasSignature() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_SellerAddress != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
 }
 
-func (x *Invoice) HasSignature() bool {
+func (x *Invoice) HasTransactionHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_TransactionHash != nil
+}
+
+func (x *Invoice) HasPaymentConfirmationsRemaining() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 15)
+}
+
+func (x *Invoice) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 16)
+}
+
+func (x *Invoice) HasExpiresAtHeight() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 17)
+}
+
+func (x *Invoice) HasPriceKoinu() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 18)
+}
+
+func (x *Invoice) ClearBlockHeight() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_BlockHeight = 0
+}
+
+func (x *Invoice) ClearBuyerAddress() {
+	x.xxx_hidden_BuyerAddress = nil
+}
+
+func (x *Invoice) ClearCreatedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *Invoice) ClearHash() {
+	x.xxx_hidden_Hash = nil
+}
+
+func (x *Invoice) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *Invoice) ClearMintHash() {
+	x.xxx_hidden_MintHash = nil
+}
+
+func (x *Invoice) ClearPaidAt() {
+	x.xxx_hidden_PaidAt = nil
+}
+
+func (x *Invoice) ClearPaymentAddress() {
+	x.xxx_hidden_PaymentAddress = nil
+}
+
+func (x *Invoice) ClearPendingTokenBalanceId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_PendingTokenBalanceId = nil
+}
+
+func (x *Invoice) ClearPublicKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_PublicKey = nil
+}
+
+func (x *Invoice) ClearQuantity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_Quantity = 0
+}
+
+func (x *Invoice) ClearSellerAddress() {
+	x.xxx_hidden_SellerAddress = nil
+}
+
+func (x *Invoice) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
+	x.xxx_hidden_Signature = nil
+}
+
+func (x *Invoice) ClearTransactionHash() {
+	x.xxx_hidden_TransactionHash = nil
+}
+
+func (x *Invoice) ClearPaymentConfirmationsRemaining() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 15)
+	x.xxx_hidden_PaymentConfirmationsRemaining = 0
+}
+
+func (x *Invoice) ClearStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 16)
+	x.xxx_hidden_Status = nil
+}
+
+func (x *Invoice) ClearExpiresAtHeight() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 17)
+	x.xxx_hidden_ExpiresAtHeight = 0
+}
+
+func (x *Invoice) ClearPriceKoinu() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 18)
+	x.xxx_hidden_PriceKoinu = 0
+}
+
+type Invoice_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	BlockHeight                   *int32
+	BuyerAddress                  *Address
+	CreatedAt                     *string
+	Hash                          *Hash
+	Id                            *string
+	MintHash                      *Hash
+	PaidAt                        *SqlNullTime
+	PaymentAddress                *Address
+	PendingTokenBalanceId         *string
+	PublicKey                     *string
+	Quantity                      *int32
+	SellerAddress                 *Address
+	Signature                     *string
+	TransactionHash               *Hash
+	Splits                        []*InvoiceSplit
+	PaymentConfirmationsRemaining *int32
+	Status                        *string
+	ExpiresAtHeight               *int64
+	PriceKoinu                    *int64
+}
+
+func (b0 Invoice_builder) Build() *Invoice {
+	m0 := &Invoice{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.BlockHeight != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 19)
+		x.xxx_hidden_BlockHeight = *b.BlockHeight
+	}
+	x.xxx_hidden_BuyerAddress = b.BuyerAddress
+	if b.CreatedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 19)
+		x.xxx_hidden_CreatedAt = b.CreatedAt
+	}
+	x.xxx_hidden_Hash = b.Hash
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 19)
+		x.xxx_hidden_Id = b.Id
+	}
+	x.xxx_hidden_MintHash = b.MintHash
+	x.xxx_hidden_PaidAt = b.PaidAt
+	x.xxx_hidden_PaymentAddress = b.PaymentAddress
+	if b.PendingTokenBalanceId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 19)
+		x.xxx_hidden_PendingTokenBalanceId = b.PendingTokenBalanceId
+	}
+	if b.PublicKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 19)
+		x.xxx_hidden_PublicKey = b.PublicKey
+	}
+	if b.Quantity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 19)
+		x.xxx_hidden_Quantity = *b.Quantity
+	}
+	x.xxx_hidden_SellerAddress = b.SellerAddress
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 19)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	x.xxx_hidden_TransactionHash = b.TransactionHash
+	x.xxx_hidden_Splits = &b.Splits
+	if b.PaymentConfirmationsRemaining != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 19)
+		x.xxx_hidden_PaymentConfirmationsRemaining = *b.PaymentConfirmationsRemaining
+	}
+	if b.Status != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 16, 19)
+		x.xxx_hidden_Status = b.Status
+	}
+	if b.ExpiresAtHeight != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 17, 19)
+		x.xxx_hidden_ExpiresAtHeight = *b.ExpiresAtHeight
+	}
+	if b.PriceKoinu != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 18, 19)
+		x.xxx_hidden_PriceKoinu = *b.PriceKoinu
+	}
+	return m0
+}
+
+type InvoiceTransition struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_FromStatus      *string                `protobuf:"bytes,1,opt,name=from_status,json=fromStatus"`
+	xxx_hidden_ToStatus        *string                `protobuf:"bytes,2,opt,name=to_status,json=toStatus"`
+	xxx_hidden_BlockHeight     int64                  `protobuf:"varint,3,opt,name=block_height,json=blockHeight"`
+	xxx_hidden_TransactionHash *Hash                  `protobuf:"bytes,4,opt,name=transaction_hash,json=transactionHash"`
+	xxx_hidden_CreatedAt       *string                `protobuf:"bytes,5,opt,name=created_at,json=createdAt"`
+	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
+	XXX_presence               [1]uint32
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *InvoiceTransition) Reset() {
+	*x = InvoiceTransition{}
+	mi := &file_common_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceTransition) ProtoMessage() {}
+
+func (x *InvoiceTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvoiceTransition) GetFromStatus() string {
+	if x != nil {
+		if x.xxx_hidden_FromStatus != nil {
+			return *x.xxx_hidden_FromStatus
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvoiceTransition) GetToStatus() string {
+	if x != nil {
+		if x.xxx_hidden_ToStatus != nil {
+			return *x.xxx_hidden_ToStatus
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvoiceTransition) GetBlockHeight() int64 {
+	if x != nil {
+		return x.xxx_hidden_BlockHeight
+	}
+	return 0
+}
+
+func (x *InvoiceTransition) GetTransactionHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_TransactionHash
+	}
+	return nil
+}
+
+func (x *InvoiceTransition) GetCreatedAt() string {
+	if x != nil {
+		if x.xxx_hidden_CreatedAt != nil {
+			return *x.xxx_hidden_CreatedAt
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvoiceTransition) SetFromStatus(v string) {
+	x.xxx_hidden_FromStatus = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *InvoiceTransition) SetToStatus(v string) {
+	x.xxx_hidden_ToStatus = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *InvoiceTransition) SetBlockHeight(v int64) {
+	x.xxx_hidden_BlockHeight = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *InvoiceTransition) SetTransactionHash(v *Hash) {
+	x.xxx_hidden_TransactionHash = v
+}
+
+func (x *InvoiceTransition) SetCreatedAt(v string) {
+	x.xxx_hidden_CreatedAt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *InvoiceTransition) HasFromStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvoiceTransition) HasToStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvoiceTransition) HasBlockHeight() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *InvoiceTransition) HasTransactionHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_TransactionHash != nil
+}
+
+func (x *InvoiceTransition) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *InvoiceTransition) ClearFromStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_FromStatus = nil
+}
+
+func (x *InvoiceTransition) ClearToStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ToStatus = nil
+}
+
+func (x *InvoiceTransition) ClearBlockHeight() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_BlockHeight = 0
+}
+
+func (x *InvoiceTransition) ClearTransactionHash() {
+	x.xxx_hidden_TransactionHash = nil
+}
+
+func (x *InvoiceTransition) ClearCreatedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_CreatedAt = nil
+}
+
+type InvoiceTransition_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	FromStatus      *string
+	ToStatus        *string
+	BlockHeight     *int64
+	TransactionHash *Hash
+	CreatedAt       *string
+}
+
+func (b0 InvoiceTransition_builder) Build() *InvoiceTransition {
+	m0 := &InvoiceTransition{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.FromStatus != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_FromStatus = b.FromStatus
+	}
+	if b.ToStatus != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_ToStatus = b.ToStatus
+	}
+	if b.BlockHeight != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_BlockHeight = *b.BlockHeight
+	}
+	x.xxx_hidden_TransactionHash = b.TransactionHash
+	if b.CreatedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_CreatedAt = b.CreatedAt
+	}
+	return m0
+}
+
+type InvoiceSplit struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Address     *Address               `protobuf:"bytes,1,opt,name=address"`
+	xxx_hidden_BasisPoints int32                  `protobuf:"varint,2,opt,name=basis_points,json=basisPoints"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvoiceSplit) Reset() {
+	*x = InvoiceSplit{}
+	mi := &file_common_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceSplit) ProtoMessage() {}
+
+func (x *InvoiceSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvoiceSplit) GetAddress() *Address {
+	if x != nil {
+		return x.xxx_hidden_Address
+	}
+	return nil
+}
+
+func (x *InvoiceSplit) GetBasisPoints() int32 {
+	if x != nil {
+		return x.xxx_hidden_BasisPoints
+	}
+	return 0
+}
+
+func (x *InvoiceSplit) SetAddress(v *Address) {
+	x.xxx_hidden_Address = v
+}
+
+func (x *InvoiceSplit) SetBasisPoints(v int32) {
+	x.xxx_hidden_BasisPoints = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *InvoiceSplit) HasAddress() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Address != nil
+}
+
+func (x *InvoiceSplit) HasBasisPoints() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *InvoiceSplit) ClearAddress() {
+	x.xxx_hidden_Address = nil
+}
+
+func (x *InvoiceSplit) ClearBasisPoints() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_BasisPoints = 0
+}
+
+type InvoiceSplit_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Address     *Address
+	BasisPoints *int32
+}
+
+func (b0 InvoiceSplit_builder) Build() *InvoiceSplit {
+	m0 := &InvoiceSplit{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Address = b.Address
+	if b.BasisPoints != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_BasisPoints = *b.BasisPoints
+	}
+	return m0
+}
+
+type Distribution struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Hash           *Hash                  `protobuf:"bytes,1,opt,name=hash"`
+	xxx_hidden_MintHash       *Hash                  `protobuf:"bytes,2,opt,name=mint_hash,json=mintHash"`
+	xxx_hidden_TotalKoinu     int64                  `protobuf:"varint,3,opt,name=total_koinu,json=totalKoinu"`
+	xxx_hidden_RecordHeight   int64                  `protobuf:"varint,4,opt,name=record_height,json=recordHeight"`
+	xxx_hidden_PublicKey      *string                `protobuf:"bytes,5,opt,name=public_key,json=publicKey"`
+	xxx_hidden_Signature      *string                `protobuf:"bytes,6,opt,name=signature"`
+	xxx_hidden_CreatedAt      *string                `protobuf:"bytes,7,opt,name=created_at,json=createdAt"`
+	xxx_hidden_ClaimedKoinu   int64                  `protobuf:"varint,8,opt,name=claimed_koinu,json=claimedKoinu"`
+	xxx_hidden_UnclaimedKoinu int64                  `protobuf:"varint,9,opt,name=unclaimed_koinu,json=unclaimedKoinu"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *Distribution) Reset() {
+	*x = Distribution{}
+	mi := &file_common_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Distribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Distribution) ProtoMessage() {}
+
+func (x *Distribution) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Distribution) GetHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_Hash
+	}
+	return nil
+}
+
+func (x *Distribution) GetMintHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_MintHash
+	}
+	return nil
+}
+
+func (x *Distribution) GetTotalKoinu() int64 {
+	if x != nil {
+		return x.xxx_hidden_TotalKoinu
+	}
+	return 0
+}
+
+func (x *Distribution) GetRecordHeight() int64 {
+	if x != nil {
+		return x.xxx_hidden_RecordHeight
+	}
+	return 0
+}
+
+func (x *Distribution) GetPublicKey() string {
+	if x != nil {
+		if x.xxx_hidden_PublicKey != nil {
+			return *x.xxx_hidden_PublicKey
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Distribution) GetSignature() string {
+	if x != nil {
+		if x.xxx_hidden_Signature != nil {
+			return *x.xxx_hidden_Signature
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Distribution) GetCreatedAt() string {
+	if x != nil {
+		if x.xxx_hidden_CreatedAt != nil {
+			return *x.xxx_hidden_CreatedAt
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Distribution) GetClaimedKoinu() int64 {
+	if x != nil {
+		return x.xxx_hidden_ClaimedKoinu
+	}
+	return 0
+}
+
+func (x *Distribution) GetUnclaimedKoinu() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnclaimedKoinu
+	}
+	return 0
+}
+
+func (x *Distribution) SetHash(v *Hash) {
+	x.xxx_hidden_Hash = v
+}
+
+func (x *Distribution) SetMintHash(v *Hash) {
+	x.xxx_hidden_MintHash = v
+}
+
+func (x *Distribution) SetTotalKoinu(v int64) {
+	x.xxx_hidden_TotalKoinu = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 9)
+}
+
+func (x *Distribution) SetRecordHeight(v int64) {
+	x.xxx_hidden_RecordHeight = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 9)
+}
+
+func (x *Distribution) SetPublicKey(v string) {
+	x.xxx_hidden_PublicKey = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 9)
+}
+
+func (x *Distribution) SetSignature(v string) {
+	x.xxx_hidden_Signature = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 9)
+}
+
+func (x *Distribution) SetCreatedAt(v string) {
+	x.xxx_hidden_CreatedAt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 9)
+}
+
+func (x *Distribution) SetClaimedKoinu(v int64) {
+	x.xxx_hidden_ClaimedKoinu = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 9)
+}
+
+func (x *Distribution) SetUnclaimedKoinu(v int64) {
+	x.xxx_hidden_UnclaimedKoinu = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 9)
+}
+
+func (x *Distribution) HasHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Hash != nil
+}
+
+func (x *Distribution) HasMintHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MintHash != nil
+}
+
+func (x *Distribution) HasTotalKoinu() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Distribution) HasRecordHeight() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Distribution) HasPublicKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Distribution) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *Distribution) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *Distribution) HasClaimedKoinu() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *Distribution) HasUnclaimedKoinu() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *Distribution) ClearHash() {
+	x.xxx_hidden_Hash = nil
+}
+
+func (x *Distribution) ClearMintHash() {
+	x.xxx_hidden_MintHash = nil
+}
+
+func (x *Distribution) ClearTotalKoinu() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_TotalKoinu = 0
+}
+
+func (x *Distribution) ClearRecordHeight() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_RecordHeight = 0
+}
+
+func (x *Distribution) ClearPublicKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_PublicKey = nil
+}
+
+func (x *Distribution) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Signature = nil
+}
+
+func (x *Distribution) ClearCreatedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *Distribution) ClearClaimedKoinu() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_ClaimedKoinu = 0
+}
+
+func (x *Distribution) ClearUnclaimedKoinu() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_UnclaimedKoinu = 0
+}
+
+type Distribution_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Hash           *Hash
+	MintHash       *Hash
+	TotalKoinu     *int64
+	RecordHeight   *int64
+	PublicKey      *string
+	Signature      *string
+	CreatedAt      *string
+	ClaimedKoinu   *int64
+	UnclaimedKoinu *int64
+}
+
+func (b0 Distribution_builder) Build() *Distribution {
+	m0 := &Distribution{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Hash = b.Hash
+	x.xxx_hidden_MintHash = b.MintHash
+	if b.TotalKoinu != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 9)
+		x.xxx_hidden_TotalKoinu = *b.TotalKoinu
+	}
+	if b.RecordHeight != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 9)
+		x.xxx_hidden_RecordHeight = *b.RecordHeight
+	}
+	if b.PublicKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 9)
+		x.xxx_hidden_PublicKey = b.PublicKey
+	}
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 9)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	if b.CreatedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 9)
+		x.xxx_hidden_CreatedAt = b.CreatedAt
+	}
+	if b.ClaimedKoinu != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 9)
+		x.xxx_hidden_ClaimedKoinu = *b.ClaimedKoinu
+	}
+	if b.UnclaimedKoinu != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 9)
+		x.xxx_hidden_UnclaimedKoinu = *b.UnclaimedKoinu
+	}
+	return m0
+}
+
+type DistributionEntitlement struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Address          *Address               `protobuf:"bytes,1,opt,name=address"`
+	xxx_hidden_Quantity         int32                  `protobuf:"varint,2,opt,name=quantity"`
+	xxx_hidden_EntitlementKoinu int64                  `protobuf:"varint,3,opt,name=entitlement_koinu,json=entitlementKoinu"`
+	xxx_hidden_ClaimedKoinu     int64                  `protobuf:"varint,4,opt,name=claimed_koinu,json=claimedKoinu"`
+	xxx_hidden_UnclaimedKoinu   int64                  `protobuf:"varint,5,opt,name=unclaimed_koinu,json=unclaimedKoinu"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *DistributionEntitlement) Reset() {
+	*x = DistributionEntitlement{}
+	mi := &file_common_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DistributionEntitlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistributionEntitlement) ProtoMessage() {}
+
+func (x *DistributionEntitlement) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DistributionEntitlement) GetAddress() *Address {
+	if x != nil {
+		return x.xxx_hidden_Address
+	}
+	return nil
+}
+
+func (x *DistributionEntitlement) GetQuantity() int32 {
+	if x != nil {
+		return x.xxx_hidden_Quantity
+	}
+	return 0
+}
+
+func (x *DistributionEntitlement) GetEntitlementKoinu() int64 {
+	if x != nil {
+		return x.xxx_hidden_EntitlementKoinu
+	}
+	return 0
+}
+
+func (x *DistributionEntitlement) GetClaimedKoinu() int64 {
+	if x != nil {
+		return x.xxx_hidden_ClaimedKoinu
+	}
+	return 0
+}
+
+func (x *DistributionEntitlement) GetUnclaimedKoinu() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnclaimedKoinu
+	}
+	return 0
+}
+
+func (x *DistributionEntitlement) SetAddress(v *Address) {
+	x.xxx_hidden_Address = v
+}
+
+func (x *DistributionEntitlement) SetQuantity(v int32) {
+	x.xxx_hidden_Quantity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *DistributionEntitlement) SetEntitlementKoinu(v int64) {
+	x.xxx_hidden_EntitlementKoinu = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *DistributionEntitlement) SetClaimedKoinu(v int64) {
+	x.xxx_hidden_ClaimedKoinu = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *DistributionEntitlement) SetUnclaimedKoinu(v int64) {
+	x.xxx_hidden_UnclaimedKoinu = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *DistributionEntitlement) HasAddress() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Address != nil
+}
+
+func (x *DistributionEntitlement) HasQuantity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DistributionEntitlement) HasEntitlementKoinu() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *DistributionEntitlement) HasClaimedKoinu() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *DistributionEntitlement) HasUnclaimedKoinu() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *DistributionEntitlement) ClearAddress() {
+	x.xxx_hidden_Address = nil
+}
+
+func (x *DistributionEntitlement) ClearQuantity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Quantity = 0
+}
+
+func (x *DistributionEntitlement) ClearEntitlementKoinu() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_EntitlementKoinu = 0
+}
+
+func (x *DistributionEntitlement) ClearClaimedKoinu() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_ClaimedKoinu = 0
+}
+
+func (x *DistributionEntitlement) ClearUnclaimedKoinu() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_UnclaimedKoinu = 0
+}
+
+type DistributionEntitlement_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Address          *Address
+	Quantity         *int32
+	EntitlementKoinu *int64
+	ClaimedKoinu     *int64
+	UnclaimedKoinu   *int64
+}
+
+func (b0 DistributionEntitlement_builder) Build() *DistributionEntitlement {
+	m0 := &DistributionEntitlement{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Address = b.Address
+	if b.Quantity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_Quantity = *b.Quantity
+	}
+	if b.EntitlementKoinu != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_EntitlementKoinu = *b.EntitlementKoinu
+	}
+	if b.ClaimedKoinu != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_ClaimedKoinu = *b.ClaimedKoinu
+	}
+	if b.UnclaimedKoinu != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_UnclaimedKoinu = *b.UnclaimedKoinu
+	}
+	return m0
 }
 
-func (x *Invoice) HasTransactionHash() bool {
-	if x == nil {
-		return false
-	}
-	return x.xxx_hidden_TransactionHash != nil
+type CapTableEntry struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Address     *Address               `protobuf:"bytes,1,opt,name=address"`
+	xxx_hidden_Quantity    int32                  `protobuf:"varint,2,opt,name=quantity"`
+	xxx_hidden_BasisPoints int32                  `protobuf:"varint,3,opt,name=basis_points,json=basisPoints"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *Invoice) ClearBlockHeight() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_BlockHeight = 0
+func (x *CapTableEntry) Reset() {
+	*x = CapTableEntry{}
+	mi := &file_common_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Invoice) ClearBuyerAddress() {
-	x.xxx_hidden_BuyerAddress = nil
+func (x *CapTableEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Invoice) ClearCreatedAt() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
-	x.xxx_hidden_CreatedAt = nil
+func (*CapTableEntry) ProtoMessage() {}
+
+func (x *CapTableEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *Invoice) ClearHash() {
-	x.xxx_hidden_Hash = nil
+func (x *CapTableEntry) GetAddress() *Address {
+	if x != nil {
+		return x.xxx_hidden_Address
+	}
+	return nil
 }
 
-func (x *Invoice) ClearId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
-	x.xxx_hidden_Id = nil
+func (x *CapTableEntry) GetQuantity() int32 {
+	if x != nil {
+		return x.xxx_hidden_Quantity
+	}
+	return 0
 }
 
-func (x *Invoice) ClearMintHash() {
-	x.xxx_hidden_MintHash = nil
+func (x *CapTableEntry) GetBasisPoints() int32 {
+	if x != nil {
+		return x.xxx_hidden_BasisPoints
+	}
+	return 0
 }
 
-func (x *Invoice) ClearPaidAt() {
-	x.xxx_hidden_PaidAt = nil
+func (x *CapTableEntry) SetAddress(v *Address) {
+	x.xxx_hidden_Address = v
 }
 
-func (x *Invoice) ClearPaymentAddress() {
-	x.xxx_hidden_PaymentAddress = nil
+func (x *CapTableEntry) SetQuantity(v int32) {
+	x.xxx_hidden_Quantity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
 }
 
-func (x *Invoice) ClearPendingTokenBalanceId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
-	x.xxx_hidden_PendingTokenBalanceId = nil
+func (x *CapTableEntry) SetBasisPoints(v int32) {
+	x.xxx_hidden_BasisPoints = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
 }
 
-func (x *Invoice) ClearPrice() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
-	x.xxx_hidden_Price = 0
+func (x *CapTableEntry) HasAddress() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Address != nil
 }
 
-func (x *Invoice) ClearPublicKey() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
-	x.xxx_hidden_PublicKey = nil
+func (x *CapTableEntry) HasQuantity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *Invoice) ClearQuantity() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
-	x.xxx_hidden_Quantity = 0
+func (x *CapTableEntry) HasBasisPoints() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *Invoice) ClearSellerAddress() {
-	x.xxx_hidden_SellerAddress = nil
+func (x *CapTableEntry) ClearAddress() {
+	x.xxx_hidden_Address = nil
 }
 
-func (x *Invoice) ClearSignature() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
-	x.xxx_hidden_Signature = nil
+func (x *CapTableEntry) ClearQuantity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Quantity = 0
 }
 
-func (x *Invoice) ClearTransactionHash() {
-	x.xxx_hidden_TransactionHash = nil
+func (x *CapTableEntry) ClearBasisPoints() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_BasisPoints = 0
 }
 
-type Invoice_builder struct {
+type CapTableEntry_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	BlockHeight           *int32
-	BuyerAddress          *Address
-	CreatedAt             *string
-	Hash                  *Hash
-	Id                    *string
-	MintHash              *Hash
-	PaidAt                *SqlNullTime
-	PaymentAddress        *Address
-	PendingTokenBalanceId *string
-	Price                 *int32
-	PublicKey             *string
-	Quantity              *int32
-	SellerAddress         *Address
-	Signature             *string
-	TransactionHash       *Hash
+	Address     *Address
+	Quantity    *int32
+	BasisPoints *int32
 }
 
-func (b0 Invoice_builder) Build() *Invoice {
-	m0 := &Invoice{}
+func (b0 CapTableEntry_builder) Build() *CapTableEntry {
+	m0 := &CapTableEntry{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.BlockHeight != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 15)
-		x.xxx_hidden_BlockHeight = *b.BlockHeight
-	}
-	x.xxx_hidden_BuyerAddress = b.BuyerAddress
-	if b.CreatedAt != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 15)
-		x.xxx_hidden_CreatedAt = b.CreatedAt
-	}
-	x.xxx_hidden_Hash = b.Hash
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 15)
-		x.xxx_hidden_Id = b.Id
-	}
-	x.xxx_hidden_MintHash = b.MintHash
-	x.xxx_hidden_PaidAt = b.PaidAt
-	x.xxx_hidden_PaymentAddress = b.PaymentAddress
-	if b.PendingTokenBalanceId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 15)
-		x.xxx_hidden_PendingTokenBalanceId = b.PendingTokenBalanceId
-	}
-	if b.Price != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 15)
-		x.xxx_hidden_Price = *b.Price
-	}
-	if b.PublicKey != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 15)
-		x.xxx_hidden_PublicKey = b.PublicKey
-	}
+	x.xxx_hidden_Address = b.Address
 	if b.Quantity != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 15)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
 		x.xxx_hidden_Quantity = *b.Quantity
 	}
-	x.xxx_hidden_SellerAddress = b.SellerAddress
-	if b.Signature != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 15)
-		x.xxx_hidden_Signature = b.Signature
+	if b.BasisPoints != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_BasisPoints = *b.BasisPoints
 	}
-	x.xxx_hidden_TransactionHash = b.TransactionHash
 	return m0
 }
 
@@ -1628,7 +2700,7 @@ type TokenBalance struct {
 
 func (x *TokenBalance) Reset() {
 	*x = TokenBalance{}
-	mi := &file_common_proto_msgTypes[7]
+	mi := &file_common_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1640,7 +2712,7 @@ func (x *TokenBalance) String() string {
 func (*TokenBalance) ProtoMessage() {}
 
 func (x *TokenBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[7]
+	mi := &file_common_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1824,7 +2896,7 @@ type BuyOffer struct {
 
 func (x *BuyOffer) Reset() {
 	*x = BuyOffer{}
-	mi := &file_common_proto_msgTypes[8]
+	mi := &file_common_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1836,7 +2908,7 @@ func (x *BuyOffer) String() string {
 func (*BuyOffer) ProtoMessage() {}
 
 func (x *BuyOffer) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[8]
+	mi := &file_common_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2160,7 +3232,7 @@ type SellOffer struct {
 
 func (x *SellOffer) Reset() {
 	*x = SellOffer{}
-	mi := &file_common_proto_msgTypes[9]
+	mi := &file_common_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2172,7 +3244,7 @@ func (x *SellOffer) String() string {
 func (*SellOffer) ProtoMessage() {}
 
 func (x *SellOffer) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[9]
+	mi := &file_common_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2463,7 +3535,7 @@ type BuyOfferWithMint struct {
 
 func (x *BuyOfferWithMint) Reset() {
 	*x = BuyOfferWithMint{}
-	mi := &file_common_proto_msgTypes[10]
+	mi := &file_common_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2475,7 +3547,7 @@ func (x *BuyOfferWithMint) String() string {
 func (*BuyOfferWithMint) ProtoMessage() {}
 
 func (x *BuyOfferWithMint) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[10]
+	mi := &file_common_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2556,7 +3628,7 @@ type SellOfferWithMint struct {
 
 func (x *SellOfferWithMint) Reset() {
 	*x = SellOfferWithMint{}
-	mi := &file_common_proto_msgTypes[11]
+	mi := &file_common_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2568,7 +3640,7 @@ func (x *SellOfferWithMint) String() string {
 func (*SellOfferWithMint) ProtoMessage() {}
 
 func (x *SellOfferWithMint) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[11]
+	mi := &file_common_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2639,6 +3711,183 @@ func (b0 SellOfferWithMint_builder) Build() *SellOfferWithMint {
 	return m0
 }
 
+type AuditViolation struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Check       *string                `protobuf:"bytes,1,opt,name=check"`
+	xxx_hidden_MintHash    *string                `protobuf:"bytes,2,opt,name=mint_hash,json=mintHash"`
+	xxx_hidden_Subject     *string                `protobuf:"bytes,3,opt,name=subject"`
+	xxx_hidden_Message     *string                `protobuf:"bytes,4,opt,name=message"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AuditViolation) Reset() {
+	*x = AuditViolation{}
+	mi := &file_common_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditViolation) ProtoMessage() {}
+
+func (x *AuditViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AuditViolation) GetCheck() string {
+	if x != nil {
+		if x.xxx_hidden_Check != nil {
+			return *x.xxx_hidden_Check
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AuditViolation) GetMintHash() string {
+	if x != nil {
+		if x.xxx_hidden_MintHash != nil {
+			return *x.xxx_hidden_MintHash
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AuditViolation) GetSubject() string {
+	if x != nil {
+		if x.xxx_hidden_Subject != nil {
+			return *x.xxx_hidden_Subject
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AuditViolation) GetMessage() string {
+	if x != nil {
+		if x.xxx_hidden_Message != nil {
+			return *x.xxx_hidden_Message
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AuditViolation) SetCheck(v string) {
+	x.xxx_hidden_Check = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *AuditViolation) SetMintHash(v string) {
+	x.xxx_hidden_MintHash = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *AuditViolation) SetSubject(v string) {
+	x.xxx_hidden_Subject = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *AuditViolation) SetMessage(v string) {
+	x.xxx_hidden_Message = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *AuditViolation) HasCheck() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *AuditViolation) HasMintHash() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *AuditViolation) HasSubject() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *AuditViolation) HasMessage() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *AuditViolation) ClearCheck() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Check = nil
+}
+
+func (x *AuditViolation) ClearMintHash() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_MintHash = nil
+}
+
+func (x *AuditViolation) ClearSubject() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Subject = nil
+}
+
+func (x *AuditViolation) ClearMessage() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Message = nil
+}
+
+type AuditViolation_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Check    *string
+	MintHash *string
+	Subject  *string
+	Message  *string
+}
+
+func (b0 AuditViolation_builder) Build() *AuditViolation {
+	m0 := &AuditViolation{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Check != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Check = b.Check
+	}
+	if b.MintHash != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_MintHash = b.MintHash
+	}
+	if b.Subject != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Subject = b.Subject
+	}
+	if b.Message != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Message = b.Message
+	}
+	return m0
+}
+
 var File_common_proto protoreflect.FileDescriptor
 
 const file_common_proto_rawDesc = "" +
@@ -2660,7 +3909,7 @@ const file_common_proto_rawDesc = "" +
 	"public_key\x18\x02 \x01(\tR\tpublicKey\x12\x10\n" +
 	"\x03url\x18\x03 \x01(\tR\x03url\"C\n" +
 	"\x12StringInterfaceMap\x12-\n" +
-	"\x05value\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x05value\"\xed\a\n" +
+	"\x05value\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x05value\"\xa6\b\n" +
 	"\x04Mint\x12I\n" +
 	"\x0easset_managers\x18\x01 \x03(\v2\".fractalengine.rpc.v1.AssetManagerR\rassetManagers\x12!\n" +
 	"\fblock_height\x18\x02 \x01(\x05R\vblockHeight\x12(\n" +
@@ -2684,7 +3933,8 @@ const file_common_proto_rawDesc = "" +
 	"\x1asignature_requirement_type\x18\x11 \x01(\x0e2..fractalengine.rpc.v1.SignatureRequirementTypeR\x18signatureRequirementType\x12\x12\n" +
 	"\x04tags\x18\x12 \x03(\tR\x04tags\x12\x1c\n" +
 	"\x05title\x18\x13 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05title\x12E\n" +
-	"\x10transaction_hash\x18\x14 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\x0ftransactionHash\"\xc1\x05\n" +
+	"\x10transaction_hash\x18\x14 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\x0ftransactionHash\x127\n" +
+	"\x17confirmations_remaining\x18\x15 \x01(\x05R\x16confirmationsRemaining\"\x9a\a\n" +
 	"\aInvoice\x12!\n" +
 	"\fblock_height\x18\x01 \x01(\x05R\vblockHeight\x12B\n" +
 	"\rbuyer_address\x18\x02 \x01(\v2\x1d.fractalengine.rpc.v1.AddressR\fbuyerAddress\x12\x1d\n" +
@@ -2695,15 +3945,54 @@ const file_common_proto_rawDesc = "" +
 	"\tmint_hash\x18\x06 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\bmintHash\x12:\n" +
 	"\apaid_at\x18\a \x01(\v2!.fractalengine.rpc.v1.SqlNullTimeR\x06paidAt\x12F\n" +
 	"\x0fpayment_address\x18\b \x01(\v2\x1d.fractalengine.rpc.v1.AddressR\x0epaymentAddress\x127\n" +
-	"\x18pending_token_balance_id\x18\t \x01(\tR\x15pendingTokenBalanceId\x12\x14\n" +
-	"\x05price\x18\n" +
-	" \x01(\x05R\x05price\x12\x1d\n" +
+	"\x18pending_token_balance_id\x18\t \x01(\tR\x15pendingTokenBalanceId\x12\x1d\n" +
 	"\n" +
 	"public_key\x18\v \x01(\tR\tpublicKey\x12\x1a\n" +
 	"\bquantity\x18\f \x01(\x05R\bquantity\x12D\n" +
 	"\x0eseller_address\x18\r \x01(\v2\x1d.fractalengine.rpc.v1.AddressR\rsellerAddress\x12\x1c\n" +
 	"\tsignature\x18\x0e \x01(\tR\tsignature\x12E\n" +
-	"\x10transaction_hash\x18\x0f \x01(\v2\x1a.fractalengine.rpc.v1.HashR\x0ftransactionHash\"\xda\x01\n" +
+	"\x10transaction_hash\x18\x0f \x01(\v2\x1a.fractalengine.rpc.v1.HashR\x0ftransactionHash\x12:\n" +
+	"\x06splits\x18\x10 \x03(\v2\".fractalengine.rpc.v1.InvoiceSplitR\x06splits\x12F\n" +
+	"\x1fpayment_confirmations_remaining\x18\x11 \x01(\x05R\x1dpaymentConfirmationsRemaining\x12\x16\n" +
+	"\x06status\x18\x12 \x01(\tR\x06status\x12*\n" +
+	"\x11expires_at_height\x18\x13 \x01(\x03R\x0fexpiresAtHeight\x12\x1f\n" +
+	"\vprice_koinu\x18\x14 \x01(\x03R\n" +
+	"priceKoinuJ\x04\b\n" +
+	"\x10\v\"\xda\x01\n" +
+	"\x11InvoiceTransition\x12\x1f\n" +
+	"\vfrom_status\x18\x01 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x02 \x01(\tR\btoStatus\x12!\n" +
+	"\fblock_height\x18\x03 \x01(\x03R\vblockHeight\x12E\n" +
+	"\x10transaction_hash\x18\x04 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\x0ftransactionHash\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"j\n" +
+	"\fInvoiceSplit\x127\n" +
+	"\aaddress\x18\x01 \x01(\v2\x1d.fractalengine.rpc.v1.AddressR\aaddress\x12!\n" +
+	"\fbasis_points\x18\x02 \x01(\x05R\vbasisPoints\"\xe7\x02\n" +
+	"\fDistribution\x12.\n" +
+	"\x04hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\x04hash\x127\n" +
+	"\tmint_hash\x18\x02 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\bmintHash\x12\x1f\n" +
+	"\vtotal_koinu\x18\x03 \x01(\x03R\n" +
+	"totalKoinu\x12#\n" +
+	"\rrecord_height\x18\x04 \x01(\x03R\frecordHeight\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x05 \x01(\tR\tpublicKey\x12\x1c\n" +
+	"\tsignature\x18\x06 \x01(\tR\tsignature\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12#\n" +
+	"\rclaimed_koinu\x18\b \x01(\x03R\fclaimedKoinu\x12'\n" +
+	"\x0funclaimed_koinu\x18\t \x01(\x03R\x0eunclaimedKoinu\"\xe9\x01\n" +
+	"\x17DistributionEntitlement\x127\n" +
+	"\aaddress\x18\x01 \x01(\v2\x1d.fractalengine.rpc.v1.AddressR\aaddress\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12+\n" +
+	"\x11entitlement_koinu\x18\x03 \x01(\x03R\x10entitlementKoinu\x12#\n" +
+	"\rclaimed_koinu\x18\x04 \x01(\x03R\fclaimedKoinu\x12'\n" +
+	"\x0funclaimed_koinu\x18\x05 \x01(\x03R\x0eunclaimedKoinu\"\x87\x01\n" +
+	"\rCapTableEntry\x127\n" +
+	"\aaddress\x18\x01 \x01(\v2\x1d.fractalengine.rpc.v1.AddressR\aaddress\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12!\n" +
+	"\fbasis_points\x18\x03 \x01(\x05R\vbasisPoints\"\xda\x01\n" +
 	"\fTokenBalance\x127\n" +
 	"\aaddress\x18\x01 \x01(\v2\x1d.fractalengine.rpc.v1.AddressR\aaddress\x12\x1d\n" +
 	"\n" +
@@ -2743,7 +4032,12 @@ const file_common_proto_rawDesc = "" +
 	"\x04mint\x18\x02 \x01(\v2\x1a.fractalengine.rpc.v1.MintR\x04mint\"z\n" +
 	"\x11SellOfferWithMint\x125\n" +
 	"\x05offer\x18\x01 \x01(\v2\x1f.fractalengine.rpc.v1.SellOfferR\x05offer\x12.\n" +
-	"\x04mint\x18\x02 \x01(\v2\x1a.fractalengine.rpc.v1.MintR\x04mint*\x92\x02\n" +
+	"\x04mint\x18\x02 \x01(\v2\x1a.fractalengine.rpc.v1.MintR\x04mint\"w\n" +
+	"\x0eAuditViolation\x12\x14\n" +
+	"\x05check\x18\x01 \x01(\tR\x05check\x12\x1b\n" +
+	"\tmint_hash\x18\x02 \x01(\tR\bmintHash\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage*\x92\x02\n" +
 	"\x18SignatureRequirementType\x12*\n" +
 	"&SIGNATURE_REQUIREMENT_TYPE_UNSPECIFIED\x10\x00\x126\n" +
 	"2SIGNATURE_REQUIREMENT_TYPE_REQUIRES_ALL_SIGNATURES\x10\x01\x125\n" +
@@ -2752,62 +4046,75 @@ const file_common_proto_rawDesc = "" +
 	"\x1fSIGNATURE_REQUIREMENT_TYPE_NONE\x10\x04B.Z,dogecoin.org/fractal-engine/pkg/rpc/protocolb\beditionsp\xe8\a"
 
 var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_common_proto_goTypes = []any{
-	(SignatureRequirementType)(0), // 0: fractalengine.rpc.v1.SignatureRequirementType
-	(*StringResponse)(nil),        // 1: fractalengine.rpc.v1.StringResponse
-	(*StringMapResponse)(nil),     // 2: fractalengine.rpc.v1.StringMapResponse
-	(*SqlNullTime)(nil),           // 3: fractalengine.rpc.v1.SqlNullTime
-	(*AssetManager)(nil),          // 4: fractalengine.rpc.v1.AssetManager
-	(*StringInterfaceMap)(nil),    // 5: fractalengine.rpc.v1.StringInterfaceMap
-	(*Mint)(nil),                  // 6: fractalengine.rpc.v1.Mint
-	(*Invoice)(nil),               // 7: fractalengine.rpc.v1.Invoice
-	(*TokenBalance)(nil),          // 8: fractalengine.rpc.v1.TokenBalance
-	(*BuyOffer)(nil),              // 9: fractalengine.rpc.v1.BuyOffer
-	(*SellOffer)(nil),             // 10: fractalengine.rpc.v1.SellOffer
-	(*BuyOfferWithMint)(nil),      // 11: fractalengine.rpc.v1.BuyOfferWithMint
-	(*SellOfferWithMint)(nil),     // 12: fractalengine.rpc.v1.SellOfferWithMint
-	nil,                           // 13: fractalengine.rpc.v1.StringMapResponse.ValuesEntry
-	(*structpb.Struct)(nil),       // 14: google.protobuf.Struct
-	(*Hash)(nil),                  // 15: fractalengine.rpc.v1.Hash
-	(*Address)(nil),               // 16: fractalengine.rpc.v1.Address
+	(SignatureRequirementType)(0),   // 0: fractalengine.rpc.v1.SignatureRequirementType
+	(*StringResponse)(nil),          // 1: fractalengine.rpc.v1.StringResponse
+	(*StringMapResponse)(nil),       // 2: fractalengine.rpc.v1.StringMapResponse
+	(*SqlNullTime)(nil),             // 3: fractalengine.rpc.v1.SqlNullTime
+	(*AssetManager)(nil),            // 4: fractalengine.rpc.v1.AssetManager
+	(*StringInterfaceMap)(nil),      // 5: fractalengine.rpc.v1.StringInterfaceMap
+	(*Mint)(nil),                    // 6: fractalengine.rpc.v1.Mint
+	(*Invoice)(nil),                 // 7: fractalengine.rpc.v1.Invoice
+	(*InvoiceTransition)(nil),       // 8: fractalengine.rpc.v1.InvoiceTransition
+	(*InvoiceSplit)(nil),            // 9: fractalengine.rpc.v1.InvoiceSplit
+	(*Distribution)(nil),            // 10: fractalengine.rpc.v1.Distribution
+	(*DistributionEntitlement)(nil), // 11: fractalengine.rpc.v1.DistributionEntitlement
+	(*CapTableEntry)(nil),           // 12: fractalengine.rpc.v1.CapTableEntry
+	(*TokenBalance)(nil),            // 13: fractalengine.rpc.v1.TokenBalance
+	(*BuyOffer)(nil),                // 14: fractalengine.rpc.v1.BuyOffer
+	(*SellOffer)(nil),               // 15: fractalengine.rpc.v1.SellOffer
+	(*BuyOfferWithMint)(nil),        // 16: fractalengine.rpc.v1.BuyOfferWithMint
+	(*SellOfferWithMint)(nil),       // 17: fractalengine.rpc.v1.SellOfferWithMint
+	(*AuditViolation)(nil),          // 18: fractalengine.rpc.v1.AuditViolation
+	nil,                             // 19: fractalengine.rpc.v1.StringMapResponse.ValuesEntry
+	(*structpb.Struct)(nil),         // 20: google.protobuf.Struct
+	(*Hash)(nil),                    // 21: fractalengine.rpc.v1.Hash
+	(*Address)(nil),                 // 22: fractalengine.rpc.v1.Address
 }
 var file_common_proto_depIdxs = []int32{
-	13, // 0: fractalengine.rpc.v1.StringMapResponse.values:type_name -> fractalengine.rpc.v1.StringMapResponse.ValuesEntry
-	14, // 1: fractalengine.rpc.v1.StringInterfaceMap.value:type_name -> google.protobuf.Struct
+	19, // 0: fractalengine.rpc.v1.StringMapResponse.values:type_name -> fractalengine.rpc.v1.StringMapResponse.ValuesEntry
+	20, // 1: fractalengine.rpc.v1.StringInterfaceMap.value:type_name -> google.protobuf.Struct
 	4,  // 2: fractalengine.rpc.v1.Mint.asset_managers:type_name -> fractalengine.rpc.v1.AssetManager
-	15, // 3: fractalengine.rpc.v1.Mint.hash:type_name -> fractalengine.rpc.v1.Hash
+	21, // 3: fractalengine.rpc.v1.Mint.hash:type_name -> fractalengine.rpc.v1.Hash
 	5,  // 4: fractalengine.rpc.v1.Mint.lockup_options:type_name -> fractalengine.rpc.v1.StringInterfaceMap
 	5,  // 5: fractalengine.rpc.v1.Mint.metadata:type_name -> fractalengine.rpc.v1.StringInterfaceMap
-	16, // 6: fractalengine.rpc.v1.Mint.owner_address:type_name -> fractalengine.rpc.v1.Address
+	22, // 6: fractalengine.rpc.v1.Mint.owner_address:type_name -> fractalengine.rpc.v1.Address
 	5,  // 7: fractalengine.rpc.v1.Mint.requirements:type_name -> fractalengine.rpc.v1.StringInterfaceMap
 	0,  // 8: fractalengine.rpc.v1.Mint.signature_requirement_type:type_name -> fractalengine.rpc.v1.SignatureRequirementType
-	15, // 9: fractalengine.rpc.v1.Mint.transaction_hash:type_name -> fractalengine.rpc.v1.Hash
-	16, // 10: fractalengine.rpc.v1.Invoice.buyer_address:type_name -> fractalengine.rpc.v1.Address
-	15, // 11: fractalengine.rpc.v1.Invoice.hash:type_name -> fractalengine.rpc.v1.Hash
-	15, // 12: fractalengine.rpc.v1.Invoice.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	21, // 9: fractalengine.rpc.v1.Mint.transaction_hash:type_name -> fractalengine.rpc.v1.Hash
+	22, // 10: fractalengine.rpc.v1.Invoice.buyer_address:type_name -> fractalengine.rpc.v1.Address
+	21, // 11: fractalengine.rpc.v1.Invoice.hash:type_name -> fractalengine.rpc.v1.Hash
+	21, // 12: fractalengine.rpc.v1.Invoice.mint_hash:type_name -> fractalengine.rpc.v1.Hash
 	3,  // 13: fractalengine.rpc.v1.Invoice.paid_at:type_name -> fractalengine.rpc.v1.SqlNullTime
-	16, // 14: fractalengine.rpc.v1.Invoice.payment_address:type_name -> fractalengine.rpc.v1.Address
-	16, // 15: fractalengine.rpc.v1.Invoice.seller_address:type_name -> fractalengine.rpc.v1.Address
-	15, // 16: fractalengine.rpc.v1.Invoice.transaction_hash:type_name -> fractalengine.rpc.v1.Hash
-	16, // 17: fractalengine.rpc.v1.TokenBalance.address:type_name -> fractalengine.rpc.v1.Address
-	15, // 18: fractalengine.rpc.v1.TokenBalance.mint_hash:type_name -> fractalengine.rpc.v1.Hash
-	15, // 19: fractalengine.rpc.v1.BuyOffer.hash:type_name -> fractalengine.rpc.v1.Hash
-	15, // 20: fractalengine.rpc.v1.BuyOffer.mint_hash:type_name -> fractalengine.rpc.v1.Hash
-	16, // 21: fractalengine.rpc.v1.BuyOffer.offerer_address:type_name -> fractalengine.rpc.v1.Address
-	16, // 22: fractalengine.rpc.v1.BuyOffer.seller_address:type_name -> fractalengine.rpc.v1.Address
-	15, // 23: fractalengine.rpc.v1.SellOffer.hash:type_name -> fractalengine.rpc.v1.Hash
-	15, // 24: fractalengine.rpc.v1.SellOffer.mint_hash:type_name -> fractalengine.rpc.v1.Hash
-	16, // 25: fractalengine.rpc.v1.SellOffer.offerer_address:type_name -> fractalengine.rpc.v1.Address
-	9,  // 26: fractalengine.rpc.v1.BuyOfferWithMint.offer:type_name -> fractalengine.rpc.v1.BuyOffer
-	6,  // 27: fractalengine.rpc.v1.BuyOfferWithMint.mint:type_name -> fractalengine.rpc.v1.Mint
-	10, // 28: fractalengine.rpc.v1.SellOfferWithMint.offer:type_name -> fractalengine.rpc.v1.SellOffer
-	6,  // 29: fractalengine.rpc.v1.SellOfferWithMint.mint:type_name -> fractalengine.rpc.v1.Mint
-	30, // [30:30] is the sub-list for method output_type
-	30, // [30:30] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	22, // 14: fractalengine.rpc.v1.Invoice.payment_address:type_name -> fractalengine.rpc.v1.Address
+	22, // 15: fractalengine.rpc.v1.Invoice.seller_address:type_name -> fractalengine.rpc.v1.Address
+	21, // 16: fractalengine.rpc.v1.Invoice.transaction_hash:type_name -> fractalengine.rpc.v1.Hash
+	9,  // 17: fractalengine.rpc.v1.Invoice.splits:type_name -> fractalengine.rpc.v1.InvoiceSplit
+	21, // 18: fractalengine.rpc.v1.InvoiceTransition.transaction_hash:type_name -> fractalengine.rpc.v1.Hash
+	22, // 19: fractalengine.rpc.v1.InvoiceSplit.address:type_name -> fractalengine.rpc.v1.Address
+	21, // 20: fractalengine.rpc.v1.Distribution.hash:type_name -> fractalengine.rpc.v1.Hash
+	21, // 21: fractalengine.rpc.v1.Distribution.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	22, // 22: fractalengine.rpc.v1.DistributionEntitlement.address:type_name -> fractalengine.rpc.v1.Address
+	22, // 23: fractalengine.rpc.v1.CapTableEntry.address:type_name -> fractalengine.rpc.v1.Address
+	22, // 24: fractalengine.rpc.v1.TokenBalance.address:type_name -> fractalengine.rpc.v1.Address
+	21, // 25: fractalengine.rpc.v1.TokenBalance.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	21, // 26: fractalengine.rpc.v1.BuyOffer.hash:type_name -> fractalengine.rpc.v1.Hash
+	21, // 27: fractalengine.rpc.v1.BuyOffer.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	22, // 28: fractalengine.rpc.v1.BuyOffer.offerer_address:type_name -> fractalengine.rpc.v1.Address
+	22, // 29: fractalengine.rpc.v1.BuyOffer.seller_address:type_name -> fractalengine.rpc.v1.Address
+	21, // 30: fractalengine.rpc.v1.SellOffer.hash:type_name -> fractalengine.rpc.v1.Hash
+	21, // 31: fractalengine.rpc.v1.SellOffer.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	22, // 32: fractalengine.rpc.v1.SellOffer.offerer_address:type_name -> fractalengine.rpc.v1.Address
+	14, // 33: fractalengine.rpc.v1.BuyOfferWithMint.offer:type_name -> fractalengine.rpc.v1.BuyOffer
+	6,  // 34: fractalengine.rpc.v1.BuyOfferWithMint.mint:type_name -> fractalengine.rpc.v1.Mint
+	15, // 35: fractalengine.rpc.v1.SellOfferWithMint.offer:type_name -> fractalengine.rpc.v1.SellOffer
+	6,  // 36: fractalengine.rpc.v1.SellOfferWithMint.mint:type_name -> fractalengine.rpc.v1.Mint
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_common_proto_init() }
@@ -2822,7 +4129,7 @@ func file_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   13,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,907 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: distributions.proto
+
+package protocol
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateDistributionRequest struct {
+	state                   protoimpl.MessageState            `protogen:"opaque.v1"`
+	xxx_hidden_Payload      *CreateDistributionRequestPayload `protobuf:"bytes,1,opt,name=payload"`
+	xxx_hidden_PublicKey    *string                           `protobuf:"bytes,2,opt,name=public_key,json=publicKey"`
+	xxx_hidden_Signature    *string                           `protobuf:"bytes,3,opt,name=signature"`
+	xxx_hidden_RedeemScript *string                           `protobuf:"bytes,4,opt,name=redeem_script,json=redeemScript"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CreateDistributionRequest) Reset() {
+	*x = CreateDistributionRequest{}
+	mi := &file_distributions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDistributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDistributionRequest) ProtoMessage() {}
+
+func (x *CreateDistributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_distributions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateDistributionRequest) GetPayload() *CreateDistributionRequestPayload {
+	if x != nil {
+		return x.xxx_hidden_Payload
+	}
+	return nil
+}
+
+func (x *CreateDistributionRequest) GetPublicKey() string {
+	if x != nil {
+		if x.xxx_hidden_PublicKey != nil {
+			return *x.xxx_hidden_PublicKey
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateDistributionRequest) GetSignature() string {
+	if x != nil {
+		if x.xxx_hidden_Signature != nil {
+			return *x.xxx_hidden_Signature
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateDistributionRequest) GetRedeemScript() string {
+	if x != nil {
+		if x.xxx_hidden_RedeemScript != nil {
+			return *x.xxx_hidden_RedeemScript
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateDistributionRequest) SetPayload(v *CreateDistributionRequestPayload) {
+	x.xxx_hidden_Payload = v
+}
+
+func (x *CreateDistributionRequest) SetPublicKey(v string) {
+	x.xxx_hidden_PublicKey = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *CreateDistributionRequest) SetSignature(v string) {
+	x.xxx_hidden_Signature = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *CreateDistributionRequest) SetRedeemScript(v string) {
+	x.xxx_hidden_RedeemScript = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *CreateDistributionRequest) HasPayload() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Payload != nil
+}
+
+func (x *CreateDistributionRequest) HasPublicKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CreateDistributionRequest) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *CreateDistributionRequest) HasRedeemScript() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *CreateDistributionRequest) ClearPayload() {
+	x.xxx_hidden_Payload = nil
+}
+
+func (x *CreateDistributionRequest) ClearPublicKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_PublicKey = nil
+}
+
+func (x *CreateDistributionRequest) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Signature = nil
+}
+
+func (x *CreateDistributionRequest) ClearRedeemScript() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_RedeemScript = nil
+}
+
+type CreateDistributionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Payload      *CreateDistributionRequestPayload
+	PublicKey    *string
+	Signature    *string
+	RedeemScript *string
+}
+
+func (b0 CreateDistributionRequest_builder) Build() *CreateDistributionRequest {
+	m0 := &CreateDistributionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Payload = b.Payload
+	if b.PublicKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_PublicKey = b.PublicKey
+	}
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	if b.RedeemScript != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_RedeemScript = b.RedeemScript
+	}
+	return m0
+}
+
+type CreateDistributionRequestPayload struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MintHash     *Hash                  `protobuf:"bytes,1,opt,name=mint_hash,json=mintHash"`
+	xxx_hidden_TotalKoinu   int64                  `protobuf:"varint,2,opt,name=total_koinu,json=totalKoinu"`
+	xxx_hidden_RecordHeight int64                  `protobuf:"varint,3,opt,name=record_height,json=recordHeight"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CreateDistributionRequestPayload) Reset() {
+	*x = CreateDistributionRequestPayload{}
+	mi := &file_distributions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDistributionRequestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDistributionRequestPayload) ProtoMessage() {}
+
+func (x *CreateDistributionRequestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_distributions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateDistributionRequestPayload) GetMintHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_MintHash
+	}
+	return nil
+}
+
+func (x *CreateDistributionRequestPayload) GetTotalKoinu() int64 {
+	if x != nil {
+		return x.xxx_hidden_TotalKoinu
+	}
+	return 0
+}
+
+func (x *CreateDistributionRequestPayload) GetRecordHeight() int64 {
+	if x != nil {
+		return x.xxx_hidden_RecordHeight
+	}
+	return 0
+}
+
+func (x *CreateDistributionRequestPayload) SetMintHash(v *Hash) {
+	x.xxx_hidden_MintHash = v
+}
+
+func (x *CreateDistributionRequestPayload) SetTotalKoinu(v int64) {
+	x.xxx_hidden_TotalKoinu = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *CreateDistributionRequestPayload) SetRecordHeight(v int64) {
+	x.xxx_hidden_RecordHeight = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *CreateDistributionRequestPayload) HasMintHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MintHash != nil
+}
+
+func (x *CreateDistributionRequestPayload) HasTotalKoinu() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CreateDistributionRequestPayload) HasRecordHeight() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *CreateDistributionRequestPayload) ClearMintHash() {
+	x.xxx_hidden_MintHash = nil
+}
+
+func (x *CreateDistributionRequestPayload) ClearTotalKoinu() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_TotalKoinu = 0
+}
+
+func (x *CreateDistributionRequestPayload) ClearRecordHeight() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_RecordHeight = 0
+}
+
+type CreateDistributionRequestPayload_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MintHash     *Hash
+	TotalKoinu   *int64
+	RecordHeight *int64
+}
+
+func (b0 CreateDistributionRequestPayload_builder) Build() *CreateDistributionRequestPayload {
+	m0 := &CreateDistributionRequestPayload{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_MintHash = b.MintHash
+	if b.TotalKoinu != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_TotalKoinu = *b.TotalKoinu
+	}
+	if b.RecordHeight != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_RecordHeight = *b.RecordHeight
+	}
+	return m0
+}
+
+type CreateDistributionResponse struct {
+	state                             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Hash                   *Hash                  `protobuf:"bytes,1,opt,name=hash"`
+	xxx_hidden_EncodedTransactionBody *string                `protobuf:"bytes,2,opt,name=encoded_transaction_body,json=encodedTransactionBody"`
+	XXX_raceDetectHookData            protoimpl.RaceDetectHookData
+	XXX_presence                      [1]uint32
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *CreateDistributionResponse) Reset() {
+	*x = CreateDistributionResponse{}
+	mi := &file_distributions_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDistributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDistributionResponse) ProtoMessage() {}
+
+func (x *CreateDistributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_distributions_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateDistributionResponse) GetHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_Hash
+	}
+	return nil
+}
+
+func (x *CreateDistributionResponse) GetEncodedTransactionBody() string {
+	if x != nil {
+		if x.xxx_hidden_EncodedTransactionBody != nil {
+			return *x.xxx_hidden_EncodedTransactionBody
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateDistributionResponse) SetHash(v *Hash) {
+	x.xxx_hidden_Hash = v
+}
+
+func (x *CreateDistributionResponse) SetEncodedTransactionBody(v string) {
+	x.xxx_hidden_EncodedTransactionBody = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *CreateDistributionResponse) HasHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Hash != nil
+}
+
+func (x *CreateDistributionResponse) HasEncodedTransactionBody() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CreateDistributionResponse) ClearHash() {
+	x.xxx_hidden_Hash = nil
+}
+
+func (x *CreateDistributionResponse) ClearEncodedTransactionBody() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_EncodedTransactionBody = nil
+}
+
+type CreateDistributionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Hash                   *Hash
+	EncodedTransactionBody *string
+}
+
+func (b0 CreateDistributionResponse_builder) Build() *CreateDistributionResponse {
+	m0 := &CreateDistributionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Hash = b.Hash
+	if b.EncodedTransactionBody != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_EncodedTransactionBody = b.EncodedTransactionBody
+	}
+	return m0
+}
+
+type GetDistributionRequest struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Hash *Hash                  `protobuf:"bytes,1,opt,name=hash"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetDistributionRequest) Reset() {
+	*x = GetDistributionRequest{}
+	mi := &file_distributions_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDistributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDistributionRequest) ProtoMessage() {}
+
+func (x *GetDistributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_distributions_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDistributionRequest) GetHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_Hash
+	}
+	return nil
+}
+
+func (x *GetDistributionRequest) SetHash(v *Hash) {
+	x.xxx_hidden_Hash = v
+}
+
+func (x *GetDistributionRequest) HasHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Hash != nil
+}
+
+func (x *GetDistributionRequest) ClearHash() {
+	x.xxx_hidden_Hash = nil
+}
+
+type GetDistributionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Hash *Hash
+}
+
+func (b0 GetDistributionRequest_builder) Build() *GetDistributionRequest {
+	m0 := &GetDistributionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Hash = b.Hash
+	return m0
+}
+
+type GetDistributionResponse struct {
+	state                   protoimpl.MessageState      `protogen:"opaque.v1"`
+	xxx_hidden_Distribution *Distribution               `protobuf:"bytes,1,opt,name=distribution"`
+	xxx_hidden_Entitlements *[]*DistributionEntitlement `protobuf:"bytes,2,rep,name=entitlements"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetDistributionResponse) Reset() {
+	*x = GetDistributionResponse{}
+	mi := &file_distributions_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDistributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDistributionResponse) ProtoMessage() {}
+
+func (x *GetDistributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_distributions_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDistributionResponse) GetDistribution() *Distribution {
+	if x != nil {
+		return x.xxx_hidden_Distribution
+	}
+	return nil
+}
+
+func (x *GetDistributionResponse) GetEntitlements() []*DistributionEntitlement {
+	if x != nil {
+		if x.xxx_hidden_Entitlements != nil {
+			return *x.xxx_hidden_Entitlements
+		}
+	}
+	return nil
+}
+
+func (x *GetDistributionResponse) SetDistribution(v *Distribution) {
+	x.xxx_hidden_Distribution = v
+}
+
+func (x *GetDistributionResponse) SetEntitlements(v []*DistributionEntitlement) {
+	x.xxx_hidden_Entitlements = &v
+}
+
+func (x *GetDistributionResponse) HasDistribution() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Distribution != nil
+}
+
+func (x *GetDistributionResponse) ClearDistribution() {
+	x.xxx_hidden_Distribution = nil
+}
+
+type GetDistributionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Distribution *Distribution
+	Entitlements []*DistributionEntitlement
+}
+
+func (b0 GetDistributionResponse_builder) Build() *GetDistributionResponse {
+	m0 := &GetDistributionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Distribution = b.Distribution
+	x.xxx_hidden_Entitlements = &b.Entitlements
+	return m0
+}
+
+type GetDistributionsRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MintHash *Hash                  `protobuf:"bytes,1,opt,name=mint_hash,json=mintHash"`
+	xxx_hidden_Limit    *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=limit"`
+	xxx_hidden_Page     *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=page"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetDistributionsRequest) Reset() {
+	*x = GetDistributionsRequest{}
+	mi := &file_distributions_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDistributionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDistributionsRequest) ProtoMessage() {}
+
+func (x *GetDistributionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_distributions_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDistributionsRequest) GetMintHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_MintHash
+	}
+	return nil
+}
+
+func (x *GetDistributionsRequest) GetLimit() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return nil
+}
+
+func (x *GetDistributionsRequest) GetPage() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.xxx_hidden_Page
+	}
+	return nil
+}
+
+func (x *GetDistributionsRequest) SetMintHash(v *Hash) {
+	x.xxx_hidden_MintHash = v
+}
+
+func (x *GetDistributionsRequest) SetLimit(v *wrapperspb.Int32Value) {
+	x.xxx_hidden_Limit = v
+}
+
+func (x *GetDistributionsRequest) SetPage(v *wrapperspb.Int32Value) {
+	x.xxx_hidden_Page = v
+}
+
+func (x *GetDistributionsRequest) HasMintHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MintHash != nil
+}
+
+func (x *GetDistributionsRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Limit != nil
+}
+
+func (x *GetDistributionsRequest) HasPage() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Page != nil
+}
+
+func (x *GetDistributionsRequest) ClearMintHash() {
+	x.xxx_hidden_MintHash = nil
+}
+
+func (x *GetDistributionsRequest) ClearLimit() {
+	x.xxx_hidden_Limit = nil
+}
+
+func (x *GetDistributionsRequest) ClearPage() {
+	x.xxx_hidden_Page = nil
+}
+
+type GetDistributionsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MintHash *Hash
+	Limit    *wrapperspb.Int32Value
+	Page     *wrapperspb.Int32Value
+}
+
+func (b0 GetDistributionsRequest_builder) Build() *GetDistributionsRequest {
+	m0 := &GetDistributionsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_MintHash = b.MintHash
+	x.xxx_hidden_Limit = b.Limit
+	x.xxx_hidden_Page = b.Page
+	return m0
+}
+
+type GetDistributionsResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Distributions *[]*Distribution       `protobuf:"bytes,1,rep,name=distributions"`
+	xxx_hidden_Limit         int32                  `protobuf:"varint,2,opt,name=limit"`
+	xxx_hidden_Page          int32                  `protobuf:"varint,3,opt,name=page"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetDistributionsResponse) Reset() {
+	*x = GetDistributionsResponse{}
+	mi := &file_distributions_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDistributionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDistributionsResponse) ProtoMessage() {}
+
+func (x *GetDistributionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_distributions_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDistributionsResponse) GetDistributions() []*Distribution {
+	if x != nil {
+		if x.xxx_hidden_Distributions != nil {
+			return *x.xxx_hidden_Distributions
+		}
+	}
+	return nil
+}
+
+func (x *GetDistributionsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *GetDistributionsResponse) GetPage() int32 {
+	if x != nil {
+		return x.xxx_hidden_Page
+	}
+	return 0
+}
+
+func (x *GetDistributionsResponse) SetDistributions(v []*Distribution) {
+	x.xxx_hidden_Distributions = &v
+}
+
+func (x *GetDistributionsResponse) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *GetDistributionsResponse) SetPage(v int32) {
+	x.xxx_hidden_Page = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *GetDistributionsResponse) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetDistributionsResponse) HasPage() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *GetDistributionsResponse) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Limit = 0
+}
+
+func (x *GetDistributionsResponse) ClearPage() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Page = 0
+}
+
+type GetDistributionsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Distributions []*Distribution
+	Limit         *int32
+	Page          *int32
+}
+
+func (b0 GetDistributionsResponse_builder) Build() *GetDistributionsResponse {
+	m0 := &GetDistributionsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Distributions = &b.Distributions
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Limit = *b.Limit
+	}
+	if b.Page != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Page = *b.Page
+	}
+	return m0
+}
+
+var File_distributions_proto protoreflect.FileDescriptor
+
+const file_distributions_proto_rawDesc = "" +
+	"\n" +
+	"\x13distributions.proto\x12\x14fractalengine.rpc.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\fcommon.proto\x1a\vtypes.proto\"\xe1\x01\n" +
+	"\x19CreateDistributionRequest\x12P\n" +
+	"\apayload\x18\x01 \x01(\v26.fractalengine.rpc.v1.CreateDistributionRequestPayloadR\apayload\x12&\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tpublicKey\x12%\n" +
+	"\tsignature\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tsignature\x12#\n" +
+	"\rredeem_script\x18\x04 \x01(\tR\fredeemScript\"\xb3\x01\n" +
+	" CreateDistributionRequestPayload\x127\n" +
+	"\tmint_hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\bmintHash\x12(\n" +
+	"\vtotal_koinu\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\n" +
+	"totalKoinu\x12,\n" +
+	"\rrecord_height\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\frecordHeight\"\x86\x01\n" +
+	"\x1aCreateDistributionResponse\x12.\n" +
+	"\x04hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\x04hash\x128\n" +
+	"\x18encoded_transaction_body\x18\x02 \x01(\tR\x16encodedTransactionBody\"H\n" +
+	"\x16GetDistributionRequest\x12.\n" +
+	"\x04hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\x04hash\"\xb4\x01\n" +
+	"\x17GetDistributionResponse\x12F\n" +
+	"\fdistribution\x18\x01 \x01(\v2\".fractalengine.rpc.v1.DistributionR\fdistribution\x12Q\n" +
+	"\fentitlements\x18\x02 \x03(\v2-.fractalengine.rpc.v1.DistributionEntitlementR\fentitlements\"\xb6\x01\n" +
+	"\x17GetDistributionsRequest\x127\n" +
+	"\tmint_hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\bmintHash\x121\n" +
+	"\x05limit\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\x05limit\x12/\n" +
+	"\x04page\x18\x03 \x01(\v2\x1b.google.protobuf.Int32ValueR\x04page\"\x8e\x01\n" +
+	"\x18GetDistributionsResponse\x12H\n" +
+	"\rdistributions\x18\x01 \x03(\v2\".fractalengine.rpc.v1.DistributionR\rdistributions\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04pageB.Z,dogecoin.org/fractal-engine/pkg/rpc/protocolb\beditionsp\xe8\a"
+
+var file_distributions_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_distributions_proto_goTypes = []any{
+	(*CreateDistributionRequest)(nil),        // 0: fractalengine.rpc.v1.CreateDistributionRequest
+	(*CreateDistributionRequestPayload)(nil), // 1: fractalengine.rpc.v1.CreateDistributionRequestPayload
+	(*CreateDistributionResponse)(nil),       // 2: fractalengine.rpc.v1.CreateDistributionResponse
+	(*GetDistributionRequest)(nil),           // 3: fractalengine.rpc.v1.GetDistributionRequest
+	(*GetDistributionResponse)(nil),          // 4: fractalengine.rpc.v1.GetDistributionResponse
+	(*GetDistributionsRequest)(nil),          // 5: fractalengine.rpc.v1.GetDistributionsRequest
+	(*GetDistributionsResponse)(nil),         // 6: fractalengine.rpc.v1.GetDistributionsResponse
+	(*Hash)(nil),                             // 7: fractalengine.rpc.v1.Hash
+	(*Distribution)(nil),                     // 8: fractalengine.rpc.v1.Distribution
+	(*DistributionEntitlement)(nil),          // 9: fractalengine.rpc.v1.DistributionEntitlement
+	(*wrapperspb.Int32Value)(nil),            // 10: google.protobuf.Int32Value
+}
+var file_distributions_proto_depIdxs = []int32{
+	1,  // 0: fractalengine.rpc.v1.CreateDistributionRequest.payload:type_name -> fractalengine.rpc.v1.CreateDistributionRequestPayload
+	7,  // 1: fractalengine.rpc.v1.CreateDistributionRequestPayload.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	7,  // 2: fractalengine.rpc.v1.CreateDistributionResponse.hash:type_name -> fractalengine.rpc.v1.Hash
+	7,  // 3: fractalengine.rpc.v1.GetDistributionRequest.hash:type_name -> fractalengine.rpc.v1.Hash
+	8,  // 4: fractalengine.rpc.v1.GetDistributionResponse.distribution:type_name -> fractalengine.rpc.v1.Distribution
+	9,  // 5: fractalengine.rpc.v1.GetDistributionResponse.entitlements:type_name -> fractalengine.rpc.v1.DistributionEntitlement
+	7,  // 6: fractalengine.rpc.v1.GetDistributionsRequest.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	10, // 7: fractalengine.rpc.v1.GetDistributionsRequest.limit:type_name -> google.protobuf.Int32Value
+	10, // 8: fractalengine.rpc.v1.GetDistributionsRequest.page:type_name -> google.protobuf.Int32Value
+	8,  // 9: fractalengine.rpc.v1.GetDistributionsResponse.distributions:type_name -> fractalengine.rpc.v1.Distribution
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_distributions_proto_init() }
+func file_distributions_proto_init() {
+	if File_distributions_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_types_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_distributions_proto_rawDesc), len(file_distributions_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_distributions_proto_goTypes,
+		DependencyIndexes: file_distributions_proto_depIdxs,
+		MessageInfos:      file_distributions_proto_msgTypes,
+	}.Build()
+	File_distributions_proto = out.File
+	file_distributions_proto_goTypes = nil
+	file_distributions_proto_depIdxs = nil
+}
@@ -0,0 +1,647 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: events.proto
+
+package protocol
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNSPECIFIED                EventType = 0
+	EventType_EVENT_TYPE_MINT_CONFIRMED             EventType = 1
+	EventType_EVENT_TYPE_INVOICE_CONFIRMED          EventType = 2
+	EventType_EVENT_TYPE_PAYMENT_MATCHED            EventType = 3
+	EventType_EVENT_TYPE_BALANCE_CHANGED            EventType = 4
+	EventType_EVENT_TYPE_OFFER_CREATED              EventType = 5
+	EventType_EVENT_TYPE_OFFER_DELETED              EventType = 6
+	EventType_EVENT_TYPE_CHAIN_REORG                EventType = 7
+	EventType_EVENT_TYPE_INVOICE_TIMED_OUT          EventType = 8
+	EventType_EVENT_TYPE_PAYMENT_RECEIVED           EventType = 9
+	EventType_EVENT_TYPE_INVOICE_EXPIRED            EventType = 10
+	EventType_EVENT_TYPE_INVOICE_CANCELLED          EventType = 11
+	EventType_EVENT_TYPE_MINT_AMENDED               EventType = 12
+	EventType_EVENT_TYPE_MINT_OWNERSHIP_TRANSFERRED EventType = 13
+	EventType_EVENT_TYPE_DISTRIBUTION_PAID          EventType = 14
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0:  "EVENT_TYPE_UNSPECIFIED",
+		1:  "EVENT_TYPE_MINT_CONFIRMED",
+		2:  "EVENT_TYPE_INVOICE_CONFIRMED",
+		3:  "EVENT_TYPE_PAYMENT_MATCHED",
+		4:  "EVENT_TYPE_BALANCE_CHANGED",
+		5:  "EVENT_TYPE_OFFER_CREATED",
+		6:  "EVENT_TYPE_OFFER_DELETED",
+		7:  "EVENT_TYPE_CHAIN_REORG",
+		8:  "EVENT_TYPE_INVOICE_TIMED_OUT",
+		9:  "EVENT_TYPE_PAYMENT_RECEIVED",
+		10: "EVENT_TYPE_INVOICE_EXPIRED",
+		11: "EVENT_TYPE_INVOICE_CANCELLED",
+		12: "EVENT_TYPE_MINT_AMENDED",
+		13: "EVENT_TYPE_MINT_OWNERSHIP_TRANSFERRED",
+		14: "EVENT_TYPE_DISTRIBUTION_PAID",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":                0,
+		"EVENT_TYPE_MINT_CONFIRMED":             1,
+		"EVENT_TYPE_INVOICE_CONFIRMED":          2,
+		"EVENT_TYPE_PAYMENT_MATCHED":            3,
+		"EVENT_TYPE_BALANCE_CHANGED":            4,
+		"EVENT_TYPE_OFFER_CREATED":              5,
+		"EVENT_TYPE_OFFER_DELETED":              6,
+		"EVENT_TYPE_CHAIN_REORG":                7,
+		"EVENT_TYPE_INVOICE_TIMED_OUT":          8,
+		"EVENT_TYPE_PAYMENT_RECEIVED":           9,
+		"EVENT_TYPE_INVOICE_EXPIRED":            10,
+		"EVENT_TYPE_INVOICE_CANCELLED":          11,
+		"EVENT_TYPE_MINT_AMENDED":               12,
+		"EVENT_TYPE_MINT_OWNERSHIP_TRANSFERRED": 13,
+		"EVENT_TYPE_DISTRIBUTION_PAID":          14,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_events_proto_enumTypes[0].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_events_proto_enumTypes[0]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type SubscribeEventsRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Address  *Address               `protobuf:"bytes,1,opt,name=address"`
+	xxx_hidden_MintHash *Hash                  `protobuf:"bytes,2,opt,name=mint_hash,json=mintHash"`
+	xxx_hidden_Types    []EventType            `protobuf:"varint,3,rep,packed,name=types,enum=fractalengine.rpc.v1.EventType"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SubscribeEventsRequest) Reset() {
+	*x = SubscribeEventsRequest{}
+	mi := &file_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SubscribeEventsRequest) GetAddress() *Address {
+	if x != nil {
+		return x.xxx_hidden_Address
+	}
+	return nil
+}
+
+func (x *SubscribeEventsRequest) GetMintHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_MintHash
+	}
+	return nil
+}
+
+func (x *SubscribeEventsRequest) GetTypes() []EventType {
+	if x != nil {
+		return x.xxx_hidden_Types
+	}
+	return nil
+}
+
+func (x *SubscribeEventsRequest) SetAddress(v *Address) {
+	x.xxx_hidden_Address = v
+}
+
+func (x *SubscribeEventsRequest) SetMintHash(v *Hash) {
+	x.xxx_hidden_MintHash = v
+}
+
+func (x *SubscribeEventsRequest) SetTypes(v []EventType) {
+	x.xxx_hidden_Types = v
+}
+
+func (x *SubscribeEventsRequest) HasAddress() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Address != nil
+}
+
+func (x *SubscribeEventsRequest) HasMintHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MintHash != nil
+}
+
+func (x *SubscribeEventsRequest) ClearAddress() {
+	x.xxx_hidden_Address = nil
+}
+
+func (x *SubscribeEventsRequest) ClearMintHash() {
+	x.xxx_hidden_MintHash = nil
+}
+
+type SubscribeEventsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Address  *Address
+	MintHash *Hash
+	Types    []EventType
+}
+
+func (b0 SubscribeEventsRequest_builder) Build() *SubscribeEventsRequest {
+	m0 := &SubscribeEventsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Address = b.Address
+	x.xxx_hidden_MintHash = b.MintHash
+	x.xxx_hidden_Types = b.Types
+	return m0
+}
+
+type Event struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Type        EventType              `protobuf:"varint,1,opt,name=type,enum=fractalengine.rpc.v1.EventType"`
+	xxx_hidden_MintHash    *string                `protobuf:"bytes,2,opt,name=mint_hash,json=mintHash"`
+	xxx_hidden_Hash        *string                `protobuf:"bytes,3,opt,name=hash"`
+	xxx_hidden_TxHash      *string                `protobuf:"bytes,4,opt,name=tx_hash,json=txHash"`
+	xxx_hidden_Addresses   []string               `protobuf:"bytes,5,rep,name=addresses"`
+	xxx_hidden_Quantity    int32                  `protobuf:"varint,6,opt,name=quantity"`
+	xxx_hidden_BlockHeight int64                  `protobuf:"varint,7,opt,name=block_height,json=blockHeight"`
+	xxx_hidden_CreatedAt   *string                `protobuf:"bytes,8,opt,name=created_at,json=createdAt"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Event) GetType() EventType {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *Event) GetMintHash() string {
+	if x != nil {
+		if x.xxx_hidden_MintHash != nil {
+			return *x.xxx_hidden_MintHash
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Event) GetHash() string {
+	if x != nil {
+		if x.xxx_hidden_Hash != nil {
+			return *x.xxx_hidden_Hash
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Event) GetTxHash() string {
+	if x != nil {
+		if x.xxx_hidden_TxHash != nil {
+			return *x.xxx_hidden_TxHash
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Event) GetAddresses() []string {
+	if x != nil {
+		return x.xxx_hidden_Addresses
+	}
+	return nil
+}
+
+func (x *Event) GetQuantity() int32 {
+	if x != nil {
+		return x.xxx_hidden_Quantity
+	}
+	return 0
+}
+
+func (x *Event) GetBlockHeight() int64 {
+	if x != nil {
+		return x.xxx_hidden_BlockHeight
+	}
+	return 0
+}
+
+func (x *Event) GetCreatedAt() string {
+	if x != nil {
+		if x.xxx_hidden_CreatedAt != nil {
+			return *x.xxx_hidden_CreatedAt
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Event) SetType(v EventType) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
+}
+
+func (x *Event) SetMintHash(v string) {
+	x.xxx_hidden_MintHash = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
+}
+
+func (x *Event) SetHash(v string) {
+	x.xxx_hidden_Hash = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
+}
+
+func (x *Event) SetTxHash(v string) {
+	x.xxx_hidden_TxHash = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
+}
+
+func (x *Event) SetAddresses(v []string) {
+	x.xxx_hidden_Addresses = v
+}
+
+func (x *Event) SetQuantity(v int32) {
+	x.xxx_hidden_Quantity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
+}
+
+func (x *Event) SetBlockHeight(v int64) {
+	x.xxx_hidden_BlockHeight = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+}
+
+func (x *Event) SetCreatedAt(v string) {
+	x.xxx_hidden_CreatedAt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+}
+
+func (x *Event) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Event) HasMintHash() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Event) HasHash() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Event) HasTxHash() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Event) HasQuantity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *Event) HasBlockHeight() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *Event) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *Event) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Type = EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *Event) ClearMintHash() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_MintHash = nil
+}
+
+func (x *Event) ClearHash() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Hash = nil
+}
+
+func (x *Event) ClearTxHash() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_TxHash = nil
+}
+
+func (x *Event) ClearQuantity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Quantity = 0
+}
+
+func (x *Event) ClearBlockHeight() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_BlockHeight = 0
+}
+
+func (x *Event) ClearCreatedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_CreatedAt = nil
+}
+
+type Event_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Type        *EventType
+	MintHash    *string
+	Hash        *string
+	TxHash      *string
+	Addresses   []string
+	Quantity    *int32
+	BlockHeight *int64
+	CreatedAt   *string
+}
+
+func (b0 Event_builder) Build() *Event {
+	m0 := &Event{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.MintHash != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
+		x.xxx_hidden_MintHash = b.MintHash
+	}
+	if b.Hash != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
+		x.xxx_hidden_Hash = b.Hash
+	}
+	if b.TxHash != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
+		x.xxx_hidden_TxHash = b.TxHash
+	}
+	x.xxx_hidden_Addresses = b.Addresses
+	if b.Quantity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
+		x.xxx_hidden_Quantity = *b.Quantity
+	}
+	if b.BlockHeight != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_BlockHeight = *b.BlockHeight
+	}
+	if b.CreatedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_CreatedAt = b.CreatedAt
+	}
+	return m0
+}
+
+type SubscribeEventsResponse struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Event *Event                 `protobuf:"bytes,1,opt,name=event"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SubscribeEventsResponse) Reset() {
+	*x = SubscribeEventsResponse{}
+	mi := &file_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeEventsResponse) ProtoMessage() {}
+
+func (x *SubscribeEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SubscribeEventsResponse) GetEvent() *Event {
+	if x != nil {
+		return x.xxx_hidden_Event
+	}
+	return nil
+}
+
+func (x *SubscribeEventsResponse) SetEvent(v *Event) {
+	x.xxx_hidden_Event = v
+}
+
+func (x *SubscribeEventsResponse) HasEvent() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Event != nil
+}
+
+func (x *SubscribeEventsResponse) ClearEvent() {
+	x.xxx_hidden_Event = nil
+}
+
+type SubscribeEventsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Event *Event
+}
+
+func (b0 SubscribeEventsResponse_builder) Build() *SubscribeEventsResponse {
+	m0 := &SubscribeEventsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Event = b.Event
+	return m0
+}
+
+var File_events_proto protoreflect.FileDescriptor
+
+const file_events_proto_rawDesc = "" +
+	"\n" +
+	"\fevents.proto\x12\x14fractalengine.rpc.v1\x1a\vtypes.proto\"\xc1\x01\n" +
+	"\x16SubscribeEventsRequest\x127\n" +
+	"\aaddress\x18\x01 \x01(\v2\x1d.fractalengine.rpc.v1.AddressR\aaddress\x127\n" +
+	"\tmint_hash\x18\x02 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\bmintHash\x125\n" +
+	"\x05types\x18\x03 \x03(\x0e2\x1f.fractalengine.rpc.v1.EventTypeR\x05types\"\x82\x02\n" +
+	"\x05Event\x123\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1f.fractalengine.rpc.v1.EventTypeR\x04type\x12\x1b\n" +
+	"\tmint_hash\x18\x02 \x01(\tR\bmintHash\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12\x17\n" +
+	"\atx_hash\x18\x04 \x01(\tR\x06txHash\x12\x1c\n" +
+	"\taddresses\x18\x05 \x03(\tR\taddresses\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12!\n" +
+	"\fblock_height\x18\a \x01(\x03R\vblockHeight\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"L\n" +
+	"\x17SubscribeEventsResponse\x121\n" +
+	"\x05event\x18\x01 \x01(\v2\x1b.fractalengine.rpc.v1.EventR\x05event*\xef\x03\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19EVENT_TYPE_MINT_CONFIRMED\x10\x01\x12 \n" +
+	"\x1cEVENT_TYPE_INVOICE_CONFIRMED\x10\x02\x12\x1e\n" +
+	"\x1aEVENT_TYPE_PAYMENT_MATCHED\x10\x03\x12\x1e\n" +
+	"\x1aEVENT_TYPE_BALANCE_CHANGED\x10\x04\x12\x1c\n" +
+	"\x18EVENT_TYPE_OFFER_CREATED\x10\x05\x12\x1c\n" +
+	"\x18EVENT_TYPE_OFFER_DELETED\x10\x06\x12\x1a\n" +
+	"\x16EVENT_TYPE_CHAIN_REORG\x10\a\x12 \n" +
+	"\x1cEVENT_TYPE_INVOICE_TIMED_OUT\x10\b\x12\x1f\n" +
+	"\x1bEVENT_TYPE_PAYMENT_RECEIVED\x10\t\x12\x1e\n" +
+	"\x1aEVENT_TYPE_INVOICE_EXPIRED\x10\n" +
+	"\x12 \n" +
+	"\x1cEVENT_TYPE_INVOICE_CANCELLED\x10\v\x12\x1b\n" +
+	"\x17EVENT_TYPE_MINT_AMENDED\x10\f\x12)\n" +
+	"%EVENT_TYPE_MINT_OWNERSHIP_TRANSFERRED\x10\r\x12 \n" +
+	"\x1cEVENT_TYPE_DISTRIBUTION_PAID\x10\x0eB.Z,dogecoin.org/fractal-engine/pkg/rpc/protocolb\beditionsp\xe8\a"
+
+var file_events_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_events_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_events_proto_goTypes = []any{
+	(EventType)(0),                  // 0: fractalengine.rpc.v1.EventType
+	(*SubscribeEventsRequest)(nil),  // 1: fractalengine.rpc.v1.SubscribeEventsRequest
+	(*Event)(nil),                   // 2: fractalengine.rpc.v1.Event
+	(*SubscribeEventsResponse)(nil), // 3: fractalengine.rpc.v1.SubscribeEventsResponse
+	(*Address)(nil),                 // 4: fractalengine.rpc.v1.Address
+	(*Hash)(nil),                    // 5: fractalengine.rpc.v1.Hash
+}
+var file_events_proto_depIdxs = []int32{
+	4, // 0: fractalengine.rpc.v1.SubscribeEventsRequest.address:type_name -> fractalengine.rpc.v1.Address
+	5, // 1: fractalengine.rpc.v1.SubscribeEventsRequest.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	0, // 2: fractalengine.rpc.v1.SubscribeEventsRequest.types:type_name -> fractalengine.rpc.v1.EventType
+	0, // 3: fractalengine.rpc.v1.Event.type:type_name -> fractalengine.rpc.v1.EventType
+	2, // 4: fractalengine.rpc.v1.SubscribeEventsResponse.event:type_name -> fractalengine.rpc.v1.Event
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_events_proto_init() }
+func file_events_proto_init() {
+	if File_events_proto != nil {
+		return
+	}
+	file_types_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_proto_rawDesc), len(file_events_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_proto_goTypes,
+		DependencyIndexes: file_events_proto_depIdxs,
+		EnumInfos:         file_events_proto_enumTypes,
+		MessageInfos:      file_events_proto_msgTypes,
+	}.Build()
+	File_events_proto = out.File
+	file_events_proto_goTypes = nil
+	file_events_proto_depIdxs = nil
+}
@@ -28,6 +28,10 @@ type GetHealthResponse struct {
 	xxx_hidden_UpdatedAt          *string                `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt"`
 	xxx_hidden_Version            *string                `protobuf:"bytes,5,opt,name=version"`
 	xxx_hidden_WalletsEnabled     bool                   `protobuf:"varint,6,opt,name=wallets_enabled,json=walletsEnabled"`
+	xxx_hidden_AuditedAt          *string                `protobuf:"bytes,7,opt,name=audited_at,json=auditedAt"`
+	xxx_hidden_AuditBlockHeight   int32                  `protobuf:"varint,8,opt,name=audit_block_height,json=auditBlockHeight"`
+	xxx_hidden_AuditMintsChecked  int32                  `protobuf:"varint,9,opt,name=audit_mints_checked,json=auditMintsChecked"`
+	xxx_hidden_AuditViolations    *[]*AuditViolation     `protobuf:"bytes,10,rep,name=audit_violations,json=auditViolations"`
 	XXX_raceDetectHookData        protoimpl.RaceDetectHookData
 	XXX_presence                  [1]uint32
 	unknownFields                 protoimpl.UnknownFields
@@ -110,34 +114,86 @@ func (x *GetHealthResponse) GetWalletsEnabled() bool {
 	return false
 }
 
+func (x *GetHealthResponse) GetAuditedAt() string {
+	if x != nil {
+		if x.xxx_hidden_AuditedAt != nil {
+			return *x.xxx_hidden_AuditedAt
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetHealthResponse) GetAuditBlockHeight() int32 {
+	if x != nil {
+		return x.xxx_hidden_AuditBlockHeight
+	}
+	return 0
+}
+
+func (x *GetHealthResponse) GetAuditMintsChecked() int32 {
+	if x != nil {
+		return x.xxx_hidden_AuditMintsChecked
+	}
+	return 0
+}
+
+func (x *GetHealthResponse) GetAuditViolations() []*AuditViolation {
+	if x != nil {
+		if x.xxx_hidden_AuditViolations != nil {
+			return *x.xxx_hidden_AuditViolations
+		}
+	}
+	return nil
+}
+
 func (x *GetHealthResponse) SetChain(v string) {
 	x.xxx_hidden_Chain = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 10)
 }
 
 func (x *GetHealthResponse) SetCurrentBlockHeight(v int32) {
 	x.xxx_hidden_CurrentBlockHeight = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 10)
 }
 
 func (x *GetHealthResponse) SetLatestBlockHeight(v int32) {
 	x.xxx_hidden_LatestBlockHeight = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 10)
 }
 
 func (x *GetHealthResponse) SetUpdatedAt(v string) {
 	x.xxx_hidden_UpdatedAt = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 10)
 }
 
 func (x *GetHealthResponse) SetVersion(v string) {
 	x.xxx_hidden_Version = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 10)
 }
 
 func (x *GetHealthResponse) SetWalletsEnabled(v bool) {
 	x.xxx_hidden_WalletsEnabled = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 10)
+}
+
+func (x *GetHealthResponse) SetAuditedAt(v string) {
+	x.xxx_hidden_AuditedAt = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 10)
+}
+
+func (x *GetHealthResponse) SetAuditBlockHeight(v int32) {
+	x.xxx_hidden_AuditBlockHeight = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 10)
+}
+
+func (x *GetHealthResponse) SetAuditMintsChecked(v int32) {
+	x.xxx_hidden_AuditMintsChecked = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 10)
+}
+
+func (x *GetHealthResponse) SetAuditViolations(v []*AuditViolation) {
+	x.xxx_hidden_AuditViolations = &v
 }
 
 func (x *GetHealthResponse) HasChain() bool {
@@ -182,6 +238,27 @@ func (x *GetHealthResponse) HasWalletsEnabled() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
 }
 
+func (x *GetHealthResponse) HasAuditedAt() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *GetHealthResponse) HasAuditBlockHeight() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *GetHealthResponse) HasAuditMintsChecked() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
 func (x *GetHealthResponse) ClearChain() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Chain = nil
@@ -212,6 +289,21 @@ func (x *GetHealthResponse) ClearWalletsEnabled() {
 	x.xxx_hidden_WalletsEnabled = false
 }
 
+func (x *GetHealthResponse) ClearAuditedAt() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_AuditedAt = nil
+}
+
+func (x *GetHealthResponse) ClearAuditBlockHeight() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_AuditBlockHeight = 0
+}
+
+func (x *GetHealthResponse) ClearAuditMintsChecked() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_AuditMintsChecked = 0
+}
+
 type GetHealthResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -221,6 +313,10 @@ type GetHealthResponse_builder struct {
 	UpdatedAt          *string
 	Version            *string
 	WalletsEnabled     *bool
+	AuditedAt          *string
+	AuditBlockHeight   *int32
+	AuditMintsChecked  *int32
+	AuditViolations    []*AuditViolation
 }
 
 func (b0 GetHealthResponse_builder) Build() *GetHealthResponse {
@@ -228,29 +324,42 @@ func (b0 GetHealthResponse_builder) Build() *GetHealthResponse {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Chain != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 10)
 		x.xxx_hidden_Chain = b.Chain
 	}
 	if b.CurrentBlockHeight != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 10)
 		x.xxx_hidden_CurrentBlockHeight = *b.CurrentBlockHeight
 	}
 	if b.LatestBlockHeight != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 10)
 		x.xxx_hidden_LatestBlockHeight = *b.LatestBlockHeight
 	}
 	if b.UpdatedAt != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 10)
 		x.xxx_hidden_UpdatedAt = b.UpdatedAt
 	}
 	if b.Version != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 10)
 		x.xxx_hidden_Version = b.Version
 	}
 	if b.WalletsEnabled != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 10)
 		x.xxx_hidden_WalletsEnabled = *b.WalletsEnabled
 	}
+	if b.AuditedAt != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 10)
+		x.xxx_hidden_AuditedAt = b.AuditedAt
+	}
+	if b.AuditBlockHeight != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 10)
+		x.xxx_hidden_AuditBlockHeight = *b.AuditBlockHeight
+	}
+	if b.AuditMintsChecked != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 10)
+		x.xxx_hidden_AuditMintsChecked = *b.AuditMintsChecked
+	}
+	x.xxx_hidden_AuditViolations = &b.AuditViolations
 	return m0
 }
 
@@ -301,7 +410,7 @@ var File_health_proto protoreflect.FileDescriptor
 
 const file_health_proto_rawDesc = "" +
 	"\n" +
-	"\fhealth.proto\x12\x14fractalengine.rpc.v1\"\xed\x01\n" +
+	"\fhealth.proto\x12\x14fractalengine.rpc.v1\x1a\fcommon.proto\"\xbb\x03\n" +
 	"\x11GetHealthResponse\x12\x14\n" +
 	"\x05chain\x18\x01 \x01(\tR\x05chain\x120\n" +
 	"\x14current_block_height\x18\x02 \x01(\x05R\x12currentBlockHeight\x12.\n" +
@@ -309,20 +418,28 @@ const file_health_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x04 \x01(\tR\tupdatedAt\x12\x18\n" +
 	"\aversion\x18\x05 \x01(\tR\aversion\x12'\n" +
-	"\x0fwallets_enabled\x18\x06 \x01(\bR\x0ewalletsEnabled\"\x12\n" +
+	"\x0fwallets_enabled\x18\x06 \x01(\bR\x0ewalletsEnabled\x12\x1d\n" +
+	"\n" +
+	"audited_at\x18\a \x01(\tR\tauditedAt\x12,\n" +
+	"\x12audit_block_height\x18\b \x01(\x05R\x10auditBlockHeight\x12.\n" +
+	"\x13audit_mints_checked\x18\t \x01(\x05R\x11auditMintsChecked\x12O\n" +
+	"\x10audit_violations\x18\n" +
+	" \x03(\v2$.fractalengine.rpc.v1.AuditViolationR\x0fauditViolations\"\x12\n" +
 	"\x10GetHealthRequestB.Z,dogecoin.org/fractal-engine/pkg/rpc/protocolb\beditionsp\xe8\a"
 
 var file_health_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_health_proto_goTypes = []any{
 	(*GetHealthResponse)(nil), // 0: fractalengine.rpc.v1.GetHealthResponse
 	(*GetHealthRequest)(nil),  // 1: fractalengine.rpc.v1.GetHealthRequest
+	(*AuditViolation)(nil),    // 2: fractalengine.rpc.v1.AuditViolation
 }
 var file_health_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: fractalengine.rpc.v1.GetHealthResponse.audit_violations:type_name -> fractalengine.rpc.v1.AuditViolation
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_health_proto_init() }
@@ -330,6 +447,7 @@ func file_health_proto_init() {
 	if File_health_proto != nil {
 		return
 	}
+	file_common_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
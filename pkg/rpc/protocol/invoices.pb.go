@@ -876,14 +876,15 @@ func (b0 CreateInvoiceSignatureResponse_builder) Build() *CreateInvoiceSignature
 }
 
 type CreateInvoiceRequest struct {
-	state                  protoimpl.MessageState       `protogen:"opaque.v1"`
-	xxx_hidden_Payload     *CreateInvoiceRequestPayload `protobuf:"bytes,1,opt,name=payload"`
-	xxx_hidden_PublicKey   *string                      `protobuf:"bytes,2,opt,name=public_key,json=publicKey"`
-	xxx_hidden_Signature   *string                      `protobuf:"bytes,3,opt,name=signature"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	state                   protoimpl.MessageState       `protogen:"opaque.v1"`
+	xxx_hidden_Payload      *CreateInvoiceRequestPayload `protobuf:"bytes,1,opt,name=payload"`
+	xxx_hidden_PublicKey    *string                      `protobuf:"bytes,2,opt,name=public_key,json=publicKey"`
+	xxx_hidden_Signature    *string                      `protobuf:"bytes,3,opt,name=signature"`
+	xxx_hidden_RedeemScript *string                      `protobuf:"bytes,4,opt,name=redeem_script,json=redeemScript"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *CreateInvoiceRequest) Reset() {
@@ -938,18 +939,33 @@ func (x *CreateInvoiceRequest) GetSignature() string {
 	return ""
 }
 
+func (x *CreateInvoiceRequest) GetRedeemScript() string {
+	if x != nil {
+		if x.xxx_hidden_RedeemScript != nil {
+			return *x.xxx_hidden_RedeemScript
+		}
+		return ""
+	}
+	return ""
+}
+
 func (x *CreateInvoiceRequest) SetPayload(v *CreateInvoiceRequestPayload) {
 	x.xxx_hidden_Payload = v
 }
 
 func (x *CreateInvoiceRequest) SetPublicKey(v string) {
 	x.xxx_hidden_PublicKey = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
 }
 
 func (x *CreateInvoiceRequest) SetSignature(v string) {
 	x.xxx_hidden_Signature = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *CreateInvoiceRequest) SetRedeemScript(v string) {
+	x.xxx_hidden_RedeemScript = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
 }
 
 func (x *CreateInvoiceRequest) HasPayload() bool {
@@ -973,6 +989,13 @@ func (x *CreateInvoiceRequest) HasSignature() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
+func (x *CreateInvoiceRequest) HasRedeemScript() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
 func (x *CreateInvoiceRequest) ClearPayload() {
 	x.xxx_hidden_Payload = nil
 }
@@ -987,12 +1010,18 @@ func (x *CreateInvoiceRequest) ClearSignature() {
 	x.xxx_hidden_Signature = nil
 }
 
+func (x *CreateInvoiceRequest) ClearRedeemScript() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_RedeemScript = nil
+}
+
 type CreateInvoiceRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Payload   *CreateInvoiceRequestPayload
-	PublicKey *string
-	Signature *string
+	Payload      *CreateInvoiceRequestPayload
+	PublicKey    *string
+	Signature    *string
+	RedeemScript *string
 }
 
 func (b0 CreateInvoiceRequest_builder) Build() *CreateInvoiceRequest {
@@ -1001,28 +1030,34 @@ func (b0 CreateInvoiceRequest_builder) Build() *CreateInvoiceRequest {
 	_, _ = b, x
 	x.xxx_hidden_Payload = b.Payload
 	if b.PublicKey != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
 		x.xxx_hidden_PublicKey = b.PublicKey
 	}
 	if b.Signature != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
 		x.xxx_hidden_Signature = b.Signature
 	}
+	if b.RedeemScript != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_RedeemScript = b.RedeemScript
+	}
 	return m0
 }
 
 type CreateInvoiceRequestPayload struct {
-	state                     protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_PaymentAddress *Address               `protobuf:"bytes,1,opt,name=payment_address,json=paymentAddress"`
-	xxx_hidden_BuyerAddress   *Address               `protobuf:"bytes,2,opt,name=buyer_address,json=buyerAddress"`
-	xxx_hidden_MintHash       *Hash                  `protobuf:"bytes,3,opt,name=mint_hash,json=mintHash"`
-	xxx_hidden_Quantity       int32                  `protobuf:"varint,4,opt,name=quantity"`
-	xxx_hidden_Price          int32                  `protobuf:"varint,5,opt,name=price"`
-	xxx_hidden_SellerAddress  *Address               `protobuf:"bytes,6,opt,name=seller_address,json=sellerAddress"`
-	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
-	XXX_presence              [1]uint32
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PaymentAddress  *Address               `protobuf:"bytes,1,opt,name=payment_address,json=paymentAddress"`
+	xxx_hidden_BuyerAddress    *Address               `protobuf:"bytes,2,opt,name=buyer_address,json=buyerAddress"`
+	xxx_hidden_MintHash        *Hash                  `protobuf:"bytes,3,opt,name=mint_hash,json=mintHash"`
+	xxx_hidden_Quantity        int32                  `protobuf:"varint,4,opt,name=quantity"`
+	xxx_hidden_SellerAddress   *Address               `protobuf:"bytes,6,opt,name=seller_address,json=sellerAddress"`
+	xxx_hidden_Splits          *[]*InvoiceSplit       `protobuf:"bytes,7,rep,name=splits"`
+	xxx_hidden_ExpiresAtHeight int64                  `protobuf:"varint,8,opt,name=expires_at_height,json=expiresAtHeight"`
+	xxx_hidden_PriceKoinu      int64                  `protobuf:"varint,9,opt,name=price_koinu,json=priceKoinu"`
+	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
+	XXX_presence               [1]uint32
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
 }
 
 func (x *CreateInvoiceRequestPayload) Reset() {
@@ -1078,20 +1113,36 @@ func (x *CreateInvoiceRequestPayload) GetQuantity() int32 {
 	return 0
 }
 
-func (x *CreateInvoiceRequestPayload) GetPrice() int32 {
+func (x *CreateInvoiceRequestPayload) GetSellerAddress() *Address {
 	if x != nil {
-		return x.xxx_hidden_Price
+		return x.xxx_hidden_SellerAddress
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateInvoiceRequestPayload) GetSellerAddress() *Address {
+func (x *CreateInvoiceRequestPayload) GetSplits() []*InvoiceSplit {
 	if x != nil {
-		return x.xxx_hidden_SellerAddress
+		if x.xxx_hidden_Splits != nil {
+			return *x.xxx_hidden_Splits
+		}
 	}
 	return nil
 }
 
+func (x *CreateInvoiceRequestPayload) GetExpiresAtHeight() int64 {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAtHeight
+	}
+	return 0
+}
+
+func (x *CreateInvoiceRequestPayload) GetPriceKoinu() int64 {
+	if x != nil {
+		return x.xxx_hidden_PriceKoinu
+	}
+	return 0
+}
+
 func (x *CreateInvoiceRequestPayload) SetPaymentAddress(v *Address) {
 	x.xxx_hidden_PaymentAddress = v
 }
@@ -1106,18 +1157,27 @@ func (x *CreateInvoiceRequestPayload) SetMintHash(v *Hash) {
 
 func (x *CreateInvoiceRequestPayload) SetQuantity(v int32) {
 	x.xxx_hidden_Quantity = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
-}
-
-func (x *CreateInvoiceRequestPayload) SetPrice(v int32) {
-	x.xxx_hidden_Price = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
 }
 
 func (x *CreateInvoiceRequestPayload) SetSellerAddress(v *Address) {
 	x.xxx_hidden_SellerAddress = v
 }
 
+func (x *CreateInvoiceRequestPayload) SetSplits(v []*InvoiceSplit) {
+	x.xxx_hidden_Splits = &v
+}
+
+func (x *CreateInvoiceRequestPayload) SetExpiresAtHeight(v int64) {
+	x.xxx_hidden_ExpiresAtHeight = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+}
+
+func (x *CreateInvoiceRequestPayload) SetPriceKoinu(v int64) {
+	x.xxx_hidden_PriceKoinu = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+}
+
 func (x *CreateInvoiceRequestPayload) HasPaymentAddress() bool {
 	if x == nil {
 		return false
@@ -1146,18 +1206,25 @@ func (x *CreateInvoiceRequestPayload) HasQuantity() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
-func (x *CreateInvoiceRequestPayload) HasPrice() bool {
+func (x *CreateInvoiceRequestPayload) HasSellerAddress() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+	return x.xxx_hidden_SellerAddress != nil
 }
 
-func (x *CreateInvoiceRequestPayload) HasSellerAddress() bool {
+func (x *CreateInvoiceRequestPayload) HasExpiresAtHeight() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_SellerAddress != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *CreateInvoiceRequestPayload) HasPriceKoinu() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
 }
 
 func (x *CreateInvoiceRequestPayload) ClearPaymentAddress() {
@@ -1177,24 +1244,31 @@ func (x *CreateInvoiceRequestPayload) ClearQuantity() {
 	x.xxx_hidden_Quantity = 0
 }
 
-func (x *CreateInvoiceRequestPayload) ClearPrice() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
-	x.xxx_hidden_Price = 0
-}
-
 func (x *CreateInvoiceRequestPayload) ClearSellerAddress() {
 	x.xxx_hidden_SellerAddress = nil
 }
 
+func (x *CreateInvoiceRequestPayload) ClearExpiresAtHeight() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_ExpiresAtHeight = 0
+}
+
+func (x *CreateInvoiceRequestPayload) ClearPriceKoinu() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_PriceKoinu = 0
+}
+
 type CreateInvoiceRequestPayload_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	PaymentAddress *Address
-	BuyerAddress   *Address
-	MintHash       *Hash
-	Quantity       *int32
-	Price          *int32
-	SellerAddress  *Address
+	PaymentAddress  *Address
+	BuyerAddress    *Address
+	MintHash        *Hash
+	Quantity        *int32
+	SellerAddress   *Address
+	Splits          []*InvoiceSplit
+	ExpiresAtHeight *int64
+	PriceKoinu      *int64
 }
 
 func (b0 CreateInvoiceRequestPayload_builder) Build() *CreateInvoiceRequestPayload {
@@ -1205,14 +1279,19 @@ func (b0 CreateInvoiceRequestPayload_builder) Build() *CreateInvoiceRequestPaylo
 	x.xxx_hidden_BuyerAddress = b.BuyerAddress
 	x.xxx_hidden_MintHash = b.MintHash
 	if b.Quantity != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
 		x.xxx_hidden_Quantity = *b.Quantity
 	}
-	if b.Price != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
-		x.xxx_hidden_Price = *b.Price
-	}
 	x.xxx_hidden_SellerAddress = b.SellerAddress
+	x.xxx_hidden_Splits = &b.Splits
+	if b.ExpiresAtHeight != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_ExpiresAtHeight = *b.ExpiresAtHeight
+	}
+	if b.PriceKoinu != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_PriceKoinu = *b.PriceKoinu
+	}
 	return m0
 }
 
@@ -1319,6 +1398,516 @@ func (b0 CreateInvoiceResponse_builder) Build() *CreateInvoiceResponse {
 	return m0
 }
 
+type CancelInvoiceRequest struct {
+	state                   protoimpl.MessageState       `protogen:"opaque.v1"`
+	xxx_hidden_Payload      *CancelInvoiceRequestPayload `protobuf:"bytes,1,opt,name=payload"`
+	xxx_hidden_PublicKey    *string                      `protobuf:"bytes,2,opt,name=public_key,json=publicKey"`
+	xxx_hidden_Signature    *string                      `protobuf:"bytes,3,opt,name=signature"`
+	xxx_hidden_RedeemScript *string                      `protobuf:"bytes,4,opt,name=redeem_script,json=redeemScript"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CancelInvoiceRequest) Reset() {
+	*x = CancelInvoiceRequest{}
+	mi := &file_invoices_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInvoiceRequest) ProtoMessage() {}
+
+func (x *CancelInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoices_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CancelInvoiceRequest) GetPayload() *CancelInvoiceRequestPayload {
+	if x != nil {
+		return x.xxx_hidden_Payload
+	}
+	return nil
+}
+
+func (x *CancelInvoiceRequest) GetPublicKey() string {
+	if x != nil {
+		if x.xxx_hidden_PublicKey != nil {
+			return *x.xxx_hidden_PublicKey
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CancelInvoiceRequest) GetSignature() string {
+	if x != nil {
+		if x.xxx_hidden_Signature != nil {
+			return *x.xxx_hidden_Signature
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CancelInvoiceRequest) GetRedeemScript() string {
+	if x != nil {
+		if x.xxx_hidden_RedeemScript != nil {
+			return *x.xxx_hidden_RedeemScript
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CancelInvoiceRequest) SetPayload(v *CancelInvoiceRequestPayload) {
+	x.xxx_hidden_Payload = v
+}
+
+func (x *CancelInvoiceRequest) SetPublicKey(v string) {
+	x.xxx_hidden_PublicKey = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *CancelInvoiceRequest) SetSignature(v string) {
+	x.xxx_hidden_Signature = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *CancelInvoiceRequest) SetRedeemScript(v string) {
+	x.xxx_hidden_RedeemScript = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *CancelInvoiceRequest) HasPayload() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Payload != nil
+}
+
+func (x *CancelInvoiceRequest) HasPublicKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CancelInvoiceRequest) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *CancelInvoiceRequest) HasRedeemScript() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *CancelInvoiceRequest) ClearPayload() {
+	x.xxx_hidden_Payload = nil
+}
+
+func (x *CancelInvoiceRequest) ClearPublicKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_PublicKey = nil
+}
+
+func (x *CancelInvoiceRequest) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Signature = nil
+}
+
+func (x *CancelInvoiceRequest) ClearRedeemScript() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_RedeemScript = nil
+}
+
+type CancelInvoiceRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Payload      *CancelInvoiceRequestPayload
+	PublicKey    *string
+	Signature    *string
+	RedeemScript *string
+}
+
+func (b0 CancelInvoiceRequest_builder) Build() *CancelInvoiceRequest {
+	m0 := &CancelInvoiceRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Payload = b.Payload
+	if b.PublicKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_PublicKey = b.PublicKey
+	}
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	if b.RedeemScript != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_RedeemScript = b.RedeemScript
+	}
+	return m0
+}
+
+type CancelInvoiceRequestPayload struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_InvoiceHash *Hash                  `protobuf:"bytes,1,opt,name=invoice_hash,json=invoiceHash"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CancelInvoiceRequestPayload) Reset() {
+	*x = CancelInvoiceRequestPayload{}
+	mi := &file_invoices_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelInvoiceRequestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInvoiceRequestPayload) ProtoMessage() {}
+
+func (x *CancelInvoiceRequestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_invoices_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CancelInvoiceRequestPayload) GetInvoiceHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_InvoiceHash
+	}
+	return nil
+}
+
+func (x *CancelInvoiceRequestPayload) SetInvoiceHash(v *Hash) {
+	x.xxx_hidden_InvoiceHash = v
+}
+
+func (x *CancelInvoiceRequestPayload) HasInvoiceHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_InvoiceHash != nil
+}
+
+func (x *CancelInvoiceRequestPayload) ClearInvoiceHash() {
+	x.xxx_hidden_InvoiceHash = nil
+}
+
+type CancelInvoiceRequestPayload_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	InvoiceHash *Hash
+}
+
+func (b0 CancelInvoiceRequestPayload_builder) Build() *CancelInvoiceRequestPayload {
+	m0 := &CancelInvoiceRequestPayload{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_InvoiceHash = b.InvoiceHash
+	return m0
+}
+
+type CancelInvoiceResponse struct {
+	state                             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Value                  *string                `protobuf:"bytes,1,opt,name=value"`
+	xxx_hidden_EncodedTransactionBody *string                `protobuf:"bytes,2,opt,name=encoded_transaction_body,json=encodedTransactionBody"`
+	XXX_raceDetectHookData            protoimpl.RaceDetectHookData
+	XXX_presence                      [1]uint32
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *CancelInvoiceResponse) Reset() {
+	*x = CancelInvoiceResponse{}
+	mi := &file_invoices_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInvoiceResponse) ProtoMessage() {}
+
+func (x *CancelInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoices_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CancelInvoiceResponse) GetValue() string {
+	if x != nil {
+		if x.xxx_hidden_Value != nil {
+			return *x.xxx_hidden_Value
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CancelInvoiceResponse) GetEncodedTransactionBody() string {
+	if x != nil {
+		if x.xxx_hidden_EncodedTransactionBody != nil {
+			return *x.xxx_hidden_EncodedTransactionBody
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CancelInvoiceResponse) SetValue(v string) {
+	x.xxx_hidden_Value = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *CancelInvoiceResponse) SetEncodedTransactionBody(v string) {
+	x.xxx_hidden_EncodedTransactionBody = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *CancelInvoiceResponse) HasValue() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CancelInvoiceResponse) HasEncodedTransactionBody() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CancelInvoiceResponse) ClearValue() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Value = nil
+}
+
+func (x *CancelInvoiceResponse) ClearEncodedTransactionBody() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_EncodedTransactionBody = nil
+}
+
+type CancelInvoiceResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Value                  *string
+	EncodedTransactionBody *string
+}
+
+func (b0 CancelInvoiceResponse_builder) Build() *CancelInvoiceResponse {
+	m0 := &CancelInvoiceResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Value != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Value = b.Value
+	}
+	if b.EncodedTransactionBody != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_EncodedTransactionBody = b.EncodedTransactionBody
+	}
+	return m0
+}
+
+type GetInvoiceHistoryRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_InvoiceHash *Hash                  `protobuf:"bytes,1,opt,name=invoice_hash,json=invoiceHash"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetInvoiceHistoryRequest) Reset() {
+	*x = GetInvoiceHistoryRequest{}
+	mi := &file_invoices_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceHistoryRequest) ProtoMessage() {}
+
+func (x *GetInvoiceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoices_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetInvoiceHistoryRequest) GetInvoiceHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_InvoiceHash
+	}
+	return nil
+}
+
+func (x *GetInvoiceHistoryRequest) SetInvoiceHash(v *Hash) {
+	x.xxx_hidden_InvoiceHash = v
+}
+
+func (x *GetInvoiceHistoryRequest) HasInvoiceHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_InvoiceHash != nil
+}
+
+func (x *GetInvoiceHistoryRequest) ClearInvoiceHash() {
+	x.xxx_hidden_InvoiceHash = nil
+}
+
+type GetInvoiceHistoryRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	InvoiceHash *Hash
+}
+
+func (b0 GetInvoiceHistoryRequest_builder) Build() *GetInvoiceHistoryRequest {
+	m0 := &GetInvoiceHistoryRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_InvoiceHash = b.InvoiceHash
+	return m0
+}
+
+type GetInvoiceHistoryResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Status      *string                `protobuf:"bytes,1,opt,name=status"`
+	xxx_hidden_Transitions *[]*InvoiceTransition  `protobuf:"bytes,2,rep,name=transitions"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetInvoiceHistoryResponse) Reset() {
+	*x = GetInvoiceHistoryResponse{}
+	mi := &file_invoices_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceHistoryResponse) ProtoMessage() {}
+
+func (x *GetInvoiceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoices_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetInvoiceHistoryResponse) GetStatus() string {
+	if x != nil {
+		if x.xxx_hidden_Status != nil {
+			return *x.xxx_hidden_Status
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetInvoiceHistoryResponse) GetTransitions() []*InvoiceTransition {
+	if x != nil {
+		if x.xxx_hidden_Transitions != nil {
+			return *x.xxx_hidden_Transitions
+		}
+	}
+	return nil
+}
+
+func (x *GetInvoiceHistoryResponse) SetStatus(v string) {
+	x.xxx_hidden_Status = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *GetInvoiceHistoryResponse) SetTransitions(v []*InvoiceTransition) {
+	x.xxx_hidden_Transitions = &v
+}
+
+func (x *GetInvoiceHistoryResponse) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetInvoiceHistoryResponse) ClearStatus() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Status = nil
+}
+
+type GetInvoiceHistoryResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Status      *string
+	Transitions []*InvoiceTransition
+}
+
+func (b0 GetInvoiceHistoryResponse_builder) Build() *GetInvoiceHistoryResponse {
+	m0 := &GetInvoiceHistoryResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Status != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Status = b.Status
+	}
+	x.xxx_hidden_Transitions = &b.Transitions
+	return m0
+}
+
 var File_invoices_proto protoreflect.FileDescriptor
 
 const file_invoices_proto_rawDesc = "" +
@@ -1351,24 +1940,44 @@ const file_invoices_proto_rawDesc = "" +
 	"public_key\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tpublicKey\x12%\n" +
 	"\tsignature\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tsignature\"0\n" +
 	"\x1eCreateInvoiceSignatureResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xb2\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xd7\x01\n" +
 	"\x14CreateInvoiceRequest\x12K\n" +
 	"\apayload\x18\x01 \x01(\v21.fractalengine.rpc.v1.CreateInvoiceRequestPayloadR\apayload\x12&\n" +
 	"\n" +
 	"public_key\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tpublicKey\x12%\n" +
-	"\tsignature\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tsignature\"\x90\x03\n" +
+	"\tsignature\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tsignature\x12#\n" +
+	"\rredeem_script\x18\x04 \x01(\tR\fredeemScript\"\x92\x04\n" +
 	"\x1bCreateInvoiceRequestPayload\x12O\n" +
 	"\x0fpayment_address\x18\x01 \x01(\v2\x1d.fractalengine.rpc.v1.AddressB\a\xbaH\x04r\x02\x10\x01R\x0epaymentAddress\x12K\n" +
 	"\rbuyer_address\x18\x02 \x01(\v2\x1d.fractalengine.rpc.v1.AddressB\a\xbaH\x04r\x02\x10\x01R\fbuyerAddress\x12@\n" +
 	"\tmint_hash\x18\x03 \x01(\v2\x1a.fractalengine.rpc.v1.HashB\a\xbaH\x04r\x02\x10\x01R\bmintHash\x12#\n" +
-	"\bquantity\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bquantity\x12\x1d\n" +
-	"\x05price\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\x05price\x12M\n" +
-	"\x0eseller_address\x18\x06 \x01(\v2\x1d.fractalengine.rpc.v1.AddressB\a\xbaH\x04r\x02\x10\x01R\rsellerAddress\"\x81\x01\n" +
+	"\bquantity\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bquantity\x12M\n" +
+	"\x0eseller_address\x18\x06 \x01(\v2\x1d.fractalengine.rpc.v1.AddressB\a\xbaH\x04r\x02\x10\x01R\rsellerAddress\x12:\n" +
+	"\x06splits\x18\a \x03(\v2\".fractalengine.rpc.v1.InvoiceSplitR\x06splits\x123\n" +
+	"\x11expires_at_height\x18\b \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x0fexpiresAtHeight\x12(\n" +
+	"\vprice_koinu\x18\t \x01(\x03B\a\xbaH\x04\"\x02 \x00R\n" +
+	"priceKoinuJ\x04\b\x05\x10\x06\"\x81\x01\n" +
 	"\x15CreateInvoiceResponse\x12.\n" +
 	"\x04hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\x04hash\x128\n" +
-	"\x18encoded_transaction_body\x18\x02 \x01(\tR\x16encodedTransactionBodyB.Z,dogecoin.org/fractal-engine/pkg/rpc/protocolb\beditionsp\xe8\a"
-
-var file_invoices_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+	"\x18encoded_transaction_body\x18\x02 \x01(\tR\x16encodedTransactionBody\"\xd7\x01\n" +
+	"\x14CancelInvoiceRequest\x12K\n" +
+	"\apayload\x18\x01 \x01(\v21.fractalengine.rpc.v1.CancelInvoiceRequestPayloadR\apayload\x12&\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tpublicKey\x12%\n" +
+	"\tsignature\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tsignature\x12#\n" +
+	"\rredeem_script\x18\x04 \x01(\tR\fredeemScript\"e\n" +
+	"\x1bCancelInvoiceRequestPayload\x12F\n" +
+	"\finvoice_hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashB\a\xbaH\x04r\x02\x10\x01R\vinvoiceHash\"g\n" +
+	"\x15CancelInvoiceResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x128\n" +
+	"\x18encoded_transaction_body\x18\x02 \x01(\tR\x16encodedTransactionBody\"Y\n" +
+	"\x18GetInvoiceHistoryRequest\x12=\n" +
+	"\finvoice_hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\vinvoiceHash\"~\n" +
+	"\x19GetInvoiceHistoryResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12I\n" +
+	"\vtransitions\x18\x02 \x03(\v2'.fractalengine.rpc.v1.InvoiceTransitionR\vtransitionsB.Z,dogecoin.org/fractal-engine/pkg/rpc/protocolb\beditionsp\xe8\a"
+
+var file_invoices_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_invoices_proto_goTypes = []any{
 	(*GetInvoicesRequest)(nil),                   // 0: fractalengine.rpc.v1.GetInvoicesRequest
 	(*GetAllInvoicesRequest)(nil),                // 1: fractalengine.rpc.v1.GetAllInvoicesRequest
@@ -1380,33 +1989,45 @@ var file_invoices_proto_goTypes = []any{
 	(*CreateInvoiceRequest)(nil),                 // 7: fractalengine.rpc.v1.CreateInvoiceRequest
 	(*CreateInvoiceRequestPayload)(nil),          // 8: fractalengine.rpc.v1.CreateInvoiceRequestPayload
 	(*CreateInvoiceResponse)(nil),                // 9: fractalengine.rpc.v1.CreateInvoiceResponse
-	(*Address)(nil),                              // 10: fractalengine.rpc.v1.Address
-	(*wrapperspb.Int32Value)(nil),                // 11: google.protobuf.Int32Value
-	(*Hash)(nil),                                 // 12: fractalengine.rpc.v1.Hash
-	(*Invoice)(nil),                              // 13: fractalengine.rpc.v1.Invoice
+	(*CancelInvoiceRequest)(nil),                 // 10: fractalengine.rpc.v1.CancelInvoiceRequest
+	(*CancelInvoiceRequestPayload)(nil),          // 11: fractalengine.rpc.v1.CancelInvoiceRequestPayload
+	(*CancelInvoiceResponse)(nil),                // 12: fractalengine.rpc.v1.CancelInvoiceResponse
+	(*GetInvoiceHistoryRequest)(nil),             // 13: fractalengine.rpc.v1.GetInvoiceHistoryRequest
+	(*GetInvoiceHistoryResponse)(nil),            // 14: fractalengine.rpc.v1.GetInvoiceHistoryResponse
+	(*Address)(nil),                              // 15: fractalengine.rpc.v1.Address
+	(*wrapperspb.Int32Value)(nil),                // 16: google.protobuf.Int32Value
+	(*Hash)(nil),                                 // 17: fractalengine.rpc.v1.Hash
+	(*Invoice)(nil),                              // 18: fractalengine.rpc.v1.Invoice
+	(*InvoiceSplit)(nil),                         // 19: fractalengine.rpc.v1.InvoiceSplit
+	(*InvoiceTransition)(nil),                    // 20: fractalengine.rpc.v1.InvoiceTransition
 }
 var file_invoices_proto_depIdxs = []int32{
-	10, // 0: fractalengine.rpc.v1.GetInvoicesRequest.address:type_name -> fractalengine.rpc.v1.Address
-	11, // 1: fractalengine.rpc.v1.GetInvoicesRequest.limit:type_name -> google.protobuf.Int32Value
-	11, // 2: fractalengine.rpc.v1.GetInvoicesRequest.page:type_name -> google.protobuf.Int32Value
-	12, // 3: fractalengine.rpc.v1.GetInvoicesRequest.mint_hash:type_name -> fractalengine.rpc.v1.Hash
-	11, // 4: fractalengine.rpc.v1.GetAllInvoicesRequest.limit:type_name -> google.protobuf.Int32Value
-	11, // 5: fractalengine.rpc.v1.GetAllInvoicesRequest.page:type_name -> google.protobuf.Int32Value
-	12, // 6: fractalengine.rpc.v1.GetAllInvoicesRequest.mint_hash:type_name -> fractalengine.rpc.v1.Hash
-	13, // 7: fractalengine.rpc.v1.GetInvoicesResponse.invoices:type_name -> fractalengine.rpc.v1.Invoice
-	13, // 8: fractalengine.rpc.v1.GetAllInvoicesResponse.invoices:type_name -> fractalengine.rpc.v1.Invoice
+	15, // 0: fractalengine.rpc.v1.GetInvoicesRequest.address:type_name -> fractalengine.rpc.v1.Address
+	16, // 1: fractalengine.rpc.v1.GetInvoicesRequest.limit:type_name -> google.protobuf.Int32Value
+	16, // 2: fractalengine.rpc.v1.GetInvoicesRequest.page:type_name -> google.protobuf.Int32Value
+	17, // 3: fractalengine.rpc.v1.GetInvoicesRequest.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	16, // 4: fractalengine.rpc.v1.GetAllInvoicesRequest.limit:type_name -> google.protobuf.Int32Value
+	16, // 5: fractalengine.rpc.v1.GetAllInvoicesRequest.page:type_name -> google.protobuf.Int32Value
+	17, // 6: fractalengine.rpc.v1.GetAllInvoicesRequest.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	18, // 7: fractalengine.rpc.v1.GetInvoicesResponse.invoices:type_name -> fractalengine.rpc.v1.Invoice
+	18, // 8: fractalengine.rpc.v1.GetAllInvoicesResponse.invoices:type_name -> fractalengine.rpc.v1.Invoice
 	5,  // 9: fractalengine.rpc.v1.CreateInvoiceSignatureRequest.payload:type_name -> fractalengine.rpc.v1.CreateInvoiceSignatureRequestPayload
 	8,  // 10: fractalengine.rpc.v1.CreateInvoiceRequest.payload:type_name -> fractalengine.rpc.v1.CreateInvoiceRequestPayload
-	10, // 11: fractalengine.rpc.v1.CreateInvoiceRequestPayload.payment_address:type_name -> fractalengine.rpc.v1.Address
-	10, // 12: fractalengine.rpc.v1.CreateInvoiceRequestPayload.buyer_address:type_name -> fractalengine.rpc.v1.Address
-	12, // 13: fractalengine.rpc.v1.CreateInvoiceRequestPayload.mint_hash:type_name -> fractalengine.rpc.v1.Hash
-	10, // 14: fractalengine.rpc.v1.CreateInvoiceRequestPayload.seller_address:type_name -> fractalengine.rpc.v1.Address
-	12, // 15: fractalengine.rpc.v1.CreateInvoiceResponse.hash:type_name -> fractalengine.rpc.v1.Hash
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	15, // 11: fractalengine.rpc.v1.CreateInvoiceRequestPayload.payment_address:type_name -> fractalengine.rpc.v1.Address
+	15, // 12: fractalengine.rpc.v1.CreateInvoiceRequestPayload.buyer_address:type_name -> fractalengine.rpc.v1.Address
+	17, // 13: fractalengine.rpc.v1.CreateInvoiceRequestPayload.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	15, // 14: fractalengine.rpc.v1.CreateInvoiceRequestPayload.seller_address:type_name -> fractalengine.rpc.v1.Address
+	19, // 15: fractalengine.rpc.v1.CreateInvoiceRequestPayload.splits:type_name -> fractalengine.rpc.v1.InvoiceSplit
+	17, // 16: fractalengine.rpc.v1.CreateInvoiceResponse.hash:type_name -> fractalengine.rpc.v1.Hash
+	11, // 17: fractalengine.rpc.v1.CancelInvoiceRequest.payload:type_name -> fractalengine.rpc.v1.CancelInvoiceRequestPayload
+	17, // 18: fractalengine.rpc.v1.CancelInvoiceRequestPayload.invoice_hash:type_name -> fractalengine.rpc.v1.Hash
+	17, // 19: fractalengine.rpc.v1.GetInvoiceHistoryRequest.invoice_hash:type_name -> fractalengine.rpc.v1.Hash
+	20, // 20: fractalengine.rpc.v1.GetInvoiceHistoryResponse.transitions:type_name -> fractalengine.rpc.v1.InvoiceTransition
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_invoices_proto_init() }
@@ -1422,7 +2043,7 @@ func file_invoices_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_invoices_proto_rawDesc), len(file_invoices_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -116,10 +116,13 @@ func (b0 GetMintsRequest_builder) Build() *GetMintsRequest {
 }
 
 type GetMintRequest struct {
-	state           protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Hash *Hash                  `protobuf:"bytes,1,opt,name=hash"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Hash        *Hash                  `protobuf:"bytes,1,opt,name=hash"`
+	xxx_hidden_Version     int32                  `protobuf:"varint,2,opt,name=version"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *GetMintRequest) Reset() {
@@ -154,10 +157,22 @@ func (x *GetMintRequest) GetHash() *Hash {
 	return nil
 }
 
+func (x *GetMintRequest) GetVersion() int32 {
+	if x != nil {
+		return x.xxx_hidden_Version
+	}
+	return 0
+}
+
 func (x *GetMintRequest) SetHash(v *Hash) {
 	x.xxx_hidden_Hash = v
 }
 
+func (x *GetMintRequest) SetVersion(v int32) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
 func (x *GetMintRequest) HasHash() bool {
 	if x == nil {
 		return false
@@ -165,14 +180,27 @@ func (x *GetMintRequest) HasHash() bool {
 	return x.xxx_hidden_Hash != nil
 }
 
+func (x *GetMintRequest) HasVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
 func (x *GetMintRequest) ClearHash() {
 	x.xxx_hidden_Hash = nil
 }
 
+func (x *GetMintRequest) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Version = 0
+}
+
 type GetMintRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Hash *Hash
+	Hash    *Hash
+	Version *int32
 }
 
 func (b0 GetMintRequest_builder) Build() *GetMintRequest {
@@ -180,6 +208,10 @@ func (b0 GetMintRequest_builder) Build() *GetMintRequest {
 	b, x := &b0, m0
 	_, _ = b, x
 	x.xxx_hidden_Hash = b.Hash
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Version = *b.Version
+	}
 	return m0
 }
 
@@ -335,10 +367,16 @@ func (b0 GetMintsResponse_builder) Build() *GetMintsResponse {
 }
 
 type GetMintResponse struct {
-	state           protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Mint *Mint                  `protobuf:"bytes,1,opt,name=mint"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Mint              *Mint                  `protobuf:"bytes,1,opt,name=mint"`
+	xxx_hidden_BurnedSupply      int32                  `protobuf:"varint,2,opt,name=burned_supply,json=burnedSupply"`
+	xxx_hidden_CirculatingSupply int32                  `protobuf:"varint,3,opt,name=circulating_supply,json=circulatingSupply"`
+	xxx_hidden_Version           int32                  `protobuf:"varint,4,opt,name=version"`
+	xxx_hidden_LatestVersion     int32                  `protobuf:"varint,5,opt,name=latest_version,json=latestVersion"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
 func (x *GetMintResponse) Reset() {
@@ -373,10 +411,58 @@ func (x *GetMintResponse) GetMint() *Mint {
 	return nil
 }
 
+func (x *GetMintResponse) GetBurnedSupply() int32 {
+	if x != nil {
+		return x.xxx_hidden_BurnedSupply
+	}
+	return 0
+}
+
+func (x *GetMintResponse) GetCirculatingSupply() int32 {
+	if x != nil {
+		return x.xxx_hidden_CirculatingSupply
+	}
+	return 0
+}
+
+func (x *GetMintResponse) GetVersion() int32 {
+	if x != nil {
+		return x.xxx_hidden_Version
+	}
+	return 0
+}
+
+func (x *GetMintResponse) GetLatestVersion() int32 {
+	if x != nil {
+		return x.xxx_hidden_LatestVersion
+	}
+	return 0
+}
+
 func (x *GetMintResponse) SetMint(v *Mint) {
 	x.xxx_hidden_Mint = v
 }
 
+func (x *GetMintResponse) SetBurnedSupply(v int32) {
+	x.xxx_hidden_BurnedSupply = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *GetMintResponse) SetCirculatingSupply(v int32) {
+	x.xxx_hidden_CirculatingSupply = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *GetMintResponse) SetVersion(v int32) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *GetMintResponse) SetLatestVersion(v int32) {
+	x.xxx_hidden_LatestVersion = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
 func (x *GetMintResponse) HasMint() bool {
 	if x == nil {
 		return false
@@ -384,14 +470,66 @@ func (x *GetMintResponse) HasMint() bool {
 	return x.xxx_hidden_Mint != nil
 }
 
+func (x *GetMintResponse) HasBurnedSupply() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetMintResponse) HasCirculatingSupply() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *GetMintResponse) HasVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *GetMintResponse) HasLatestVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
 func (x *GetMintResponse) ClearMint() {
 	x.xxx_hidden_Mint = nil
 }
 
+func (x *GetMintResponse) ClearBurnedSupply() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_BurnedSupply = 0
+}
+
+func (x *GetMintResponse) ClearCirculatingSupply() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_CirculatingSupply = 0
+}
+
+func (x *GetMintResponse) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Version = 0
+}
+
+func (x *GetMintResponse) ClearLatestVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_LatestVersion = 0
+}
+
 type GetMintResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Mint *Mint
+	Mint              *Mint
+	BurnedSupply      *int32
+	CirculatingSupply *int32
+	Version           *int32
+	LatestVersion     *int32
 }
 
 func (b0 GetMintResponse_builder) Build() *GetMintResponse {
@@ -399,18 +537,35 @@ func (b0 GetMintResponse_builder) Build() *GetMintResponse {
 	b, x := &b0, m0
 	_, _ = b, x
 	x.xxx_hidden_Mint = b.Mint
+	if b.BurnedSupply != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_BurnedSupply = *b.BurnedSupply
+	}
+	if b.CirculatingSupply != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_CirculatingSupply = *b.CirculatingSupply
+	}
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_Version = *b.Version
+	}
+	if b.LatestVersion != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_LatestVersion = *b.LatestVersion
+	}
 	return m0
 }
 
 type CreateMintRequest struct {
-	state                  protoimpl.MessageState    `protogen:"opaque.v1"`
-	xxx_hidden_Payload     *CreateMintRequestPayload `protobuf:"bytes,1,opt,name=payload"`
-	xxx_hidden_PublicKey   *string                   `protobuf:"bytes,2,opt,name=public_key,json=publicKey"`
-	xxx_hidden_Signature   *string                   `protobuf:"bytes,3,opt,name=signature"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	state                   protoimpl.MessageState    `protogen:"opaque.v1"`
+	xxx_hidden_Payload      *CreateMintRequestPayload `protobuf:"bytes,1,opt,name=payload"`
+	xxx_hidden_PublicKey    *string                   `protobuf:"bytes,2,opt,name=public_key,json=publicKey"`
+	xxx_hidden_Signature    *string                   `protobuf:"bytes,3,opt,name=signature"`
+	xxx_hidden_RedeemScript *string                   `protobuf:"bytes,4,opt,name=redeem_script,json=redeemScript"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *CreateMintRequest) Reset() {
@@ -465,18 +620,33 @@ func (x *CreateMintRequest) GetSignature() string {
 	return ""
 }
 
+func (x *CreateMintRequest) GetRedeemScript() string {
+	if x != nil {
+		if x.xxx_hidden_RedeemScript != nil {
+			return *x.xxx_hidden_RedeemScript
+		}
+		return ""
+	}
+	return ""
+}
+
 func (x *CreateMintRequest) SetPayload(v *CreateMintRequestPayload) {
 	x.xxx_hidden_Payload = v
 }
 
 func (x *CreateMintRequest) SetPublicKey(v string) {
 	x.xxx_hidden_PublicKey = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
 }
 
 func (x *CreateMintRequest) SetSignature(v string) {
 	x.xxx_hidden_Signature = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *CreateMintRequest) SetRedeemScript(v string) {
+	x.xxx_hidden_RedeemScript = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
 }
 
 func (x *CreateMintRequest) HasPayload() bool {
@@ -500,6 +670,13 @@ func (x *CreateMintRequest) HasSignature() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
+func (x *CreateMintRequest) HasRedeemScript() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
 func (x *CreateMintRequest) ClearPayload() {
 	x.xxx_hidden_Payload = nil
 }
@@ -514,12 +691,18 @@ func (x *CreateMintRequest) ClearSignature() {
 	x.xxx_hidden_Signature = nil
 }
 
+func (x *CreateMintRequest) ClearRedeemScript() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_RedeemScript = nil
+}
+
 type CreateMintRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Payload   *CreateMintRequestPayload
-	PublicKey *string
-	Signature *string
+	Payload      *CreateMintRequestPayload
+	PublicKey    *string
+	Signature    *string
+	RedeemScript *string
 }
 
 func (b0 CreateMintRequest_builder) Build() *CreateMintRequest {
@@ -528,13 +711,17 @@ func (b0 CreateMintRequest_builder) Build() *CreateMintRequest {
 	_, _ = b, x
 	x.xxx_hidden_Payload = b.Payload
 	if b.PublicKey != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
 		x.xxx_hidden_PublicKey = b.PublicKey
 	}
 	if b.Signature != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
 		x.xxx_hidden_Signature = b.Signature
 	}
+	if b.RedeemScript != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_RedeemScript = b.RedeemScript
+	}
 	return m0
 }
 
@@ -1040,83 +1227,1444 @@ func (b0 CreateMintResponse_builder) Build() *CreateMintResponse {
 	return m0
 }
 
-var File_mints_proto protoreflect.FileDescriptor
+type AmendMintRequest struct {
+	state                   protoimpl.MessageState   `protogen:"opaque.v1"`
+	xxx_hidden_Payload      *AmendMintRequestPayload `protobuf:"bytes,1,opt,name=payload"`
+	xxx_hidden_PublicKey    *string                  `protobuf:"bytes,2,opt,name=public_key,json=publicKey"`
+	xxx_hidden_Signature    *string                  `protobuf:"bytes,3,opt,name=signature"`
+	xxx_hidden_RedeemScript *string                  `protobuf:"bytes,4,opt,name=redeem_script,json=redeemScript"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
 
-const file_mints_proto_rawDesc = "" +
-	"\n" +
-	"\vmints.proto\x12\x14fractalengine.rpc.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\fcommon.proto\x1a\vtypes.proto\"u\n" +
-	"\x0fGetMintsRequest\x121\n" +
-	"\x05limit\x18\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\x05limit\x12/\n" +
-	"\x04page\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\x04page\"@\n" +
-	"\x0eGetMintRequest\x12.\n" +
-	"\x04hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\x04hash\"\x84\x01\n" +
-	"\x10GetMintsResponse\x12\x14\n" +
-	"\x05limit\x18\x01 \x01(\x05R\x05limit\x120\n" +
-	"\x05mints\x18\x02 \x03(\v2\x1a.fractalengine.rpc.v1.MintR\x05mints\x12\x12\n" +
-	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05total\x18\x04 \x01(\x05R\x05total\"A\n" +
-	"\x0fGetMintResponse\x12.\n" +
-	"\x04mint\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.MintR\x04mint\"\x9a\x01\n" +
-	"\x11CreateMintRequest\x12H\n" +
-	"\apayload\x18\x01 \x01(\v2..fractalengine.rpc.v1.CreateMintRequestPayloadR\apayload\x12\x1d\n" +
-	"\n" +
-	"public_key\x18\x02 \x01(\tR\tpublicKey\x12\x1c\n" +
-	"\tsignature\x18\x03 \x01(\tR\tsignature\"\xf6\x05\n" +
-	"\x18CreateMintRequestPayload\x12I\n" +
-	"\x0easset_managers\x18\x01 \x03(\v2\".fractalengine.rpc.v1.AssetManagerR\rassetManagers\x12(\n" +
-	"\x10contract_of_sale\x18\x02 \x01(\tR\x0econtractOfSale\x12)\n" +
-	"\vdescription\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\vdescription\x12\x19\n" +
-	"\bfeed_url\x18\x04 \x01(\tR\afeedUrl\x12.\n" +
-	"\x0efraction_count\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\rfractionCount\x12O\n" +
-	"\x0elockup_options\x18\x06 \x01(\v2(.fractalengine.rpc.v1.StringInterfaceMapR\rlockupOptions\x12D\n" +
-	"\bmetadata\x18\a \x01(\v2(.fractalengine.rpc.v1.StringInterfaceMapR\bmetadata\x12%\n" +
-	"\x0emin_signatures\x18\b \x01(\x05R\rminSignatures\x12B\n" +
-	"\rowner_address\x18\t \x01(\v2\x1d.fractalengine.rpc.v1.AddressR\fownerAddress\x12L\n" +
-	"\frequirements\x18\n" +
-	" \x01(\v2(.fractalengine.rpc.v1.StringInterfaceMapR\frequirements\x12l\n" +
-	"\x1asignature_requirement_type\x18\v \x01(\x0e2..fractalengine.rpc.v1.SignatureRequirementTypeR\x18signatureRequirementType\x12\x12\n" +
-	"\x04tags\x18\f \x03(\tR\x04tags\x12\x1d\n" +
-	"\x05title\x18\r \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x05title\"~\n" +
-	"\x12CreateMintResponse\x128\n" +
-	"\x18encoded_transaction_body\x18\x01 \x01(\tR\x16encodedTransactionBody\x12.\n" +
-	"\x04hash\x18\x02 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\x04hashB.Z,dogecoin.org/fractal-engine/pkg/rpc/protocolb\beditionsp\xe8\a"
+func (x *AmendMintRequest) Reset() {
+	*x = AmendMintRequest{}
+	mi := &file_mints_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AmendMintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendMintRequest) ProtoMessage() {}
+
+func (x *AmendMintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mints_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AmendMintRequest) GetPayload() *AmendMintRequestPayload {
+	if x != nil {
+		return x.xxx_hidden_Payload
+	}
+	return nil
+}
+
+func (x *AmendMintRequest) GetPublicKey() string {
+	if x != nil {
+		if x.xxx_hidden_PublicKey != nil {
+			return *x.xxx_hidden_PublicKey
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AmendMintRequest) GetSignature() string {
+	if x != nil {
+		if x.xxx_hidden_Signature != nil {
+			return *x.xxx_hidden_Signature
+		}
+		return ""
+	}
+	return ""
+}
 
-var file_mints_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+func (x *AmendMintRequest) GetRedeemScript() string {
+	if x != nil {
+		if x.xxx_hidden_RedeemScript != nil {
+			return *x.xxx_hidden_RedeemScript
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AmendMintRequest) SetPayload(v *AmendMintRequestPayload) {
+	x.xxx_hidden_Payload = v
+}
+
+func (x *AmendMintRequest) SetPublicKey(v string) {
+	x.xxx_hidden_PublicKey = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *AmendMintRequest) SetSignature(v string) {
+	x.xxx_hidden_Signature = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *AmendMintRequest) SetRedeemScript(v string) {
+	x.xxx_hidden_RedeemScript = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *AmendMintRequest) HasPayload() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Payload != nil
+}
+
+func (x *AmendMintRequest) HasPublicKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *AmendMintRequest) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *AmendMintRequest) HasRedeemScript() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *AmendMintRequest) ClearPayload() {
+	x.xxx_hidden_Payload = nil
+}
+
+func (x *AmendMintRequest) ClearPublicKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_PublicKey = nil
+}
+
+func (x *AmendMintRequest) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Signature = nil
+}
+
+func (x *AmendMintRequest) ClearRedeemScript() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_RedeemScript = nil
+}
+
+type AmendMintRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Payload      *AmendMintRequestPayload
+	PublicKey    *string
+	Signature    *string
+	RedeemScript *string
+}
+
+func (b0 AmendMintRequest_builder) Build() *AmendMintRequest {
+	m0 := &AmendMintRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Payload = b.Payload
+	if b.PublicKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_PublicKey = b.PublicKey
+	}
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	if b.RedeemScript != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_RedeemScript = b.RedeemScript
+	}
+	return m0
+}
+
+type AmendMintRequestPayload struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MintHash       *Hash                  `protobuf:"bytes,1,opt,name=mint_hash,json=mintHash"`
+	xxx_hidden_Description    *string                `protobuf:"bytes,2,opt,name=description"`
+	xxx_hidden_Metadata       *StringInterfaceMap    `protobuf:"bytes,3,opt,name=metadata"`
+	xxx_hidden_FeedUrl        *string                `protobuf:"bytes,4,opt,name=feed_url,json=feedUrl"`
+	xxx_hidden_ContractOfSale *string                `protobuf:"bytes,5,opt,name=contract_of_sale,json=contractOfSale"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *AmendMintRequestPayload) Reset() {
+	*x = AmendMintRequestPayload{}
+	mi := &file_mints_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AmendMintRequestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendMintRequestPayload) ProtoMessage() {}
+
+func (x *AmendMintRequestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_mints_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AmendMintRequestPayload) GetMintHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_MintHash
+	}
+	return nil
+}
+
+func (x *AmendMintRequestPayload) GetDescription() string {
+	if x != nil {
+		if x.xxx_hidden_Description != nil {
+			return *x.xxx_hidden_Description
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AmendMintRequestPayload) GetMetadata() *StringInterfaceMap {
+	if x != nil {
+		return x.xxx_hidden_Metadata
+	}
+	return nil
+}
+
+func (x *AmendMintRequestPayload) GetFeedUrl() string {
+	if x != nil {
+		if x.xxx_hidden_FeedUrl != nil {
+			return *x.xxx_hidden_FeedUrl
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AmendMintRequestPayload) GetContractOfSale() string {
+	if x != nil {
+		if x.xxx_hidden_ContractOfSale != nil {
+			return *x.xxx_hidden_ContractOfSale
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AmendMintRequestPayload) SetMintHash(v *Hash) {
+	x.xxx_hidden_MintHash = v
+}
+
+func (x *AmendMintRequestPayload) SetDescription(v string) {
+	x.xxx_hidden_Description = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *AmendMintRequestPayload) SetMetadata(v *StringInterfaceMap) {
+	x.xxx_hidden_Metadata = v
+}
+
+func (x *AmendMintRequestPayload) SetFeedUrl(v string) {
+	x.xxx_hidden_FeedUrl = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *AmendMintRequestPayload) SetContractOfSale(v string) {
+	x.xxx_hidden_ContractOfSale = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *AmendMintRequestPayload) HasMintHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MintHash != nil
+}
+
+func (x *AmendMintRequestPayload) HasDescription() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *AmendMintRequestPayload) HasMetadata() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Metadata != nil
+}
+
+func (x *AmendMintRequestPayload) HasFeedUrl() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *AmendMintRequestPayload) HasContractOfSale() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *AmendMintRequestPayload) ClearMintHash() {
+	x.xxx_hidden_MintHash = nil
+}
+
+func (x *AmendMintRequestPayload) ClearDescription() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Description = nil
+}
+
+func (x *AmendMintRequestPayload) ClearMetadata() {
+	x.xxx_hidden_Metadata = nil
+}
+
+func (x *AmendMintRequestPayload) ClearFeedUrl() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_FeedUrl = nil
+}
+
+func (x *AmendMintRequestPayload) ClearContractOfSale() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_ContractOfSale = nil
+}
+
+type AmendMintRequestPayload_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MintHash       *Hash
+	Description    *string
+	Metadata       *StringInterfaceMap
+	FeedUrl        *string
+	ContractOfSale *string
+}
+
+func (b0 AmendMintRequestPayload_builder) Build() *AmendMintRequestPayload {
+	m0 := &AmendMintRequestPayload{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_MintHash = b.MintHash
+	if b.Description != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_Description = b.Description
+	}
+	x.xxx_hidden_Metadata = b.Metadata
+	if b.FeedUrl != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_FeedUrl = b.FeedUrl
+	}
+	if b.ContractOfSale != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_ContractOfSale = b.ContractOfSale
+	}
+	return m0
+}
+
+type AmendMintResponse struct {
+	state                             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Hash                   *Hash                  `protobuf:"bytes,1,opt,name=hash"`
+	xxx_hidden_EncodedTransactionBody *string                `protobuf:"bytes,2,opt,name=encoded_transaction_body,json=encodedTransactionBody"`
+	XXX_raceDetectHookData            protoimpl.RaceDetectHookData
+	XXX_presence                      [1]uint32
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *AmendMintResponse) Reset() {
+	*x = AmendMintResponse{}
+	mi := &file_mints_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AmendMintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendMintResponse) ProtoMessage() {}
+
+func (x *AmendMintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mints_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AmendMintResponse) GetHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_Hash
+	}
+	return nil
+}
+
+func (x *AmendMintResponse) GetEncodedTransactionBody() string {
+	if x != nil {
+		if x.xxx_hidden_EncodedTransactionBody != nil {
+			return *x.xxx_hidden_EncodedTransactionBody
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AmendMintResponse) SetHash(v *Hash) {
+	x.xxx_hidden_Hash = v
+}
+
+func (x *AmendMintResponse) SetEncodedTransactionBody(v string) {
+	x.xxx_hidden_EncodedTransactionBody = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *AmendMintResponse) HasHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Hash != nil
+}
+
+func (x *AmendMintResponse) HasEncodedTransactionBody() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *AmendMintResponse) ClearHash() {
+	x.xxx_hidden_Hash = nil
+}
+
+func (x *AmendMintResponse) ClearEncodedTransactionBody() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_EncodedTransactionBody = nil
+}
+
+type AmendMintResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Hash                   *Hash
+	EncodedTransactionBody *string
+}
+
+func (b0 AmendMintResponse_builder) Build() *AmendMintResponse {
+	m0 := &AmendMintResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Hash = b.Hash
+	if b.EncodedTransactionBody != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_EncodedTransactionBody = b.EncodedTransactionBody
+	}
+	return m0
+}
+
+type CreateMintAmendmentSignatureRequest struct {
+	state              protoimpl.MessageState                      `protogen:"opaque.v1"`
+	xxx_hidden_Payload *CreateMintAmendmentSignatureRequestPayload `protobuf:"bytes,1,opt,name=payload"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateMintAmendmentSignatureRequest) Reset() {
+	*x = CreateMintAmendmentSignatureRequest{}
+	mi := &file_mints_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMintAmendmentSignatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMintAmendmentSignatureRequest) ProtoMessage() {}
+
+func (x *CreateMintAmendmentSignatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mints_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateMintAmendmentSignatureRequest) GetPayload() *CreateMintAmendmentSignatureRequestPayload {
+	if x != nil {
+		return x.xxx_hidden_Payload
+	}
+	return nil
+}
+
+func (x *CreateMintAmendmentSignatureRequest) SetPayload(v *CreateMintAmendmentSignatureRequestPayload) {
+	x.xxx_hidden_Payload = v
+}
+
+func (x *CreateMintAmendmentSignatureRequest) HasPayload() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Payload != nil
+}
+
+func (x *CreateMintAmendmentSignatureRequest) ClearPayload() {
+	x.xxx_hidden_Payload = nil
+}
+
+type CreateMintAmendmentSignatureRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Payload *CreateMintAmendmentSignatureRequestPayload
+}
+
+func (b0 CreateMintAmendmentSignatureRequest_builder) Build() *CreateMintAmendmentSignatureRequest {
+	m0 := &CreateMintAmendmentSignatureRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Payload = b.Payload
+	return m0
+}
+
+type CreateMintAmendmentSignatureRequestPayload struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_AmendmentHash *string                `protobuf:"bytes,1,opt,name=amendment_hash,json=amendmentHash"`
+	xxx_hidden_MintHash      *Hash                  `protobuf:"bytes,2,opt,name=mint_hash,json=mintHash"`
+	xxx_hidden_PublicKey     *string                `protobuf:"bytes,3,opt,name=public_key,json=publicKey"`
+	xxx_hidden_Signature     *string                `protobuf:"bytes,4,opt,name=signature"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) Reset() {
+	*x = CreateMintAmendmentSignatureRequestPayload{}
+	mi := &file_mints_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMintAmendmentSignatureRequestPayload) ProtoMessage() {}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_mints_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) GetAmendmentHash() string {
+	if x != nil {
+		if x.xxx_hidden_AmendmentHash != nil {
+			return *x.xxx_hidden_AmendmentHash
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) GetMintHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_MintHash
+	}
+	return nil
+}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) GetPublicKey() string {
+	if x != nil {
+		if x.xxx_hidden_PublicKey != nil {
+			return *x.xxx_hidden_PublicKey
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) GetSignature() string {
+	if x != nil {
+		if x.xxx_hidden_Signature != nil {
+			return *x.xxx_hidden_Signature
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) SetAmendmentHash(v string) {
+	x.xxx_hidden_AmendmentHash = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) SetMintHash(v *Hash) {
+	x.xxx_hidden_MintHash = v
+}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) SetPublicKey(v string) {
+	x.xxx_hidden_PublicKey = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) SetSignature(v string) {
+	x.xxx_hidden_Signature = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) HasAmendmentHash() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) HasMintHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MintHash != nil
+}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) HasPublicKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) ClearAmendmentHash() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_AmendmentHash = nil
+}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) ClearMintHash() {
+	x.xxx_hidden_MintHash = nil
+}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) ClearPublicKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_PublicKey = nil
+}
+
+func (x *CreateMintAmendmentSignatureRequestPayload) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Signature = nil
+}
+
+type CreateMintAmendmentSignatureRequestPayload_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	AmendmentHash *string
+	MintHash      *Hash
+	PublicKey     *string
+	Signature     *string
+}
+
+func (b0 CreateMintAmendmentSignatureRequestPayload_builder) Build() *CreateMintAmendmentSignatureRequestPayload {
+	m0 := &CreateMintAmendmentSignatureRequestPayload{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.AmendmentHash != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_AmendmentHash = b.AmendmentHash
+	}
+	x.xxx_hidden_MintHash = b.MintHash
+	if b.PublicKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_PublicKey = b.PublicKey
+	}
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	return m0
+}
+
+type CreateMintAmendmentSignatureResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateMintAmendmentSignatureResponse) Reset() {
+	*x = CreateMintAmendmentSignatureResponse{}
+	mi := &file_mints_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMintAmendmentSignatureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMintAmendmentSignatureResponse) ProtoMessage() {}
+
+func (x *CreateMintAmendmentSignatureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mints_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateMintAmendmentSignatureResponse) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateMintAmendmentSignatureResponse) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *CreateMintAmendmentSignatureResponse) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CreateMintAmendmentSignatureResponse) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type CreateMintAmendmentSignatureResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 CreateMintAmendmentSignatureResponse_builder) Build() *CreateMintAmendmentSignatureResponse {
+	m0 := &CreateMintAmendmentSignatureResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+type TransferMintOwnershipRequest struct {
+	state                        protoimpl.MessageState               `protogen:"opaque.v1"`
+	xxx_hidden_Payload           *TransferMintOwnershipRequestPayload `protobuf:"bytes,1,opt,name=payload"`
+	xxx_hidden_PublicKey         *string                              `protobuf:"bytes,2,opt,name=public_key,json=publicKey"`
+	xxx_hidden_Signature         *string                              `protobuf:"bytes,3,opt,name=signature"`
+	xxx_hidden_RedeemScript      *string                              `protobuf:"bytes,4,opt,name=redeem_script,json=redeemScript"`
+	xxx_hidden_NewOwnerSignature *string                              `protobuf:"bytes,5,opt,name=new_owner_signature,json=newOwnerSignature"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *TransferMintOwnershipRequest) Reset() {
+	*x = TransferMintOwnershipRequest{}
+	mi := &file_mints_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferMintOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferMintOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferMintOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mints_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TransferMintOwnershipRequest) GetPayload() *TransferMintOwnershipRequestPayload {
+	if x != nil {
+		return x.xxx_hidden_Payload
+	}
+	return nil
+}
+
+func (x *TransferMintOwnershipRequest) GetPublicKey() string {
+	if x != nil {
+		if x.xxx_hidden_PublicKey != nil {
+			return *x.xxx_hidden_PublicKey
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TransferMintOwnershipRequest) GetSignature() string {
+	if x != nil {
+		if x.xxx_hidden_Signature != nil {
+			return *x.xxx_hidden_Signature
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TransferMintOwnershipRequest) GetRedeemScript() string {
+	if x != nil {
+		if x.xxx_hidden_RedeemScript != nil {
+			return *x.xxx_hidden_RedeemScript
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TransferMintOwnershipRequest) GetNewOwnerSignature() string {
+	if x != nil {
+		if x.xxx_hidden_NewOwnerSignature != nil {
+			return *x.xxx_hidden_NewOwnerSignature
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TransferMintOwnershipRequest) SetPayload(v *TransferMintOwnershipRequestPayload) {
+	x.xxx_hidden_Payload = v
+}
+
+func (x *TransferMintOwnershipRequest) SetPublicKey(v string) {
+	x.xxx_hidden_PublicKey = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *TransferMintOwnershipRequest) SetSignature(v string) {
+	x.xxx_hidden_Signature = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *TransferMintOwnershipRequest) SetRedeemScript(v string) {
+	x.xxx_hidden_RedeemScript = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *TransferMintOwnershipRequest) SetNewOwnerSignature(v string) {
+	x.xxx_hidden_NewOwnerSignature = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *TransferMintOwnershipRequest) HasPayload() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Payload != nil
+}
+
+func (x *TransferMintOwnershipRequest) HasPublicKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *TransferMintOwnershipRequest) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *TransferMintOwnershipRequest) HasRedeemScript() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *TransferMintOwnershipRequest) HasNewOwnerSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *TransferMintOwnershipRequest) ClearPayload() {
+	x.xxx_hidden_Payload = nil
+}
+
+func (x *TransferMintOwnershipRequest) ClearPublicKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_PublicKey = nil
+}
+
+func (x *TransferMintOwnershipRequest) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Signature = nil
+}
+
+func (x *TransferMintOwnershipRequest) ClearRedeemScript() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_RedeemScript = nil
+}
+
+func (x *TransferMintOwnershipRequest) ClearNewOwnerSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_NewOwnerSignature = nil
+}
+
+type TransferMintOwnershipRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Payload           *TransferMintOwnershipRequestPayload
+	PublicKey         *string
+	Signature         *string
+	RedeemScript      *string
+	NewOwnerSignature *string
+}
+
+func (b0 TransferMintOwnershipRequest_builder) Build() *TransferMintOwnershipRequest {
+	m0 := &TransferMintOwnershipRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Payload = b.Payload
+	if b.PublicKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_PublicKey = b.PublicKey
+	}
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	if b.RedeemScript != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_RedeemScript = b.RedeemScript
+	}
+	if b.NewOwnerSignature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_NewOwnerSignature = b.NewOwnerSignature
+	}
+	return m0
+}
+
+type TransferMintOwnershipRequestPayload struct {
+	state                               protoimpl.MessageState   `protogen:"opaque.v1"`
+	xxx_hidden_MintHash                 *Hash                    `protobuf:"bytes,1,opt,name=mint_hash,json=mintHash"`
+	xxx_hidden_NewOwnerAddress          *Address                 `protobuf:"bytes,2,opt,name=new_owner_address,json=newOwnerAddress"`
+	xxx_hidden_NewPublicKey             *string                  `protobuf:"bytes,3,opt,name=new_public_key,json=newPublicKey"`
+	xxx_hidden_SignatureRequirementType SignatureRequirementType `protobuf:"varint,4,opt,name=signature_requirement_type,json=signatureRequirementType,enum=fractalengine.rpc.v1.SignatureRequirementType"`
+	xxx_hidden_AssetManagers            *[]*AssetManager         `protobuf:"bytes,5,rep,name=asset_managers,json=assetManagers"`
+	xxx_hidden_MinSignatures            int32                    `protobuf:"varint,6,opt,name=min_signatures,json=minSignatures"`
+	XXX_raceDetectHookData              protoimpl.RaceDetectHookData
+	XXX_presence                        [1]uint32
+	unknownFields                       protoimpl.UnknownFields
+	sizeCache                           protoimpl.SizeCache
+}
+
+func (x *TransferMintOwnershipRequestPayload) Reset() {
+	*x = TransferMintOwnershipRequestPayload{}
+	mi := &file_mints_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferMintOwnershipRequestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferMintOwnershipRequestPayload) ProtoMessage() {}
+
+func (x *TransferMintOwnershipRequestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_mints_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TransferMintOwnershipRequestPayload) GetMintHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_MintHash
+	}
+	return nil
+}
+
+func (x *TransferMintOwnershipRequestPayload) GetNewOwnerAddress() *Address {
+	if x != nil {
+		return x.xxx_hidden_NewOwnerAddress
+	}
+	return nil
+}
+
+func (x *TransferMintOwnershipRequestPayload) GetNewPublicKey() string {
+	if x != nil {
+		if x.xxx_hidden_NewPublicKey != nil {
+			return *x.xxx_hidden_NewPublicKey
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TransferMintOwnershipRequestPayload) GetSignatureRequirementType() SignatureRequirementType {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 3) {
+			return x.xxx_hidden_SignatureRequirementType
+		}
+	}
+	return SignatureRequirementType_SIGNATURE_REQUIREMENT_TYPE_UNSPECIFIED
+}
+
+func (x *TransferMintOwnershipRequestPayload) GetAssetManagers() []*AssetManager {
+	if x != nil {
+		if x.xxx_hidden_AssetManagers != nil {
+			return *x.xxx_hidden_AssetManagers
+		}
+	}
+	return nil
+}
+
+func (x *TransferMintOwnershipRequestPayload) GetMinSignatures() int32 {
+	if x != nil {
+		return x.xxx_hidden_MinSignatures
+	}
+	return 0
+}
+
+func (x *TransferMintOwnershipRequestPayload) SetMintHash(v *Hash) {
+	x.xxx_hidden_MintHash = v
+}
+
+func (x *TransferMintOwnershipRequestPayload) SetNewOwnerAddress(v *Address) {
+	x.xxx_hidden_NewOwnerAddress = v
+}
+
+func (x *TransferMintOwnershipRequestPayload) SetNewPublicKey(v string) {
+	x.xxx_hidden_NewPublicKey = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 6)
+}
+
+func (x *TransferMintOwnershipRequestPayload) SetSignatureRequirementType(v SignatureRequirementType) {
+	x.xxx_hidden_SignatureRequirementType = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+}
+
+func (x *TransferMintOwnershipRequestPayload) SetAssetManagers(v []*AssetManager) {
+	x.xxx_hidden_AssetManagers = &v
+}
+
+func (x *TransferMintOwnershipRequestPayload) SetMinSignatures(v int32) {
+	x.xxx_hidden_MinSignatures = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 6)
+}
+
+func (x *TransferMintOwnershipRequestPayload) HasMintHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MintHash != nil
+}
+
+func (x *TransferMintOwnershipRequestPayload) HasNewOwnerAddress() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_NewOwnerAddress != nil
+}
+
+func (x *TransferMintOwnershipRequestPayload) HasNewPublicKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *TransferMintOwnershipRequestPayload) HasSignatureRequirementType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *TransferMintOwnershipRequestPayload) HasMinSignatures() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *TransferMintOwnershipRequestPayload) ClearMintHash() {
+	x.xxx_hidden_MintHash = nil
+}
+
+func (x *TransferMintOwnershipRequestPayload) ClearNewOwnerAddress() {
+	x.xxx_hidden_NewOwnerAddress = nil
+}
+
+func (x *TransferMintOwnershipRequestPayload) ClearNewPublicKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_NewPublicKey = nil
+}
+
+func (x *TransferMintOwnershipRequestPayload) ClearSignatureRequirementType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_SignatureRequirementType = SignatureRequirementType_SIGNATURE_REQUIREMENT_TYPE_UNSPECIFIED
+}
+
+func (x *TransferMintOwnershipRequestPayload) ClearMinSignatures() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_MinSignatures = 0
+}
+
+type TransferMintOwnershipRequestPayload_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MintHash                 *Hash
+	NewOwnerAddress          *Address
+	NewPublicKey             *string
+	SignatureRequirementType *SignatureRequirementType
+	AssetManagers            []*AssetManager
+	MinSignatures            *int32
+}
+
+func (b0 TransferMintOwnershipRequestPayload_builder) Build() *TransferMintOwnershipRequestPayload {
+	m0 := &TransferMintOwnershipRequestPayload{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_MintHash = b.MintHash
+	x.xxx_hidden_NewOwnerAddress = b.NewOwnerAddress
+	if b.NewPublicKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 6)
+		x.xxx_hidden_NewPublicKey = b.NewPublicKey
+	}
+	if b.SignatureRequirementType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		x.xxx_hidden_SignatureRequirementType = *b.SignatureRequirementType
+	}
+	x.xxx_hidden_AssetManagers = &b.AssetManagers
+	if b.MinSignatures != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 6)
+		x.xxx_hidden_MinSignatures = *b.MinSignatures
+	}
+	return m0
+}
+
+type TransferMintOwnershipResponse struct {
+	state                             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Hash                   *Hash                  `protobuf:"bytes,1,opt,name=hash"`
+	xxx_hidden_EncodedTransactionBody *string                `protobuf:"bytes,2,opt,name=encoded_transaction_body,json=encodedTransactionBody"`
+	XXX_raceDetectHookData            protoimpl.RaceDetectHookData
+	XXX_presence                      [1]uint32
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *TransferMintOwnershipResponse) Reset() {
+	*x = TransferMintOwnershipResponse{}
+	mi := &file_mints_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferMintOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferMintOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferMintOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mints_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TransferMintOwnershipResponse) GetHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_Hash
+	}
+	return nil
+}
+
+func (x *TransferMintOwnershipResponse) GetEncodedTransactionBody() string {
+	if x != nil {
+		if x.xxx_hidden_EncodedTransactionBody != nil {
+			return *x.xxx_hidden_EncodedTransactionBody
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TransferMintOwnershipResponse) SetHash(v *Hash) {
+	x.xxx_hidden_Hash = v
+}
+
+func (x *TransferMintOwnershipResponse) SetEncodedTransactionBody(v string) {
+	x.xxx_hidden_EncodedTransactionBody = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *TransferMintOwnershipResponse) HasHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Hash != nil
+}
+
+func (x *TransferMintOwnershipResponse) HasEncodedTransactionBody() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *TransferMintOwnershipResponse) ClearHash() {
+	x.xxx_hidden_Hash = nil
+}
+
+func (x *TransferMintOwnershipResponse) ClearEncodedTransactionBody() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_EncodedTransactionBody = nil
+}
+
+type TransferMintOwnershipResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Hash                   *Hash
+	EncodedTransactionBody *string
+}
+
+func (b0 TransferMintOwnershipResponse_builder) Build() *TransferMintOwnershipResponse {
+	m0 := &TransferMintOwnershipResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Hash = b.Hash
+	if b.EncodedTransactionBody != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_EncodedTransactionBody = b.EncodedTransactionBody
+	}
+	return m0
+}
+
+var File_mints_proto protoreflect.FileDescriptor
+
+const file_mints_proto_rawDesc = "" +
+	"\n" +
+	"\vmints.proto\x12\x14fractalengine.rpc.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\fcommon.proto\x1a\vtypes.proto\"u\n" +
+	"\x0fGetMintsRequest\x121\n" +
+	"\x05limit\x18\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\x05limit\x12/\n" +
+	"\x04page\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\x04page\"c\n" +
+	"\x0eGetMintRequest\x12.\n" +
+	"\x04hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\x04hash\x12!\n" +
+	"\aversion\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\aversion\"\x84\x01\n" +
+	"\x10GetMintsResponse\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x120\n" +
+	"\x05mints\x18\x02 \x03(\v2\x1a.fractalengine.rpc.v1.MintR\x05mints\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\"\xd6\x01\n" +
+	"\x0fGetMintResponse\x12.\n" +
+	"\x04mint\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.MintR\x04mint\x12#\n" +
+	"\rburned_supply\x18\x02 \x01(\x05R\fburnedSupply\x12-\n" +
+	"\x12circulating_supply\x18\x03 \x01(\x05R\x11circulatingSupply\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\x12%\n" +
+	"\x0elatest_version\x18\x05 \x01(\x05R\rlatestVersion\"\xbf\x01\n" +
+	"\x11CreateMintRequest\x12H\n" +
+	"\apayload\x18\x01 \x01(\v2..fractalengine.rpc.v1.CreateMintRequestPayloadR\apayload\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\x12#\n" +
+	"\rredeem_script\x18\x04 \x01(\tR\fredeemScript\"\xf6\x05\n" +
+	"\x18CreateMintRequestPayload\x12I\n" +
+	"\x0easset_managers\x18\x01 \x03(\v2\".fractalengine.rpc.v1.AssetManagerR\rassetManagers\x12(\n" +
+	"\x10contract_of_sale\x18\x02 \x01(\tR\x0econtractOfSale\x12)\n" +
+	"\vdescription\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\vdescription\x12\x19\n" +
+	"\bfeed_url\x18\x04 \x01(\tR\afeedUrl\x12.\n" +
+	"\x0efraction_count\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\rfractionCount\x12O\n" +
+	"\x0elockup_options\x18\x06 \x01(\v2(.fractalengine.rpc.v1.StringInterfaceMapR\rlockupOptions\x12D\n" +
+	"\bmetadata\x18\a \x01(\v2(.fractalengine.rpc.v1.StringInterfaceMapR\bmetadata\x12%\n" +
+	"\x0emin_signatures\x18\b \x01(\x05R\rminSignatures\x12B\n" +
+	"\rowner_address\x18\t \x01(\v2\x1d.fractalengine.rpc.v1.AddressR\fownerAddress\x12L\n" +
+	"\frequirements\x18\n" +
+	" \x01(\v2(.fractalengine.rpc.v1.StringInterfaceMapR\frequirements\x12l\n" +
+	"\x1asignature_requirement_type\x18\v \x01(\x0e2..fractalengine.rpc.v1.SignatureRequirementTypeR\x18signatureRequirementType\x12\x12\n" +
+	"\x04tags\x18\f \x03(\tR\x04tags\x12\x1d\n" +
+	"\x05title\x18\r \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x05title\"~\n" +
+	"\x12CreateMintResponse\x128\n" +
+	"\x18encoded_transaction_body\x18\x01 \x01(\tR\x16encodedTransactionBody\x12.\n" +
+	"\x04hash\x18\x02 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\x04hash\"\xcf\x01\n" +
+	"\x10AmendMintRequest\x12G\n" +
+	"\apayload\x18\x01 \x01(\v2-.fractalengine.rpc.v1.AmendMintRequestPayloadR\apayload\x12&\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tpublicKey\x12%\n" +
+	"\tsignature\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tsignature\x12#\n" +
+	"\rredeem_script\x18\x04 \x01(\tR\fredeemScript\"\x88\x02\n" +
+	"\x17AmendMintRequestPayload\x127\n" +
+	"\tmint_hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\bmintHash\x12)\n" +
+	"\vdescription\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\vdescription\x12D\n" +
+	"\bmetadata\x18\x03 \x01(\v2(.fractalengine.rpc.v1.StringInterfaceMapR\bmetadata\x12\x19\n" +
+	"\bfeed_url\x18\x04 \x01(\tR\afeedUrl\x12(\n" +
+	"\x10contract_of_sale\x18\x05 \x01(\tR\x0econtractOfSale\"}\n" +
+	"\x11AmendMintResponse\x12.\n" +
+	"\x04hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\x04hash\x128\n" +
+	"\x18encoded_transaction_body\x18\x02 \x01(\tR\x16encodedTransactionBody\"\x81\x01\n" +
+	"#CreateMintAmendmentSignatureRequest\x12Z\n" +
+	"\apayload\x18\x01 \x01(\v2@.fractalengine.rpc.v1.CreateMintAmendmentSignatureRequestPayloadR\apayload\"\xf7\x01\n" +
+	"*CreateMintAmendmentSignatureRequestPayload\x12A\n" +
+	"\x0eamendment_hash\x18\x01 \x01(\tB\x1a\xbaH\x17r\x15\x10\x012\x11^[a-fA-F0-9]{64}$R\ramendmentHash\x127\n" +
+	"\tmint_hash\x18\x02 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\bmintHash\x12&\n" +
+	"\n" +
+	"public_key\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tpublicKey\x12%\n" +
+	"\tsignature\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tsignature\"6\n" +
+	"$CreateMintAmendmentSignatureResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa0\x02\n" +
+	"\x1cTransferMintOwnershipRequest\x12S\n" +
+	"\apayload\x18\x01 \x01(\v29.fractalengine.rpc.v1.TransferMintOwnershipRequestPayloadR\apayload\x12&\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tpublicKey\x12%\n" +
+	"\tsignature\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tsignature\x12#\n" +
+	"\rredeem_script\x18\x04 \x01(\tR\fredeemScript\x127\n" +
+	"\x13new_owner_signature\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x11newOwnerSignature\"\xb8\x03\n" +
+	"#TransferMintOwnershipRequestPayload\x127\n" +
+	"\tmint_hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\bmintHash\x12I\n" +
+	"\x11new_owner_address\x18\x02 \x01(\v2\x1d.fractalengine.rpc.v1.AddressR\x0fnewOwnerAddress\x12-\n" +
+	"\x0enew_public_key\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\fnewPublicKey\x12l\n" +
+	"\x1asignature_requirement_type\x18\x04 \x01(\x0e2..fractalengine.rpc.v1.SignatureRequirementTypeR\x18signatureRequirementType\x12I\n" +
+	"\x0easset_managers\x18\x05 \x03(\v2\".fractalengine.rpc.v1.AssetManagerR\rassetManagers\x12%\n" +
+	"\x0emin_signatures\x18\x06 \x01(\x05R\rminSignatures\"\x89\x01\n" +
+	"\x1dTransferMintOwnershipResponse\x12.\n" +
+	"\x04hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\x04hash\x128\n" +
+	"\x18encoded_transaction_body\x18\x02 \x01(\tR\x16encodedTransactionBodyB.Z,dogecoin.org/fractal-engine/pkg/rpc/protocolb\beditionsp\xe8\a"
+
+var file_mints_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_mints_proto_goTypes = []any{
-	(*GetMintsRequest)(nil),          // 0: fractalengine.rpc.v1.GetMintsRequest
-	(*GetMintRequest)(nil),           // 1: fractalengine.rpc.v1.GetMintRequest
-	(*GetMintsResponse)(nil),         // 2: fractalengine.rpc.v1.GetMintsResponse
-	(*GetMintResponse)(nil),          // 3: fractalengine.rpc.v1.GetMintResponse
-	(*CreateMintRequest)(nil),        // 4: fractalengine.rpc.v1.CreateMintRequest
-	(*CreateMintRequestPayload)(nil), // 5: fractalengine.rpc.v1.CreateMintRequestPayload
-	(*CreateMintResponse)(nil),       // 6: fractalengine.rpc.v1.CreateMintResponse
-	(*wrapperspb.Int32Value)(nil),    // 7: google.protobuf.Int32Value
-	(*Hash)(nil),                     // 8: fractalengine.rpc.v1.Hash
-	(*Mint)(nil),                     // 9: fractalengine.rpc.v1.Mint
-	(*AssetManager)(nil),             // 10: fractalengine.rpc.v1.AssetManager
-	(*StringInterfaceMap)(nil),       // 11: fractalengine.rpc.v1.StringInterfaceMap
-	(*Address)(nil),                  // 12: fractalengine.rpc.v1.Address
-	(SignatureRequirementType)(0),    // 13: fractalengine.rpc.v1.SignatureRequirementType
+	(*GetMintsRequest)(nil),                            // 0: fractalengine.rpc.v1.GetMintsRequest
+	(*GetMintRequest)(nil),                             // 1: fractalengine.rpc.v1.GetMintRequest
+	(*GetMintsResponse)(nil),                           // 2: fractalengine.rpc.v1.GetMintsResponse
+	(*GetMintResponse)(nil),                            // 3: fractalengine.rpc.v1.GetMintResponse
+	(*CreateMintRequest)(nil),                          // 4: fractalengine.rpc.v1.CreateMintRequest
+	(*CreateMintRequestPayload)(nil),                   // 5: fractalengine.rpc.v1.CreateMintRequestPayload
+	(*CreateMintResponse)(nil),                         // 6: fractalengine.rpc.v1.CreateMintResponse
+	(*AmendMintRequest)(nil),                           // 7: fractalengine.rpc.v1.AmendMintRequest
+	(*AmendMintRequestPayload)(nil),                    // 8: fractalengine.rpc.v1.AmendMintRequestPayload
+	(*AmendMintResponse)(nil),                          // 9: fractalengine.rpc.v1.AmendMintResponse
+	(*CreateMintAmendmentSignatureRequest)(nil),        // 10: fractalengine.rpc.v1.CreateMintAmendmentSignatureRequest
+	(*CreateMintAmendmentSignatureRequestPayload)(nil), // 11: fractalengine.rpc.v1.CreateMintAmendmentSignatureRequestPayload
+	(*CreateMintAmendmentSignatureResponse)(nil),       // 12: fractalengine.rpc.v1.CreateMintAmendmentSignatureResponse
+	(*TransferMintOwnershipRequest)(nil),               // 13: fractalengine.rpc.v1.TransferMintOwnershipRequest
+	(*TransferMintOwnershipRequestPayload)(nil),        // 14: fractalengine.rpc.v1.TransferMintOwnershipRequestPayload
+	(*TransferMintOwnershipResponse)(nil),              // 15: fractalengine.rpc.v1.TransferMintOwnershipResponse
+	(*wrapperspb.Int32Value)(nil),                      // 16: google.protobuf.Int32Value
+	(*Hash)(nil),                                       // 17: fractalengine.rpc.v1.Hash
+	(*Mint)(nil),                                       // 18: fractalengine.rpc.v1.Mint
+	(*AssetManager)(nil),                               // 19: fractalengine.rpc.v1.AssetManager
+	(*StringInterfaceMap)(nil),                         // 20: fractalengine.rpc.v1.StringInterfaceMap
+	(*Address)(nil),                                    // 21: fractalengine.rpc.v1.Address
+	(SignatureRequirementType)(0),                      // 22: fractalengine.rpc.v1.SignatureRequirementType
 }
 var file_mints_proto_depIdxs = []int32{
-	7,  // 0: fractalengine.rpc.v1.GetMintsRequest.limit:type_name -> google.protobuf.Int32Value
-	7,  // 1: fractalengine.rpc.v1.GetMintsRequest.page:type_name -> google.protobuf.Int32Value
-	8,  // 2: fractalengine.rpc.v1.GetMintRequest.hash:type_name -> fractalengine.rpc.v1.Hash
-	9,  // 3: fractalengine.rpc.v1.GetMintsResponse.mints:type_name -> fractalengine.rpc.v1.Mint
-	9,  // 4: fractalengine.rpc.v1.GetMintResponse.mint:type_name -> fractalengine.rpc.v1.Mint
+	16, // 0: fractalengine.rpc.v1.GetMintsRequest.limit:type_name -> google.protobuf.Int32Value
+	16, // 1: fractalengine.rpc.v1.GetMintsRequest.page:type_name -> google.protobuf.Int32Value
+	17, // 2: fractalengine.rpc.v1.GetMintRequest.hash:type_name -> fractalengine.rpc.v1.Hash
+	18, // 3: fractalengine.rpc.v1.GetMintsResponse.mints:type_name -> fractalengine.rpc.v1.Mint
+	18, // 4: fractalengine.rpc.v1.GetMintResponse.mint:type_name -> fractalengine.rpc.v1.Mint
 	5,  // 5: fractalengine.rpc.v1.CreateMintRequest.payload:type_name -> fractalengine.rpc.v1.CreateMintRequestPayload
-	10, // 6: fractalengine.rpc.v1.CreateMintRequestPayload.asset_managers:type_name -> fractalengine.rpc.v1.AssetManager
-	11, // 7: fractalengine.rpc.v1.CreateMintRequestPayload.lockup_options:type_name -> fractalengine.rpc.v1.StringInterfaceMap
-	11, // 8: fractalengine.rpc.v1.CreateMintRequestPayload.metadata:type_name -> fractalengine.rpc.v1.StringInterfaceMap
-	12, // 9: fractalengine.rpc.v1.CreateMintRequestPayload.owner_address:type_name -> fractalengine.rpc.v1.Address
-	11, // 10: fractalengine.rpc.v1.CreateMintRequestPayload.requirements:type_name -> fractalengine.rpc.v1.StringInterfaceMap
-	13, // 11: fractalengine.rpc.v1.CreateMintRequestPayload.signature_requirement_type:type_name -> fractalengine.rpc.v1.SignatureRequirementType
-	8,  // 12: fractalengine.rpc.v1.CreateMintResponse.hash:type_name -> fractalengine.rpc.v1.Hash
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	19, // 6: fractalengine.rpc.v1.CreateMintRequestPayload.asset_managers:type_name -> fractalengine.rpc.v1.AssetManager
+	20, // 7: fractalengine.rpc.v1.CreateMintRequestPayload.lockup_options:type_name -> fractalengine.rpc.v1.StringInterfaceMap
+	20, // 8: fractalengine.rpc.v1.CreateMintRequestPayload.metadata:type_name -> fractalengine.rpc.v1.StringInterfaceMap
+	21, // 9: fractalengine.rpc.v1.CreateMintRequestPayload.owner_address:type_name -> fractalengine.rpc.v1.Address
+	20, // 10: fractalengine.rpc.v1.CreateMintRequestPayload.requirements:type_name -> fractalengine.rpc.v1.StringInterfaceMap
+	22, // 11: fractalengine.rpc.v1.CreateMintRequestPayload.signature_requirement_type:type_name -> fractalengine.rpc.v1.SignatureRequirementType
+	17, // 12: fractalengine.rpc.v1.CreateMintResponse.hash:type_name -> fractalengine.rpc.v1.Hash
+	8,  // 13: fractalengine.rpc.v1.AmendMintRequest.payload:type_name -> fractalengine.rpc.v1.AmendMintRequestPayload
+	17, // 14: fractalengine.rpc.v1.AmendMintRequestPayload.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	20, // 15: fractalengine.rpc.v1.AmendMintRequestPayload.metadata:type_name -> fractalengine.rpc.v1.StringInterfaceMap
+	17, // 16: fractalengine.rpc.v1.AmendMintResponse.hash:type_name -> fractalengine.rpc.v1.Hash
+	11, // 17: fractalengine.rpc.v1.CreateMintAmendmentSignatureRequest.payload:type_name -> fractalengine.rpc.v1.CreateMintAmendmentSignatureRequestPayload
+	17, // 18: fractalengine.rpc.v1.CreateMintAmendmentSignatureRequestPayload.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	14, // 19: fractalengine.rpc.v1.TransferMintOwnershipRequest.payload:type_name -> fractalengine.rpc.v1.TransferMintOwnershipRequestPayload
+	17, // 20: fractalengine.rpc.v1.TransferMintOwnershipRequestPayload.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	21, // 21: fractalengine.rpc.v1.TransferMintOwnershipRequestPayload.new_owner_address:type_name -> fractalengine.rpc.v1.Address
+	22, // 22: fractalengine.rpc.v1.TransferMintOwnershipRequestPayload.signature_requirement_type:type_name -> fractalengine.rpc.v1.SignatureRequirementType
+	19, // 23: fractalengine.rpc.v1.TransferMintOwnershipRequestPayload.asset_managers:type_name -> fractalengine.rpc.v1.AssetManager
+	17, // 24: fractalengine.rpc.v1.TransferMintOwnershipResponse.hash:type_name -> fractalengine.rpc.v1.Hash
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_mints_proto_init() }
@@ -1132,7 +2680,7 @@ func file_mints_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mints_proto_rawDesc), len(file_mints_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1560,12 +1560,13 @@ func (b0 DeleteSellOfferRequest_builder) Build() *DeleteSellOfferRequest {
 }
 
 type DeleteSellOfferResponse struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Value       *string                `protobuf:"bytes,1,opt,name=value"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	state                             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Value                  *string                `protobuf:"bytes,1,opt,name=value"`
+	xxx_hidden_EncodedTransactionBody *string                `protobuf:"bytes,2,opt,name=encoded_transaction_body,json=encodedTransactionBody"`
+	XXX_raceDetectHookData            protoimpl.RaceDetectHookData
+	XXX_presence                      [1]uint32
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
 }
 
 func (x *DeleteSellOfferResponse) Reset() {
@@ -1603,9 +1604,24 @@ func (x *DeleteSellOfferResponse) GetValue() string {
 	return ""
 }
 
+func (x *DeleteSellOfferResponse) GetEncodedTransactionBody() string {
+	if x != nil {
+		if x.xxx_hidden_EncodedTransactionBody != nil {
+			return *x.xxx_hidden_EncodedTransactionBody
+		}
+		return ""
+	}
+	return ""
+}
+
 func (x *DeleteSellOfferResponse) SetValue(v string) {
 	x.xxx_hidden_Value = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *DeleteSellOfferResponse) SetEncodedTransactionBody(v string) {
+	x.xxx_hidden_EncodedTransactionBody = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
 }
 
 func (x *DeleteSellOfferResponse) HasValue() bool {
@@ -1615,15 +1631,28 @@ func (x *DeleteSellOfferResponse) HasValue() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
+func (x *DeleteSellOfferResponse) HasEncodedTransactionBody() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
 func (x *DeleteSellOfferResponse) ClearValue() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Value = nil
 }
 
+func (x *DeleteSellOfferResponse) ClearEncodedTransactionBody() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_EncodedTransactionBody = nil
+}
+
 type DeleteSellOfferResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Value *string
+	Value                  *string
+	EncodedTransactionBody *string
 }
 
 func (b0 DeleteSellOfferResponse_builder) Build() *DeleteSellOfferResponse {
@@ -1631,9 +1660,13 @@ func (b0 DeleteSellOfferResponse_builder) Build() *DeleteSellOfferResponse {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Value != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
 		x.xxx_hidden_Value = b.Value
 	}
+	if b.EncodedTransactionBody != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_EncodedTransactionBody = b.EncodedTransactionBody
+	}
 	return m0
 }
 
@@ -1842,12 +1875,13 @@ func (b0 DeleteBuyOfferRequest_builder) Build() *DeleteBuyOfferRequest {
 }
 
 type DeleteBuyOfferResponse struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Value       *string                `protobuf:"bytes,1,opt,name=value"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	state                             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Value                  *string                `protobuf:"bytes,1,opt,name=value"`
+	xxx_hidden_EncodedTransactionBody *string                `protobuf:"bytes,2,opt,name=encoded_transaction_body,json=encodedTransactionBody"`
+	XXX_raceDetectHookData            protoimpl.RaceDetectHookData
+	XXX_presence                      [1]uint32
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
 }
 
 func (x *DeleteBuyOfferResponse) Reset() {
@@ -1885,9 +1919,24 @@ func (x *DeleteBuyOfferResponse) GetValue() string {
 	return ""
 }
 
+func (x *DeleteBuyOfferResponse) GetEncodedTransactionBody() string {
+	if x != nil {
+		if x.xxx_hidden_EncodedTransactionBody != nil {
+			return *x.xxx_hidden_EncodedTransactionBody
+		}
+		return ""
+	}
+	return ""
+}
+
 func (x *DeleteBuyOfferResponse) SetValue(v string) {
 	x.xxx_hidden_Value = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *DeleteBuyOfferResponse) SetEncodedTransactionBody(v string) {
+	x.xxx_hidden_EncodedTransactionBody = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
 }
 
 func (x *DeleteBuyOfferResponse) HasValue() bool {
@@ -1897,15 +1946,28 @@ func (x *DeleteBuyOfferResponse) HasValue() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
+func (x *DeleteBuyOfferResponse) HasEncodedTransactionBody() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
 func (x *DeleteBuyOfferResponse) ClearValue() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Value = nil
 }
 
+func (x *DeleteBuyOfferResponse) ClearEncodedTransactionBody() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_EncodedTransactionBody = nil
+}
+
 type DeleteBuyOfferResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Value *string
+	Value                  *string
+	EncodedTransactionBody *string
 }
 
 func (b0 DeleteBuyOfferResponse_builder) Build() *DeleteBuyOfferResponse {
@@ -1913,9 +1975,13 @@ func (b0 DeleteBuyOfferResponse_builder) Build() *DeleteBuyOfferResponse {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Value != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
 		x.xxx_hidden_Value = b.Value
 	}
+	if b.EncodedTransactionBody != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_EncodedTransactionBody = b.EncodedTransactionBody
+	}
 	return m0
 }
 
@@ -1987,6 +2053,284 @@ func (b0 DeleteBuyOfferRequestPayload_builder) Build() *DeleteBuyOfferRequestPay
 	return m0
 }
 
+type GetOrderBookRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MintHash *Hash                  `protobuf:"bytes,1,opt,name=mint_hash,json=mintHash"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetOrderBookRequest) Reset() {
+	*x = GetOrderBookRequest{}
+	mi := &file_offers_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderBookRequest) ProtoMessage() {}
+
+func (x *GetOrderBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_offers_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetOrderBookRequest) GetMintHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_MintHash
+	}
+	return nil
+}
+
+func (x *GetOrderBookRequest) SetMintHash(v *Hash) {
+	x.xxx_hidden_MintHash = v
+}
+
+func (x *GetOrderBookRequest) HasMintHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MintHash != nil
+}
+
+func (x *GetOrderBookRequest) ClearMintHash() {
+	x.xxx_hidden_MintHash = nil
+}
+
+type GetOrderBookRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MintHash *Hash
+}
+
+func (b0 GetOrderBookRequest_builder) Build() *GetOrderBookRequest {
+	m0 := &GetOrderBookRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_MintHash = b.MintHash
+	return m0
+}
+
+type OrderBookLevel struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Price       int32                  `protobuf:"varint,1,opt,name=price"`
+	xxx_hidden_Quantity    int32                  `protobuf:"varint,2,opt,name=quantity"`
+	xxx_hidden_Orders      int32                  `protobuf:"varint,3,opt,name=orders"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *OrderBookLevel) Reset() {
+	*x = OrderBookLevel{}
+	mi := &file_offers_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBookLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookLevel) ProtoMessage() {}
+
+func (x *OrderBookLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_offers_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *OrderBookLevel) GetPrice() int32 {
+	if x != nil {
+		return x.xxx_hidden_Price
+	}
+	return 0
+}
+
+func (x *OrderBookLevel) GetQuantity() int32 {
+	if x != nil {
+		return x.xxx_hidden_Quantity
+	}
+	return 0
+}
+
+func (x *OrderBookLevel) GetOrders() int32 {
+	if x != nil {
+		return x.xxx_hidden_Orders
+	}
+	return 0
+}
+
+func (x *OrderBookLevel) SetPrice(v int32) {
+	x.xxx_hidden_Price = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *OrderBookLevel) SetQuantity(v int32) {
+	x.xxx_hidden_Quantity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *OrderBookLevel) SetOrders(v int32) {
+	x.xxx_hidden_Orders = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *OrderBookLevel) HasPrice() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *OrderBookLevel) HasQuantity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *OrderBookLevel) HasOrders() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *OrderBookLevel) ClearPrice() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Price = 0
+}
+
+func (x *OrderBookLevel) ClearQuantity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Quantity = 0
+}
+
+func (x *OrderBookLevel) ClearOrders() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Orders = 0
+}
+
+type OrderBookLevel_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Price    *int32
+	Quantity *int32
+	Orders   *int32
+}
+
+func (b0 OrderBookLevel_builder) Build() *OrderBookLevel {
+	m0 := &OrderBookLevel{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Price != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Price = *b.Price
+	}
+	if b.Quantity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Quantity = *b.Quantity
+	}
+	if b.Orders != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Orders = *b.Orders
+	}
+	return m0
+}
+
+type GetOrderBookResponse struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Bids *[]*OrderBookLevel     `protobuf:"bytes,1,rep,name=bids"`
+	xxx_hidden_Asks *[]*OrderBookLevel     `protobuf:"bytes,2,rep,name=asks"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetOrderBookResponse) Reset() {
+	*x = GetOrderBookResponse{}
+	mi := &file_offers_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderBookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderBookResponse) ProtoMessage() {}
+
+func (x *GetOrderBookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_offers_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetOrderBookResponse) GetBids() []*OrderBookLevel {
+	if x != nil {
+		if x.xxx_hidden_Bids != nil {
+			return *x.xxx_hidden_Bids
+		}
+	}
+	return nil
+}
+
+func (x *GetOrderBookResponse) GetAsks() []*OrderBookLevel {
+	if x != nil {
+		if x.xxx_hidden_Asks != nil {
+			return *x.xxx_hidden_Asks
+		}
+	}
+	return nil
+}
+
+func (x *GetOrderBookResponse) SetBids(v []*OrderBookLevel) {
+	x.xxx_hidden_Bids = &v
+}
+
+func (x *GetOrderBookResponse) SetAsks(v []*OrderBookLevel) {
+	x.xxx_hidden_Asks = &v
+}
+
+type GetOrderBookResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Bids []*OrderBookLevel
+	Asks []*OrderBookLevel
+}
+
+func (b0 GetOrderBookResponse_builder) Build() *GetOrderBookResponse {
+	m0 := &GetOrderBookResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Bids = &b.Bids
+	x.xxx_hidden_Asks = &b.Asks
+	return m0
+}
+
 var File_offers_proto protoreflect.FileDescriptor
 
 const file_offers_proto_rawDesc = "" +
@@ -2043,9 +2387,10 @@ const file_offers_proto_rawDesc = "" +
 	"\apayload\x18\x01 \x01(\v23.fractalengine.rpc.v1.DeleteSellOfferRequestPayloadR\apayload\x12&\n" +
 	"\n" +
 	"public_key\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tpublicKey\x12%\n" +
-	"\tsignature\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tsignature\"/\n" +
+	"\tsignature\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tsignature\"i\n" +
 	"\x17DeleteSellOfferResponse\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\"c\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x128\n" +
+	"\x18encoded_transaction_body\x18\x02 \x01(\tR\x16encodedTransactionBody\"c\n" +
 	"\x1dDeleteSellOfferRequestPayload\x12B\n" +
 	"\n" +
 	"offer_hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashB\a\xbaH\x04r\x02\x10\x01R\tofferHash\"\xb4\x01\n" +
@@ -2053,14 +2398,24 @@ const file_offers_proto_rawDesc = "" +
 	"\apayload\x18\x01 \x01(\v22.fractalengine.rpc.v1.DeleteBuyOfferRequestPayloadR\apayload\x12&\n" +
 	"\n" +
 	"public_key\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tpublicKey\x12%\n" +
-	"\tsignature\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tsignature\".\n" +
+	"\tsignature\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tsignature\"h\n" +
 	"\x16DeleteBuyOfferResponse\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\"b\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x128\n" +
+	"\x18encoded_transaction_body\x18\x02 \x01(\tR\x16encodedTransactionBody\"b\n" +
 	"\x1cDeleteBuyOfferRequestPayload\x12B\n" +
 	"\n" +
-	"offer_hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashB\a\xbaH\x04r\x02\x10\x01R\tofferHashB.Z,dogecoin.org/fractal-engine/pkg/rpc/protocolb\beditionsp\xe8\a"
-
-var file_offers_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+	"offer_hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashB\a\xbaH\x04r\x02\x10\x01R\tofferHash\"N\n" +
+	"\x13GetOrderBookRequest\x127\n" +
+	"\tmint_hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\bmintHash\"Z\n" +
+	"\x0eOrderBookLevel\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\x05R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06orders\x18\x03 \x01(\x05R\x06orders\"\x8a\x01\n" +
+	"\x14GetOrderBookResponse\x128\n" +
+	"\x04bids\x18\x01 \x03(\v2$.fractalengine.rpc.v1.OrderBookLevelR\x04bids\x128\n" +
+	"\x04asks\x18\x02 \x03(\v2$.fractalengine.rpc.v1.OrderBookLevelR\x04asksB.Z,dogecoin.org/fractal-engine/pkg/rpc/protocolb\beditionsp\xe8\a"
+
+var file_offers_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_offers_proto_goTypes = []any{
 	(*CreateSellOfferResponse)(nil),       // 0: fractalengine.rpc.v1.CreateSellOfferResponse
 	(*CreateBuyOfferResponse)(nil),        // 1: fractalengine.rpc.v1.CreateBuyOfferResponse
@@ -2078,41 +2433,47 @@ var file_offers_proto_goTypes = []any{
 	(*DeleteBuyOfferRequest)(nil),         // 13: fractalengine.rpc.v1.DeleteBuyOfferRequest
 	(*DeleteBuyOfferResponse)(nil),        // 14: fractalengine.rpc.v1.DeleteBuyOfferResponse
 	(*DeleteBuyOfferRequestPayload)(nil),  // 15: fractalengine.rpc.v1.DeleteBuyOfferRequestPayload
-	(*Hash)(nil),                          // 16: fractalengine.rpc.v1.Hash
-	(*wrapperspb.Int32Value)(nil),         // 17: google.protobuf.Int32Value
-	(*Address)(nil),                       // 18: fractalengine.rpc.v1.Address
-	(*SellOfferWithMint)(nil),             // 19: fractalengine.rpc.v1.SellOfferWithMint
-	(*BuyOfferWithMint)(nil),              // 20: fractalengine.rpc.v1.BuyOfferWithMint
+	(*GetOrderBookRequest)(nil),           // 16: fractalengine.rpc.v1.GetOrderBookRequest
+	(*OrderBookLevel)(nil),                // 17: fractalengine.rpc.v1.OrderBookLevel
+	(*GetOrderBookResponse)(nil),          // 18: fractalengine.rpc.v1.GetOrderBookResponse
+	(*Hash)(nil),                          // 19: fractalengine.rpc.v1.Hash
+	(*wrapperspb.Int32Value)(nil),         // 20: google.protobuf.Int32Value
+	(*Address)(nil),                       // 21: fractalengine.rpc.v1.Address
+	(*SellOfferWithMint)(nil),             // 22: fractalengine.rpc.v1.SellOfferWithMint
+	(*BuyOfferWithMint)(nil),              // 23: fractalengine.rpc.v1.BuyOfferWithMint
 }
 var file_offers_proto_depIdxs = []int32{
-	16, // 0: fractalengine.rpc.v1.CreateSellOfferResponse.hash:type_name -> fractalengine.rpc.v1.Hash
-	16, // 1: fractalengine.rpc.v1.CreateBuyOfferResponse.hash:type_name -> fractalengine.rpc.v1.Hash
-	17, // 2: fractalengine.rpc.v1.GetSellOffersRequest.limit:type_name -> google.protobuf.Int32Value
-	17, // 3: fractalengine.rpc.v1.GetSellOffersRequest.page:type_name -> google.protobuf.Int32Value
-	16, // 4: fractalengine.rpc.v1.GetSellOffersRequest.mint_hash:type_name -> fractalengine.rpc.v1.Hash
-	18, // 5: fractalengine.rpc.v1.GetSellOffersRequest.offerer_address:type_name -> fractalengine.rpc.v1.Address
-	17, // 6: fractalengine.rpc.v1.GetBuyOffersRequest.limit:type_name -> google.protobuf.Int32Value
-	17, // 7: fractalengine.rpc.v1.GetBuyOffersRequest.page:type_name -> google.protobuf.Int32Value
-	16, // 8: fractalengine.rpc.v1.GetBuyOffersRequest.mint_hash:type_name -> fractalengine.rpc.v1.Hash
-	18, // 9: fractalengine.rpc.v1.GetBuyOffersRequest.seller_address:type_name -> fractalengine.rpc.v1.Address
-	19, // 10: fractalengine.rpc.v1.GetSellOffersResponse.offers:type_name -> fractalengine.rpc.v1.SellOfferWithMint
-	20, // 11: fractalengine.rpc.v1.GetBuyOffersResponse.offers:type_name -> fractalengine.rpc.v1.BuyOfferWithMint
+	19, // 0: fractalengine.rpc.v1.CreateSellOfferResponse.hash:type_name -> fractalengine.rpc.v1.Hash
+	19, // 1: fractalengine.rpc.v1.CreateBuyOfferResponse.hash:type_name -> fractalengine.rpc.v1.Hash
+	20, // 2: fractalengine.rpc.v1.GetSellOffersRequest.limit:type_name -> google.protobuf.Int32Value
+	20, // 3: fractalengine.rpc.v1.GetSellOffersRequest.page:type_name -> google.protobuf.Int32Value
+	19, // 4: fractalengine.rpc.v1.GetSellOffersRequest.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	21, // 5: fractalengine.rpc.v1.GetSellOffersRequest.offerer_address:type_name -> fractalengine.rpc.v1.Address
+	20, // 6: fractalengine.rpc.v1.GetBuyOffersRequest.limit:type_name -> google.protobuf.Int32Value
+	20, // 7: fractalengine.rpc.v1.GetBuyOffersRequest.page:type_name -> google.protobuf.Int32Value
+	19, // 8: fractalengine.rpc.v1.GetBuyOffersRequest.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	21, // 9: fractalengine.rpc.v1.GetBuyOffersRequest.seller_address:type_name -> fractalengine.rpc.v1.Address
+	22, // 10: fractalengine.rpc.v1.GetSellOffersResponse.offers:type_name -> fractalengine.rpc.v1.SellOfferWithMint
+	23, // 11: fractalengine.rpc.v1.GetBuyOffersResponse.offers:type_name -> fractalengine.rpc.v1.BuyOfferWithMint
 	7,  // 12: fractalengine.rpc.v1.CreateSellOfferRequest.payload:type_name -> fractalengine.rpc.v1.CreateSellOfferRequestPayload
-	18, // 13: fractalengine.rpc.v1.CreateSellOfferRequestPayload.offerer_address:type_name -> fractalengine.rpc.v1.Address
-	16, // 14: fractalengine.rpc.v1.CreateSellOfferRequestPayload.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	21, // 13: fractalengine.rpc.v1.CreateSellOfferRequestPayload.offerer_address:type_name -> fractalengine.rpc.v1.Address
+	19, // 14: fractalengine.rpc.v1.CreateSellOfferRequestPayload.mint_hash:type_name -> fractalengine.rpc.v1.Hash
 	9,  // 15: fractalengine.rpc.v1.CreateBuyOfferRequest.payload:type_name -> fractalengine.rpc.v1.CreateBuyOfferRequestPayload
-	18, // 16: fractalengine.rpc.v1.CreateBuyOfferRequestPayload.offerer_address:type_name -> fractalengine.rpc.v1.Address
-	18, // 17: fractalengine.rpc.v1.CreateBuyOfferRequestPayload.seller_address:type_name -> fractalengine.rpc.v1.Address
-	16, // 18: fractalengine.rpc.v1.CreateBuyOfferRequestPayload.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	21, // 16: fractalengine.rpc.v1.CreateBuyOfferRequestPayload.offerer_address:type_name -> fractalengine.rpc.v1.Address
+	21, // 17: fractalengine.rpc.v1.CreateBuyOfferRequestPayload.seller_address:type_name -> fractalengine.rpc.v1.Address
+	19, // 18: fractalengine.rpc.v1.CreateBuyOfferRequestPayload.mint_hash:type_name -> fractalengine.rpc.v1.Hash
 	12, // 19: fractalengine.rpc.v1.DeleteSellOfferRequest.payload:type_name -> fractalengine.rpc.v1.DeleteSellOfferRequestPayload
-	16, // 20: fractalengine.rpc.v1.DeleteSellOfferRequestPayload.offer_hash:type_name -> fractalengine.rpc.v1.Hash
+	19, // 20: fractalengine.rpc.v1.DeleteSellOfferRequestPayload.offer_hash:type_name -> fractalengine.rpc.v1.Hash
 	15, // 21: fractalengine.rpc.v1.DeleteBuyOfferRequest.payload:type_name -> fractalengine.rpc.v1.DeleteBuyOfferRequestPayload
-	16, // 22: fractalengine.rpc.v1.DeleteBuyOfferRequestPayload.offer_hash:type_name -> fractalengine.rpc.v1.Hash
-	23, // [23:23] is the sub-list for method output_type
-	23, // [23:23] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	19, // 22: fractalengine.rpc.v1.DeleteBuyOfferRequestPayload.offer_hash:type_name -> fractalengine.rpc.v1.Hash
+	19, // 23: fractalengine.rpc.v1.GetOrderBookRequest.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	17, // 24: fractalengine.rpc.v1.GetOrderBookResponse.bids:type_name -> fractalengine.rpc.v1.OrderBookLevel
+	17, // 25: fractalengine.rpc.v1.GetOrderBookResponse.asks:type_name -> fractalengine.rpc.v1.OrderBookLevel
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_offers_proto_init() }
@@ -2128,7 +2489,7 @@ func file_offers_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_offers_proto_rawDesc), len(file_offers_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -48,6 +48,24 @@ const (
 	// FractalEngineRpcServiceGetStatsProcedure is the fully-qualified name of the
 	// FractalEngineRpcService's GetStats RPC.
 	FractalEngineRpcServiceGetStatsProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/GetStats"
+	// FractalEngineRpcServiceSubscribeEventsProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's SubscribeEvents RPC.
+	FractalEngineRpcServiceSubscribeEventsProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/SubscribeEvents"
+	// FractalEngineRpcServiceCreateWebhookProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's CreateWebhook RPC.
+	FractalEngineRpcServiceCreateWebhookProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/CreateWebhook"
+	// FractalEngineRpcServiceGetWebhooksProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's GetWebhooks RPC.
+	FractalEngineRpcServiceGetWebhooksProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/GetWebhooks"
+	// FractalEngineRpcServiceDeleteWebhookProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's DeleteWebhook RPC.
+	FractalEngineRpcServiceDeleteWebhookProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/DeleteWebhook"
+	// FractalEngineRpcServiceGetWebhookDeliveriesProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's GetWebhookDeliveries RPC.
+	FractalEngineRpcServiceGetWebhookDeliveriesProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/GetWebhookDeliveries"
+	// FractalEngineRpcServiceReplayWebhookDeliveryProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's ReplayWebhookDelivery RPC.
+	FractalEngineRpcServiceReplayWebhookDeliveryProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/ReplayWebhookDelivery"
 	// FractalEngineRpcServiceGetInvoicesProcedure is the fully-qualified name of the
 	// FractalEngineRpcService's GetInvoices RPC.
 	FractalEngineRpcServiceGetInvoicesProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/GetInvoices"
@@ -60,6 +78,12 @@ const (
 	// FractalEngineRpcServiceCreateInvoiceSignatureProcedure is the fully-qualified name of the
 	// FractalEngineRpcService's CreateInvoiceSignature RPC.
 	FractalEngineRpcServiceCreateInvoiceSignatureProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/CreateInvoiceSignature"
+	// FractalEngineRpcServiceCancelInvoiceProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's CancelInvoice RPC.
+	FractalEngineRpcServiceCancelInvoiceProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/CancelInvoice"
+	// FractalEngineRpcServiceGetInvoiceHistoryProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's GetInvoiceHistory RPC.
+	FractalEngineRpcServiceGetInvoiceHistoryProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/GetInvoiceHistory"
 	// FractalEngineRpcServiceGetMintsProcedure is the fully-qualified name of the
 	// FractalEngineRpcService's GetMints RPC.
 	FractalEngineRpcServiceGetMintsProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/GetMints"
@@ -69,6 +93,15 @@ const (
 	// FractalEngineRpcServiceCreateMintProcedure is the fully-qualified name of the
 	// FractalEngineRpcService's CreateMint RPC.
 	FractalEngineRpcServiceCreateMintProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/CreateMint"
+	// FractalEngineRpcServiceAmendMintProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's AmendMint RPC.
+	FractalEngineRpcServiceAmendMintProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/AmendMint"
+	// FractalEngineRpcServiceCreateMintAmendmentSignatureProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's CreateMintAmendmentSignature RPC.
+	FractalEngineRpcServiceCreateMintAmendmentSignatureProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/CreateMintAmendmentSignature"
+	// FractalEngineRpcServiceTransferMintOwnershipProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's TransferMintOwnership RPC.
+	FractalEngineRpcServiceTransferMintOwnershipProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/TransferMintOwnership"
 	// FractalEngineRpcServiceCreateNewPaymentProcedure is the fully-qualified name of the
 	// FractalEngineRpcService's CreateNewPayment RPC.
 	FractalEngineRpcServiceCreateNewPaymentProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/CreateNewPayment"
@@ -78,6 +111,30 @@ const (
 	// FractalEngineRpcServiceGetTokenBalancesProcedure is the fully-qualified name of the
 	// FractalEngineRpcService's GetTokenBalances RPC.
 	FractalEngineRpcServiceGetTokenBalancesProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/GetTokenBalances"
+	// FractalEngineRpcServiceGetCapTableProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's GetCapTable RPC.
+	FractalEngineRpcServiceGetCapTableProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/GetCapTable"
+	// FractalEngineRpcServiceTransferTokensProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's TransferTokens RPC.
+	FractalEngineRpcServiceTransferTokensProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/TransferTokens"
+	// FractalEngineRpcServiceCreateBurnProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's CreateBurn RPC.
+	FractalEngineRpcServiceCreateBurnProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/CreateBurn"
+	// FractalEngineRpcServiceCreateBurnSignatureProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's CreateBurnSignature RPC.
+	FractalEngineRpcServiceCreateBurnSignatureProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/CreateBurnSignature"
+	// FractalEngineRpcServiceCreateAttestationProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's CreateAttestation RPC.
+	FractalEngineRpcServiceCreateAttestationProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/CreateAttestation"
+	// FractalEngineRpcServiceCreateDistributionProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's CreateDistribution RPC.
+	FractalEngineRpcServiceCreateDistributionProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/CreateDistribution"
+	// FractalEngineRpcServiceGetDistributionProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's GetDistribution RPC.
+	FractalEngineRpcServiceGetDistributionProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/GetDistribution"
+	// FractalEngineRpcServiceGetDistributionsProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's GetDistributions RPC.
+	FractalEngineRpcServiceGetDistributionsProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/GetDistributions"
 	// FractalEngineRpcServiceGetSellOffersProcedure is the fully-qualified name of the
 	// FractalEngineRpcService's GetSellOffers RPC.
 	FractalEngineRpcServiceGetSellOffersProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/GetSellOffers"
@@ -96,6 +153,9 @@ const (
 	// FractalEngineRpcServiceDeleteBuyOfferProcedure is the fully-qualified name of the
 	// FractalEngineRpcService's DeleteBuyOffer RPC.
 	FractalEngineRpcServiceDeleteBuyOfferProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/DeleteBuyOffer"
+	// FractalEngineRpcServiceGetOrderBookProcedure is the fully-qualified name of the
+	// FractalEngineRpcService's GetOrderBook RPC.
+	FractalEngineRpcServiceGetOrderBookProcedure = "/fractalengine.rpc.v1.FractalEngineRpcService/GetOrderBook"
 )
 
 // FractalEngineRpcServiceClient is a client for the fractalengine.rpc.v1.FractalEngineRpcService
@@ -106,22 +166,42 @@ type FractalEngineRpcServiceClient interface {
 	DogeTopUp(context.Context, *connect.Request[protocol.DogeTopUpRequest]) (*connect.Response[protocol.DogeTopUpResponse], error)
 	GetHealth(context.Context, *connect.Request[protocol.GetHealthRequest]) (*connect.Response[protocol.GetHealthResponse], error)
 	GetStats(context.Context, *connect.Request[protocol.GetStatsRequest]) (*connect.Response[protocol.GetStatsResponse], error)
+	SubscribeEvents(context.Context, *connect.Request[protocol.SubscribeEventsRequest]) (*connect.ServerStreamForClient[protocol.SubscribeEventsResponse], error)
+	CreateWebhook(context.Context, *connect.Request[protocol.CreateWebhookRequest]) (*connect.Response[protocol.CreateWebhookResponse], error)
+	GetWebhooks(context.Context, *connect.Request[protocol.GetWebhooksRequest]) (*connect.Response[protocol.GetWebhooksResponse], error)
+	DeleteWebhook(context.Context, *connect.Request[protocol.DeleteWebhookRequest]) (*connect.Response[protocol.DeleteWebhookResponse], error)
+	GetWebhookDeliveries(context.Context, *connect.Request[protocol.GetWebhookDeliveriesRequest]) (*connect.Response[protocol.GetWebhookDeliveriesResponse], error)
+	ReplayWebhookDelivery(context.Context, *connect.Request[protocol.ReplayWebhookDeliveryRequest]) (*connect.Response[protocol.ReplayWebhookDeliveryResponse], error)
 	GetInvoices(context.Context, *connect.Request[protocol.GetInvoicesRequest]) (*connect.Response[protocol.GetInvoicesResponse], error)
 	GetAllInvoices(context.Context, *connect.Request[protocol.GetAllInvoicesRequest]) (*connect.Response[protocol.GetAllInvoicesResponse], error)
 	CreateInvoice(context.Context, *connect.Request[protocol.CreateInvoiceRequest]) (*connect.Response[protocol.CreateInvoiceResponse], error)
 	CreateInvoiceSignature(context.Context, *connect.Request[protocol.CreateInvoiceSignatureRequest]) (*connect.Response[protocol.CreateInvoiceSignatureResponse], error)
+	CancelInvoice(context.Context, *connect.Request[protocol.CancelInvoiceRequest]) (*connect.Response[protocol.CancelInvoiceResponse], error)
+	GetInvoiceHistory(context.Context, *connect.Request[protocol.GetInvoiceHistoryRequest]) (*connect.Response[protocol.GetInvoiceHistoryResponse], error)
 	GetMints(context.Context, *connect.Request[protocol.GetMintsRequest]) (*connect.Response[protocol.GetMintsResponse], error)
 	GetMint(context.Context, *connect.Request[protocol.GetMintRequest]) (*connect.Response[protocol.GetMintResponse], error)
 	CreateMint(context.Context, *connect.Request[protocol.CreateMintRequest]) (*connect.Response[protocol.CreateMintResponse], error)
+	AmendMint(context.Context, *connect.Request[protocol.AmendMintRequest]) (*connect.Response[protocol.AmendMintResponse], error)
+	CreateMintAmendmentSignature(context.Context, *connect.Request[protocol.CreateMintAmendmentSignatureRequest]) (*connect.Response[protocol.CreateMintAmendmentSignatureResponse], error)
+	TransferMintOwnership(context.Context, *connect.Request[protocol.TransferMintOwnershipRequest]) (*connect.Response[protocol.TransferMintOwnershipResponse], error)
 	CreateNewPayment(context.Context, *connect.Request[protocol.CreateNewPaymentRequest]) (*connect.Response[protocol.CreateNewPaymentResponse], error)
 	GetPendingTokenBalances(context.Context, *connect.Request[protocol.GetPendingTokenBalancesRequest]) (*connect.Response[protocol.GetPendingTokenBalancesResponse], error)
 	GetTokenBalances(context.Context, *connect.Request[protocol.GetTokenBalancesRequest]) (*connect.Response[protocol.GetTokenBalancesResponse], error)
+	GetCapTable(context.Context, *connect.Request[protocol.GetCapTableRequest]) (*connect.Response[protocol.GetCapTableResponse], error)
+	TransferTokens(context.Context, *connect.Request[protocol.TransferTokensRequest]) (*connect.Response[protocol.TransferTokensResponse], error)
+	CreateBurn(context.Context, *connect.Request[protocol.CreateBurnRequest]) (*connect.Response[protocol.CreateBurnResponse], error)
+	CreateBurnSignature(context.Context, *connect.Request[protocol.CreateBurnSignatureRequest]) (*connect.Response[protocol.CreateBurnSignatureResponse], error)
+	CreateAttestation(context.Context, *connect.Request[protocol.CreateAttestationRequest]) (*connect.Response[protocol.CreateAttestationResponse], error)
+	CreateDistribution(context.Context, *connect.Request[protocol.CreateDistributionRequest]) (*connect.Response[protocol.CreateDistributionResponse], error)
+	GetDistribution(context.Context, *connect.Request[protocol.GetDistributionRequest]) (*connect.Response[protocol.GetDistributionResponse], error)
+	GetDistributions(context.Context, *connect.Request[protocol.GetDistributionsRequest]) (*connect.Response[protocol.GetDistributionsResponse], error)
 	GetSellOffers(context.Context, *connect.Request[protocol.GetSellOffersRequest]) (*connect.Response[protocol.GetSellOffersResponse], error)
 	CreateSellOffer(context.Context, *connect.Request[protocol.CreateSellOfferRequest]) (*connect.Response[protocol.CreateSellOfferResponse], error)
 	DeleteSellOffer(context.Context, *connect.Request[protocol.DeleteSellOfferRequest]) (*connect.Response[protocol.DeleteSellOfferResponse], error)
 	GetBuyOffers(context.Context, *connect.Request[protocol.GetBuyOffersRequest]) (*connect.Response[protocol.GetBuyOffersResponse], error)
 	CreateBuyOffer(context.Context, *connect.Request[protocol.CreateBuyOfferRequest]) (*connect.Response[protocol.CreateBuyOfferResponse], error)
 	DeleteBuyOffer(context.Context, *connect.Request[protocol.DeleteBuyOfferRequest]) (*connect.Response[protocol.DeleteBuyOfferResponse], error)
+	GetOrderBook(context.Context, *connect.Request[protocol.GetOrderBookRequest]) (*connect.Response[protocol.GetOrderBookResponse], error)
 }
 
 // NewFractalEngineRpcServiceClient constructs a client for the
@@ -166,6 +246,42 @@ func NewFractalEngineRpcServiceClient(httpClient connect.HTTPClient, baseURL str
 			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("GetStats")),
 			connect.WithClientOptions(opts...),
 		),
+		subscribeEvents: connect.NewClient[protocol.SubscribeEventsRequest, protocol.SubscribeEventsResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceSubscribeEventsProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("SubscribeEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		createWebhook: connect.NewClient[protocol.CreateWebhookRequest, protocol.CreateWebhookResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceCreateWebhookProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("CreateWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		getWebhooks: connect.NewClient[protocol.GetWebhooksRequest, protocol.GetWebhooksResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceGetWebhooksProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("GetWebhooks")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhook: connect.NewClient[protocol.DeleteWebhookRequest, protocol.DeleteWebhookResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceDeleteWebhookProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("DeleteWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		getWebhookDeliveries: connect.NewClient[protocol.GetWebhookDeliveriesRequest, protocol.GetWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceGetWebhookDeliveriesProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("GetWebhookDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+		replayWebhookDelivery: connect.NewClient[protocol.ReplayWebhookDeliveryRequest, protocol.ReplayWebhookDeliveryResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceReplayWebhookDeliveryProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("ReplayWebhookDelivery")),
+			connect.WithClientOptions(opts...),
+		),
 		getInvoices: connect.NewClient[protocol.GetInvoicesRequest, protocol.GetInvoicesResponse](
 			httpClient,
 			baseURL+FractalEngineRpcServiceGetInvoicesProcedure,
@@ -190,6 +306,18 @@ func NewFractalEngineRpcServiceClient(httpClient connect.HTTPClient, baseURL str
 			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("CreateInvoiceSignature")),
 			connect.WithClientOptions(opts...),
 		),
+		cancelInvoice: connect.NewClient[protocol.CancelInvoiceRequest, protocol.CancelInvoiceResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceCancelInvoiceProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("CancelInvoice")),
+			connect.WithClientOptions(opts...),
+		),
+		getInvoiceHistory: connect.NewClient[protocol.GetInvoiceHistoryRequest, protocol.GetInvoiceHistoryResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceGetInvoiceHistoryProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("GetInvoiceHistory")),
+			connect.WithClientOptions(opts...),
+		),
 		getMints: connect.NewClient[protocol.GetMintsRequest, protocol.GetMintsResponse](
 			httpClient,
 			baseURL+FractalEngineRpcServiceGetMintsProcedure,
@@ -208,6 +336,24 @@ func NewFractalEngineRpcServiceClient(httpClient connect.HTTPClient, baseURL str
 			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("CreateMint")),
 			connect.WithClientOptions(opts...),
 		),
+		amendMint: connect.NewClient[protocol.AmendMintRequest, protocol.AmendMintResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceAmendMintProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("AmendMint")),
+			connect.WithClientOptions(opts...),
+		),
+		createMintAmendmentSignature: connect.NewClient[protocol.CreateMintAmendmentSignatureRequest, protocol.CreateMintAmendmentSignatureResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceCreateMintAmendmentSignatureProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("CreateMintAmendmentSignature")),
+			connect.WithClientOptions(opts...),
+		),
+		transferMintOwnership: connect.NewClient[protocol.TransferMintOwnershipRequest, protocol.TransferMintOwnershipResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceTransferMintOwnershipProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("TransferMintOwnership")),
+			connect.WithClientOptions(opts...),
+		),
 		createNewPayment: connect.NewClient[protocol.CreateNewPaymentRequest, protocol.CreateNewPaymentResponse](
 			httpClient,
 			baseURL+FractalEngineRpcServiceCreateNewPaymentProcedure,
@@ -226,6 +372,54 @@ func NewFractalEngineRpcServiceClient(httpClient connect.HTTPClient, baseURL str
 			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("GetTokenBalances")),
 			connect.WithClientOptions(opts...),
 		),
+		getCapTable: connect.NewClient[protocol.GetCapTableRequest, protocol.GetCapTableResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceGetCapTableProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("GetCapTable")),
+			connect.WithClientOptions(opts...),
+		),
+		transferTokens: connect.NewClient[protocol.TransferTokensRequest, protocol.TransferTokensResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceTransferTokensProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("TransferTokens")),
+			connect.WithClientOptions(opts...),
+		),
+		createBurn: connect.NewClient[protocol.CreateBurnRequest, protocol.CreateBurnResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceCreateBurnProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("CreateBurn")),
+			connect.WithClientOptions(opts...),
+		),
+		createBurnSignature: connect.NewClient[protocol.CreateBurnSignatureRequest, protocol.CreateBurnSignatureResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceCreateBurnSignatureProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("CreateBurnSignature")),
+			connect.WithClientOptions(opts...),
+		),
+		createAttestation: connect.NewClient[protocol.CreateAttestationRequest, protocol.CreateAttestationResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceCreateAttestationProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("CreateAttestation")),
+			connect.WithClientOptions(opts...),
+		),
+		createDistribution: connect.NewClient[protocol.CreateDistributionRequest, protocol.CreateDistributionResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceCreateDistributionProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("CreateDistribution")),
+			connect.WithClientOptions(opts...),
+		),
+		getDistribution: connect.NewClient[protocol.GetDistributionRequest, protocol.GetDistributionResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceGetDistributionProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("GetDistribution")),
+			connect.WithClientOptions(opts...),
+		),
+		getDistributions: connect.NewClient[protocol.GetDistributionsRequest, protocol.GetDistributionsResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceGetDistributionsProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("GetDistributions")),
+			connect.WithClientOptions(opts...),
+		),
 		getSellOffers: connect.NewClient[protocol.GetSellOffersRequest, protocol.GetSellOffersResponse](
 			httpClient,
 			baseURL+FractalEngineRpcServiceGetSellOffersProcedure,
@@ -262,32 +456,58 @@ func NewFractalEngineRpcServiceClient(httpClient connect.HTTPClient, baseURL str
 			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("DeleteBuyOffer")),
 			connect.WithClientOptions(opts...),
 		),
+		getOrderBook: connect.NewClient[protocol.GetOrderBookRequest, protocol.GetOrderBookResponse](
+			httpClient,
+			baseURL+FractalEngineRpcServiceGetOrderBookProcedure,
+			connect.WithSchema(fractalEngineRpcServiceMethods.ByName("GetOrderBook")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // fractalEngineRpcServiceClient implements FractalEngineRpcServiceClient.
 type fractalEngineRpcServiceClient struct {
-	dogeConfirm             *connect.Client[protocol.DogeConfirmRequest, protocol.DogeConfirmResponse]
-	dogeSend                *connect.Client[protocol.DogeSendRequest, protocol.DogeSendResponse]
-	dogeTopUp               *connect.Client[protocol.DogeTopUpRequest, protocol.DogeTopUpResponse]
-	getHealth               *connect.Client[protocol.GetHealthRequest, protocol.GetHealthResponse]
-	getStats                *connect.Client[protocol.GetStatsRequest, protocol.GetStatsResponse]
-	getInvoices             *connect.Client[protocol.GetInvoicesRequest, protocol.GetInvoicesResponse]
-	getAllInvoices          *connect.Client[protocol.GetAllInvoicesRequest, protocol.GetAllInvoicesResponse]
-	createInvoice           *connect.Client[protocol.CreateInvoiceRequest, protocol.CreateInvoiceResponse]
-	createInvoiceSignature  *connect.Client[protocol.CreateInvoiceSignatureRequest, protocol.CreateInvoiceSignatureResponse]
-	getMints                *connect.Client[protocol.GetMintsRequest, protocol.GetMintsResponse]
-	getMint                 *connect.Client[protocol.GetMintRequest, protocol.GetMintResponse]
-	createMint              *connect.Client[protocol.CreateMintRequest, protocol.CreateMintResponse]
-	createNewPayment        *connect.Client[protocol.CreateNewPaymentRequest, protocol.CreateNewPaymentResponse]
-	getPendingTokenBalances *connect.Client[protocol.GetPendingTokenBalancesRequest, protocol.GetPendingTokenBalancesResponse]
-	getTokenBalances        *connect.Client[protocol.GetTokenBalancesRequest, protocol.GetTokenBalancesResponse]
-	getSellOffers           *connect.Client[protocol.GetSellOffersRequest, protocol.GetSellOffersResponse]
-	createSellOffer         *connect.Client[protocol.CreateSellOfferRequest, protocol.CreateSellOfferResponse]
-	deleteSellOffer         *connect.Client[protocol.DeleteSellOfferRequest, protocol.DeleteSellOfferResponse]
-	getBuyOffers            *connect.Client[protocol.GetBuyOffersRequest, protocol.GetBuyOffersResponse]
-	createBuyOffer          *connect.Client[protocol.CreateBuyOfferRequest, protocol.CreateBuyOfferResponse]
-	deleteBuyOffer          *connect.Client[protocol.DeleteBuyOfferRequest, protocol.DeleteBuyOfferResponse]
+	dogeConfirm                  *connect.Client[protocol.DogeConfirmRequest, protocol.DogeConfirmResponse]
+	dogeSend                     *connect.Client[protocol.DogeSendRequest, protocol.DogeSendResponse]
+	dogeTopUp                    *connect.Client[protocol.DogeTopUpRequest, protocol.DogeTopUpResponse]
+	getHealth                    *connect.Client[protocol.GetHealthRequest, protocol.GetHealthResponse]
+	getStats                     *connect.Client[protocol.GetStatsRequest, protocol.GetStatsResponse]
+	subscribeEvents              *connect.Client[protocol.SubscribeEventsRequest, protocol.SubscribeEventsResponse]
+	createWebhook                *connect.Client[protocol.CreateWebhookRequest, protocol.CreateWebhookResponse]
+	getWebhooks                  *connect.Client[protocol.GetWebhooksRequest, protocol.GetWebhooksResponse]
+	deleteWebhook                *connect.Client[protocol.DeleteWebhookRequest, protocol.DeleteWebhookResponse]
+	getWebhookDeliveries         *connect.Client[protocol.GetWebhookDeliveriesRequest, protocol.GetWebhookDeliveriesResponse]
+	replayWebhookDelivery        *connect.Client[protocol.ReplayWebhookDeliveryRequest, protocol.ReplayWebhookDeliveryResponse]
+	getInvoices                  *connect.Client[protocol.GetInvoicesRequest, protocol.GetInvoicesResponse]
+	getAllInvoices               *connect.Client[protocol.GetAllInvoicesRequest, protocol.GetAllInvoicesResponse]
+	createInvoice                *connect.Client[protocol.CreateInvoiceRequest, protocol.CreateInvoiceResponse]
+	createInvoiceSignature       *connect.Client[protocol.CreateInvoiceSignatureRequest, protocol.CreateInvoiceSignatureResponse]
+	cancelInvoice                *connect.Client[protocol.CancelInvoiceRequest, protocol.CancelInvoiceResponse]
+	getInvoiceHistory            *connect.Client[protocol.GetInvoiceHistoryRequest, protocol.GetInvoiceHistoryResponse]
+	getMints                     *connect.Client[protocol.GetMintsRequest, protocol.GetMintsResponse]
+	getMint                      *connect.Client[protocol.GetMintRequest, protocol.GetMintResponse]
+	createMint                   *connect.Client[protocol.CreateMintRequest, protocol.CreateMintResponse]
+	amendMint                    *connect.Client[protocol.AmendMintRequest, protocol.AmendMintResponse]
+	createMintAmendmentSignature *connect.Client[protocol.CreateMintAmendmentSignatureRequest, protocol.CreateMintAmendmentSignatureResponse]
+	transferMintOwnership        *connect.Client[protocol.TransferMintOwnershipRequest, protocol.TransferMintOwnershipResponse]
+	createNewPayment             *connect.Client[protocol.CreateNewPaymentRequest, protocol.CreateNewPaymentResponse]
+	getPendingTokenBalances      *connect.Client[protocol.GetPendingTokenBalancesRequest, protocol.GetPendingTokenBalancesResponse]
+	getTokenBalances             *connect.Client[protocol.GetTokenBalancesRequest, protocol.GetTokenBalancesResponse]
+	getCapTable                  *connect.Client[protocol.GetCapTableRequest, protocol.GetCapTableResponse]
+	transferTokens               *connect.Client[protocol.TransferTokensRequest, protocol.TransferTokensResponse]
+	createBurn                   *connect.Client[protocol.CreateBurnRequest, protocol.CreateBurnResponse]
+	createBurnSignature          *connect.Client[protocol.CreateBurnSignatureRequest, protocol.CreateBurnSignatureResponse]
+	createAttestation            *connect.Client[protocol.CreateAttestationRequest, protocol.CreateAttestationResponse]
+	createDistribution           *connect.Client[protocol.CreateDistributionRequest, protocol.CreateDistributionResponse]
+	getDistribution              *connect.Client[protocol.GetDistributionRequest, protocol.GetDistributionResponse]
+	getDistributions             *connect.Client[protocol.GetDistributionsRequest, protocol.GetDistributionsResponse]
+	getSellOffers                *connect.Client[protocol.GetSellOffersRequest, protocol.GetSellOffersResponse]
+	createSellOffer              *connect.Client[protocol.CreateSellOfferRequest, protocol.CreateSellOfferResponse]
+	deleteSellOffer              *connect.Client[protocol.DeleteSellOfferRequest, protocol.DeleteSellOfferResponse]
+	getBuyOffers                 *connect.Client[protocol.GetBuyOffersRequest, protocol.GetBuyOffersResponse]
+	createBuyOffer               *connect.Client[protocol.CreateBuyOfferRequest, protocol.CreateBuyOfferResponse]
+	deleteBuyOffer               *connect.Client[protocol.DeleteBuyOfferRequest, protocol.DeleteBuyOfferResponse]
+	getOrderBook                 *connect.Client[protocol.GetOrderBookRequest, protocol.GetOrderBookResponse]
 }
 
 // DogeConfirm calls fractalengine.rpc.v1.FractalEngineRpcService.DogeConfirm.
@@ -315,6 +535,36 @@ func (c *fractalEngineRpcServiceClient) GetStats(ctx context.Context, req *conne
 	return c.getStats.CallUnary(ctx, req)
 }
 
+// SubscribeEvents calls fractalengine.rpc.v1.FractalEngineRpcService.SubscribeEvents.
+func (c *fractalEngineRpcServiceClient) SubscribeEvents(ctx context.Context, req *connect.Request[protocol.SubscribeEventsRequest]) (*connect.ServerStreamForClient[protocol.SubscribeEventsResponse], error) {
+	return c.subscribeEvents.CallServerStream(ctx, req)
+}
+
+// CreateWebhook calls fractalengine.rpc.v1.FractalEngineRpcService.CreateWebhook.
+func (c *fractalEngineRpcServiceClient) CreateWebhook(ctx context.Context, req *connect.Request[protocol.CreateWebhookRequest]) (*connect.Response[protocol.CreateWebhookResponse], error) {
+	return c.createWebhook.CallUnary(ctx, req)
+}
+
+// GetWebhooks calls fractalengine.rpc.v1.FractalEngineRpcService.GetWebhooks.
+func (c *fractalEngineRpcServiceClient) GetWebhooks(ctx context.Context, req *connect.Request[protocol.GetWebhooksRequest]) (*connect.Response[protocol.GetWebhooksResponse], error) {
+	return c.getWebhooks.CallUnary(ctx, req)
+}
+
+// DeleteWebhook calls fractalengine.rpc.v1.FractalEngineRpcService.DeleteWebhook.
+func (c *fractalEngineRpcServiceClient) DeleteWebhook(ctx context.Context, req *connect.Request[protocol.DeleteWebhookRequest]) (*connect.Response[protocol.DeleteWebhookResponse], error) {
+	return c.deleteWebhook.CallUnary(ctx, req)
+}
+
+// GetWebhookDeliveries calls fractalengine.rpc.v1.FractalEngineRpcService.GetWebhookDeliveries.
+func (c *fractalEngineRpcServiceClient) GetWebhookDeliveries(ctx context.Context, req *connect.Request[protocol.GetWebhookDeliveriesRequest]) (*connect.Response[protocol.GetWebhookDeliveriesResponse], error) {
+	return c.getWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// ReplayWebhookDelivery calls fractalengine.rpc.v1.FractalEngineRpcService.ReplayWebhookDelivery.
+func (c *fractalEngineRpcServiceClient) ReplayWebhookDelivery(ctx context.Context, req *connect.Request[protocol.ReplayWebhookDeliveryRequest]) (*connect.Response[protocol.ReplayWebhookDeliveryResponse], error) {
+	return c.replayWebhookDelivery.CallUnary(ctx, req)
+}
+
 // GetInvoices calls fractalengine.rpc.v1.FractalEngineRpcService.GetInvoices.
 func (c *fractalEngineRpcServiceClient) GetInvoices(ctx context.Context, req *connect.Request[protocol.GetInvoicesRequest]) (*connect.Response[protocol.GetInvoicesResponse], error) {
 	return c.getInvoices.CallUnary(ctx, req)
@@ -335,6 +585,16 @@ func (c *fractalEngineRpcServiceClient) CreateInvoiceSignature(ctx context.Conte
 	return c.createInvoiceSignature.CallUnary(ctx, req)
 }
 
+// CancelInvoice calls fractalengine.rpc.v1.FractalEngineRpcService.CancelInvoice.
+func (c *fractalEngineRpcServiceClient) CancelInvoice(ctx context.Context, req *connect.Request[protocol.CancelInvoiceRequest]) (*connect.Response[protocol.CancelInvoiceResponse], error) {
+	return c.cancelInvoice.CallUnary(ctx, req)
+}
+
+// GetInvoiceHistory calls fractalengine.rpc.v1.FractalEngineRpcService.GetInvoiceHistory.
+func (c *fractalEngineRpcServiceClient) GetInvoiceHistory(ctx context.Context, req *connect.Request[protocol.GetInvoiceHistoryRequest]) (*connect.Response[protocol.GetInvoiceHistoryResponse], error) {
+	return c.getInvoiceHistory.CallUnary(ctx, req)
+}
+
 // GetMints calls fractalengine.rpc.v1.FractalEngineRpcService.GetMints.
 func (c *fractalEngineRpcServiceClient) GetMints(ctx context.Context, req *connect.Request[protocol.GetMintsRequest]) (*connect.Response[protocol.GetMintsResponse], error) {
 	return c.getMints.CallUnary(ctx, req)
@@ -350,6 +610,22 @@ func (c *fractalEngineRpcServiceClient) CreateMint(ctx context.Context, req *con
 	return c.createMint.CallUnary(ctx, req)
 }
 
+// AmendMint calls fractalengine.rpc.v1.FractalEngineRpcService.AmendMint.
+func (c *fractalEngineRpcServiceClient) AmendMint(ctx context.Context, req *connect.Request[protocol.AmendMintRequest]) (*connect.Response[protocol.AmendMintResponse], error) {
+	return c.amendMint.CallUnary(ctx, req)
+}
+
+// CreateMintAmendmentSignature calls
+// fractalengine.rpc.v1.FractalEngineRpcService.CreateMintAmendmentSignature.
+func (c *fractalEngineRpcServiceClient) CreateMintAmendmentSignature(ctx context.Context, req *connect.Request[protocol.CreateMintAmendmentSignatureRequest]) (*connect.Response[protocol.CreateMintAmendmentSignatureResponse], error) {
+	return c.createMintAmendmentSignature.CallUnary(ctx, req)
+}
+
+// TransferMintOwnership calls fractalengine.rpc.v1.FractalEngineRpcService.TransferMintOwnership.
+func (c *fractalEngineRpcServiceClient) TransferMintOwnership(ctx context.Context, req *connect.Request[protocol.TransferMintOwnershipRequest]) (*connect.Response[protocol.TransferMintOwnershipResponse], error) {
+	return c.transferMintOwnership.CallUnary(ctx, req)
+}
+
 // CreateNewPayment calls fractalengine.rpc.v1.FractalEngineRpcService.CreateNewPayment.
 func (c *fractalEngineRpcServiceClient) CreateNewPayment(ctx context.Context, req *connect.Request[protocol.CreateNewPaymentRequest]) (*connect.Response[protocol.CreateNewPaymentResponse], error) {
 	return c.createNewPayment.CallUnary(ctx, req)
@@ -366,6 +642,46 @@ func (c *fractalEngineRpcServiceClient) GetTokenBalances(ctx context.Context, re
 	return c.getTokenBalances.CallUnary(ctx, req)
 }
 
+// GetCapTable calls fractalengine.rpc.v1.FractalEngineRpcService.GetCapTable.
+func (c *fractalEngineRpcServiceClient) GetCapTable(ctx context.Context, req *connect.Request[protocol.GetCapTableRequest]) (*connect.Response[protocol.GetCapTableResponse], error) {
+	return c.getCapTable.CallUnary(ctx, req)
+}
+
+// TransferTokens calls fractalengine.rpc.v1.FractalEngineRpcService.TransferTokens.
+func (c *fractalEngineRpcServiceClient) TransferTokens(ctx context.Context, req *connect.Request[protocol.TransferTokensRequest]) (*connect.Response[protocol.TransferTokensResponse], error) {
+	return c.transferTokens.CallUnary(ctx, req)
+}
+
+// CreateBurn calls fractalengine.rpc.v1.FractalEngineRpcService.CreateBurn.
+func (c *fractalEngineRpcServiceClient) CreateBurn(ctx context.Context, req *connect.Request[protocol.CreateBurnRequest]) (*connect.Response[protocol.CreateBurnResponse], error) {
+	return c.createBurn.CallUnary(ctx, req)
+}
+
+// CreateBurnSignature calls fractalengine.rpc.v1.FractalEngineRpcService.CreateBurnSignature.
+func (c *fractalEngineRpcServiceClient) CreateBurnSignature(ctx context.Context, req *connect.Request[protocol.CreateBurnSignatureRequest]) (*connect.Response[protocol.CreateBurnSignatureResponse], error) {
+	return c.createBurnSignature.CallUnary(ctx, req)
+}
+
+// CreateAttestation calls fractalengine.rpc.v1.FractalEngineRpcService.CreateAttestation.
+func (c *fractalEngineRpcServiceClient) CreateAttestation(ctx context.Context, req *connect.Request[protocol.CreateAttestationRequest]) (*connect.Response[protocol.CreateAttestationResponse], error) {
+	return c.createAttestation.CallUnary(ctx, req)
+}
+
+// CreateDistribution calls fractalengine.rpc.v1.FractalEngineRpcService.CreateDistribution.
+func (c *fractalEngineRpcServiceClient) CreateDistribution(ctx context.Context, req *connect.Request[protocol.CreateDistributionRequest]) (*connect.Response[protocol.CreateDistributionResponse], error) {
+	return c.createDistribution.CallUnary(ctx, req)
+}
+
+// GetDistribution calls fractalengine.rpc.v1.FractalEngineRpcService.GetDistribution.
+func (c *fractalEngineRpcServiceClient) GetDistribution(ctx context.Context, req *connect.Request[protocol.GetDistributionRequest]) (*connect.Response[protocol.GetDistributionResponse], error) {
+	return c.getDistribution.CallUnary(ctx, req)
+}
+
+// GetDistributions calls fractalengine.rpc.v1.FractalEngineRpcService.GetDistributions.
+func (c *fractalEngineRpcServiceClient) GetDistributions(ctx context.Context, req *connect.Request[protocol.GetDistributionsRequest]) (*connect.Response[protocol.GetDistributionsResponse], error) {
+	return c.getDistributions.CallUnary(ctx, req)
+}
+
 // GetSellOffers calls fractalengine.rpc.v1.FractalEngineRpcService.GetSellOffers.
 func (c *fractalEngineRpcServiceClient) GetSellOffers(ctx context.Context, req *connect.Request[protocol.GetSellOffersRequest]) (*connect.Response[protocol.GetSellOffersResponse], error) {
 	return c.getSellOffers.CallUnary(ctx, req)
@@ -396,6 +712,11 @@ func (c *fractalEngineRpcServiceClient) DeleteBuyOffer(ctx context.Context, req
 	return c.deleteBuyOffer.CallUnary(ctx, req)
 }
 
+// GetOrderBook calls fractalengine.rpc.v1.FractalEngineRpcService.GetOrderBook.
+func (c *fractalEngineRpcServiceClient) GetOrderBook(ctx context.Context, req *connect.Request[protocol.GetOrderBookRequest]) (*connect.Response[protocol.GetOrderBookResponse], error) {
+	return c.getOrderBook.CallUnary(ctx, req)
+}
+
 // FractalEngineRpcServiceHandler is an implementation of the
 // fractalengine.rpc.v1.FractalEngineRpcService service.
 type FractalEngineRpcServiceHandler interface {
@@ -404,22 +725,42 @@ type FractalEngineRpcServiceHandler interface {
 	DogeTopUp(context.Context, *connect.Request[protocol.DogeTopUpRequest]) (*connect.Response[protocol.DogeTopUpResponse], error)
 	GetHealth(context.Context, *connect.Request[protocol.GetHealthRequest]) (*connect.Response[protocol.GetHealthResponse], error)
 	GetStats(context.Context, *connect.Request[protocol.GetStatsRequest]) (*connect.Response[protocol.GetStatsResponse], error)
+	SubscribeEvents(context.Context, *connect.Request[protocol.SubscribeEventsRequest], *connect.ServerStream[protocol.SubscribeEventsResponse]) error
+	CreateWebhook(context.Context, *connect.Request[protocol.CreateWebhookRequest]) (*connect.Response[protocol.CreateWebhookResponse], error)
+	GetWebhooks(context.Context, *connect.Request[protocol.GetWebhooksRequest]) (*connect.Response[protocol.GetWebhooksResponse], error)
+	DeleteWebhook(context.Context, *connect.Request[protocol.DeleteWebhookRequest]) (*connect.Response[protocol.DeleteWebhookResponse], error)
+	GetWebhookDeliveries(context.Context, *connect.Request[protocol.GetWebhookDeliveriesRequest]) (*connect.Response[protocol.GetWebhookDeliveriesResponse], error)
+	ReplayWebhookDelivery(context.Context, *connect.Request[protocol.ReplayWebhookDeliveryRequest]) (*connect.Response[protocol.ReplayWebhookDeliveryResponse], error)
 	GetInvoices(context.Context, *connect.Request[protocol.GetInvoicesRequest]) (*connect.Response[protocol.GetInvoicesResponse], error)
 	GetAllInvoices(context.Context, *connect.Request[protocol.GetAllInvoicesRequest]) (*connect.Response[protocol.GetAllInvoicesResponse], error)
 	CreateInvoice(context.Context, *connect.Request[protocol.CreateInvoiceRequest]) (*connect.Response[protocol.CreateInvoiceResponse], error)
 	CreateInvoiceSignature(context.Context, *connect.Request[protocol.CreateInvoiceSignatureRequest]) (*connect.Response[protocol.CreateInvoiceSignatureResponse], error)
+	CancelInvoice(context.Context, *connect.Request[protocol.CancelInvoiceRequest]) (*connect.Response[protocol.CancelInvoiceResponse], error)
+	GetInvoiceHistory(context.Context, *connect.Request[protocol.GetInvoiceHistoryRequest]) (*connect.Response[protocol.GetInvoiceHistoryResponse], error)
 	GetMints(context.Context, *connect.Request[protocol.GetMintsRequest]) (*connect.Response[protocol.GetMintsResponse], error)
 	GetMint(context.Context, *connect.Request[protocol.GetMintRequest]) (*connect.Response[protocol.GetMintResponse], error)
 	CreateMint(context.Context, *connect.Request[protocol.CreateMintRequest]) (*connect.Response[protocol.CreateMintResponse], error)
+	AmendMint(context.Context, *connect.Request[protocol.AmendMintRequest]) (*connect.Response[protocol.AmendMintResponse], error)
+	CreateMintAmendmentSignature(context.Context, *connect.Request[protocol.CreateMintAmendmentSignatureRequest]) (*connect.Response[protocol.CreateMintAmendmentSignatureResponse], error)
+	TransferMintOwnership(context.Context, *connect.Request[protocol.TransferMintOwnershipRequest]) (*connect.Response[protocol.TransferMintOwnershipResponse], error)
 	CreateNewPayment(context.Context, *connect.Request[protocol.CreateNewPaymentRequest]) (*connect.Response[protocol.CreateNewPaymentResponse], error)
 	GetPendingTokenBalances(context.Context, *connect.Request[protocol.GetPendingTokenBalancesRequest]) (*connect.Response[protocol.GetPendingTokenBalancesResponse], error)
 	GetTokenBalances(context.Context, *connect.Request[protocol.GetTokenBalancesRequest]) (*connect.Response[protocol.GetTokenBalancesResponse], error)
+	GetCapTable(context.Context, *connect.Request[protocol.GetCapTableRequest]) (*connect.Response[protocol.GetCapTableResponse], error)
+	TransferTokens(context.Context, *connect.Request[protocol.TransferTokensRequest]) (*connect.Response[protocol.TransferTokensResponse], error)
+	CreateBurn(context.Context, *connect.Request[protocol.CreateBurnRequest]) (*connect.Response[protocol.CreateBurnResponse], error)
+	CreateBurnSignature(context.Context, *connect.Request[protocol.CreateBurnSignatureRequest]) (*connect.Response[protocol.CreateBurnSignatureResponse], error)
+	CreateAttestation(context.Context, *connect.Request[protocol.CreateAttestationRequest]) (*connect.Response[protocol.CreateAttestationResponse], error)
+	CreateDistribution(context.Context, *connect.Request[protocol.CreateDistributionRequest]) (*connect.Response[protocol.CreateDistributionResponse], error)
+	GetDistribution(context.Context, *connect.Request[protocol.GetDistributionRequest]) (*connect.Response[protocol.GetDistributionResponse], error)
+	GetDistributions(context.Context, *connect.Request[protocol.GetDistributionsRequest]) (*connect.Response[protocol.GetDistributionsResponse], error)
 	GetSellOffers(context.Context, *connect.Request[protocol.GetSellOffersRequest]) (*connect.Response[protocol.GetSellOffersResponse], error)
 	CreateSellOffer(context.Context, *connect.Request[protocol.CreateSellOfferRequest]) (*connect.Response[protocol.CreateSellOfferResponse], error)
 	DeleteSellOffer(context.Context, *connect.Request[protocol.DeleteSellOfferRequest]) (*connect.Response[protocol.DeleteSellOfferResponse], error)
 	GetBuyOffers(context.Context, *connect.Request[protocol.GetBuyOffersRequest]) (*connect.Response[protocol.GetBuyOffersResponse], error)
 	CreateBuyOffer(context.Context, *connect.Request[protocol.CreateBuyOfferRequest]) (*connect.Response[protocol.CreateBuyOfferResponse], error)
 	DeleteBuyOffer(context.Context, *connect.Request[protocol.DeleteBuyOfferRequest]) (*connect.Response[protocol.DeleteBuyOfferResponse], error)
+	GetOrderBook(context.Context, *connect.Request[protocol.GetOrderBookRequest]) (*connect.Response[protocol.GetOrderBookResponse], error)
 }
 
 // NewFractalEngineRpcServiceHandler builds an HTTP handler from the service implementation. It
@@ -459,6 +800,42 @@ func NewFractalEngineRpcServiceHandler(svc FractalEngineRpcServiceHandler, opts
 		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("GetStats")),
 		connect.WithHandlerOptions(opts...),
 	)
+	fractalEngineRpcServiceSubscribeEventsHandler := connect.NewServerStreamHandler(
+		FractalEngineRpcServiceSubscribeEventsProcedure,
+		svc.SubscribeEvents,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("SubscribeEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fractalEngineRpcServiceCreateWebhookHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceCreateWebhookProcedure,
+		svc.CreateWebhook,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("CreateWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fractalEngineRpcServiceGetWebhooksHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceGetWebhooksProcedure,
+		svc.GetWebhooks,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("GetWebhooks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fractalEngineRpcServiceDeleteWebhookHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceDeleteWebhookProcedure,
+		svc.DeleteWebhook,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("DeleteWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fractalEngineRpcServiceGetWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceGetWebhookDeliveriesProcedure,
+		svc.GetWebhookDeliveries,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("GetWebhookDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fractalEngineRpcServiceReplayWebhookDeliveryHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceReplayWebhookDeliveryProcedure,
+		svc.ReplayWebhookDelivery,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("ReplayWebhookDelivery")),
+		connect.WithHandlerOptions(opts...),
+	)
 	fractalEngineRpcServiceGetInvoicesHandler := connect.NewUnaryHandler(
 		FractalEngineRpcServiceGetInvoicesProcedure,
 		svc.GetInvoices,
@@ -483,6 +860,18 @@ func NewFractalEngineRpcServiceHandler(svc FractalEngineRpcServiceHandler, opts
 		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("CreateInvoiceSignature")),
 		connect.WithHandlerOptions(opts...),
 	)
+	fractalEngineRpcServiceCancelInvoiceHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceCancelInvoiceProcedure,
+		svc.CancelInvoice,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("CancelInvoice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fractalEngineRpcServiceGetInvoiceHistoryHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceGetInvoiceHistoryProcedure,
+		svc.GetInvoiceHistory,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("GetInvoiceHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
 	fractalEngineRpcServiceGetMintsHandler := connect.NewUnaryHandler(
 		FractalEngineRpcServiceGetMintsProcedure,
 		svc.GetMints,
@@ -501,6 +890,24 @@ func NewFractalEngineRpcServiceHandler(svc FractalEngineRpcServiceHandler, opts
 		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("CreateMint")),
 		connect.WithHandlerOptions(opts...),
 	)
+	fractalEngineRpcServiceAmendMintHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceAmendMintProcedure,
+		svc.AmendMint,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("AmendMint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fractalEngineRpcServiceCreateMintAmendmentSignatureHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceCreateMintAmendmentSignatureProcedure,
+		svc.CreateMintAmendmentSignature,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("CreateMintAmendmentSignature")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fractalEngineRpcServiceTransferMintOwnershipHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceTransferMintOwnershipProcedure,
+		svc.TransferMintOwnership,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("TransferMintOwnership")),
+		connect.WithHandlerOptions(opts...),
+	)
 	fractalEngineRpcServiceCreateNewPaymentHandler := connect.NewUnaryHandler(
 		FractalEngineRpcServiceCreateNewPaymentProcedure,
 		svc.CreateNewPayment,
@@ -519,6 +926,54 @@ func NewFractalEngineRpcServiceHandler(svc FractalEngineRpcServiceHandler, opts
 		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("GetTokenBalances")),
 		connect.WithHandlerOptions(opts...),
 	)
+	fractalEngineRpcServiceGetCapTableHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceGetCapTableProcedure,
+		svc.GetCapTable,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("GetCapTable")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fractalEngineRpcServiceTransferTokensHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceTransferTokensProcedure,
+		svc.TransferTokens,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("TransferTokens")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fractalEngineRpcServiceCreateBurnHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceCreateBurnProcedure,
+		svc.CreateBurn,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("CreateBurn")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fractalEngineRpcServiceCreateBurnSignatureHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceCreateBurnSignatureProcedure,
+		svc.CreateBurnSignature,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("CreateBurnSignature")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fractalEngineRpcServiceCreateAttestationHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceCreateAttestationProcedure,
+		svc.CreateAttestation,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("CreateAttestation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fractalEngineRpcServiceCreateDistributionHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceCreateDistributionProcedure,
+		svc.CreateDistribution,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("CreateDistribution")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fractalEngineRpcServiceGetDistributionHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceGetDistributionProcedure,
+		svc.GetDistribution,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("GetDistribution")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fractalEngineRpcServiceGetDistributionsHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceGetDistributionsProcedure,
+		svc.GetDistributions,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("GetDistributions")),
+		connect.WithHandlerOptions(opts...),
+	)
 	fractalEngineRpcServiceGetSellOffersHandler := connect.NewUnaryHandler(
 		FractalEngineRpcServiceGetSellOffersProcedure,
 		svc.GetSellOffers,
@@ -555,6 +1010,12 @@ func NewFractalEngineRpcServiceHandler(svc FractalEngineRpcServiceHandler, opts
 		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("DeleteBuyOffer")),
 		connect.WithHandlerOptions(opts...),
 	)
+	fractalEngineRpcServiceGetOrderBookHandler := connect.NewUnaryHandler(
+		FractalEngineRpcServiceGetOrderBookProcedure,
+		svc.GetOrderBook,
+		connect.WithSchema(fractalEngineRpcServiceMethods.ByName("GetOrderBook")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/fractalengine.rpc.v1.FractalEngineRpcService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case FractalEngineRpcServiceDogeConfirmProcedure:
@@ -567,6 +1028,18 @@ func NewFractalEngineRpcServiceHandler(svc FractalEngineRpcServiceHandler, opts
 			fractalEngineRpcServiceGetHealthHandler.ServeHTTP(w, r)
 		case FractalEngineRpcServiceGetStatsProcedure:
 			fractalEngineRpcServiceGetStatsHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceSubscribeEventsProcedure:
+			fractalEngineRpcServiceSubscribeEventsHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceCreateWebhookProcedure:
+			fractalEngineRpcServiceCreateWebhookHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceGetWebhooksProcedure:
+			fractalEngineRpcServiceGetWebhooksHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceDeleteWebhookProcedure:
+			fractalEngineRpcServiceDeleteWebhookHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceGetWebhookDeliveriesProcedure:
+			fractalEngineRpcServiceGetWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceReplayWebhookDeliveryProcedure:
+			fractalEngineRpcServiceReplayWebhookDeliveryHandler.ServeHTTP(w, r)
 		case FractalEngineRpcServiceGetInvoicesProcedure:
 			fractalEngineRpcServiceGetInvoicesHandler.ServeHTTP(w, r)
 		case FractalEngineRpcServiceGetAllInvoicesProcedure:
@@ -575,18 +1048,44 @@ func NewFractalEngineRpcServiceHandler(svc FractalEngineRpcServiceHandler, opts
 			fractalEngineRpcServiceCreateInvoiceHandler.ServeHTTP(w, r)
 		case FractalEngineRpcServiceCreateInvoiceSignatureProcedure:
 			fractalEngineRpcServiceCreateInvoiceSignatureHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceCancelInvoiceProcedure:
+			fractalEngineRpcServiceCancelInvoiceHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceGetInvoiceHistoryProcedure:
+			fractalEngineRpcServiceGetInvoiceHistoryHandler.ServeHTTP(w, r)
 		case FractalEngineRpcServiceGetMintsProcedure:
 			fractalEngineRpcServiceGetMintsHandler.ServeHTTP(w, r)
 		case FractalEngineRpcServiceGetMintProcedure:
 			fractalEngineRpcServiceGetMintHandler.ServeHTTP(w, r)
 		case FractalEngineRpcServiceCreateMintProcedure:
 			fractalEngineRpcServiceCreateMintHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceAmendMintProcedure:
+			fractalEngineRpcServiceAmendMintHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceCreateMintAmendmentSignatureProcedure:
+			fractalEngineRpcServiceCreateMintAmendmentSignatureHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceTransferMintOwnershipProcedure:
+			fractalEngineRpcServiceTransferMintOwnershipHandler.ServeHTTP(w, r)
 		case FractalEngineRpcServiceCreateNewPaymentProcedure:
 			fractalEngineRpcServiceCreateNewPaymentHandler.ServeHTTP(w, r)
 		case FractalEngineRpcServiceGetPendingTokenBalancesProcedure:
 			fractalEngineRpcServiceGetPendingTokenBalancesHandler.ServeHTTP(w, r)
 		case FractalEngineRpcServiceGetTokenBalancesProcedure:
 			fractalEngineRpcServiceGetTokenBalancesHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceGetCapTableProcedure:
+			fractalEngineRpcServiceGetCapTableHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceTransferTokensProcedure:
+			fractalEngineRpcServiceTransferTokensHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceCreateBurnProcedure:
+			fractalEngineRpcServiceCreateBurnHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceCreateBurnSignatureProcedure:
+			fractalEngineRpcServiceCreateBurnSignatureHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceCreateAttestationProcedure:
+			fractalEngineRpcServiceCreateAttestationHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceCreateDistributionProcedure:
+			fractalEngineRpcServiceCreateDistributionHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceGetDistributionProcedure:
+			fractalEngineRpcServiceGetDistributionHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceGetDistributionsProcedure:
+			fractalEngineRpcServiceGetDistributionsHandler.ServeHTTP(w, r)
 		case FractalEngineRpcServiceGetSellOffersProcedure:
 			fractalEngineRpcServiceGetSellOffersHandler.ServeHTTP(w, r)
 		case FractalEngineRpcServiceCreateSellOfferProcedure:
@@ -599,6 +1098,8 @@ func NewFractalEngineRpcServiceHandler(svc FractalEngineRpcServiceHandler, opts
 			fractalEngineRpcServiceCreateBuyOfferHandler.ServeHTTP(w, r)
 		case FractalEngineRpcServiceDeleteBuyOfferProcedure:
 			fractalEngineRpcServiceDeleteBuyOfferHandler.ServeHTTP(w, r)
+		case FractalEngineRpcServiceGetOrderBookProcedure:
+			fractalEngineRpcServiceGetOrderBookHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -628,6 +1129,30 @@ func (UnimplementedFractalEngineRpcServiceHandler) GetStats(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.GetStats is not implemented"))
 }
 
+func (UnimplementedFractalEngineRpcServiceHandler) SubscribeEvents(context.Context, *connect.Request[protocol.SubscribeEventsRequest], *connect.ServerStream[protocol.SubscribeEventsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.SubscribeEvents is not implemented"))
+}
+
+func (UnimplementedFractalEngineRpcServiceHandler) CreateWebhook(context.Context, *connect.Request[protocol.CreateWebhookRequest]) (*connect.Response[protocol.CreateWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.CreateWebhook is not implemented"))
+}
+
+func (UnimplementedFractalEngineRpcServiceHandler) GetWebhooks(context.Context, *connect.Request[protocol.GetWebhooksRequest]) (*connect.Response[protocol.GetWebhooksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.GetWebhooks is not implemented"))
+}
+
+func (UnimplementedFractalEngineRpcServiceHandler) DeleteWebhook(context.Context, *connect.Request[protocol.DeleteWebhookRequest]) (*connect.Response[protocol.DeleteWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.DeleteWebhook is not implemented"))
+}
+
+func (UnimplementedFractalEngineRpcServiceHandler) GetWebhookDeliveries(context.Context, *connect.Request[protocol.GetWebhookDeliveriesRequest]) (*connect.Response[protocol.GetWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.GetWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedFractalEngineRpcServiceHandler) ReplayWebhookDelivery(context.Context, *connect.Request[protocol.ReplayWebhookDeliveryRequest]) (*connect.Response[protocol.ReplayWebhookDeliveryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.ReplayWebhookDelivery is not implemented"))
+}
+
 func (UnimplementedFractalEngineRpcServiceHandler) GetInvoices(context.Context, *connect.Request[protocol.GetInvoicesRequest]) (*connect.Response[protocol.GetInvoicesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.GetInvoices is not implemented"))
 }
@@ -644,6 +1169,14 @@ func (UnimplementedFractalEngineRpcServiceHandler) CreateInvoiceSignature(contex
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.CreateInvoiceSignature is not implemented"))
 }
 
+func (UnimplementedFractalEngineRpcServiceHandler) CancelInvoice(context.Context, *connect.Request[protocol.CancelInvoiceRequest]) (*connect.Response[protocol.CancelInvoiceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.CancelInvoice is not implemented"))
+}
+
+func (UnimplementedFractalEngineRpcServiceHandler) GetInvoiceHistory(context.Context, *connect.Request[protocol.GetInvoiceHistoryRequest]) (*connect.Response[protocol.GetInvoiceHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.GetInvoiceHistory is not implemented"))
+}
+
 func (UnimplementedFractalEngineRpcServiceHandler) GetMints(context.Context, *connect.Request[protocol.GetMintsRequest]) (*connect.Response[protocol.GetMintsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.GetMints is not implemented"))
 }
@@ -656,6 +1189,18 @@ func (UnimplementedFractalEngineRpcServiceHandler) CreateMint(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.CreateMint is not implemented"))
 }
 
+func (UnimplementedFractalEngineRpcServiceHandler) AmendMint(context.Context, *connect.Request[protocol.AmendMintRequest]) (*connect.Response[protocol.AmendMintResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.AmendMint is not implemented"))
+}
+
+func (UnimplementedFractalEngineRpcServiceHandler) CreateMintAmendmentSignature(context.Context, *connect.Request[protocol.CreateMintAmendmentSignatureRequest]) (*connect.Response[protocol.CreateMintAmendmentSignatureResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.CreateMintAmendmentSignature is not implemented"))
+}
+
+func (UnimplementedFractalEngineRpcServiceHandler) TransferMintOwnership(context.Context, *connect.Request[protocol.TransferMintOwnershipRequest]) (*connect.Response[protocol.TransferMintOwnershipResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.TransferMintOwnership is not implemented"))
+}
+
 func (UnimplementedFractalEngineRpcServiceHandler) CreateNewPayment(context.Context, *connect.Request[protocol.CreateNewPaymentRequest]) (*connect.Response[protocol.CreateNewPaymentResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.CreateNewPayment is not implemented"))
 }
@@ -668,6 +1213,38 @@ func (UnimplementedFractalEngineRpcServiceHandler) GetTokenBalances(context.Cont
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.GetTokenBalances is not implemented"))
 }
 
+func (UnimplementedFractalEngineRpcServiceHandler) GetCapTable(context.Context, *connect.Request[protocol.GetCapTableRequest]) (*connect.Response[protocol.GetCapTableResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.GetCapTable is not implemented"))
+}
+
+func (UnimplementedFractalEngineRpcServiceHandler) TransferTokens(context.Context, *connect.Request[protocol.TransferTokensRequest]) (*connect.Response[protocol.TransferTokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.TransferTokens is not implemented"))
+}
+
+func (UnimplementedFractalEngineRpcServiceHandler) CreateBurn(context.Context, *connect.Request[protocol.CreateBurnRequest]) (*connect.Response[protocol.CreateBurnResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.CreateBurn is not implemented"))
+}
+
+func (UnimplementedFractalEngineRpcServiceHandler) CreateBurnSignature(context.Context, *connect.Request[protocol.CreateBurnSignatureRequest]) (*connect.Response[protocol.CreateBurnSignatureResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.CreateBurnSignature is not implemented"))
+}
+
+func (UnimplementedFractalEngineRpcServiceHandler) CreateAttestation(context.Context, *connect.Request[protocol.CreateAttestationRequest]) (*connect.Response[protocol.CreateAttestationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.CreateAttestation is not implemented"))
+}
+
+func (UnimplementedFractalEngineRpcServiceHandler) CreateDistribution(context.Context, *connect.Request[protocol.CreateDistributionRequest]) (*connect.Response[protocol.CreateDistributionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.CreateDistribution is not implemented"))
+}
+
+func (UnimplementedFractalEngineRpcServiceHandler) GetDistribution(context.Context, *connect.Request[protocol.GetDistributionRequest]) (*connect.Response[protocol.GetDistributionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.GetDistribution is not implemented"))
+}
+
+func (UnimplementedFractalEngineRpcServiceHandler) GetDistributions(context.Context, *connect.Request[protocol.GetDistributionsRequest]) (*connect.Response[protocol.GetDistributionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.GetDistributions is not implemented"))
+}
+
 func (UnimplementedFractalEngineRpcServiceHandler) GetSellOffers(context.Context, *connect.Request[protocol.GetSellOffersRequest]) (*connect.Response[protocol.GetSellOffersResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.GetSellOffers is not implemented"))
 }
@@ -691,3 +1268,7 @@ func (UnimplementedFractalEngineRpcServiceHandler) CreateBuyOffer(context.Contex
 func (UnimplementedFractalEngineRpcServiceHandler) DeleteBuyOffer(context.Context, *connect.Request[protocol.DeleteBuyOfferRequest]) (*connect.Response[protocol.DeleteBuyOfferResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.DeleteBuyOffer is not implemented"))
 }
+
+func (UnimplementedFractalEngineRpcServiceHandler) GetOrderBook(context.Context, *connect.Request[protocol.GetOrderBookRequest]) (*connect.Response[protocol.GetOrderBookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fractalengine.rpc.v1.FractalEngineRpcService.GetOrderBook is not implemented"))
+}
@@ -24,75 +24,136 @@ var File_rpc_proto protoreflect.FileDescriptor
 
 const file_rpc_proto_rawDesc = "" +
 	"\n" +
-	"\trpc.proto\x12\x14fractalengine.rpc.v1\x1a\n" +
-	"doge.proto\x1a\fhealth.proto\x1a\x0einvoices.proto\x1a\vmints.proto\x1a\foffers.proto\x1a\x0epayments.proto\x1a\vstats.proto\x1a\ftokens.proto2\xbe\x11\n" +
+	"\trpc.proto\x12\x14fractalengine.rpc.v1\x1a\x12attestations.proto\x1a\vburns.proto\x1a\x13distributions.proto\x1a\n" +
+	"doge.proto\x1a\fevents.proto\x1a\fhealth.proto\x1a\x0einvoices.proto\x1a\vmints.proto\x1a\foffers.proto\x1a\x0epayments.proto\x1a\vstats.proto\x1a\ftokens.proto\x1a\x0ewebhooks.proto2\xaa#\n" +
 	"\x17FractalEngineRpcService\x12b\n" +
 	"\vDogeConfirm\x12(.fractalengine.rpc.v1.DogeConfirmRequest\x1a).fractalengine.rpc.v1.DogeConfirmResponse\x12Y\n" +
 	"\bDogeSend\x12%.fractalengine.rpc.v1.DogeSendRequest\x1a&.fractalengine.rpc.v1.DogeSendResponse\x12\\\n" +
 	"\tDogeTopUp\x12&.fractalengine.rpc.v1.DogeTopUpRequest\x1a'.fractalengine.rpc.v1.DogeTopUpResponse\x12\\\n" +
 	"\tGetHealth\x12&.fractalengine.rpc.v1.GetHealthRequest\x1a'.fractalengine.rpc.v1.GetHealthResponse\x12Y\n" +
-	"\bGetStats\x12%.fractalengine.rpc.v1.GetStatsRequest\x1a&.fractalengine.rpc.v1.GetStatsResponse\x12b\n" +
+	"\bGetStats\x12%.fractalengine.rpc.v1.GetStatsRequest\x1a&.fractalengine.rpc.v1.GetStatsResponse\x12p\n" +
+	"\x0fSubscribeEvents\x12,.fractalengine.rpc.v1.SubscribeEventsRequest\x1a-.fractalengine.rpc.v1.SubscribeEventsResponse0\x01\x12h\n" +
+	"\rCreateWebhook\x12*.fractalengine.rpc.v1.CreateWebhookRequest\x1a+.fractalengine.rpc.v1.CreateWebhookResponse\x12b\n" +
+	"\vGetWebhooks\x12(.fractalengine.rpc.v1.GetWebhooksRequest\x1a).fractalengine.rpc.v1.GetWebhooksResponse\x12h\n" +
+	"\rDeleteWebhook\x12*.fractalengine.rpc.v1.DeleteWebhookRequest\x1a+.fractalengine.rpc.v1.DeleteWebhookResponse\x12}\n" +
+	"\x14GetWebhookDeliveries\x121.fractalengine.rpc.v1.GetWebhookDeliveriesRequest\x1a2.fractalengine.rpc.v1.GetWebhookDeliveriesResponse\x12\x80\x01\n" +
+	"\x15ReplayWebhookDelivery\x122.fractalengine.rpc.v1.ReplayWebhookDeliveryRequest\x1a3.fractalengine.rpc.v1.ReplayWebhookDeliveryResponse\x12b\n" +
 	"\vGetInvoices\x12(.fractalengine.rpc.v1.GetInvoicesRequest\x1a).fractalengine.rpc.v1.GetInvoicesResponse\x12k\n" +
 	"\x0eGetAllInvoices\x12+.fractalengine.rpc.v1.GetAllInvoicesRequest\x1a,.fractalengine.rpc.v1.GetAllInvoicesResponse\x12h\n" +
 	"\rCreateInvoice\x12*.fractalengine.rpc.v1.CreateInvoiceRequest\x1a+.fractalengine.rpc.v1.CreateInvoiceResponse\x12\x83\x01\n" +
-	"\x16CreateInvoiceSignature\x123.fractalengine.rpc.v1.CreateInvoiceSignatureRequest\x1a4.fractalengine.rpc.v1.CreateInvoiceSignatureResponse\x12Y\n" +
+	"\x16CreateInvoiceSignature\x123.fractalengine.rpc.v1.CreateInvoiceSignatureRequest\x1a4.fractalengine.rpc.v1.CreateInvoiceSignatureResponse\x12h\n" +
+	"\rCancelInvoice\x12*.fractalengine.rpc.v1.CancelInvoiceRequest\x1a+.fractalengine.rpc.v1.CancelInvoiceResponse\x12t\n" +
+	"\x11GetInvoiceHistory\x12..fractalengine.rpc.v1.GetInvoiceHistoryRequest\x1a/.fractalengine.rpc.v1.GetInvoiceHistoryResponse\x12Y\n" +
 	"\bGetMints\x12%.fractalengine.rpc.v1.GetMintsRequest\x1a&.fractalengine.rpc.v1.GetMintsResponse\x12V\n" +
 	"\aGetMint\x12$.fractalengine.rpc.v1.GetMintRequest\x1a%.fractalengine.rpc.v1.GetMintResponse\x12_\n" +
 	"\n" +
-	"CreateMint\x12'.fractalengine.rpc.v1.CreateMintRequest\x1a(.fractalengine.rpc.v1.CreateMintResponse\x12q\n" +
+	"CreateMint\x12'.fractalengine.rpc.v1.CreateMintRequest\x1a(.fractalengine.rpc.v1.CreateMintResponse\x12\\\n" +
+	"\tAmendMint\x12&.fractalengine.rpc.v1.AmendMintRequest\x1a'.fractalengine.rpc.v1.AmendMintResponse\x12\x95\x01\n" +
+	"\x1cCreateMintAmendmentSignature\x129.fractalengine.rpc.v1.CreateMintAmendmentSignatureRequest\x1a:.fractalengine.rpc.v1.CreateMintAmendmentSignatureResponse\x12\x80\x01\n" +
+	"\x15TransferMintOwnership\x122.fractalengine.rpc.v1.TransferMintOwnershipRequest\x1a3.fractalengine.rpc.v1.TransferMintOwnershipResponse\x12q\n" +
 	"\x10CreateNewPayment\x12-.fractalengine.rpc.v1.CreateNewPaymentRequest\x1a..fractalengine.rpc.v1.CreateNewPaymentResponse\x12\x86\x01\n" +
 	"\x17GetPendingTokenBalances\x124.fractalengine.rpc.v1.GetPendingTokenBalancesRequest\x1a5.fractalengine.rpc.v1.GetPendingTokenBalancesResponse\x12q\n" +
-	"\x10GetTokenBalances\x12-.fractalengine.rpc.v1.GetTokenBalancesRequest\x1a..fractalengine.rpc.v1.GetTokenBalancesResponse\x12h\n" +
+	"\x10GetTokenBalances\x12-.fractalengine.rpc.v1.GetTokenBalancesRequest\x1a..fractalengine.rpc.v1.GetTokenBalancesResponse\x12b\n" +
+	"\vGetCapTable\x12(.fractalengine.rpc.v1.GetCapTableRequest\x1a).fractalengine.rpc.v1.GetCapTableResponse\x12k\n" +
+	"\x0eTransferTokens\x12+.fractalengine.rpc.v1.TransferTokensRequest\x1a,.fractalengine.rpc.v1.TransferTokensResponse\x12_\n" +
+	"\n" +
+	"CreateBurn\x12'.fractalengine.rpc.v1.CreateBurnRequest\x1a(.fractalengine.rpc.v1.CreateBurnResponse\x12z\n" +
+	"\x13CreateBurnSignature\x120.fractalengine.rpc.v1.CreateBurnSignatureRequest\x1a1.fractalengine.rpc.v1.CreateBurnSignatureResponse\x12t\n" +
+	"\x11CreateAttestation\x12..fractalengine.rpc.v1.CreateAttestationRequest\x1a/.fractalengine.rpc.v1.CreateAttestationResponse\x12w\n" +
+	"\x12CreateDistribution\x12/.fractalengine.rpc.v1.CreateDistributionRequest\x1a0.fractalengine.rpc.v1.CreateDistributionResponse\x12n\n" +
+	"\x0fGetDistribution\x12,.fractalengine.rpc.v1.GetDistributionRequest\x1a-.fractalengine.rpc.v1.GetDistributionResponse\x12q\n" +
+	"\x10GetDistributions\x12-.fractalengine.rpc.v1.GetDistributionsRequest\x1a..fractalengine.rpc.v1.GetDistributionsResponse\x12h\n" +
 	"\rGetSellOffers\x12*.fractalengine.rpc.v1.GetSellOffersRequest\x1a+.fractalengine.rpc.v1.GetSellOffersResponse\x12n\n" +
 	"\x0fCreateSellOffer\x12,.fractalengine.rpc.v1.CreateSellOfferRequest\x1a-.fractalengine.rpc.v1.CreateSellOfferResponse\x12n\n" +
 	"\x0fDeleteSellOffer\x12,.fractalengine.rpc.v1.DeleteSellOfferRequest\x1a-.fractalengine.rpc.v1.DeleteSellOfferResponse\x12e\n" +
 	"\fGetBuyOffers\x12).fractalengine.rpc.v1.GetBuyOffersRequest\x1a*.fractalengine.rpc.v1.GetBuyOffersResponse\x12k\n" +
 	"\x0eCreateBuyOffer\x12+.fractalengine.rpc.v1.CreateBuyOfferRequest\x1a,.fractalengine.rpc.v1.CreateBuyOfferResponse\x12k\n" +
-	"\x0eDeleteBuyOffer\x12+.fractalengine.rpc.v1.DeleteBuyOfferRequest\x1a,.fractalengine.rpc.v1.DeleteBuyOfferResponseB.Z,dogecoin.org/fractal-engine/pkg/rpc/protocolb\beditionsp\xe8\a"
+	"\x0eDeleteBuyOffer\x12+.fractalengine.rpc.v1.DeleteBuyOfferRequest\x1a,.fractalengine.rpc.v1.DeleteBuyOfferResponse\x12e\n" +
+	"\fGetOrderBook\x12).fractalengine.rpc.v1.GetOrderBookRequest\x1a*.fractalengine.rpc.v1.GetOrderBookResponseB.Z,dogecoin.org/fractal-engine/pkg/rpc/protocolb\beditionsp\xe8\a"
 
 var file_rpc_proto_goTypes = []any{
-	(*DogeConfirmRequest)(nil),              // 0: fractalengine.rpc.v1.DogeConfirmRequest
-	(*DogeSendRequest)(nil),                 // 1: fractalengine.rpc.v1.DogeSendRequest
-	(*DogeTopUpRequest)(nil),                // 2: fractalengine.rpc.v1.DogeTopUpRequest
-	(*GetHealthRequest)(nil),                // 3: fractalengine.rpc.v1.GetHealthRequest
-	(*GetStatsRequest)(nil),                 // 4: fractalengine.rpc.v1.GetStatsRequest
-	(*GetInvoicesRequest)(nil),              // 5: fractalengine.rpc.v1.GetInvoicesRequest
-	(*GetAllInvoicesRequest)(nil),           // 6: fractalengine.rpc.v1.GetAllInvoicesRequest
-	(*CreateInvoiceRequest)(nil),            // 7: fractalengine.rpc.v1.CreateInvoiceRequest
-	(*CreateInvoiceSignatureRequest)(nil),   // 8: fractalengine.rpc.v1.CreateInvoiceSignatureRequest
-	(*GetMintsRequest)(nil),                 // 9: fractalengine.rpc.v1.GetMintsRequest
-	(*GetMintRequest)(nil),                  // 10: fractalengine.rpc.v1.GetMintRequest
-	(*CreateMintRequest)(nil),               // 11: fractalengine.rpc.v1.CreateMintRequest
-	(*CreateNewPaymentRequest)(nil),         // 12: fractalengine.rpc.v1.CreateNewPaymentRequest
-	(*GetPendingTokenBalancesRequest)(nil),  // 13: fractalengine.rpc.v1.GetPendingTokenBalancesRequest
-	(*GetTokenBalancesRequest)(nil),         // 14: fractalengine.rpc.v1.GetTokenBalancesRequest
-	(*GetSellOffersRequest)(nil),            // 15: fractalengine.rpc.v1.GetSellOffersRequest
-	(*CreateSellOfferRequest)(nil),          // 16: fractalengine.rpc.v1.CreateSellOfferRequest
-	(*DeleteSellOfferRequest)(nil),          // 17: fractalengine.rpc.v1.DeleteSellOfferRequest
-	(*GetBuyOffersRequest)(nil),             // 18: fractalengine.rpc.v1.GetBuyOffersRequest
-	(*CreateBuyOfferRequest)(nil),           // 19: fractalengine.rpc.v1.CreateBuyOfferRequest
-	(*DeleteBuyOfferRequest)(nil),           // 20: fractalengine.rpc.v1.DeleteBuyOfferRequest
-	(*DogeConfirmResponse)(nil),             // 21: fractalengine.rpc.v1.DogeConfirmResponse
-	(*DogeSendResponse)(nil),                // 22: fractalengine.rpc.v1.DogeSendResponse
-	(*DogeTopUpResponse)(nil),               // 23: fractalengine.rpc.v1.DogeTopUpResponse
-	(*GetHealthResponse)(nil),               // 24: fractalengine.rpc.v1.GetHealthResponse
-	(*GetStatsResponse)(nil),                // 25: fractalengine.rpc.v1.GetStatsResponse
-	(*GetInvoicesResponse)(nil),             // 26: fractalengine.rpc.v1.GetInvoicesResponse
-	(*GetAllInvoicesResponse)(nil),          // 27: fractalengine.rpc.v1.GetAllInvoicesResponse
-	(*CreateInvoiceResponse)(nil),           // 28: fractalengine.rpc.v1.CreateInvoiceResponse
-	(*CreateInvoiceSignatureResponse)(nil),  // 29: fractalengine.rpc.v1.CreateInvoiceSignatureResponse
-	(*GetMintsResponse)(nil),                // 30: fractalengine.rpc.v1.GetMintsResponse
-	(*GetMintResponse)(nil),                 // 31: fractalengine.rpc.v1.GetMintResponse
-	(*CreateMintResponse)(nil),              // 32: fractalengine.rpc.v1.CreateMintResponse
-	(*CreateNewPaymentResponse)(nil),        // 33: fractalengine.rpc.v1.CreateNewPaymentResponse
-	(*GetPendingTokenBalancesResponse)(nil), // 34: fractalengine.rpc.v1.GetPendingTokenBalancesResponse
-	(*GetTokenBalancesResponse)(nil),        // 35: fractalengine.rpc.v1.GetTokenBalancesResponse
-	(*GetSellOffersResponse)(nil),           // 36: fractalengine.rpc.v1.GetSellOffersResponse
-	(*CreateSellOfferResponse)(nil),         // 37: fractalengine.rpc.v1.CreateSellOfferResponse
-	(*DeleteSellOfferResponse)(nil),         // 38: fractalengine.rpc.v1.DeleteSellOfferResponse
-	(*GetBuyOffersResponse)(nil),            // 39: fractalengine.rpc.v1.GetBuyOffersResponse
-	(*CreateBuyOfferResponse)(nil),          // 40: fractalengine.rpc.v1.CreateBuyOfferResponse
-	(*DeleteBuyOfferResponse)(nil),          // 41: fractalengine.rpc.v1.DeleteBuyOfferResponse
+	(*DogeConfirmRequest)(nil),                   // 0: fractalengine.rpc.v1.DogeConfirmRequest
+	(*DogeSendRequest)(nil),                      // 1: fractalengine.rpc.v1.DogeSendRequest
+	(*DogeTopUpRequest)(nil),                     // 2: fractalengine.rpc.v1.DogeTopUpRequest
+	(*GetHealthRequest)(nil),                     // 3: fractalengine.rpc.v1.GetHealthRequest
+	(*GetStatsRequest)(nil),                      // 4: fractalengine.rpc.v1.GetStatsRequest
+	(*SubscribeEventsRequest)(nil),               // 5: fractalengine.rpc.v1.SubscribeEventsRequest
+	(*CreateWebhookRequest)(nil),                 // 6: fractalengine.rpc.v1.CreateWebhookRequest
+	(*GetWebhooksRequest)(nil),                   // 7: fractalengine.rpc.v1.GetWebhooksRequest
+	(*DeleteWebhookRequest)(nil),                 // 8: fractalengine.rpc.v1.DeleteWebhookRequest
+	(*GetWebhookDeliveriesRequest)(nil),          // 9: fractalengine.rpc.v1.GetWebhookDeliveriesRequest
+	(*ReplayWebhookDeliveryRequest)(nil),         // 10: fractalengine.rpc.v1.ReplayWebhookDeliveryRequest
+	(*GetInvoicesRequest)(nil),                   // 11: fractalengine.rpc.v1.GetInvoicesRequest
+	(*GetAllInvoicesRequest)(nil),                // 12: fractalengine.rpc.v1.GetAllInvoicesRequest
+	(*CreateInvoiceRequest)(nil),                 // 13: fractalengine.rpc.v1.CreateInvoiceRequest
+	(*CreateInvoiceSignatureRequest)(nil),        // 14: fractalengine.rpc.v1.CreateInvoiceSignatureRequest
+	(*CancelInvoiceRequest)(nil),                 // 15: fractalengine.rpc.v1.CancelInvoiceRequest
+	(*GetInvoiceHistoryRequest)(nil),             // 16: fractalengine.rpc.v1.GetInvoiceHistoryRequest
+	(*GetMintsRequest)(nil),                      // 17: fractalengine.rpc.v1.GetMintsRequest
+	(*GetMintRequest)(nil),                       // 18: fractalengine.rpc.v1.GetMintRequest
+	(*CreateMintRequest)(nil),                    // 19: fractalengine.rpc.v1.CreateMintRequest
+	(*AmendMintRequest)(nil),                     // 20: fractalengine.rpc.v1.AmendMintRequest
+	(*CreateMintAmendmentSignatureRequest)(nil),  // 21: fractalengine.rpc.v1.CreateMintAmendmentSignatureRequest
+	(*TransferMintOwnershipRequest)(nil),         // 22: fractalengine.rpc.v1.TransferMintOwnershipRequest
+	(*CreateNewPaymentRequest)(nil),              // 23: fractalengine.rpc.v1.CreateNewPaymentRequest
+	(*GetPendingTokenBalancesRequest)(nil),       // 24: fractalengine.rpc.v1.GetPendingTokenBalancesRequest
+	(*GetTokenBalancesRequest)(nil),              // 25: fractalengine.rpc.v1.GetTokenBalancesRequest
+	(*GetCapTableRequest)(nil),                   // 26: fractalengine.rpc.v1.GetCapTableRequest
+	(*TransferTokensRequest)(nil),                // 27: fractalengine.rpc.v1.TransferTokensRequest
+	(*CreateBurnRequest)(nil),                    // 28: fractalengine.rpc.v1.CreateBurnRequest
+	(*CreateBurnSignatureRequest)(nil),           // 29: fractalengine.rpc.v1.CreateBurnSignatureRequest
+	(*CreateAttestationRequest)(nil),             // 30: fractalengine.rpc.v1.CreateAttestationRequest
+	(*CreateDistributionRequest)(nil),            // 31: fractalengine.rpc.v1.CreateDistributionRequest
+	(*GetDistributionRequest)(nil),               // 32: fractalengine.rpc.v1.GetDistributionRequest
+	(*GetDistributionsRequest)(nil),              // 33: fractalengine.rpc.v1.GetDistributionsRequest
+	(*GetSellOffersRequest)(nil),                 // 34: fractalengine.rpc.v1.GetSellOffersRequest
+	(*CreateSellOfferRequest)(nil),               // 35: fractalengine.rpc.v1.CreateSellOfferRequest
+	(*DeleteSellOfferRequest)(nil),               // 36: fractalengine.rpc.v1.DeleteSellOfferRequest
+	(*GetBuyOffersRequest)(nil),                  // 37: fractalengine.rpc.v1.GetBuyOffersRequest
+	(*CreateBuyOfferRequest)(nil),                // 38: fractalengine.rpc.v1.CreateBuyOfferRequest
+	(*DeleteBuyOfferRequest)(nil),                // 39: fractalengine.rpc.v1.DeleteBuyOfferRequest
+	(*GetOrderBookRequest)(nil),                  // 40: fractalengine.rpc.v1.GetOrderBookRequest
+	(*DogeConfirmResponse)(nil),                  // 41: fractalengine.rpc.v1.DogeConfirmResponse
+	(*DogeSendResponse)(nil),                     // 42: fractalengine.rpc.v1.DogeSendResponse
+	(*DogeTopUpResponse)(nil),                    // 43: fractalengine.rpc.v1.DogeTopUpResponse
+	(*GetHealthResponse)(nil),                    // 44: fractalengine.rpc.v1.GetHealthResponse
+	(*GetStatsResponse)(nil),                     // 45: fractalengine.rpc.v1.GetStatsResponse
+	(*SubscribeEventsResponse)(nil),              // 46: fractalengine.rpc.v1.SubscribeEventsResponse
+	(*CreateWebhookResponse)(nil),                // 47: fractalengine.rpc.v1.CreateWebhookResponse
+	(*GetWebhooksResponse)(nil),                  // 48: fractalengine.rpc.v1.GetWebhooksResponse
+	(*DeleteWebhookResponse)(nil),                // 49: fractalengine.rpc.v1.DeleteWebhookResponse
+	(*GetWebhookDeliveriesResponse)(nil),         // 50: fractalengine.rpc.v1.GetWebhookDeliveriesResponse
+	(*ReplayWebhookDeliveryResponse)(nil),        // 51: fractalengine.rpc.v1.ReplayWebhookDeliveryResponse
+	(*GetInvoicesResponse)(nil),                  // 52: fractalengine.rpc.v1.GetInvoicesResponse
+	(*GetAllInvoicesResponse)(nil),               // 53: fractalengine.rpc.v1.GetAllInvoicesResponse
+	(*CreateInvoiceResponse)(nil),                // 54: fractalengine.rpc.v1.CreateInvoiceResponse
+	(*CreateInvoiceSignatureResponse)(nil),       // 55: fractalengine.rpc.v1.CreateInvoiceSignatureResponse
+	(*CancelInvoiceResponse)(nil),                // 56: fractalengine.rpc.v1.CancelInvoiceResponse
+	(*GetInvoiceHistoryResponse)(nil),            // 57: fractalengine.rpc.v1.GetInvoiceHistoryResponse
+	(*GetMintsResponse)(nil),                     // 58: fractalengine.rpc.v1.GetMintsResponse
+	(*GetMintResponse)(nil),                      // 59: fractalengine.rpc.v1.GetMintResponse
+	(*CreateMintResponse)(nil),                   // 60: fractalengine.rpc.v1.CreateMintResponse
+	(*AmendMintResponse)(nil),                    // 61: fractalengine.rpc.v1.AmendMintResponse
+	(*CreateMintAmendmentSignatureResponse)(nil), // 62: fractalengine.rpc.v1.CreateMintAmendmentSignatureResponse
+	(*TransferMintOwnershipResponse)(nil),        // 63: fractalengine.rpc.v1.TransferMintOwnershipResponse
+	(*CreateNewPaymentResponse)(nil),             // 64: fractalengine.rpc.v1.CreateNewPaymentResponse
+	(*GetPendingTokenBalancesResponse)(nil),      // 65: fractalengine.rpc.v1.GetPendingTokenBalancesResponse
+	(*GetTokenBalancesResponse)(nil),             // 66: fractalengine.rpc.v1.GetTokenBalancesResponse
+	(*GetCapTableResponse)(nil),                  // 67: fractalengine.rpc.v1.GetCapTableResponse
+	(*TransferTokensResponse)(nil),               // 68: fractalengine.rpc.v1.TransferTokensResponse
+	(*CreateBurnResponse)(nil),                   // 69: fractalengine.rpc.v1.CreateBurnResponse
+	(*CreateBurnSignatureResponse)(nil),          // 70: fractalengine.rpc.v1.CreateBurnSignatureResponse
+	(*CreateAttestationResponse)(nil),            // 71: fractalengine.rpc.v1.CreateAttestationResponse
+	(*CreateDistributionResponse)(nil),           // 72: fractalengine.rpc.v1.CreateDistributionResponse
+	(*GetDistributionResponse)(nil),              // 73: fractalengine.rpc.v1.GetDistributionResponse
+	(*GetDistributionsResponse)(nil),             // 74: fractalengine.rpc.v1.GetDistributionsResponse
+	(*GetSellOffersResponse)(nil),                // 75: fractalengine.rpc.v1.GetSellOffersResponse
+	(*CreateSellOfferResponse)(nil),              // 76: fractalengine.rpc.v1.CreateSellOfferResponse
+	(*DeleteSellOfferResponse)(nil),              // 77: fractalengine.rpc.v1.DeleteSellOfferResponse
+	(*GetBuyOffersResponse)(nil),                 // 78: fractalengine.rpc.v1.GetBuyOffersResponse
+	(*CreateBuyOfferResponse)(nil),               // 79: fractalengine.rpc.v1.CreateBuyOfferResponse
+	(*DeleteBuyOfferResponse)(nil),               // 80: fractalengine.rpc.v1.DeleteBuyOfferResponse
+	(*GetOrderBookResponse)(nil),                 // 81: fractalengine.rpc.v1.GetOrderBookResponse
 }
 var file_rpc_proto_depIdxs = []int32{
 	0,  // 0: fractalengine.rpc.v1.FractalEngineRpcService.DogeConfirm:input_type -> fractalengine.rpc.v1.DogeConfirmRequest
@@ -100,45 +161,85 @@ var file_rpc_proto_depIdxs = []int32{
 	2,  // 2: fractalengine.rpc.v1.FractalEngineRpcService.DogeTopUp:input_type -> fractalengine.rpc.v1.DogeTopUpRequest
 	3,  // 3: fractalengine.rpc.v1.FractalEngineRpcService.GetHealth:input_type -> fractalengine.rpc.v1.GetHealthRequest
 	4,  // 4: fractalengine.rpc.v1.FractalEngineRpcService.GetStats:input_type -> fractalengine.rpc.v1.GetStatsRequest
-	5,  // 5: fractalengine.rpc.v1.FractalEngineRpcService.GetInvoices:input_type -> fractalengine.rpc.v1.GetInvoicesRequest
-	6,  // 6: fractalengine.rpc.v1.FractalEngineRpcService.GetAllInvoices:input_type -> fractalengine.rpc.v1.GetAllInvoicesRequest
-	7,  // 7: fractalengine.rpc.v1.FractalEngineRpcService.CreateInvoice:input_type -> fractalengine.rpc.v1.CreateInvoiceRequest
-	8,  // 8: fractalengine.rpc.v1.FractalEngineRpcService.CreateInvoiceSignature:input_type -> fractalengine.rpc.v1.CreateInvoiceSignatureRequest
-	9,  // 9: fractalengine.rpc.v1.FractalEngineRpcService.GetMints:input_type -> fractalengine.rpc.v1.GetMintsRequest
-	10, // 10: fractalengine.rpc.v1.FractalEngineRpcService.GetMint:input_type -> fractalengine.rpc.v1.GetMintRequest
-	11, // 11: fractalengine.rpc.v1.FractalEngineRpcService.CreateMint:input_type -> fractalengine.rpc.v1.CreateMintRequest
-	12, // 12: fractalengine.rpc.v1.FractalEngineRpcService.CreateNewPayment:input_type -> fractalengine.rpc.v1.CreateNewPaymentRequest
-	13, // 13: fractalengine.rpc.v1.FractalEngineRpcService.GetPendingTokenBalances:input_type -> fractalengine.rpc.v1.GetPendingTokenBalancesRequest
-	14, // 14: fractalengine.rpc.v1.FractalEngineRpcService.GetTokenBalances:input_type -> fractalengine.rpc.v1.GetTokenBalancesRequest
-	15, // 15: fractalengine.rpc.v1.FractalEngineRpcService.GetSellOffers:input_type -> fractalengine.rpc.v1.GetSellOffersRequest
-	16, // 16: fractalengine.rpc.v1.FractalEngineRpcService.CreateSellOffer:input_type -> fractalengine.rpc.v1.CreateSellOfferRequest
-	17, // 17: fractalengine.rpc.v1.FractalEngineRpcService.DeleteSellOffer:input_type -> fractalengine.rpc.v1.DeleteSellOfferRequest
-	18, // 18: fractalengine.rpc.v1.FractalEngineRpcService.GetBuyOffers:input_type -> fractalengine.rpc.v1.GetBuyOffersRequest
-	19, // 19: fractalengine.rpc.v1.FractalEngineRpcService.CreateBuyOffer:input_type -> fractalengine.rpc.v1.CreateBuyOfferRequest
-	20, // 20: fractalengine.rpc.v1.FractalEngineRpcService.DeleteBuyOffer:input_type -> fractalengine.rpc.v1.DeleteBuyOfferRequest
-	21, // 21: fractalengine.rpc.v1.FractalEngineRpcService.DogeConfirm:output_type -> fractalengine.rpc.v1.DogeConfirmResponse
-	22, // 22: fractalengine.rpc.v1.FractalEngineRpcService.DogeSend:output_type -> fractalengine.rpc.v1.DogeSendResponse
-	23, // 23: fractalengine.rpc.v1.FractalEngineRpcService.DogeTopUp:output_type -> fractalengine.rpc.v1.DogeTopUpResponse
-	24, // 24: fractalengine.rpc.v1.FractalEngineRpcService.GetHealth:output_type -> fractalengine.rpc.v1.GetHealthResponse
-	25, // 25: fractalengine.rpc.v1.FractalEngineRpcService.GetStats:output_type -> fractalengine.rpc.v1.GetStatsResponse
-	26, // 26: fractalengine.rpc.v1.FractalEngineRpcService.GetInvoices:output_type -> fractalengine.rpc.v1.GetInvoicesResponse
-	27, // 27: fractalengine.rpc.v1.FractalEngineRpcService.GetAllInvoices:output_type -> fractalengine.rpc.v1.GetAllInvoicesResponse
-	28, // 28: fractalengine.rpc.v1.FractalEngineRpcService.CreateInvoice:output_type -> fractalengine.rpc.v1.CreateInvoiceResponse
-	29, // 29: fractalengine.rpc.v1.FractalEngineRpcService.CreateInvoiceSignature:output_type -> fractalengine.rpc.v1.CreateInvoiceSignatureResponse
-	30, // 30: fractalengine.rpc.v1.FractalEngineRpcService.GetMints:output_type -> fractalengine.rpc.v1.GetMintsResponse
-	31, // 31: fractalengine.rpc.v1.FractalEngineRpcService.GetMint:output_type -> fractalengine.rpc.v1.GetMintResponse
-	32, // 32: fractalengine.rpc.v1.FractalEngineRpcService.CreateMint:output_type -> fractalengine.rpc.v1.CreateMintResponse
-	33, // 33: fractalengine.rpc.v1.FractalEngineRpcService.CreateNewPayment:output_type -> fractalengine.rpc.v1.CreateNewPaymentResponse
-	34, // 34: fractalengine.rpc.v1.FractalEngineRpcService.GetPendingTokenBalances:output_type -> fractalengine.rpc.v1.GetPendingTokenBalancesResponse
-	35, // 35: fractalengine.rpc.v1.FractalEngineRpcService.GetTokenBalances:output_type -> fractalengine.rpc.v1.GetTokenBalancesResponse
-	36, // 36: fractalengine.rpc.v1.FractalEngineRpcService.GetSellOffers:output_type -> fractalengine.rpc.v1.GetSellOffersResponse
-	37, // 37: fractalengine.rpc.v1.FractalEngineRpcService.CreateSellOffer:output_type -> fractalengine.rpc.v1.CreateSellOfferResponse
-	38, // 38: fractalengine.rpc.v1.FractalEngineRpcService.DeleteSellOffer:output_type -> fractalengine.rpc.v1.DeleteSellOfferResponse
-	39, // 39: fractalengine.rpc.v1.FractalEngineRpcService.GetBuyOffers:output_type -> fractalengine.rpc.v1.GetBuyOffersResponse
-	40, // 40: fractalengine.rpc.v1.FractalEngineRpcService.CreateBuyOffer:output_type -> fractalengine.rpc.v1.CreateBuyOfferResponse
-	41, // 41: fractalengine.rpc.v1.FractalEngineRpcService.DeleteBuyOffer:output_type -> fractalengine.rpc.v1.DeleteBuyOfferResponse
-	21, // [21:42] is the sub-list for method output_type
-	0,  // [0:21] is the sub-list for method input_type
+	5,  // 5: fractalengine.rpc.v1.FractalEngineRpcService.SubscribeEvents:input_type -> fractalengine.rpc.v1.SubscribeEventsRequest
+	6,  // 6: fractalengine.rpc.v1.FractalEngineRpcService.CreateWebhook:input_type -> fractalengine.rpc.v1.CreateWebhookRequest
+	7,  // 7: fractalengine.rpc.v1.FractalEngineRpcService.GetWebhooks:input_type -> fractalengine.rpc.v1.GetWebhooksRequest
+	8,  // 8: fractalengine.rpc.v1.FractalEngineRpcService.DeleteWebhook:input_type -> fractalengine.rpc.v1.DeleteWebhookRequest
+	9,  // 9: fractalengine.rpc.v1.FractalEngineRpcService.GetWebhookDeliveries:input_type -> fractalengine.rpc.v1.GetWebhookDeliveriesRequest
+	10, // 10: fractalengine.rpc.v1.FractalEngineRpcService.ReplayWebhookDelivery:input_type -> fractalengine.rpc.v1.ReplayWebhookDeliveryRequest
+	11, // 11: fractalengine.rpc.v1.FractalEngineRpcService.GetInvoices:input_type -> fractalengine.rpc.v1.GetInvoicesRequest
+	12, // 12: fractalengine.rpc.v1.FractalEngineRpcService.GetAllInvoices:input_type -> fractalengine.rpc.v1.GetAllInvoicesRequest
+	13, // 13: fractalengine.rpc.v1.FractalEngineRpcService.CreateInvoice:input_type -> fractalengine.rpc.v1.CreateInvoiceRequest
+	14, // 14: fractalengine.rpc.v1.FractalEngineRpcService.CreateInvoiceSignature:input_type -> fractalengine.rpc.v1.CreateInvoiceSignatureRequest
+	15, // 15: fractalengine.rpc.v1.FractalEngineRpcService.CancelInvoice:input_type -> fractalengine.rpc.v1.CancelInvoiceRequest
+	16, // 16: fractalengine.rpc.v1.FractalEngineRpcService.GetInvoiceHistory:input_type -> fractalengine.rpc.v1.GetInvoiceHistoryRequest
+	17, // 17: fractalengine.rpc.v1.FractalEngineRpcService.GetMints:input_type -> fractalengine.rpc.v1.GetMintsRequest
+	18, // 18: fractalengine.rpc.v1.FractalEngineRpcService.GetMint:input_type -> fractalengine.rpc.v1.GetMintRequest
+	19, // 19: fractalengine.rpc.v1.FractalEngineRpcService.CreateMint:input_type -> fractalengine.rpc.v1.CreateMintRequest
+	20, // 20: fractalengine.rpc.v1.FractalEngineRpcService.AmendMint:input_type -> fractalengine.rpc.v1.AmendMintRequest
+	21, // 21: fractalengine.rpc.v1.FractalEngineRpcService.CreateMintAmendmentSignature:input_type -> fractalengine.rpc.v1.CreateMintAmendmentSignatureRequest
+	22, // 22: fractalengine.rpc.v1.FractalEngineRpcService.TransferMintOwnership:input_type -> fractalengine.rpc.v1.TransferMintOwnershipRequest
+	23, // 23: fractalengine.rpc.v1.FractalEngineRpcService.CreateNewPayment:input_type -> fractalengine.rpc.v1.CreateNewPaymentRequest
+	24, // 24: fractalengine.rpc.v1.FractalEngineRpcService.GetPendingTokenBalances:input_type -> fractalengine.rpc.v1.GetPendingTokenBalancesRequest
+	25, // 25: fractalengine.rpc.v1.FractalEngineRpcService.GetTokenBalances:input_type -> fractalengine.rpc.v1.GetTokenBalancesRequest
+	26, // 26: fractalengine.rpc.v1.FractalEngineRpcService.GetCapTable:input_type -> fractalengine.rpc.v1.GetCapTableRequest
+	27, // 27: fractalengine.rpc.v1.FractalEngineRpcService.TransferTokens:input_type -> fractalengine.rpc.v1.TransferTokensRequest
+	28, // 28: fractalengine.rpc.v1.FractalEngineRpcService.CreateBurn:input_type -> fractalengine.rpc.v1.CreateBurnRequest
+	29, // 29: fractalengine.rpc.v1.FractalEngineRpcService.CreateBurnSignature:input_type -> fractalengine.rpc.v1.CreateBurnSignatureRequest
+	30, // 30: fractalengine.rpc.v1.FractalEngineRpcService.CreateAttestation:input_type -> fractalengine.rpc.v1.CreateAttestationRequest
+	31, // 31: fractalengine.rpc.v1.FractalEngineRpcService.CreateDistribution:input_type -> fractalengine.rpc.v1.CreateDistributionRequest
+	32, // 32: fractalengine.rpc.v1.FractalEngineRpcService.GetDistribution:input_type -> fractalengine.rpc.v1.GetDistributionRequest
+	33, // 33: fractalengine.rpc.v1.FractalEngineRpcService.GetDistributions:input_type -> fractalengine.rpc.v1.GetDistributionsRequest
+	34, // 34: fractalengine.rpc.v1.FractalEngineRpcService.GetSellOffers:input_type -> fractalengine.rpc.v1.GetSellOffersRequest
+	35, // 35: fractalengine.rpc.v1.FractalEngineRpcService.CreateSellOffer:input_type -> fractalengine.rpc.v1.CreateSellOfferRequest
+	36, // 36: fractalengine.rpc.v1.FractalEngineRpcService.DeleteSellOffer:input_type -> fractalengine.rpc.v1.DeleteSellOfferRequest
+	37, // 37: fractalengine.rpc.v1.FractalEngineRpcService.GetBuyOffers:input_type -> fractalengine.rpc.v1.GetBuyOffersRequest
+	38, // 38: fractalengine.rpc.v1.FractalEngineRpcService.CreateBuyOffer:input_type -> fractalengine.rpc.v1.CreateBuyOfferRequest
+	39, // 39: fractalengine.rpc.v1.FractalEngineRpcService.DeleteBuyOffer:input_type -> fractalengine.rpc.v1.DeleteBuyOfferRequest
+	40, // 40: fractalengine.rpc.v1.FractalEngineRpcService.GetOrderBook:input_type -> fractalengine.rpc.v1.GetOrderBookRequest
+	41, // 41: fractalengine.rpc.v1.FractalEngineRpcService.DogeConfirm:output_type -> fractalengine.rpc.v1.DogeConfirmResponse
+	42, // 42: fractalengine.rpc.v1.FractalEngineRpcService.DogeSend:output_type -> fractalengine.rpc.v1.DogeSendResponse
+	43, // 43: fractalengine.rpc.v1.FractalEngineRpcService.DogeTopUp:output_type -> fractalengine.rpc.v1.DogeTopUpResponse
+	44, // 44: fractalengine.rpc.v1.FractalEngineRpcService.GetHealth:output_type -> fractalengine.rpc.v1.GetHealthResponse
+	45, // 45: fractalengine.rpc.v1.FractalEngineRpcService.GetStats:output_type -> fractalengine.rpc.v1.GetStatsResponse
+	46, // 46: fractalengine.rpc.v1.FractalEngineRpcService.SubscribeEvents:output_type -> fractalengine.rpc.v1.SubscribeEventsResponse
+	47, // 47: fractalengine.rpc.v1.FractalEngineRpcService.CreateWebhook:output_type -> fractalengine.rpc.v1.CreateWebhookResponse
+	48, // 48: fractalengine.rpc.v1.FractalEngineRpcService.GetWebhooks:output_type -> fractalengine.rpc.v1.GetWebhooksResponse
+	49, // 49: fractalengine.rpc.v1.FractalEngineRpcService.DeleteWebhook:output_type -> fractalengine.rpc.v1.DeleteWebhookResponse
+	50, // 50: fractalengine.rpc.v1.FractalEngineRpcService.GetWebhookDeliveries:output_type -> fractalengine.rpc.v1.GetWebhookDeliveriesResponse
+	51, // 51: fractalengine.rpc.v1.FractalEngineRpcService.ReplayWebhookDelivery:output_type -> fractalengine.rpc.v1.ReplayWebhookDeliveryResponse
+	52, // 52: fractalengine.rpc.v1.FractalEngineRpcService.GetInvoices:output_type -> fractalengine.rpc.v1.GetInvoicesResponse
+	53, // 53: fractalengine.rpc.v1.FractalEngineRpcService.GetAllInvoices:output_type -> fractalengine.rpc.v1.GetAllInvoicesResponse
+	54, // 54: fractalengine.rpc.v1.FractalEngineRpcService.CreateInvoice:output_type -> fractalengine.rpc.v1.CreateInvoiceResponse
+	55, // 55: fractalengine.rpc.v1.FractalEngineRpcService.CreateInvoiceSignature:output_type -> fractalengine.rpc.v1.CreateInvoiceSignatureResponse
+	56, // 56: fractalengine.rpc.v1.FractalEngineRpcService.CancelInvoice:output_type -> fractalengine.rpc.v1.CancelInvoiceResponse
+	57, // 57: fractalengine.rpc.v1.FractalEngineRpcService.GetInvoiceHistory:output_type -> fractalengine.rpc.v1.GetInvoiceHistoryResponse
+	58, // 58: fractalengine.rpc.v1.FractalEngineRpcService.GetMints:output_type -> fractalengine.rpc.v1.GetMintsResponse
+	59, // 59: fractalengine.rpc.v1.FractalEngineRpcService.GetMint:output_type -> fractalengine.rpc.v1.GetMintResponse
+	60, // 60: fractalengine.rpc.v1.FractalEngineRpcService.CreateMint:output_type -> fractalengine.rpc.v1.CreateMintResponse
+	61, // 61: fractalengine.rpc.v1.FractalEngineRpcService.AmendMint:output_type -> fractalengine.rpc.v1.AmendMintResponse
+	62, // 62: fractalengine.rpc.v1.FractalEngineRpcService.CreateMintAmendmentSignature:output_type -> fractalengine.rpc.v1.CreateMintAmendmentSignatureResponse
+	63, // 63: fractalengine.rpc.v1.FractalEngineRpcService.TransferMintOwnership:output_type -> fractalengine.rpc.v1.TransferMintOwnershipResponse
+	64, // 64: fractalengine.rpc.v1.FractalEngineRpcService.CreateNewPayment:output_type -> fractalengine.rpc.v1.CreateNewPaymentResponse
+	65, // 65: fractalengine.rpc.v1.FractalEngineRpcService.GetPendingTokenBalances:output_type -> fractalengine.rpc.v1.GetPendingTokenBalancesResponse
+	66, // 66: fractalengine.rpc.v1.FractalEngineRpcService.GetTokenBalances:output_type -> fractalengine.rpc.v1.GetTokenBalancesResponse
+	67, // 67: fractalengine.rpc.v1.FractalEngineRpcService.GetCapTable:output_type -> fractalengine.rpc.v1.GetCapTableResponse
+	68, // 68: fractalengine.rpc.v1.FractalEngineRpcService.TransferTokens:output_type -> fractalengine.rpc.v1.TransferTokensResponse
+	69, // 69: fractalengine.rpc.v1.FractalEngineRpcService.CreateBurn:output_type -> fractalengine.rpc.v1.CreateBurnResponse
+	70, // 70: fractalengine.rpc.v1.FractalEngineRpcService.CreateBurnSignature:output_type -> fractalengine.rpc.v1.CreateBurnSignatureResponse
+	71, // 71: fractalengine.rpc.v1.FractalEngineRpcService.CreateAttestation:output_type -> fractalengine.rpc.v1.CreateAttestationResponse
+	72, // 72: fractalengine.rpc.v1.FractalEngineRpcService.CreateDistribution:output_type -> fractalengine.rpc.v1.CreateDistributionResponse
+	73, // 73: fractalengine.rpc.v1.FractalEngineRpcService.GetDistribution:output_type -> fractalengine.rpc.v1.GetDistributionResponse
+	74, // 74: fractalengine.rpc.v1.FractalEngineRpcService.GetDistributions:output_type -> fractalengine.rpc.v1.GetDistributionsResponse
+	75, // 75: fractalengine.rpc.v1.FractalEngineRpcService.GetSellOffers:output_type -> fractalengine.rpc.v1.GetSellOffersResponse
+	76, // 76: fractalengine.rpc.v1.FractalEngineRpcService.CreateSellOffer:output_type -> fractalengine.rpc.v1.CreateSellOfferResponse
+	77, // 77: fractalengine.rpc.v1.FractalEngineRpcService.DeleteSellOffer:output_type -> fractalengine.rpc.v1.DeleteSellOfferResponse
+	78, // 78: fractalengine.rpc.v1.FractalEngineRpcService.GetBuyOffers:output_type -> fractalengine.rpc.v1.GetBuyOffersResponse
+	79, // 79: fractalengine.rpc.v1.FractalEngineRpcService.CreateBuyOffer:output_type -> fractalengine.rpc.v1.CreateBuyOfferResponse
+	80, // 80: fractalengine.rpc.v1.FractalEngineRpcService.DeleteBuyOffer:output_type -> fractalengine.rpc.v1.DeleteBuyOfferResponse
+	81, // 81: fractalengine.rpc.v1.FractalEngineRpcService.GetOrderBook:output_type -> fractalengine.rpc.v1.GetOrderBookResponse
+	41, // [41:82] is the sub-list for method output_type
+	0,  // [0:41] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -149,7 +250,11 @@ func file_rpc_proto_init() {
 	if File_rpc_proto != nil {
 		return
 	}
+	file_attestations_proto_init()
+	file_burns_proto_init()
+	file_distributions_proto_init()
 	file_doge_proto_init()
+	file_events_proto_init()
 	file_health_proto_init()
 	file_invoices_proto_init()
 	file_mints_proto_init()
@@ -157,6 +262,7 @@ func file_rpc_proto_init() {
 	file_payments_proto_init()
 	file_stats_proto_init()
 	file_tokens_proto_init()
+	file_webhooks_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -182,6 +182,7 @@ type GetTokenBalancesRequest struct {
 	xxx_hidden_IncludeMintDetails *wrapperspb.BoolValue  `protobuf:"bytes,3,opt,name=include_mint_details,json=includeMintDetails"`
 	xxx_hidden_Limit              *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=limit"`
 	xxx_hidden_Page               *wrapperspb.Int32Value `protobuf:"bytes,5,opt,name=page"`
+	xxx_hidden_AtHeight           *wrapperspb.Int64Value `protobuf:"bytes,6,opt,name=at_height,json=atHeight"`
 	unknownFields                 protoimpl.UnknownFields
 	sizeCache                     protoimpl.SizeCache
 }
@@ -246,6 +247,13 @@ func (x *GetTokenBalancesRequest) GetPage() *wrapperspb.Int32Value {
 	return nil
 }
 
+func (x *GetTokenBalancesRequest) GetAtHeight() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.xxx_hidden_AtHeight
+	}
+	return nil
+}
+
 func (x *GetTokenBalancesRequest) SetAddress(v *Address) {
 	x.xxx_hidden_Address = v
 }
@@ -266,6 +274,10 @@ func (x *GetTokenBalancesRequest) SetPage(v *wrapperspb.Int32Value) {
 	x.xxx_hidden_Page = v
 }
 
+func (x *GetTokenBalancesRequest) SetAtHeight(v *wrapperspb.Int64Value) {
+	x.xxx_hidden_AtHeight = v
+}
+
 func (x *GetTokenBalancesRequest) HasAddress() bool {
 	if x == nil {
 		return false
@@ -301,6 +313,13 @@ func (x *GetTokenBalancesRequest) HasPage() bool {
 	return x.xxx_hidden_Page != nil
 }
 
+func (x *GetTokenBalancesRequest) HasAtHeight() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_AtHeight != nil
+}
+
 func (x *GetTokenBalancesRequest) ClearAddress() {
 	x.xxx_hidden_Address = nil
 }
@@ -321,6 +340,10 @@ func (x *GetTokenBalancesRequest) ClearPage() {
 	x.xxx_hidden_Page = nil
 }
 
+func (x *GetTokenBalancesRequest) ClearAtHeight() {
+	x.xxx_hidden_AtHeight = nil
+}
+
 type GetTokenBalancesRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -329,6 +352,7 @@ type GetTokenBalancesRequest_builder struct {
 	IncludeMintDetails *wrapperspb.BoolValue
 	Limit              *wrapperspb.Int32Value
 	Page               *wrapperspb.Int32Value
+	AtHeight           *wrapperspb.Int64Value
 }
 
 func (b0 GetTokenBalancesRequest_builder) Build() *GetTokenBalancesRequest {
@@ -340,6 +364,7 @@ func (b0 GetTokenBalancesRequest_builder) Build() *GetTokenBalancesRequest {
 	x.xxx_hidden_IncludeMintDetails = b.IncludeMintDetails
 	x.xxx_hidden_Limit = b.Limit
 	x.xxx_hidden_Page = b.Page
+	x.xxx_hidden_AtHeight = b.AtHeight
 	return m0
 }
 
@@ -411,6 +436,698 @@ func (b0 GetTokenBalancesResponse_builder) Build() *GetTokenBalancesResponse {
 	return m0
 }
 
+type GetCapTableRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MintHash *Hash                  `protobuf:"bytes,1,opt,name=mint_hash,json=mintHash"`
+	xxx_hidden_AtHeight *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=at_height,json=atHeight"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetCapTableRequest) Reset() {
+	*x = GetCapTableRequest{}
+	mi := &file_tokens_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapTableRequest) ProtoMessage() {}
+
+func (x *GetCapTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tokens_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetCapTableRequest) GetMintHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_MintHash
+	}
+	return nil
+}
+
+func (x *GetCapTableRequest) GetAtHeight() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.xxx_hidden_AtHeight
+	}
+	return nil
+}
+
+func (x *GetCapTableRequest) SetMintHash(v *Hash) {
+	x.xxx_hidden_MintHash = v
+}
+
+func (x *GetCapTableRequest) SetAtHeight(v *wrapperspb.Int64Value) {
+	x.xxx_hidden_AtHeight = v
+}
+
+func (x *GetCapTableRequest) HasMintHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MintHash != nil
+}
+
+func (x *GetCapTableRequest) HasAtHeight() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_AtHeight != nil
+}
+
+func (x *GetCapTableRequest) ClearMintHash() {
+	x.xxx_hidden_MintHash = nil
+}
+
+func (x *GetCapTableRequest) ClearAtHeight() {
+	x.xxx_hidden_AtHeight = nil
+}
+
+type GetCapTableRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MintHash *Hash
+	AtHeight *wrapperspb.Int64Value
+}
+
+func (b0 GetCapTableRequest_builder) Build() *GetCapTableRequest {
+	m0 := &GetCapTableRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_MintHash = b.MintHash
+	x.xxx_hidden_AtHeight = b.AtHeight
+	return m0
+}
+
+type GetCapTableResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MintHash      *Hash                  `protobuf:"bytes,1,opt,name=mint_hash,json=mintHash"`
+	xxx_hidden_AtHeight      int64                  `protobuf:"varint,2,opt,name=at_height,json=atHeight"`
+	xxx_hidden_TotalQuantity int32                  `protobuf:"varint,3,opt,name=total_quantity,json=totalQuantity"`
+	xxx_hidden_Holders       *[]*CapTableEntry      `protobuf:"bytes,4,rep,name=holders"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetCapTableResponse) Reset() {
+	*x = GetCapTableResponse{}
+	mi := &file_tokens_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapTableResponse) ProtoMessage() {}
+
+func (x *GetCapTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tokens_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetCapTableResponse) GetMintHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_MintHash
+	}
+	return nil
+}
+
+func (x *GetCapTableResponse) GetAtHeight() int64 {
+	if x != nil {
+		return x.xxx_hidden_AtHeight
+	}
+	return 0
+}
+
+func (x *GetCapTableResponse) GetTotalQuantity() int32 {
+	if x != nil {
+		return x.xxx_hidden_TotalQuantity
+	}
+	return 0
+}
+
+func (x *GetCapTableResponse) GetHolders() []*CapTableEntry {
+	if x != nil {
+		if x.xxx_hidden_Holders != nil {
+			return *x.xxx_hidden_Holders
+		}
+	}
+	return nil
+}
+
+func (x *GetCapTableResponse) SetMintHash(v *Hash) {
+	x.xxx_hidden_MintHash = v
+}
+
+func (x *GetCapTableResponse) SetAtHeight(v int64) {
+	x.xxx_hidden_AtHeight = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *GetCapTableResponse) SetTotalQuantity(v int32) {
+	x.xxx_hidden_TotalQuantity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *GetCapTableResponse) SetHolders(v []*CapTableEntry) {
+	x.xxx_hidden_Holders = &v
+}
+
+func (x *GetCapTableResponse) HasMintHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MintHash != nil
+}
+
+func (x *GetCapTableResponse) HasAtHeight() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetCapTableResponse) HasTotalQuantity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *GetCapTableResponse) ClearMintHash() {
+	x.xxx_hidden_MintHash = nil
+}
+
+func (x *GetCapTableResponse) ClearAtHeight() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_AtHeight = 0
+}
+
+func (x *GetCapTableResponse) ClearTotalQuantity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_TotalQuantity = 0
+}
+
+type GetCapTableResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MintHash      *Hash
+	AtHeight      *int64
+	TotalQuantity *int32
+	Holders       []*CapTableEntry
+}
+
+func (b0 GetCapTableResponse_builder) Build() *GetCapTableResponse {
+	m0 := &GetCapTableResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_MintHash = b.MintHash
+	if b.AtHeight != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_AtHeight = *b.AtHeight
+	}
+	if b.TotalQuantity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_TotalQuantity = *b.TotalQuantity
+	}
+	x.xxx_hidden_Holders = &b.Holders
+	return m0
+}
+
+type TransferTokensRequest struct {
+	state                   protoimpl.MessageState        `protogen:"opaque.v1"`
+	xxx_hidden_Payload      *TransferTokensRequestPayload `protobuf:"bytes,1,opt,name=payload"`
+	xxx_hidden_PublicKey    *string                       `protobuf:"bytes,2,opt,name=public_key,json=publicKey"`
+	xxx_hidden_Signature    *string                       `protobuf:"bytes,3,opt,name=signature"`
+	xxx_hidden_RedeemScript *string                       `protobuf:"bytes,4,opt,name=redeem_script,json=redeemScript"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *TransferTokensRequest) Reset() {
+	*x = TransferTokensRequest{}
+	mi := &file_tokens_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferTokensRequest) ProtoMessage() {}
+
+func (x *TransferTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tokens_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TransferTokensRequest) GetPayload() *TransferTokensRequestPayload {
+	if x != nil {
+		return x.xxx_hidden_Payload
+	}
+	return nil
+}
+
+func (x *TransferTokensRequest) GetPublicKey() string {
+	if x != nil {
+		if x.xxx_hidden_PublicKey != nil {
+			return *x.xxx_hidden_PublicKey
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TransferTokensRequest) GetSignature() string {
+	if x != nil {
+		if x.xxx_hidden_Signature != nil {
+			return *x.xxx_hidden_Signature
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TransferTokensRequest) GetRedeemScript() string {
+	if x != nil {
+		if x.xxx_hidden_RedeemScript != nil {
+			return *x.xxx_hidden_RedeemScript
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TransferTokensRequest) SetPayload(v *TransferTokensRequestPayload) {
+	x.xxx_hidden_Payload = v
+}
+
+func (x *TransferTokensRequest) SetPublicKey(v string) {
+	x.xxx_hidden_PublicKey = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *TransferTokensRequest) SetSignature(v string) {
+	x.xxx_hidden_Signature = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *TransferTokensRequest) SetRedeemScript(v string) {
+	x.xxx_hidden_RedeemScript = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *TransferTokensRequest) HasPayload() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Payload != nil
+}
+
+func (x *TransferTokensRequest) HasPublicKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *TransferTokensRequest) HasSignature() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *TransferTokensRequest) HasRedeemScript() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *TransferTokensRequest) ClearPayload() {
+	x.xxx_hidden_Payload = nil
+}
+
+func (x *TransferTokensRequest) ClearPublicKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_PublicKey = nil
+}
+
+func (x *TransferTokensRequest) ClearSignature() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Signature = nil
+}
+
+func (x *TransferTokensRequest) ClearRedeemScript() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_RedeemScript = nil
+}
+
+type TransferTokensRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Payload      *TransferTokensRequestPayload
+	PublicKey    *string
+	Signature    *string
+	RedeemScript *string
+}
+
+func (b0 TransferTokensRequest_builder) Build() *TransferTokensRequest {
+	m0 := &TransferTokensRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Payload = b.Payload
+	if b.PublicKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_PublicKey = b.PublicKey
+	}
+	if b.Signature != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Signature = b.Signature
+	}
+	if b.RedeemScript != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_RedeemScript = b.RedeemScript
+	}
+	return m0
+}
+
+type TransferTokensRequestPayload struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_FromAddress *Address               `protobuf:"bytes,1,opt,name=from_address,json=fromAddress"`
+	xxx_hidden_ToAddress   *Address               `protobuf:"bytes,2,opt,name=to_address,json=toAddress"`
+	xxx_hidden_MintHash    *Hash                  `protobuf:"bytes,3,opt,name=mint_hash,json=mintHash"`
+	xxx_hidden_Quantity    int32                  `protobuf:"varint,4,opt,name=quantity"`
+	xxx_hidden_Nonce       int64                  `protobuf:"varint,5,opt,name=nonce"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TransferTokensRequestPayload) Reset() {
+	*x = TransferTokensRequestPayload{}
+	mi := &file_tokens_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferTokensRequestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferTokensRequestPayload) ProtoMessage() {}
+
+func (x *TransferTokensRequestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_tokens_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TransferTokensRequestPayload) GetFromAddress() *Address {
+	if x != nil {
+		return x.xxx_hidden_FromAddress
+	}
+	return nil
+}
+
+func (x *TransferTokensRequestPayload) GetToAddress() *Address {
+	if x != nil {
+		return x.xxx_hidden_ToAddress
+	}
+	return nil
+}
+
+func (x *TransferTokensRequestPayload) GetMintHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_MintHash
+	}
+	return nil
+}
+
+func (x *TransferTokensRequestPayload) GetQuantity() int32 {
+	if x != nil {
+		return x.xxx_hidden_Quantity
+	}
+	return 0
+}
+
+func (x *TransferTokensRequestPayload) GetNonce() int64 {
+	if x != nil {
+		return x.xxx_hidden_Nonce
+	}
+	return 0
+}
+
+func (x *TransferTokensRequestPayload) SetFromAddress(v *Address) {
+	x.xxx_hidden_FromAddress = v
+}
+
+func (x *TransferTokensRequestPayload) SetToAddress(v *Address) {
+	x.xxx_hidden_ToAddress = v
+}
+
+func (x *TransferTokensRequestPayload) SetMintHash(v *Hash) {
+	x.xxx_hidden_MintHash = v
+}
+
+func (x *TransferTokensRequestPayload) SetQuantity(v int32) {
+	x.xxx_hidden_Quantity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *TransferTokensRequestPayload) SetNonce(v int64) {
+	x.xxx_hidden_Nonce = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *TransferTokensRequestPayload) HasFromAddress() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_FromAddress != nil
+}
+
+func (x *TransferTokensRequestPayload) HasToAddress() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ToAddress != nil
+}
+
+func (x *TransferTokensRequestPayload) HasMintHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MintHash != nil
+}
+
+func (x *TransferTokensRequestPayload) HasQuantity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *TransferTokensRequestPayload) HasNonce() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *TransferTokensRequestPayload) ClearFromAddress() {
+	x.xxx_hidden_FromAddress = nil
+}
+
+func (x *TransferTokensRequestPayload) ClearToAddress() {
+	x.xxx_hidden_ToAddress = nil
+}
+
+func (x *TransferTokensRequestPayload) ClearMintHash() {
+	x.xxx_hidden_MintHash = nil
+}
+
+func (x *TransferTokensRequestPayload) ClearQuantity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Quantity = 0
+}
+
+func (x *TransferTokensRequestPayload) ClearNonce() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Nonce = 0
+}
+
+type TransferTokensRequestPayload_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	FromAddress *Address
+	ToAddress   *Address
+	MintHash    *Hash
+	Quantity    *int32
+	// Sets apart otherwise identical transfers, so that each signed transfer is applied once
+	Nonce *int64
+}
+
+func (b0 TransferTokensRequestPayload_builder) Build() *TransferTokensRequestPayload {
+	m0 := &TransferTokensRequestPayload{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_FromAddress = b.FromAddress
+	x.xxx_hidden_ToAddress = b.ToAddress
+	x.xxx_hidden_MintHash = b.MintHash
+	if b.Quantity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_Quantity = *b.Quantity
+	}
+	if b.Nonce != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_Nonce = *b.Nonce
+	}
+	return m0
+}
+
+type TransferTokensResponse struct {
+	state                             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_EncodedTransactionBody *string                `protobuf:"bytes,1,opt,name=encoded_transaction_body,json=encodedTransactionBody"`
+	xxx_hidden_Hash                   *Hash                  `protobuf:"bytes,2,opt,name=hash"`
+	XXX_raceDetectHookData            protoimpl.RaceDetectHookData
+	XXX_presence                      [1]uint32
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *TransferTokensResponse) Reset() {
+	*x = TransferTokensResponse{}
+	mi := &file_tokens_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferTokensResponse) ProtoMessage() {}
+
+func (x *TransferTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tokens_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TransferTokensResponse) GetEncodedTransactionBody() string {
+	if x != nil {
+		if x.xxx_hidden_EncodedTransactionBody != nil {
+			return *x.xxx_hidden_EncodedTransactionBody
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TransferTokensResponse) GetHash() *Hash {
+	if x != nil {
+		return x.xxx_hidden_Hash
+	}
+	return nil
+}
+
+func (x *TransferTokensResponse) SetEncodedTransactionBody(v string) {
+	x.xxx_hidden_EncodedTransactionBody = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *TransferTokensResponse) SetHash(v *Hash) {
+	x.xxx_hidden_Hash = v
+}
+
+func (x *TransferTokensResponse) HasEncodedTransactionBody() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TransferTokensResponse) HasHash() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Hash != nil
+}
+
+func (x *TransferTokensResponse) ClearEncodedTransactionBody() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_EncodedTransactionBody = nil
+}
+
+func (x *TransferTokensResponse) ClearHash() {
+	x.xxx_hidden_Hash = nil
+}
+
+type TransferTokensResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	EncodedTransactionBody *string
+	Hash                   *Hash
+}
+
+func (b0 TransferTokensResponse_builder) Build() *TransferTokensResponse {
+	m0 := &TransferTokensResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.EncodedTransactionBody != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_EncodedTransactionBody = b.EncodedTransactionBody
+	}
+	x.xxx_hidden_Hash = b.Hash
+	return m0
+}
+
 var File_tokens_proto protoreflect.FileDescriptor
 
 const file_tokens_proto_rawDesc = "" +
@@ -420,44 +1137,86 @@ const file_tokens_proto_rawDesc = "" +
 	"\aaddress\x18\x01 \x01(\v2\x1d.fractalengine.rpc.v1.AddressR\aaddress\x127\n" +
 	"\tmint_hash\x18\x02 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\bmintHash\"a\n" +
 	"\x1fGetPendingTokenBalancesResponse\x12>\n" +
-	"\bbalances\x18\x01 \x03(\v2\".fractalengine.rpc.v1.TokenBalanceR\bbalances\"\xbd\x02\n" +
+	"\bbalances\x18\x01 \x03(\v2\".fractalengine.rpc.v1.TokenBalanceR\bbalances\"\xf7\x02\n" +
 	"\x17GetTokenBalancesRequest\x127\n" +
 	"\aaddress\x18\x01 \x01(\v2\x1d.fractalengine.rpc.v1.AddressR\aaddress\x127\n" +
 	"\tmint_hash\x18\x02 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\bmintHash\x12L\n" +
 	"\x14include_mint_details\x18\x03 \x01(\v2\x1a.google.protobuf.BoolValueR\x12includeMintDetails\x121\n" +
 	"\x05limit\x18\x04 \x01(\v2\x1b.google.protobuf.Int32ValueR\x05limit\x12/\n" +
-	"\x04page\x18\x05 \x01(\v2\x1b.google.protobuf.Int32ValueR\x04page\"G\n" +
+	"\x04page\x18\x05 \x01(\v2\x1b.google.protobuf.Int32ValueR\x04page\x128\n" +
+	"\tat_height\x18\x06 \x01(\v2\x1b.google.protobuf.Int64ValueR\batHeight\"G\n" +
 	"\x18GetTokenBalancesResponse\x12+\n" +
-	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04dataB.Z,dogecoin.org/fractal-engine/pkg/rpc/protocolb\beditionsp\xe8\a"
-
-var file_tokens_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"\x87\x01\n" +
+	"\x12GetCapTableRequest\x127\n" +
+	"\tmint_hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\bmintHash\x128\n" +
+	"\tat_height\x18\x02 \x01(\v2\x1b.google.protobuf.Int64ValueR\batHeight\"\xd1\x01\n" +
+	"\x13GetCapTableResponse\x127\n" +
+	"\tmint_hash\x18\x01 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\bmintHash\x12\x1b\n" +
+	"\tat_height\x18\x02 \x01(\x03R\batHeight\x12%\n" +
+	"\x0etotal_quantity\x18\x03 \x01(\x05R\rtotalQuantity\x12=\n" +
+	"\aholders\x18\x04 \x03(\v2#.fractalengine.rpc.v1.CapTableEntryR\aholders\"\xd9\x01\n" +
+	"\x15TransferTokensRequest\x12L\n" +
+	"\apayload\x18\x01 \x01(\v22.fractalengine.rpc.v1.TransferTokensRequestPayloadR\apayload\x12&\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tpublicKey\x12%\n" +
+	"\tsignature\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tsignature\x12#\n" +
+	"\rredeem_script\x18\x04 \x01(\tR\fredeemScript\"\x92\x02\n" +
+	"\x1cTransferTokensRequestPayload\x12@\n" +
+	"\ffrom_address\x18\x01 \x01(\v2\x1d.fractalengine.rpc.v1.AddressR\vfromAddress\x12<\n" +
+	"\n" +
+	"to_address\x18\x02 \x01(\v2\x1d.fractalengine.rpc.v1.AddressR\ttoAddress\x127\n" +
+	"\tmint_hash\x18\x03 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\bmintHash\x12#\n" +
+	"\bquantity\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bquantity\x12\x14\n" +
+	"\x05nonce\x18\x05 \x01(\x03R\x05nonce\"\x82\x01\n" +
+	"\x16TransferTokensResponse\x128\n" +
+	"\x18encoded_transaction_body\x18\x01 \x01(\tR\x16encodedTransactionBody\x12.\n" +
+	"\x04hash\x18\x02 \x01(\v2\x1a.fractalengine.rpc.v1.HashR\x04hashB.Z,dogecoin.org/fractal-engine/pkg/rpc/protocolb\beditionsp\xe8\a"
+
+var file_tokens_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_tokens_proto_goTypes = []any{
 	(*GetPendingTokenBalancesRequest)(nil),  // 0: fractalengine.rpc.v1.GetPendingTokenBalancesRequest
 	(*GetPendingTokenBalancesResponse)(nil), // 1: fractalengine.rpc.v1.GetPendingTokenBalancesResponse
 	(*GetTokenBalancesRequest)(nil),         // 2: fractalengine.rpc.v1.GetTokenBalancesRequest
 	(*GetTokenBalancesResponse)(nil),        // 3: fractalengine.rpc.v1.GetTokenBalancesResponse
-	(*Address)(nil),                         // 4: fractalengine.rpc.v1.Address
-	(*Hash)(nil),                            // 5: fractalengine.rpc.v1.Hash
-	(*TokenBalance)(nil),                    // 6: fractalengine.rpc.v1.TokenBalance
-	(*wrapperspb.BoolValue)(nil),            // 7: google.protobuf.BoolValue
-	(*wrapperspb.Int32Value)(nil),           // 8: google.protobuf.Int32Value
-	(*structpb.Struct)(nil),                 // 9: google.protobuf.Struct
+	(*GetCapTableRequest)(nil),              // 4: fractalengine.rpc.v1.GetCapTableRequest
+	(*GetCapTableResponse)(nil),             // 5: fractalengine.rpc.v1.GetCapTableResponse
+	(*TransferTokensRequest)(nil),           // 6: fractalengine.rpc.v1.TransferTokensRequest
+	(*TransferTokensRequestPayload)(nil),    // 7: fractalengine.rpc.v1.TransferTokensRequestPayload
+	(*TransferTokensResponse)(nil),          // 8: fractalengine.rpc.v1.TransferTokensResponse
+	(*Address)(nil),                         // 9: fractalengine.rpc.v1.Address
+	(*Hash)(nil),                            // 10: fractalengine.rpc.v1.Hash
+	(*TokenBalance)(nil),                    // 11: fractalengine.rpc.v1.TokenBalance
+	(*wrapperspb.BoolValue)(nil),            // 12: google.protobuf.BoolValue
+	(*wrapperspb.Int32Value)(nil),           // 13: google.protobuf.Int32Value
+	(*wrapperspb.Int64Value)(nil),           // 14: google.protobuf.Int64Value
+	(*structpb.Struct)(nil),                 // 15: google.protobuf.Struct
+	(*CapTableEntry)(nil),                   // 16: fractalengine.rpc.v1.CapTableEntry
 }
 var file_tokens_proto_depIdxs = []int32{
-	4, // 0: fractalengine.rpc.v1.GetPendingTokenBalancesRequest.address:type_name -> fractalengine.rpc.v1.Address
-	5, // 1: fractalengine.rpc.v1.GetPendingTokenBalancesRequest.mint_hash:type_name -> fractalengine.rpc.v1.Hash
-	6, // 2: fractalengine.rpc.v1.GetPendingTokenBalancesResponse.balances:type_name -> fractalengine.rpc.v1.TokenBalance
-	4, // 3: fractalengine.rpc.v1.GetTokenBalancesRequest.address:type_name -> fractalengine.rpc.v1.Address
-	5, // 4: fractalengine.rpc.v1.GetTokenBalancesRequest.mint_hash:type_name -> fractalengine.rpc.v1.Hash
-	7, // 5: fractalengine.rpc.v1.GetTokenBalancesRequest.include_mint_details:type_name -> google.protobuf.BoolValue
-	8, // 6: fractalengine.rpc.v1.GetTokenBalancesRequest.limit:type_name -> google.protobuf.Int32Value
-	8, // 7: fractalengine.rpc.v1.GetTokenBalancesRequest.page:type_name -> google.protobuf.Int32Value
-	9, // 8: fractalengine.rpc.v1.GetTokenBalancesResponse.data:type_name -> google.protobuf.Struct
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	9,  // 0: fractalengine.rpc.v1.GetPendingTokenBalancesRequest.address:type_name -> fractalengine.rpc.v1.Address
+	10, // 1: fractalengine.rpc.v1.GetPendingTokenBalancesRequest.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	11, // 2: fractalengine.rpc.v1.GetPendingTokenBalancesResponse.balances:type_name -> fractalengine.rpc.v1.TokenBalance
+	9,  // 3: fractalengine.rpc.v1.GetTokenBalancesRequest.address:type_name -> fractalengine.rpc.v1.Address
+	10, // 4: fractalengine.rpc.v1.GetTokenBalancesRequest.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	12, // 5: fractalengine.rpc.v1.GetTokenBalancesRequest.include_mint_details:type_name -> google.protobuf.BoolValue
+	13, // 6: fractalengine.rpc.v1.GetTokenBalancesRequest.limit:type_name -> google.protobuf.Int32Value
+	13, // 7: fractalengine.rpc.v1.GetTokenBalancesRequest.page:type_name -> google.protobuf.Int32Value
+	14, // 8: fractalengine.rpc.v1.GetTokenBalancesRequest.at_height:type_name -> google.protobuf.Int64Value
+	15, // 9: fractalengine.rpc.v1.GetTokenBalancesResponse.data:type_name -> google.protobuf.Struct
+	10, // 10: fractalengine.rpc.v1.GetCapTableRequest.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	14, // 11: fractalengine.rpc.v1.GetCapTableRequest.at_height:type_name -> google.protobuf.Int64Value
+	10, // 12: fractalengine.rpc.v1.GetCapTableResponse.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	16, // 13: fractalengine.rpc.v1.GetCapTableResponse.holders:type_name -> fractalengine.rpc.v1.CapTableEntry
+	7,  // 14: fractalengine.rpc.v1.TransferTokensRequest.payload:type_name -> fractalengine.rpc.v1.TransferTokensRequestPayload
+	9,  // 15: fractalengine.rpc.v1.TransferTokensRequestPayload.from_address:type_name -> fractalengine.rpc.v1.Address
+	9,  // 16: fractalengine.rpc.v1.TransferTokensRequestPayload.to_address:type_name -> fractalengine.rpc.v1.Address
+	10, // 17: fractalengine.rpc.v1.TransferTokensRequestPayload.mint_hash:type_name -> fractalengine.rpc.v1.Hash
+	10, // 18: fractalengine.rpc.v1.TransferTokensResponse.hash:type_name -> fractalengine.rpc.v1.Hash
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_tokens_proto_init() }
@@ -473,7 +1232,7 @@ func file_tokens_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tokens_proto_rawDesc), len(file_tokens_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -9,9 +9,11 @@ import (
 	"strings"
 	"time"
 
+	connect "connectrpc.com/connect"
 	"dogecoin.org/fractal-engine/pkg/config"
 	"dogecoin.org/fractal-engine/pkg/doge"
 	"dogecoin.org/fractal-engine/pkg/dogenet"
+	"dogecoin.org/fractal-engine/pkg/metrics"
 	"dogecoin.org/fractal-engine/pkg/rpc/protocol/protocolconnect"
 	"dogecoin.org/fractal-engine/pkg/store"
 	"golang.org/x/net/http2"
@@ -34,8 +36,9 @@ func NewRpcServer(cfg *config.Config, store *store.TokenisationStore, gossipClie
 	mux := http.NewServeMux()
 
 	connectService := NewConnectRpcService(store, gossipClient, cfg, dogeClient)
-	connectPath, connectHandler := protocolconnect.NewFractalEngineRpcServiceHandler(connectService)
+	connectPath, connectHandler := protocolconnect.NewFractalEngineRpcServiceHandler(connectService, connect.WithInterceptors(metricsInterceptor{}))
 	mux.Handle(connectPath, connectHandler)
+	mux.Handle("/metrics", metrics.Handler())
 
 	handler := withCORS(cfg.CORSAllowedOrigins, h2c.NewHandler(mux, &http2.Server{}))
 
@@ -63,6 +66,7 @@ func NewRpcServer(cfg *config.Config, store *store.TokenisationStore, gossipClie
 func rateLimitMiddleware(limiter *rate.Limiter, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if !limiter.Allow() {
+			metrics.RateLimitRejections.Inc()
 			http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
 			return
 		}
@@ -1,9 +1,18 @@
 package rpc
 
 import (
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+
+	"dogecoin.org/fractal-engine/pkg/config"
+	"dogecoin.org/fractal-engine/pkg/metrics"
+	"dogecoin.org/fractal-engine/pkg/rpc/protocol/protocolconnect"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"golang.org/x/time/rate"
 )
 
 func TestWithSecureAPI_AuthorizationScenarios(t *testing.T) {
@@ -56,3 +65,68 @@ func TestWithSecureAPI_AuthorizationScenarios(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimitMiddlewareCountsRejections(t *testing.T) {
+	before := testutil.ToFloat64(metrics.RateLimitRejections)
+
+	limiter := rate.NewLimiter(rate.Limit(1), 1)
+	handler := rateLimitMiddleware(limiter, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for range 3 {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "http://example.com/test", nil))
+	}
+
+	if got := testutil.ToFloat64(metrics.RateLimitRejections) - before; got != 2 {
+		t.Fatalf("rejections = %v, want 2", got)
+	}
+}
+
+func TestMetricsEndpoint(t *testing.T) {
+	cfg := config.NewConfig()
+	cfg.RateLimitPerSecond = 100
+	tokenisationStore, err := store.NewTokenisationStore("file:metricsdb?mode=memory&cache=shared", *cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { tokenisationStore.DB.Close() })
+
+	if err := tokenisationStore.Migrate(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(NewRpcServer(cfg, tokenisationStore, nil, nil).server.Handler)
+	t.Cleanup(server.Close)
+
+	resp, err := http.Post(server.URL+protocolconnect.FractalEngineRpcServiceGetWebhooksProcedure, "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	resp, err = http.Get(server.URL + "/metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`fractal_engine_rpc_requests_total{code="ok",method="` + protocolconnect.FractalEngineRpcServiceGetWebhooksProcedure + `"}`,
+		`fractal_engine_rpc_duration_seconds_count{method="` + protocolconnect.FractalEngineRpcServiceGetWebhooksProcedure + `"}`,
+		`fractal_engine_db_query_duration_seconds_count{operation="select"}`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Fatalf("metrics output missing %s", want)
+		}
+	}
+}
@@ -17,11 +17,19 @@ import (
 
 type FakeGossipClient struct {
 	dogenet.GossipClient
-	buyOffers         []store.BuyOffer
-	sellOffers        []store.SellOffer
-	mints             []store.Mint
-	invoices          []store.UnconfirmedInvoice
-	invoiceSignatures []store.InvoiceSignature
+	buyOffers               []store.BuyOffer
+	sellOffers              []store.SellOffer
+	mints                   []store.Mint
+	invoices                []store.UnconfirmedInvoice
+	cancelledInvoices       []string
+	invoiceSignatures       []store.InvoiceSignature
+	burnSignatures          []store.BurnSignature
+	attestations            []store.Attestation
+	mintAmendments          []store.MintAmendment
+	mintAmendmentSignatures []store.MintAmendmentSignature
+	mintOwnershipTransfers  []store.MintOwnershipTransfer
+	distributions           []store.Distribution
+	tokenTransfers          []store.TokenTransfer
 }
 
 func (g *FakeGossipClient) GossipBuyOffer(offer store.BuyOffer) error {
@@ -44,6 +52,11 @@ func (g *FakeGossipClient) GossipUnconfirmedInvoice(invoice store.UnconfirmedInv
 	return nil
 }
 
+func (g *FakeGossipClient) GossipCancelInvoice(hash string, publicKey string, signature string) error {
+	g.cancelledInvoices = append(g.cancelledInvoices, hash)
+	return nil
+}
+
 func (g *FakeGossipClient) GossipDeleteBuyOffer(hash string, publicKey string, signature string) error {
 	for i, offer := range g.buyOffers {
 		if offer.Hash == hash {
@@ -73,6 +86,41 @@ func (g *FakeGossipClient) GossipInvoiceSignature(invoiceSignature store.Invoice
 	return nil
 }
 
+func (g *FakeGossipClient) GossipBurnSignature(burnSignature store.BurnSignature) error {
+	g.burnSignatures = append(g.burnSignatures, burnSignature)
+	return nil
+}
+
+func (g *FakeGossipClient) GossipAttestation(attestation store.Attestation) error {
+	g.attestations = append(g.attestations, attestation)
+	return nil
+}
+
+func (g *FakeGossipClient) GossipMintAmendment(amendment store.MintAmendment) error {
+	g.mintAmendments = append(g.mintAmendments, amendment)
+	return nil
+}
+
+func (g *FakeGossipClient) GossipMintAmendmentSignature(signature store.MintAmendmentSignature) error {
+	g.mintAmendmentSignatures = append(g.mintAmendmentSignatures, signature)
+	return nil
+}
+
+func (g *FakeGossipClient) GossipMintOwnershipTransfer(transfer store.MintOwnershipTransfer) error {
+	g.mintOwnershipTransfers = append(g.mintOwnershipTransfers, transfer)
+	return nil
+}
+
+func (g *FakeGossipClient) GossipDistribution(distribution store.Distribution) error {
+	g.distributions = append(g.distributions, distribution)
+	return nil
+}
+
+func (g *FakeGossipClient) GossipTokenTransfer(transfer store.TokenTransfer) error {
+	g.tokenTransfers = append(g.tokenTransfers, transfer)
+	return nil
+}
+
 func SetupRpcTest(t *testing.T) (*store.TokenisationStore, *FakeGossipClient, protocolconnect.FractalEngineRpcServiceClient) {
 	t.Helper()
 
@@ -86,6 +134,8 @@ func SetupRpcTest(t *testing.T) (*store.TokenisationStore, *FakeGossipClient, pr
 		mints:             []store.Mint{},
 		invoices:          []store.UnconfirmedInvoice{},
 		invoiceSignatures: []store.InvoiceSignature{},
+		burnSignatures:    []store.BurnSignature{},
+		attestations:      []store.Attestation{},
 	}
 
 	cfg := config.NewConfig()
@@ -2,10 +2,17 @@ package rpc
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"time"
 
 	connect "connectrpc.com/connect"
+	engineprotocol "dogecoin.org/fractal-engine/pkg/protocol"
 	protocol "dogecoin.org/fractal-engine/pkg/rpc/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"dogecoin.org/fractal-engine/pkg/validation"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func (s *ConnectRpcService) GetPendingTokenBalances(ctx context.Context, req *connect.Request[protocol.GetPendingTokenBalancesRequest]) (*connect.Response[protocol.GetPendingTokenBalancesResponse], error) {
@@ -50,6 +57,13 @@ func (s *ConnectRpcService) GetTokenBalances(ctx context.Context, req *connect.R
 		mintHash = req.Msg.GetMintHash().GetValue()
 	}
 
+	// Balances at a past height are reported without lockups, which only apply to
+	// fractions being spent at the chain tip
+	atHeight, err := s.balanceHeight(ctx, req.Msg.GetAtHeight())
+	if err != nil {
+		return nil, err
+	}
+
 	if includeMintDetails {
 		limit := int32(100)
 		if req.Msg.GetLimit() != nil && req.Msg.GetLimit().GetValue() > 0 && req.Msg.GetLimit().GetValue() <= limit {
@@ -64,11 +78,26 @@ func (s *ConnectRpcService) GetTokenBalances(ctx context.Context, req *connect.R
 		start := int(page * limit)
 		end := int(start + int(limit))
 
-		tokenBalances, err := s.store.GetMyMintTokenBalances(ctx, address.GetValue(), start, end)
+		tokenBalances, err := s.store.GetMyMintTokenBalancesAtHeight(ctx, address.GetValue(), atHeight, start, end)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, err)
 		}
 
+		for i := range tokenBalances {
+			if atHeight > 0 {
+				tokenBalances[i].UnlockedQuantity = tokenBalances[i].Quantity
+				continue
+			}
+
+			locked, err := s.getLockedTokenBalance(ctx, address.GetValue(), tokenBalances[i].Hash)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+
+			tokenBalances[i].LockedQuantity = locked
+			tokenBalances[i].UnlockedQuantity = tokenBalances[i].Quantity - locked
+		}
+
 		var responseData interface{}
 		if start >= len(tokenBalances) {
 			responseData = GetTokenBalanceWithMintsResponse{}
@@ -94,12 +123,30 @@ func (s *ConnectRpcService) GetTokenBalances(ctx context.Context, req *connect.R
 		return connect.NewResponse(resp), nil
 	}
 
-	tokenBalances, err := s.store.GetTokenBalances(ctx, address.GetValue(), mintHash)
+	tokenBalances, err := s.store.GetTokenBalancesAtHeight(ctx, address.GetValue(), mintHash, atHeight)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	data, err := toStructPB(map[string]interface{}{"balances": tokenBalances})
+	responseData := map[string]interface{}{"balances": tokenBalances}
+	if atHeight > 0 {
+		responseData["at_height"] = atHeight
+	} else if mintHash != "" {
+		total := 0
+		for _, balance := range tokenBalances {
+			total += balance.Quantity
+		}
+
+		locked, err := s.getLockedTokenBalance(ctx, address.GetValue(), mintHash)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		responseData["locked_quantity"] = locked
+		responseData["unlocked_quantity"] = total - locked
+	}
+
+	data, err := toStructPB(responseData)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -108,3 +155,169 @@ func (s *ConnectRpcService) GetTokenBalances(ctx context.Context, req *connect.R
 	resp.SetData(data)
 	return connect.NewResponse(resp), nil
 }
+
+func (s *ConnectRpcService) TransferTokens(ctx context.Context, req *connect.Request[protocol.TransferTokensRequest]) (*connect.Response[protocol.TransferTokensResponse], error) {
+	request, err := toTransferTokensRequest(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	mint, err := s.store.GetMintByHash(ctx, request.Payload.MintHash)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if mint.Hash == "" {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("mint not found"))
+	}
+
+	if err := s.checkMintRequirements(ctx, mint, request.Payload.ToAddress, request.Payload.Quantity); err != nil {
+		return nil, err
+	}
+
+	available, err := s.store.GetAvailableTokenBalance(ctx, request.Payload.FromAddress, request.Payload.MintHash, nil)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if available < request.Payload.Quantity {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("insufficient available balance: %d < %d", available, request.Payload.Quantity))
+	}
+
+	locked, err := s.getLockedTokenBalance(ctx, request.Payload.FromAddress, request.Payload.MintHash)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if available-locked < request.Payload.Quantity {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("insufficient unlocked balance: %d < %d", available-locked, request.Payload.Quantity))
+	}
+
+	transfer := &store.TokenTransfer{
+		MintHash:     request.Payload.MintHash,
+		FromAddress:  request.Payload.FromAddress,
+		ToAddress:    request.Payload.ToAddress,
+		Quantity:     request.Payload.Quantity,
+		Nonce:        request.Payload.Nonce,
+		PublicKey:    request.PublicKey,
+		Signature:    request.Signature,
+		RedeemScript: request.RedeemScript,
+		CreatedAt:    time.Now(),
+	}
+
+	transfer.Hash, err = transfer.GenerateHash()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	transfer.Id, err = s.store.SaveTokenTransfer(ctx, transfer)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.gossipClient.GossipTokenTransfer(*transfer); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	envelope := engineprotocol.NewTransferTransactionEnvelope(transfer.Hash, transfer.MintHash, engineprotocol.ACTION_TRANSFER)
+	encodedTransactionBody := envelope.Serialize()
+
+	resp := &protocol.TransferTokensResponse{}
+	resp.SetHash(toProtoHash(transfer.Hash))
+	resp.SetEncodedTransactionBody(hex.EncodeToString(encodedTransactionBody))
+	return connect.NewResponse(resp), nil
+}
+
+// GetCapTable returns every holder of a mint and their share of the fractions in
+// circulation, at the given height or at the chain tip.
+func (s *ConnectRpcService) GetCapTable(ctx context.Context, req *connect.Request[protocol.GetCapTableRequest]) (*connect.Response[protocol.GetCapTableResponse], error) {
+	mintHash := req.Msg.GetMintHash().GetValue()
+	if err := validation.ValidateHash(mintHash); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	atHeight, err := s.balanceHeight(ctx, req.Msg.GetAtHeight())
+	if err != nil {
+		return nil, err
+	}
+
+	if atHeight == 0 {
+		atHeight, _, _, err = s.store.GetChainPosition(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	mint, err := s.store.GetMintByHash(ctx, mintHash)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if mint.Hash == "" {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("mint not found"))
+	}
+
+	holders, err := s.store.GetTokenHoldersAtHeight(ctx, mintHash, atHeight)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	total := int64(0)
+	for _, holder := range holders {
+		total += int64(holder.Quantity)
+	}
+
+	entries := make([]*protocol.CapTableEntry, 0, len(holders))
+	for _, holder := range holders {
+		entry := &protocol.CapTableEntry{}
+		entry.SetAddress(toProtoAddress(holder.Address))
+		entry.SetQuantity(int32(holder.Quantity))
+		entry.SetBasisPoints(int32(int64(holder.Quantity) * store.BasisPointsTotal / total))
+		entries = append(entries, entry)
+	}
+
+	resp := &protocol.GetCapTableResponse{}
+	resp.SetMintHash(toProtoHash(mintHash))
+	resp.SetAtHeight(atHeight)
+	resp.SetTotalQuantity(int32(total))
+	resp.SetHolders(entries)
+	return connect.NewResponse(resp), nil
+}
+
+// balanceHeight returns the height balances are requested at, or 0 for the current
+// balances. Heights above the chain tip have not been processed yet and are rejected.
+func (s *ConnectRpcService) balanceHeight(ctx context.Context, atHeight *wrapperspb.Int64Value) (int64, error) {
+	if atHeight == nil {
+		return 0, nil
+	}
+
+	if atHeight.GetValue() <= 0 {
+		return 0, connect.NewError(connect.CodeInvalidArgument, errors.New("at_height must be greater than 0"))
+	}
+
+	blockHeight, _, _, err := s.store.GetChainPosition(ctx)
+	if err != nil {
+		return 0, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if atHeight.GetValue() > blockHeight {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at_height %d is above the chain tip %d", atHeight.GetValue(), blockHeight))
+	}
+
+	return atHeight.GetValue(), nil
+}
+
+// getLockedTokenBalance evaluates the mint's lockup at the follower's current chain
+// position and the local wall-clock time.
+func (s *ConnectRpcService) getLockedTokenBalance(ctx context.Context, address string, mintHash string) (int, error) {
+	blockHeight, _, _, err := s.store.GetChainPosition(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.store.GetLockedTokenBalance(ctx, address, mintHash, blockHeight, time.Now(), nil)
+}
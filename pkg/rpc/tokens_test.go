@@ -2,11 +2,16 @@ package rpc_test
 
 import (
 	"context"
+	"encoding/hex"
 	"testing"
 
 	connect "connectrpc.com/connect"
+	"dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	engineprotocol "dogecoin.org/fractal-engine/pkg/protocol"
 	"dogecoin.org/fractal-engine/pkg/rpc/protocol"
 	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"gotest.tools/assert"
 )
@@ -90,3 +95,357 @@ func TestGetTokenBalanceWithMintDetails(t *testing.T) {
 	assert.Equal(t, mint["description"], "description1")
 	assert.Equal(t, int(mint["fraction_count"].(float64)), 10)
 }
+
+func newTransferTokensRequest(t *testing.T, payload store.TokenTransferBody, privHex string, pubHex string) *protocol.TransferTokensRequest {
+	signature, err := doge.SignPayload(payload, privHex, pubHex)
+	assert.NilError(t, err)
+
+	fromAddressProto := &protocol.Address{}
+	fromAddressProto.SetValue(payload.FromAddress)
+	toAddressProto := &protocol.Address{}
+	toAddressProto.SetValue(payload.ToAddress)
+	mintHashProto := &protocol.Hash{}
+	mintHashProto.SetValue(payload.MintHash)
+
+	protoPayload := &protocol.TransferTokensRequestPayload{}
+	protoPayload.SetFromAddress(fromAddressProto)
+	protoPayload.SetToAddress(toAddressProto)
+	protoPayload.SetMintHash(mintHashProto)
+	protoPayload.SetQuantity(int32(payload.Quantity))
+	protoPayload.SetNonce(payload.Nonce)
+
+	request := &protocol.TransferTokensRequest{}
+	request.SetPayload(protoPayload)
+	request.SetPublicKey(pubHex)
+	request.SetSignature(signature)
+	return request
+}
+
+func TestTransferTokens(t *testing.T) {
+	tokenisationStore, gossipClient, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	privHex, pubHex, fromAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	_, _, toAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	mintHash := support.GenerateRandomHash()
+
+	_, err = tokenisationStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "Test Mint",
+		Description:   "Test Description",
+		FractionCount: 1000,
+		Hash:          mintHash,
+	}, fromAddress)
+	assert.NilError(t, err)
+
+	err = tokenisationStore.UpsertTokenBalance(ctx, fromAddress, mintHash, 100)
+	assert.NilError(t, err)
+
+	request := newTransferTokensRequest(t, store.TokenTransferBody{
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		MintHash:    mintHash,
+		Quantity:    60,
+		Nonce:       1,
+	}, privHex, pubHex)
+
+	response, err := feClient.TransferTokens(ctx, connect.NewRequest(request))
+	assert.NilError(t, err)
+
+	encodedTransactionBody, err := hex.DecodeString(response.Msg.GetEncodedTransactionBody())
+	assert.NilError(t, err)
+
+	envelope := engineprotocol.MessageEnvelope{}
+	err = envelope.Deserialize(encodedTransactionBody)
+	assert.NilError(t, err)
+	assert.Equal(t, uint8(engineprotocol.ACTION_TRANSFER), envelope.Action)
+	assert.Assert(t, len(encodedTransactionBody) <= 80)
+
+	message := engineprotocol.OnChainTransferMessage{}
+	err = proto.Unmarshal(envelope.Data, &message)
+	assert.NilError(t, err)
+	assert.Equal(t, mintHash, hex.EncodeToString(message.MintHash))
+	assert.Equal(t, response.Msg.GetHash().GetValue(), hex.EncodeToString(message.TransferHash))
+
+	// The signed transfer is kept and gossiped for the nodes that apply it
+	transfer, err := tokenisationStore.GetTokenTransfer(ctx, response.Msg.GetHash().GetValue())
+	assert.NilError(t, err)
+	assert.Equal(t, fromAddress, transfer.FromAddress)
+	assert.Equal(t, toAddress, transfer.ToAddress)
+	assert.Equal(t, 60, transfer.Quantity)
+	assert.Equal(t, int64(1), transfer.Nonce)
+	assert.Equal(t, pubHex, transfer.PublicKey)
+	assert.NilError(t, transfer.Validate())
+
+	assert.Equal(t, 1, len(gossipClient.tokenTransfers))
+	assert.Equal(t, transfer.Hash, gossipClient.tokenTransfers[0].Hash)
+
+	// The same signed transfer cannot be submitted twice
+	_, err = feClient.TransferTokens(ctx, connect.NewRequest(request))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestTransferTokensFromMultisigAddress(t *testing.T) {
+	tokenisationStore, _, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	privHex, pubHex, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	_, cosignerPubHex, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	_, _, toAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	// 1-of-2 multisig custodian wallet
+	script := []byte{0x51}
+	for _, pubKey := range []string{pubHex, cosignerPubHex} {
+		pubKeyBytes, err := hex.DecodeString(pubKey)
+		assert.NilError(t, err)
+		script = append(script, byte(len(pubKeyBytes)))
+		script = append(script, pubKeyBytes...)
+	}
+	script = append(script, 0x52, 0xae)
+	fromAddress := doge.ScriptToP2SHAddress(script, doge.ScriptPrefixRegtest)
+	mintHash := support.GenerateRandomHash()
+
+	_, err = tokenisationStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "Test Mint",
+		Description:   "Test Description",
+		FractionCount: 1000,
+		Hash:          mintHash,
+	}, fromAddress)
+	assert.NilError(t, err)
+
+	err = tokenisationStore.UpsertTokenBalance(ctx, fromAddress, mintHash, 100)
+	assert.NilError(t, err)
+
+	request := newTransferTokensRequest(t, store.TokenTransferBody{
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		MintHash:    mintHash,
+		Quantity:    60,
+	}, privHex, pubHex)
+
+	_, err = feClient.TransferTokens(ctx, connect.NewRequest(request))
+	assert.ErrorContains(t, err, "address must be P2PKH to match public key")
+
+	request.SetRedeemScript(hex.EncodeToString(script))
+
+	response, err := feClient.TransferTokens(ctx, connect.NewRequest(request))
+	assert.NilError(t, err)
+	assert.Assert(t, response.Msg.GetEncodedTransactionBody() != "")
+}
+
+func TestTransferTokensWithInsufficientAvailableBalance(t *testing.T) {
+	tokenisationStore, _, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	privHex, pubHex, fromAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	_, _, toAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	mintHash := support.GenerateRandomHash()
+
+	_, err = tokenisationStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "Test Mint",
+		Description:   "Test Description",
+		FractionCount: 1000,
+		Hash:          mintHash,
+	}, fromAddress)
+	assert.NilError(t, err)
+
+	err = tokenisationStore.UpsertTokenBalance(ctx, fromAddress, mintHash, 100)
+	assert.NilError(t, err)
+
+	// 50 tokens are reserved by a pending invoice
+	err = tokenisationStore.UpsertPendingTokenBalance(ctx, support.GenerateRandomHash(), mintHash, 50, "onchainTx", fromAddress)
+	assert.NilError(t, err)
+
+	request := newTransferTokensRequest(t, store.TokenTransferBody{
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		MintHash:    mintHash,
+		Quantity:    60,
+	}, privHex, pubHex)
+
+	_, err = feClient.TransferTokens(ctx, connect.NewRequest(request))
+	assert.ErrorContains(t, err, "insufficient available balance")
+}
+
+func TestTransferTokensToRecipientFailingMintRequirements(t *testing.T) {
+	tokenisationStore, gossipClient, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	privHex, pubHex, fromAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	_, _, toAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	mintHash := support.GenerateRandomHash()
+
+	_, err = tokenisationStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "Test Mint",
+		Description:   "Test Description",
+		FractionCount: 1000,
+		Hash:          mintHash,
+		Requirements: store.StringInterfaceMap{
+			"allow_list": []interface{}{fromAddress},
+		},
+	}, fromAddress)
+	assert.NilError(t, err)
+
+	err = tokenisationStore.UpsertTokenBalance(ctx, fromAddress, mintHash, 100)
+	assert.NilError(t, err)
+
+	request := newTransferTokensRequest(t, store.TokenTransferBody{
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		MintHash:    mintHash,
+		Quantity:    60,
+	}, privHex, pubHex)
+
+	_, err = feClient.TransferTokens(ctx, connect.NewRequest(request))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assert.ErrorContains(t, err, "not on the allow list")
+	assert.Equal(t, 0, len(gossipClient.tokenTransfers))
+}
+
+func TestTransferTokensWithInvalidRecipientChecksum(t *testing.T) {
+	_, _, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	privHex, pubHex, fromAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	request := newTransferTokensRequest(t, store.TokenTransferBody{
+		FromAddress: fromAddress,
+		ToAddress:   support.GenerateDogecoinAddress(true),
+		MintHash:    support.GenerateRandomHash(),
+		Quantity:    10,
+	}, privHex, pubHex)
+
+	_, err = feClient.TransferTokens(ctx, connect.NewRequest(request))
+	assert.ErrorContains(t, err, "invalid to_address")
+}
+
+func TestGetTokenBalanceReportsLockedQuantity(t *testing.T) {
+	tokenisationStore, _, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	_, err := tokenisationStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "mint1",
+		Description:   "description1",
+		FractionCount: 10,
+		Hash:          "mint1",
+		BlockHeight:   100,
+		LockupOptions: store.StringInterfaceMap{"type": "block_height", "blocks": float64(50)},
+	}, "owner")
+	assert.NilError(t, err)
+
+	err = tokenisationStore.UpsertTokenBalance(ctx, "address1", "mint1", 10)
+	assert.NilError(t, err)
+
+	err = tokenisationStore.UpsertChainPosition(ctx, 120, "blockHash", false)
+	assert.NilError(t, err)
+
+	request := &protocol.GetTokenBalancesRequest{}
+	addressProto := &protocol.Address{}
+	addressProto.SetValue("address1")
+	mintHashProto := &protocol.Hash{}
+	mintHashProto.SetValue("mint1")
+	request.SetAddress(addressProto)
+	request.SetMintHash(mintHashProto)
+
+	response, err := feClient.GetTokenBalances(ctx, connect.NewRequest(request))
+	assert.NilError(t, err)
+
+	data := response.Msg.GetData().AsMap()
+	assert.Equal(t, int(data["locked_quantity"].(float64)), 10)
+	assert.Equal(t, int(data["unlocked_quantity"].(float64)), 0)
+
+	// The lockup has elapsed at height 150
+	err = tokenisationStore.UpsertChainPosition(ctx, 150, "blockHash", false)
+	assert.NilError(t, err)
+
+	response, err = feClient.GetTokenBalances(ctx, connect.NewRequest(request))
+	assert.NilError(t, err)
+
+	data = response.Msg.GetData().AsMap()
+	assert.Equal(t, int(data["locked_quantity"].(float64)), 0)
+	assert.Equal(t, int(data["unlocked_quantity"].(float64)), 10)
+}
+
+func TestGetTokenBalancesAtHeightAndCapTable(t *testing.T) {
+	tokenisationStore, _, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	mintHash := support.GenerateRandomHash()
+	issuerAddress := support.GenerateDogecoinAddress(true)
+	holderAddress := support.GenerateDogecoinAddress(true)
+
+	_, err := tokenisationStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "Test Mint",
+		FractionCount: 100,
+		Hash:          mintHash,
+	}, issuerAddress)
+	assert.NilError(t, err)
+
+	tx, err := tokenisationStore.DB.BeginTx(ctx, nil)
+	assert.NilError(t, err)
+	assert.NilError(t, tokenisationStore.UpsertTokenBalanceAtBlock(ctx, issuerAddress, mintHash, 100, store.BlockRef{Height: 5, Hash: "block5", TransactionHash: "mintTx"}, tx))
+	assert.NilError(t, tokenisationStore.UpsertTokenBalanceAtBlock(ctx, issuerAddress, mintHash, -25, store.BlockRef{Height: 8, Hash: "block8", TransactionHash: "transferTx"}, tx))
+	assert.NilError(t, tokenisationStore.UpsertTokenBalanceAtBlock(ctx, holderAddress, mintHash, 25, store.BlockRef{Height: 8, Hash: "block8", TransactionHash: "transferTx"}, tx))
+	assert.NilError(t, tx.Commit())
+	assert.NilError(t, tokenisationStore.UpsertChainPosition(ctx, 10, "block10", false))
+
+	addressProto := &protocol.Address{}
+	addressProto.SetValue(issuerAddress)
+	mintHashProto := &protocol.Hash{}
+	mintHashProto.SetValue(mintHash)
+
+	request := &protocol.GetTokenBalancesRequest{}
+	request.SetAddress(addressProto)
+	request.SetMintHash(mintHashProto)
+	request.SetAtHeight(wrapperspb.Int64(6))
+
+	response, err := feClient.GetTokenBalances(ctx, connect.NewRequest(request))
+	assert.NilError(t, err)
+
+	data := response.Msg.GetData().AsMap()
+	balances, ok := data["balances"].([]interface{})
+	assert.Assert(t, ok)
+	assert.Equal(t, 1, len(balances))
+	assert.Equal(t, float64(6), data["at_height"])
+
+	balance := balances[0].(map[string]interface{})
+	assert.Equal(t, 100, int(balance["quantity"].(float64)))
+	assert.Equal(t, "mintTx", balance["transaction_hash"])
+
+	// Heights the engine has not reached yet are rejected
+	request.SetAtHeight(wrapperspb.Int64(11))
+	_, err = feClient.GetTokenBalances(ctx, connect.NewRequest(request))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	capTableRequest := &protocol.GetCapTableRequest{}
+	capTableRequest.SetMintHash(mintHashProto)
+	capTableRequest.SetAtHeight(wrapperspb.Int64(6))
+
+	capTable, err := feClient.GetCapTable(ctx, connect.NewRequest(capTableRequest))
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(capTable.Msg.GetHolders()))
+	assert.Equal(t, int32(10000), capTable.Msg.GetHolders()[0].GetBasisPoints())
+
+	capTableRequest.ClearAtHeight()
+	capTable, err = feClient.GetCapTable(ctx, connect.NewRequest(capTableRequest))
+	assert.NilError(t, err)
+	assert.Equal(t, int64(10), capTable.Msg.GetAtHeight())
+	assert.Equal(t, int32(100), capTable.Msg.GetTotalQuantity())
+
+	holders := capTable.Msg.GetHolders()
+	assert.Equal(t, 2, len(holders))
+	assert.Equal(t, issuerAddress, holders[0].GetAddress().GetValue())
+	assert.Equal(t, int32(75), holders[0].GetQuantity())
+	assert.Equal(t, int32(7500), holders[0].GetBasisPoints())
+	assert.Equal(t, holderAddress, holders[1].GetAddress().GetValue())
+	assert.Equal(t, int32(2500), holders[1].GetBasisPoints())
+}
@@ -11,8 +11,9 @@ import (
 )
 
 type SignedRequest struct {
-	PublicKey string `json:"public_key"`
-	Signature string `json:"signature"`
+	PublicKey    string `json:"public_key"`
+	Signature    string `json:"signature"`
+	RedeemScript string `json:"redeem_script,omitempty"`
 }
 
 type PrepareMintRequest struct {
@@ -60,6 +61,9 @@ func (req *PrepareMintRequest) Validate() error {
 		if err := validation.ValidateMetadataSize("requirements", reqBytes); err != nil {
 			return err
 		}
+		if _, err := store.ParseMintRequirements(req.Payload.Requirements); err != nil {
+			return err
+		}
 	}
 
 	// Validate lockup options size
@@ -71,6 +75,9 @@ func (req *PrepareMintRequest) Validate() error {
 		if err := validation.ValidateMetadataSize("lockup_options", lockupBytes); err != nil {
 			return err
 		}
+		if _, err := store.ParseLockupOptions(req.Payload.LockupOptions); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -110,6 +117,12 @@ func (req *CreateMintRequest) Validate() error {
 		return err
 	}
 
+	if req.RedeemScript != "" {
+		if err := validation.ValidateRedeemScriptPublicKey(req.Payload.OwnerAddress, req.PublicKey, req.RedeemScript); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -118,6 +131,94 @@ type CreateMintResponse struct {
 	EncodedTransactionBody string `json:"encoded_transaction_body"`
 }
 
+// AmendMintRequest is signed by the mint owner. The owner public key is checked
+// against the mint once it has been loaded.
+type AmendMintRequest struct {
+	SignedRequest
+	Payload store.MintAmendmentBody `json:"payload"`
+}
+
+func (req *AmendMintRequest) Validate() error {
+	if err := validation.ValidateHash(req.Payload.MintHash); err != nil {
+		return fmt.Errorf("invalid mint_hash: %w", err)
+	}
+
+	if err := validation.ValidateDescription(req.Payload.Description); err != nil {
+		return err
+	}
+
+	if err := validation.ValidateFeedURL(req.Payload.FeedURL); err != nil {
+		return err
+	}
+
+	if req.Payload.Metadata != nil {
+		metadataBytes, err := json.Marshal(req.Payload.Metadata)
+		if err != nil {
+			return fmt.Errorf("invalid metadata format: %w", err)
+		}
+		if err := validation.ValidateMetadataSize("metadata", metadataBytes); err != nil {
+			return err
+		}
+	}
+
+	if err := validation.ValidatePublicKey(req.PublicKey); err != nil {
+		return fmt.Errorf("invalid public_key: %w", err)
+	}
+
+	if err := doge.ValidateSignature(req.Payload, req.PublicKey, req.Signature); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TransferMintOwnershipRequest is signed by both the current and the new mint owner.
+// The current owner public key is checked against the mint once it has been loaded.
+type TransferMintOwnershipRequest struct {
+	SignedRequest
+	NewOwnerSignature string                          `json:"new_owner_signature"`
+	Payload           store.MintOwnershipTransferBody `json:"payload"`
+}
+
+func (req *TransferMintOwnershipRequest) Validate() error {
+	if err := validation.ValidateHash(req.Payload.MintHash); err != nil {
+		return fmt.Errorf("invalid mint_hash: %w", err)
+	}
+
+	if err := validation.ValidateAddress(req.Payload.NewOwnerAddress); err != nil {
+		return fmt.Errorf("invalid new_owner_address: %w", err)
+	}
+
+	if err := validation.ValidateAddressPublicKeyMatch(req.Payload.NewOwnerAddress, req.Payload.NewPublicKey); err != nil {
+		return fmt.Errorf("invalid new_public_key: %w", err)
+	}
+
+	switch req.Payload.SignatureRequirementType {
+	case store.SignatureRequirementType_ALL_SIGNATURES, store.SignatureRequirementType_ONE_SIGNATURE:
+		if len(req.Payload.AssetManagers) == 0 {
+			return fmt.Errorf("asset_managers are required by the signature requirement")
+		}
+	case store.SignatureRequirementType_MIN_SIGNATURES:
+		if req.Payload.MinSignatures < 1 || req.Payload.MinSignatures > len(req.Payload.AssetManagers) {
+			return fmt.Errorf("min_signatures must be between 1 and the number of asset_managers")
+		}
+	}
+
+	if err := validation.ValidatePublicKey(req.PublicKey); err != nil {
+		return fmt.Errorf("invalid public_key: %w", err)
+	}
+
+	if err := doge.ValidateSignature(req.Payload, req.PublicKey, req.Signature); err != nil {
+		return err
+	}
+
+	if err := doge.ValidateSignature(req.Payload, req.Payload.NewPublicKey, req.NewOwnerSignature); err != nil {
+		return fmt.Errorf("invalid new_owner_signature: %w", err)
+	}
+
+	return nil
+}
+
 type GetTokenBalanceResponse struct {
 	MintHash string `json:"mint_hash"`
 	Balance  int    `json:"balance"`
@@ -172,6 +273,27 @@ type DeleteSellOfferRequestPayload struct {
 	OfferHash string `json:"offer_hash"`
 }
 
+type CancelInvoiceRequest struct {
+	SignedRequest
+	Payload CancelInvoiceRequestPayload `json:"payload"`
+}
+
+type CancelInvoiceRequestPayload struct {
+	InvoiceHash string `json:"invoice_hash"`
+}
+
+func (req *CancelInvoiceRequest) Validate() error {
+	if err := validation.ValidateHash(req.Payload.InvoiceHash); err != nil {
+		return fmt.Errorf("invalid invoice_hash: %w", err)
+	}
+
+	if err := doge.ValidateSignature(req.Payload, req.PublicKey, req.Signature); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (req *DeleteBuyOfferRequest) Validate() error {
 	if err := validation.ValidateHash(req.Payload.OfferHash); err != nil {
 		return fmt.Errorf("invalid offer_hash: %w", err)
@@ -266,7 +388,9 @@ type CreateOfferResponse struct {
 }
 
 type GetMintResponse struct {
-	Mint store.Mint `json:"mint"`
+	Mint              store.Mint `json:"mint"`
+	BurnedSupply      int        `json:"burned_supply"`
+	CirculatingSupply int        `json:"circulating_supply"`
 }
 
 type SellOfferWithMint struct {
@@ -303,12 +427,15 @@ type CreateNewPaymentRequest struct {
 }
 
 type CreateInvoiceRequestPayload struct {
-	PaymentAddress string `json:"payment_address"`
-	BuyerAddress   string `json:"buyer_address"`
-	MintHash       string `json:"mint_hash"`
-	Quantity       int    `json:"quantity"`
-	Price          int    `json:"price"`
-	SellerAddress  string `json:"seller_address"`
+	PaymentAddress string              `json:"payment_address"`
+	BuyerAddress   string              `json:"buyer_address"`
+	MintHash       string              `json:"mint_hash"`
+	Quantity       int                 `json:"quantity"`
+	PriceKoinu     int64               `json:"price_koinu"`
+	SellerAddress  string              `json:"seller_address"`
+	Splits         store.InvoiceSplits `json:"splits,omitempty"`
+	// ExpiresAtHeight is the last block the invoice can be paid in, 0 if it does not expire
+	ExpiresAtHeight int64 `json:"expires_at_height,omitempty"`
 }
 
 func (req *CreateInvoiceRequest) Validate() error {
@@ -332,7 +459,106 @@ func (req *CreateInvoiceRequest) Validate() error {
 		return err
 	}
 
-	if err := validation.ValidatePrice("price", req.Payload.Price); err != nil {
+	if err := validation.ValidateInvoicePrice("price_koinu", req.Payload.PriceKoinu, req.Payload.Quantity); err != nil {
+		return err
+	}
+
+	if req.Payload.ExpiresAtHeight < 0 {
+		return fmt.Errorf("expires_at_height must not be negative")
+	}
+
+	if len(req.Payload.Splits) > 0 {
+		for _, split := range req.Payload.Splits {
+			if err := validation.ValidateAddress(split.Address); err != nil {
+				return fmt.Errorf("invalid split address: %w", err)
+			}
+		}
+
+		if err := req.Payload.Splits.Validate(); err != nil {
+			return err
+		}
+
+		if !req.Payload.Splits.Contains(req.Payload.PaymentAddress) {
+			return fmt.Errorf("payment_address must be one of the split addresses")
+		}
+	}
+
+	if err := doge.ValidateSignature(req.Payload, req.PublicKey, req.Signature); err != nil {
+		return err
+	}
+
+	if err := validation.ValidateOwnerPublicKey(req.Payload.SellerAddress, req.PublicKey, req.RedeemScript); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TransferTokensRequest is signed by a key that may sign for the sender address. The
+// signed payload is gossiped with the transfer so that every node can verify it.
+type TransferTokensRequest struct {
+	SignedRequest
+	Payload store.TokenTransferBody `json:"payload"`
+}
+
+func (req *TransferTokensRequest) Validate() error {
+	if err := validation.ValidateAddress(req.Payload.FromAddress); err != nil {
+		return fmt.Errorf("invalid from_address: %w", err)
+	}
+
+	if err := validation.ValidateAddressChecksum(req.Payload.ToAddress); err != nil {
+		return fmt.Errorf("invalid to_address: %w", err)
+	}
+
+	if req.Payload.FromAddress == req.Payload.ToAddress {
+		return fmt.Errorf("from_address and to_address must be different")
+	}
+
+	if err := validation.ValidateHash(req.Payload.MintHash); err != nil {
+		return fmt.Errorf("invalid mint_hash: %w", err)
+	}
+
+	if err := validation.ValidateQuantity("quantity", req.Payload.Quantity); err != nil {
+		return err
+	}
+
+	if err := doge.ValidateSignature(req.Payload, req.PublicKey, req.Signature); err != nil {
+		return err
+	}
+
+	if err := validation.ValidateOwnerPublicKey(req.Payload.FromAddress, req.PublicKey, req.RedeemScript); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type TransferTokensResponse struct {
+	Hash                   string `json:"hash"`
+	EncodedTransactionBody string `json:"encoded_transaction_body"`
+}
+
+type CreateBurnRequest struct {
+	SignedRequest
+	Payload CreateBurnRequestPayload `json:"payload"`
+}
+
+type CreateBurnRequestPayload struct {
+	OwnerAddress string `json:"owner_address"`
+	MintHash     string `json:"mint_hash"`
+	Quantity     int    `json:"quantity"`
+}
+
+func (req *CreateBurnRequest) Validate() error {
+	if err := validation.ValidateAddress(req.Payload.OwnerAddress); err != nil {
+		return fmt.Errorf("invalid owner_address: %w", err)
+	}
+
+	if err := validation.ValidateHash(req.Payload.MintHash); err != nil {
+		return fmt.Errorf("invalid mint_hash: %w", err)
+	}
+
+	if err := validation.ValidateQuantity("quantity", req.Payload.Quantity); err != nil {
 		return err
 	}
 
@@ -340,13 +566,49 @@ func (req *CreateInvoiceRequest) Validate() error {
 		return err
 	}
 
-	if err := validation.ValidateAddressPublicKeyMatch(req.Payload.SellerAddress, req.PublicKey); err != nil {
+	if err := validation.ValidateOwnerPublicKey(req.Payload.OwnerAddress, req.PublicKey, req.RedeemScript); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateDistributionRequest is signed by the mint owner or one of its asset managers.
+// The public key is checked against the mint once it has been loaded.
+type CreateDistributionRequest struct {
+	SignedRequest
+	Payload store.DistributionBody `json:"payload"`
+}
+
+func (req *CreateDistributionRequest) Validate() error {
+	if err := validation.ValidateHash(req.Payload.MintHash); err != nil {
+		return fmt.Errorf("invalid mint_hash: %w", err)
+	}
+
+	if req.Payload.TotalKoinu <= 0 {
+		return fmt.Errorf("total_koinu must be greater than 0")
+	}
+
+	if req.Payload.RecordHeight <= 0 {
+		return fmt.Errorf("record_height must be greater than 0")
+	}
+
+	if err := validation.ValidatePublicKey(req.PublicKey); err != nil {
+		return fmt.Errorf("invalid public_key: %w", err)
+	}
+
+	if err := doge.ValidateSignature(req.Payload, req.PublicKey, req.Signature); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+type CreateBurnResponse struct {
+	Hash                   string `json:"hash"`
+	EncodedTransactionBody string `json:"encoded_transaction_body"`
+}
+
 type GetInvoicesResponse struct {
 	Invoices []store.Invoice `json:"invoices"`
 	Total    int             `json:"total"`
@@ -360,12 +622,16 @@ type CreateInvoiceResponse struct {
 }
 
 type GetHealthResponse struct {
-	CurrentBlockHeight int64     `json:"current_block_height"`
-	LatestBlockHeight  int64     `json:"latest_block_height"`
-	Chain              string    `json:"chain"`
-	WalletsEnabled     bool      `json:"wallets_enabled"`
-	UpdatedAt          time.Time `json:"updated_at"`
-	Version            string    `json:"version"`
+	CurrentBlockHeight int64                  `json:"current_block_height"`
+	LatestBlockHeight  int64                  `json:"latest_block_height"`
+	Chain              string                 `json:"chain"`
+	WalletsEnabled     bool                   `json:"wallets_enabled"`
+	UpdatedAt          time.Time              `json:"updated_at"`
+	Version            string                 `json:"version"`
+	AuditedAt          time.Time              `json:"audited_at"`
+	AuditBlockHeight   int64                  `json:"audit_block_height"`
+	AuditMintsChecked  int                    `json:"audit_mints_checked"`
+	AuditViolations    []store.AuditViolation `json:"audit_violations"`
 }
 
 type Address struct {
@@ -0,0 +1,168 @@
+package rpc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	connect "connectrpc.com/connect"
+	"dogecoin.org/fractal-engine/pkg/events"
+	protocol "dogecoin.org/fractal-engine/pkg/rpc/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+)
+
+// webhookEventTypes are the processor outcomes that can be delivered to webhooks.
+var webhookEventTypes = []string{
+	string(events.EventMintConfirmed),
+	string(events.EventInvoiceConfirmed),
+	string(events.EventPaymentMatched),
+	string(events.EventInvoiceTimedOut),
+	string(events.EventPaymentReceived),
+	string(events.EventInvoiceExpired),
+	string(events.EventInvoiceCancelled),
+	string(events.EventMintAmended),
+	string(events.EventMintOwnershipTransferred),
+	string(events.EventDistributionPaid),
+}
+
+func (s *ConnectRpcService) CreateWebhook(ctx context.Context, req *connect.Request[protocol.CreateWebhookRequest]) (*connect.Response[protocol.CreateWebhookResponse], error) {
+	webhookUrl, err := url.ParseRequestURI(req.Msg.GetUrl())
+	if err != nil || (webhookUrl.Scheme != "http" && webhookUrl.Scheme != "https") || webhookUrl.Host == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("url must be an absolute http or https url"))
+	}
+
+	eventTypes := req.Msg.GetEventTypes()
+	for _, eventType := range eventTypes {
+		if !slices.Contains(webhookEventTypes, eventType) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid event type: %s", eventType))
+		}
+	}
+
+	// An empty list subscribes to every webhook event
+	if len(eventTypes) == 0 {
+		eventTypes = webhookEventTypes
+	}
+
+	secret := req.Msg.GetSecret()
+	if secret == "" {
+		secretBytes := make([]byte, 32)
+		if _, err := rand.Read(secretBytes); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		secret = hex.EncodeToString(secretBytes)
+	}
+
+	webhook := &store.Webhook{
+		Url:        webhookUrl.String(),
+		Secret:     secret,
+		EventTypes: eventTypes,
+		CreatedAt:  time.Now(),
+	}
+
+	id, err := s.store.SaveWebhook(ctx, webhook)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &protocol.CreateWebhookResponse{}
+	resp.SetId(id)
+	resp.SetSecret(secret)
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectRpcService) GetWebhooks(ctx context.Context, req *connect.Request[protocol.GetWebhooksRequest]) (*connect.Response[protocol.GetWebhooksResponse], error) {
+	webhooks, err := s.store.GetWebhooks(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoWebhooks := make([]*protocol.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		protoWebhook := &protocol.Webhook{}
+		protoWebhook.SetId(webhook.Id)
+		protoWebhook.SetUrl(webhook.Url)
+		protoWebhook.SetEventTypes(webhook.EventTypes)
+		protoWebhook.SetCreatedAt(webhook.CreatedAt.Format(time.RFC3339Nano))
+		protoWebhooks = append(protoWebhooks, protoWebhook)
+	}
+
+	resp := &protocol.GetWebhooksResponse{}
+	resp.SetWebhooks(protoWebhooks)
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectRpcService) DeleteWebhook(ctx context.Context, req *connect.Request[protocol.DeleteWebhookRequest]) (*connect.Response[protocol.DeleteWebhookResponse], error) {
+	if req.Msg.GetId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	if err := s.store.DeleteWebhook(ctx, req.Msg.GetId()); err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	return connect.NewResponse(&protocol.DeleteWebhookResponse{}), nil
+}
+
+func (s *ConnectRpcService) GetWebhookDeliveries(ctx context.Context, req *connect.Request[protocol.GetWebhookDeliveriesRequest]) (*connect.Response[protocol.GetWebhookDeliveriesResponse], error) {
+	limit := int32(100)
+	if req.Msg.GetLimit() != nil && req.Msg.GetLimit().GetValue() > 0 && req.Msg.GetLimit().GetValue() < limit {
+		limit = req.Msg.GetLimit().GetValue()
+	}
+
+	page := int32(0)
+	if req.Msg.GetPage() != nil && req.Msg.GetPage().GetValue() > 0 {
+		page = req.Msg.GetPage().GetValue()
+	}
+
+	status := req.Msg.GetStatus()
+	if status != "" && status != store.WebhookDeliveryPending && status != store.WebhookDeliveryDelivered && status != store.WebhookDeliveryDead {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid status: %s", status))
+	}
+
+	deliveries, err := s.store.GetWebhookDeliveries(ctx, status, int(page*limit), int(limit))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoDeliveries := make([]*protocol.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		protoDelivery := &protocol.WebhookDelivery{}
+		protoDelivery.SetId(delivery.Id)
+		protoDelivery.SetWebhookId(delivery.WebhookId)
+		protoDelivery.SetUrl(delivery.Url)
+		protoDelivery.SetEventType(delivery.EventType)
+		protoDelivery.SetPayload(delivery.Payload)
+		protoDelivery.SetStatus(delivery.Status)
+		protoDelivery.SetAttempts(int32(delivery.Attempts))
+		protoDelivery.SetNextAttemptAt(delivery.NextAttemptAt.Format(time.RFC3339Nano))
+		protoDelivery.SetLastError(delivery.LastError)
+		protoDelivery.SetCreatedAt(delivery.CreatedAt.Format(time.RFC3339Nano))
+		if delivery.DeliveredAt != nil {
+			protoDelivery.SetDeliveredAt(delivery.DeliveredAt.Format(time.RFC3339Nano))
+		}
+		protoDeliveries = append(protoDeliveries, protoDelivery)
+	}
+
+	resp := &protocol.GetWebhookDeliveriesResponse{}
+	resp.SetDeliveries(protoDeliveries)
+	resp.SetPage(page)
+	resp.SetLimit(limit)
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectRpcService) ReplayWebhookDelivery(ctx context.Context, req *connect.Request[protocol.ReplayWebhookDeliveryRequest]) (*connect.Response[protocol.ReplayWebhookDeliveryResponse], error) {
+	if req.Msg.GetId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	if err := s.store.ReplayWebhookDelivery(ctx, req.Msg.GetId()); err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	return connect.NewResponse(&protocol.ReplayWebhookDeliveryResponse{}), nil
+}
@@ -0,0 +1,93 @@
+package rpc_test
+
+import (
+	"context"
+	"testing"
+
+	connect "connectrpc.com/connect"
+	"dogecoin.org/fractal-engine/pkg/events"
+	"dogecoin.org/fractal-engine/pkg/rpc/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	tokenisationStore, _, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	request := &protocol.CreateWebhookRequest{}
+	request.SetUrl("ftp://example.com/hook")
+	_, err := feClient.CreateWebhook(ctx, connect.NewRequest(request))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	request.SetUrl("https://example.com/hook")
+	request.SetEventTypes([]string{"offer_created"})
+	_, err = feClient.CreateWebhook(ctx, connect.NewRequest(request))
+	assert.ErrorContains(t, err, "invalid event type: offer_created")
+
+	request.SetEventTypes(nil)
+	response, err := feClient.CreateWebhook(ctx, connect.NewRequest(request))
+	assert.NilError(t, err)
+	assert.Assert(t, response.Msg.GetId() != "")
+	assert.Equal(t, 64, len(response.Msg.GetSecret()))
+
+	webhooks, err := feClient.GetWebhooks(ctx, connect.NewRequest(&protocol.GetWebhooksRequest{}))
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(webhooks.Msg.GetWebhooks()))
+	assert.Equal(t, "https://example.com/hook", webhooks.Msg.GetWebhooks()[0].GetUrl())
+	assert.Equal(t, 10, len(webhooks.Msg.GetWebhooks()[0].GetEventTypes()))
+
+	stored, err := tokenisationStore.GetWebhooks(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, response.Msg.GetSecret(), stored[0].Secret)
+
+	deleteRequest := &protocol.DeleteWebhookRequest{}
+	deleteRequest.SetId(response.Msg.GetId())
+	_, err = feClient.DeleteWebhook(ctx, connect.NewRequest(deleteRequest))
+	assert.NilError(t, err)
+
+	_, err = feClient.DeleteWebhook(ctx, connect.NewRequest(deleteRequest))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestReplayDeadWebhookDelivery(t *testing.T) {
+	tokenisationStore, _, feClient := SetupRpcTest(t)
+	ctx := context.Background()
+
+	request := &protocol.CreateWebhookRequest{}
+	request.SetUrl("https://example.com/hook")
+	request.SetSecret("secret")
+	_, err := feClient.CreateWebhook(ctx, connect.NewRequest(request))
+	assert.NilError(t, err)
+
+	assert.NilError(t, tokenisationStore.EnqueueWebhookEvent(ctx, events.Event{Type: events.EventMintConfirmed, MintHash: "mint"}))
+
+	deliveries, err := tokenisationStore.GetWebhookDeliveries(ctx, "", 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(deliveries))
+	assert.NilError(t, tokenisationStore.MarkWebhookDeliveryFailed(ctx, deliveries[0].Id, 8, deliveries[0].NextAttemptAt, "unexpected status: 500", true))
+
+	deliveriesRequest := &protocol.GetWebhookDeliveriesRequest{}
+	deliveriesRequest.SetStatus(store.WebhookDeliveryDead)
+	deadLetters, err := feClient.GetWebhookDeliveries(ctx, connect.NewRequest(deliveriesRequest))
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(deadLetters.Msg.GetDeliveries()))
+	assert.Equal(t, "unexpected status: 500", deadLetters.Msg.GetDeliveries()[0].GetLastError())
+	assert.Equal(t, string(events.EventMintConfirmed), deadLetters.Msg.GetDeliveries()[0].GetEventType())
+
+	replayRequest := &protocol.ReplayWebhookDeliveryRequest{}
+	replayRequest.SetId(deliveries[0].Id)
+	_, err = feClient.ReplayWebhookDelivery(ctx, connect.NewRequest(replayRequest))
+	assert.NilError(t, err)
+
+	deadLetters, err = feClient.GetWebhookDeliveries(ctx, connect.NewRequest(deliveriesRequest))
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(deadLetters.Msg.GetDeliveries()))
+
+	_, err = feClient.ReplayWebhookDelivery(ctx, connect.NewRequest(replayRequest))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	deliveriesRequest.SetStatus("unknown")
+	_, err = feClient.GetWebhookDeliveries(ctx, connect.NewRequest(deliveriesRequest))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
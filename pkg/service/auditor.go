@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"dogecoin.org/fractal-engine/pkg/metrics"
+	"dogecoin.org/fractal-engine/pkg/store"
+)
+
+/*
+* The Auditor checks the derived state for corruption: negative balances, reservations
+* that no invoice accounts for, invoices for mints that were never confirmed, and mints
+* whose balances no longer add up to their fraction count less burns. The checks only
+* read, so a violation is reported rather than repaired; a reindex rebuilds the state.
+ */
+type Auditor struct {
+	store    *store.TokenisationStore
+	interval time.Duration
+	running  bool
+}
+
+func NewAuditor(store *store.TokenisationStore, interval time.Duration) *Auditor {
+	return &Auditor{store: store, interval: interval, running: false}
+}
+
+// Audit runs every check once and returns the report.
+func (a *Auditor) Audit(ctx context.Context) (store.AuditReport, error) {
+	height, _, _, err := a.store.GetChainPosition(ctx)
+	if err != nil {
+		return store.AuditReport{}, err
+	}
+
+	report := store.AuditReport{
+		CheckedAt:   time.Now(),
+		BlockHeight: height,
+		Violations:  store.AuditViolations{},
+	}
+
+	negativeBalances, err := a.store.GetNegativeTokenBalances(ctx)
+	if err != nil {
+		return store.AuditReport{}, err
+	}
+
+	for _, balance := range negativeBalances {
+		report.Violations = append(report.Violations, store.AuditViolation{
+			Check:    store.AuditCheckNegativeBalance,
+			MintHash: balance.MintHash,
+			Subject:  balance.Address,
+			Message:  fmt.Sprintf("balance of %s is %d", balance.Address, balance.Quantity),
+		})
+	}
+
+	pendingBalances, err := a.store.GetPendingTokenBalancesWithoutInvoices(ctx)
+	if err != nil {
+		return store.AuditReport{}, err
+	}
+
+	for _, pending := range pendingBalances {
+		report.Violations = append(report.Violations, store.AuditViolation{
+			Check:    store.AuditCheckOrphanedPendingBalance,
+			MintHash: pending.MintHash,
+			Subject:  pending.InvoiceHash,
+			Message:  fmt.Sprintf("%d fractions of %s are reserved for invoice %s which does not exist", pending.Quantity, pending.OwnerAddress, pending.InvoiceHash),
+		})
+	}
+
+	invoices, err := a.store.GetInvoicesWithUnknownMints(ctx)
+	if err != nil {
+		return store.AuditReport{}, err
+	}
+
+	for _, invoice := range invoices {
+		report.Violations = append(report.Violations, store.AuditViolation{
+			Check:    store.AuditCheckUnknownInvoiceMint,
+			MintHash: invoice.MintHash,
+			Subject:  invoice.Hash,
+			Message:  fmt.Sprintf("invoice %s confirmed at height %d references unknown mint %s", invoice.Hash, invoice.BlockHeight, invoice.MintHash),
+		})
+	}
+
+	supplies, err := a.store.GetLedgerSupplies(ctx)
+	if err != nil {
+		return store.AuditReport{}, err
+	}
+
+	report.MintsChecked = len(supplies)
+
+	for _, supply := range supplies {
+		if err := supply.Validate(); err != nil {
+			report.Violations = append(report.Violations, store.AuditViolation{
+				Check:    store.AuditCheckSupply,
+				MintHash: supply.MintHash,
+				Message:  err.Error(),
+			})
+		}
+	}
+
+	return report, nil
+}
+
+// Start audits the state on every interval and saves the report for GetHealth.
+func (a *Auditor) Start() {
+	a.running = true
+	ctx := context.Background()
+
+	for {
+		report, err := a.Audit(ctx)
+		if err != nil {
+			log.Println("Error auditing state:", err)
+		} else {
+			metrics.AuditViolations.Set(float64(len(report.Violations)))
+
+			if !report.Healthy() {
+				log.Println("State audit found violations:", len(report.Violations))
+			}
+
+			err = a.store.SaveAuditReport(ctx, report)
+			if err != nil {
+				log.Println("Error saving audit report:", err)
+			}
+		}
+
+		time.Sleep(a.interval)
+
+		if !a.running {
+			break
+		}
+	}
+}
+
+func (a *Auditor) Stop() {
+	a.running = false
+}
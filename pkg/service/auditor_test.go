@@ -0,0 +1,99 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/service"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func TestAuditorReportsViolations(t *testing.T) {
+	tokenStore := support.SetupTestDB(t)
+	ctx := context.Background()
+	auditor := service.NewAuditor(tokenStore, time.Minute)
+
+	mintHash := support.GenerateRandomHash()
+	ownerAddress := support.GenerateDogecoinAddress(true)
+	holderAddress := support.GenerateDogecoinAddress(true)
+
+	_, err := tokenStore.SaveMint(ctx, &store.MintWithoutID{
+		Hash:          mintHash,
+		Title:         "Audited Mint",
+		FractionCount: 100,
+		BlockHeight:   1,
+	}, ownerAddress)
+	assert.NilError(t, err)
+	assert.NilError(t, tokenStore.UpsertTokenBalance(ctx, ownerAddress, mintHash, 100))
+	assert.NilError(t, tokenStore.UpsertChainPosition(ctx, 12, "blockHash", false))
+
+	// A reservation for an on-chain invoice that is still waiting to be matched is expected
+	txId, err := tokenStore.SaveOnChainTransaction(ctx, "invoiceTx", 12, "blockHash", 0, protocol.ACTION_INVOICE, protocol.DEFAULT_VERSION, []byte{}, ownerAddress, store.StringInterfaceMap{})
+	assert.NilError(t, err)
+	assert.NilError(t, tokenStore.UpsertPendingTokenBalance(ctx, support.GenerateRandomHash(), mintHash, 10, txId, ownerAddress))
+
+	report, err := auditor.Audit(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, true, report.Healthy())
+	assert.Equal(t, 1, report.MintsChecked)
+	assert.Equal(t, int64(12), report.BlockHeight)
+
+	// Corrupt the state in each of the ways the auditor looks for
+	assert.NilError(t, tokenStore.PostToLedger(ctx, store.LedgerPosting{
+		MintHash:    mintHash,
+		FromAccount: holderAddress,
+		ToAccount:   ownerAddress,
+		Quantity:    5,
+	}, nil))
+
+	orphanedInvoiceHash := support.GenerateRandomHash()
+	assert.NilError(t, tokenStore.UpsertPendingTokenBalance(ctx, orphanedInvoiceHash, mintHash, 5, "missingTx", ownerAddress))
+
+	unknownMintHash := support.GenerateRandomHash()
+	invoiceHash := support.GenerateRandomHash()
+	_, err = tokenStore.SaveInvoice(ctx, &store.Invoice{
+		Hash:          invoiceHash,
+		MintHash:      unknownMintHash,
+		BuyerAddress:  holderAddress,
+		SellerAddress: ownerAddress,
+		Quantity:      1,
+		Price:         1,
+		BlockHeight:   11,
+		CreatedAt:     time.Now(),
+	})
+	assert.NilError(t, err)
+
+	underIssuedMintHash := support.GenerateRandomHash()
+	_, err = tokenStore.SaveMint(ctx, &store.MintWithoutID{
+		Hash:          underIssuedMintHash,
+		Title:         "Under Issued Mint",
+		FractionCount: 50,
+		BlockHeight:   2,
+	}, ownerAddress)
+	assert.NilError(t, err)
+	assert.NilError(t, tokenStore.UpsertTokenBalance(ctx, ownerAddress, underIssuedMintHash, 40))
+
+	report, err = auditor.Audit(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, false, report.Healthy())
+	assert.Equal(t, 2, report.MintsChecked)
+	assert.Equal(t, 4, len(report.Violations))
+
+	violations := map[string]store.AuditViolation{}
+	for _, violation := range report.Violations {
+		violations[violation.Check] = violation
+	}
+
+	assert.Equal(t, holderAddress, violations[store.AuditCheckNegativeBalance].Subject)
+	assert.Equal(t, mintHash, violations[store.AuditCheckNegativeBalance].MintHash)
+	assert.Equal(t, orphanedInvoiceHash, violations[store.AuditCheckOrphanedPendingBalance].Subject)
+	assert.Equal(t, invoiceHash, violations[store.AuditCheckUnknownInvoiceMint].Subject)
+	assert.Equal(t, unknownMintHash, violations[store.AuditCheckUnknownInvoiceMint].MintHash)
+	assert.Equal(t, underIssuedMintHash, violations[store.AuditCheckSupply].MintHash)
+	assert.Assert(t, strings.Contains(violations[store.AuditCheckSupply].Message, "40 fractions held, expected 50"))
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"dogecoin.org/fractal-engine/pkg/metrics"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrActionDiscarded is returned by a handler for an action of an atomic batch that can
+// never be applied, so that the whole batch is rolled back and discarded.
+var ErrActionDiscarded = errors.New("action discarded")
+
+// errBatchPending rolls back an atomic batch with an action that cannot be matched yet.
+var errBatchPending = errors.New("batch action pending")
+
+type BatchProcessor struct {
+	store    *store.TokenisationStore
+	registry *ActionRegistry
+}
+
+func NewBatchProcessor(store *store.TokenisationStore, registry *ActionRegistry) *BatchProcessor {
+	return &BatchProcessor{store: store, registry: registry}
+}
+
+/*
+* Actions from an atomic batch are applied all-or-nothing. Every action is looked up in
+* the registry and decoded before any of them is dispatched, and if one is malformed or
+* has no handler at its height the whole batch is discarded.
+* Actions are then dispatched in sub index order by handlers bound to a store scoped to
+* one database transaction. If an action cannot be matched yet or fails, the transaction
+* is rolled back and the batch retried on a later pass, so an invoice and its payment in
+* the same batch are always applied together and in that order. If an action can never
+* be applied, the transaction is rolled back and every action of the batch discarded.
+* Actions from independent batches are processed like any other transaction.
+ */
+func (p *BatchProcessor) Process(txHash string) error {
+	ctx := context.Background()
+	txs, err := p.store.GetOnChainTransactionsByTxHash(ctx, txHash)
+	if err != nil {
+		return err
+	}
+
+	handlers := make([]ActionHandler, len(txs))
+	for i, tx := range txs {
+		handler, err := p.registry.Lookup(tx.ActionType, tx.ActionVersion, tx.Height)
+		if err == nil {
+			err = validateBatchAction(tx)
+		}
+
+		if err != nil {
+			log.Printf("Discarding batch %s, action %d is invalid: %v", txHash, tx.SubIndex, err)
+			return p.discard(ctx, txs)
+		}
+
+		handlers[i] = handler
+	}
+
+	var failed store.OnChainTransaction
+	err = p.store.InTransaction(ctx, func(tokenStore *store.TokenisationStore) error {
+		for i, tx := range txs {
+			failed = tx
+
+			err := bindHandler(handlers[i], tokenStore).Process(tx)
+			if err != nil {
+				return err
+			}
+
+			pending, err := tokenStore.HasOnChainTransaction(ctx, tx.Id)
+			if err != nil {
+				return err
+			}
+
+			if pending {
+				return errBatchPending
+			}
+		}
+
+		return nil
+	})
+
+	switch {
+	case err == nil, errors.Is(err, errBatchPending):
+		return nil
+	case errors.Is(err, ErrActionDiscarded):
+		log.Printf("Discarding batch %s, action %d cannot be applied: %v", txHash, failed.SubIndex, err)
+		return p.discard(ctx, txs)
+	default:
+		recordProcessorError(failed, err)
+		return err
+	}
+}
+
+// discard drops every action of the batch.
+func (p *BatchProcessor) discard(ctx context.Context, txs []store.OnChainTransaction) error {
+	for _, tx := range txs {
+		err := p.store.RemoveOnChainTransaction(ctx, tx.Id)
+		if err != nil {
+			return err
+		}
+
+		metrics.RecordProcessorOutcome(tx.ActionType, metrics.OutcomeDiscarded)
+	}
+
+	return nil
+}
+
+// validateBatchAction checks that the payload of a known action decodes.
+func validateBatchAction(tx store.OnChainTransaction) error {
+	var message proto.Message
+	switch tx.ActionType {
+	case protocol.ACTION_MINT:
+		message = &protocol.OnChainMintMessage{}
+	case protocol.ACTION_INVOICE:
+		message = &protocol.OnChainInvoiceMessage{}
+	case protocol.ACTION_PAYMENT:
+		message = &protocol.OnChainPaymentMessage{}
+	case protocol.ACTION_TRANSFER:
+		message = &protocol.OnChainTransferMessage{}
+	case protocol.ACTION_BURN:
+		message = &protocol.OnChainBurnMessage{}
+	case protocol.ACTION_DELETE_BUY_OFFER:
+		message = &protocol.OnChainDeleteBuyOfferMessage{}
+	case protocol.ACTION_DELETE_SELL_OFFER:
+		message = &protocol.OnChainDeleteSellOfferMessage{}
+	case protocol.ACTION_CANCEL_INVOICE:
+		message = &protocol.OnChainCancelInvoiceMessage{}
+	case protocol.ACTION_AMEND_MINT:
+		message = &protocol.OnChainMintAmendmentMessage{}
+	case protocol.ACTION_TRANSFER_MINT_OWNERSHIP:
+		message = &protocol.OnChainMintOwnershipTransferMessage{}
+	case protocol.ACTION_DISTRIBUTION_PAYMENT:
+		message = &protocol.OnChainDistributionPaymentMessage{}
+	default:
+		return nil
+	}
+
+	return proto.Unmarshal(tx.ActionData, message)
+}
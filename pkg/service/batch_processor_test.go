@@ -0,0 +1,291 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/config"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/service"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+	"gotest.tools/assert"
+)
+
+func saveBatch(t *testing.T, tokenStore *store.TokenisationStore, txHash string, atomic bool, actions ...protocol.MessageEnvelope) []string {
+	ids, err := tokenStore.SaveOnChainBatchAtTime(context.Background(), txHash, 1, "blockHash", 0, protocol.NewBatchEnvelope(atomic, actions...), "ownerAddress", map[string]interface{}{}, time.Time{})
+	assert.NilError(t, err)
+	return ids
+}
+
+func paymentAction(t *testing.T, invoiceHash string) protocol.MessageEnvelope {
+	data, err := proto.Marshal(&protocol.OnChainPaymentMessage{Hash: invoiceHash})
+	assert.NilError(t, err)
+	return protocol.NewMessageEnvelope(protocol.ACTION_PAYMENT, protocol.DEFAULT_VERSION, data)
+}
+
+// boundHandler runs a test handler against the store it is bound to, like the action processors.
+type boundHandler struct {
+	store   *store.TokenisationStore
+	handler func(tokenStore *store.TokenisationStore, tx store.OnChainTransaction) error
+}
+
+func (h boundHandler) Process(tx store.OnChainTransaction) error {
+	return h.handler(h.store, tx)
+}
+
+func (h boundHandler) WithStore(tokenStore *store.TokenisationStore) service.ActionHandler {
+	return boundHandler{store: tokenStore, handler: h.handler}
+}
+
+func paymentRegistry(tokenStore *store.TokenisationStore, handler func(tokenStore *store.TokenisationStore, tx store.OnChainTransaction) error) *service.ActionRegistry {
+	registry := service.NewActionRegistry()
+	registry.Register(protocol.ACTION_PAYMENT, protocol.DEFAULT_VERSION, 0, boundHandler{store: tokenStore, handler: handler})
+	return registry
+}
+
+func TestBatchProcessorDiscardsAtomicBatchWithInvalidAction(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	saveBatch(t, tokenStore, "batchTx", true,
+		paymentAction(t, "invoice1"),
+		protocol.NewMessageEnvelope(protocol.ACTION_PAYMENT, protocol.DEFAULT_VERSION, []byte{0xFF, 0xFF}),
+	)
+
+	dispatched := 0
+	processor := service.NewBatchProcessor(tokenStore, paymentRegistry(tokenStore, func(tokenStore *store.TokenisationStore, tx store.OnChainTransaction) error {
+		dispatched++
+		return nil
+	}))
+
+	err := processor.Process("batchTx")
+	assert.NilError(t, err)
+	assert.Equal(t, dispatched, 0)
+
+	txs, err := tokenStore.GetOnChainTransactionsByTxHash(ctx, "batchTx")
+	assert.NilError(t, err)
+	assert.Equal(t, len(txs), 0)
+}
+
+func TestBatchProcessorRejectsUnsupportedActions(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	saveBatch(t, tokenStore, "batchTx", true,
+		paymentAction(t, "invoice1"),
+		protocol.NewMessageEnvelope(protocol.ACTION_ATTESTATION, protocol.DEFAULT_VERSION, []byte{}),
+	)
+
+	processor := service.NewBatchProcessor(tokenStore, paymentRegistry(tokenStore, func(tokenStore *store.TokenisationStore, tx store.OnChainTransaction) error {
+		t.Fatal("no action should be dispatched")
+		return nil
+	}))
+
+	err := processor.Process("batchTx")
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactionsByTxHash(ctx, "batchTx")
+	assert.NilError(t, err)
+	assert.Equal(t, len(txs), 0)
+}
+
+func TestBatchProcessorRetriesBatchWithPendingAction(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	ids := saveBatch(t, tokenStore, "batchTx", true,
+		paymentAction(t, "invoice1"),
+		paymentAction(t, "invoice2"),
+		paymentAction(t, "invoice3"),
+	)
+
+	// The second action stays pending on the first pass, which rolls back the first
+	matchable := map[string]bool{ids[0]: true, ids[2]: true}
+	var dispatched []int
+	processor := service.NewBatchProcessor(tokenStore, paymentRegistry(tokenStore, func(tokenStore *store.TokenisationStore, tx store.OnChainTransaction) error {
+		dispatched = append(dispatched, tx.SubIndex)
+		if matchable[tx.Id] {
+			return tokenStore.RemoveOnChainTransaction(ctx, tx.Id)
+		}
+		return nil
+	}))
+
+	err := processor.Process("batchTx")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, dispatched, []int{0, 1})
+
+	txs, err := tokenStore.GetOnChainTransactionsByTxHash(ctx, "batchTx")
+	assert.NilError(t, err)
+	assert.Equal(t, len(txs), 3)
+
+	// Once it can be matched the whole batch is applied in order
+	matchable[ids[1]] = true
+	dispatched = nil
+
+	err = processor.Process("batchTx")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, dispatched, []int{0, 1, 2})
+
+	txs, err = tokenStore.GetOnChainTransactionsByTxHash(ctx, "batchTx")
+	assert.NilError(t, err)
+	assert.Equal(t, len(txs), 0)
+}
+
+func TestBatchProcessorDiscardsBatchWhenActionCannotBeApplied(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mintHashes := make([]string, 2)
+	for i := range mintHashes {
+		mint := &store.MintWithoutID{
+			Title:         "Batch Mint",
+			FractionCount: 100,
+			Hash:          test_support.GenerateRandomHash(),
+		}
+		_, err := tokenStore.SaveUnconfirmedMint(ctx, mint)
+		assert.NilError(t, err)
+		mintHashes[i] = mint.Hash
+	}
+
+	// The burn decodes but its hashes are malformed, so the burn processor discards it
+	burnData, err := proto.Marshal(&protocol.OnChainBurnMessage{BurnHash: []byte{1, 2, 3, 4}, MintHash: []byte{1, 2, 3, 4}, Quantity: 1})
+	assert.NilError(t, err)
+
+	saveBatch(t, tokenStore, "batchTx", true,
+		protocol.NewMintTransactionEnvelope(mintHashes[0], protocol.ACTION_MINT),
+		protocol.NewMessageEnvelope(protocol.ACTION_BURN, protocol.DEFAULT_VERSION, burnData),
+		protocol.NewMintTransactionEnvelope(mintHashes[1], protocol.ACTION_MINT),
+	)
+
+	gate := service.NewConfirmationGate(tokenStore, config.ConfirmationPolicy{})
+	processor := service.NewBatchProcessor(tokenStore, service.NewDefaultActionRegistry(tokenStore, gate))
+
+	err = processor.Process("batchTx")
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactionsByTxHash(ctx, "batchTx")
+	assert.NilError(t, err)
+	assert.Equal(t, len(txs), 0)
+
+	// The mint applied before the burn was rolled back with the rest of the batch
+	mint, err := tokenStore.GetMintByHash(ctx, mintHashes[0])
+	assert.NilError(t, err)
+	assert.Equal(t, mint.Hash, "")
+
+	unconfirmed, err := tokenStore.GetUnconfirmedMints(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, len(unconfirmed), 2)
+
+	AssertTokenBalance(t, ctx, "ownerAddress", mintHashes[0], 0, tokenStore)
+
+	// Without the burn the same mints are confirmed together
+	saveBatch(t, tokenStore, "validBatchTx", true,
+		protocol.NewMintTransactionEnvelope(mintHashes[0], protocol.ACTION_MINT),
+		protocol.NewMintTransactionEnvelope(mintHashes[1], protocol.ACTION_MINT),
+	)
+
+	err = processor.Process("validBatchTx")
+	assert.NilError(t, err)
+
+	for _, mintHash := range mintHashes {
+		mint, err := tokenStore.GetMintByHash(ctx, mintHash)
+		assert.NilError(t, err)
+		assert.Equal(t, mint.Hash, mintHash)
+		AssertTokenBalance(t, ctx, "ownerAddress", mintHash, 100, tokenStore)
+	}
+}
+
+func TestBatchProcessorDiscardsBatchWithUnfundedTransfer(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	privHex, pubHex, senderAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	_, _, receiverAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	mint := &store.MintWithoutID{
+		Title:         "Batch Mint",
+		FractionCount: 100,
+		Hash:          test_support.GenerateRandomHash(),
+	}
+	_, err = tokenStore.SaveUnconfirmedMint(ctx, mint)
+	assert.NilError(t, err)
+
+	// The sender holds none of the fractions of the transferred mint
+	transfer := newSignedTransfer(t, privHex, pubHex, senderAddress, receiverAddress, test_support.GenerateRandomHash(), 10)
+	_, err = tokenStore.SaveTokenTransfer(ctx, &transfer)
+	assert.NilError(t, err)
+
+	_, err = tokenStore.SaveOnChainBatchAtTime(ctx, "batchTx", 1, "blockHash", 0, protocol.NewBatchEnvelope(true,
+		protocol.NewMintTransactionEnvelope(mint.Hash, protocol.ACTION_MINT),
+		protocol.NewTransferTransactionEnvelope(transfer.Hash, transfer.MintHash, protocol.ACTION_TRANSFER),
+	), senderAddress, map[string]interface{}{}, time.Time{})
+	assert.NilError(t, err)
+
+	gate := service.NewConfirmationGate(tokenStore, config.ConfirmationPolicy{})
+	processor := service.NewBatchProcessor(tokenStore, service.NewDefaultActionRegistry(tokenStore, gate))
+
+	err = processor.Process("batchTx")
+	assert.NilError(t, err)
+
+	// The batch is discarded rather than left to be retried on every pass
+	txs, err := tokenStore.GetOnChainTransactionsByTxHash(ctx, "batchTx")
+	assert.NilError(t, err)
+	assert.Equal(t, len(txs), 0)
+
+	confirmed, err := tokenStore.GetMintByHash(ctx, mint.Hash)
+	assert.NilError(t, err)
+	assert.Equal(t, confirmed.Hash, "")
+	AssertTokenBalance(t, ctx, senderAddress, mint.Hash, 0, tokenStore)
+
+	stored, err := tokenStore.GetTokenTransfer(ctx, transfer.Hash)
+	assert.NilError(t, err)
+	assert.Equal(t, stored.BlockHeight, int64(0))
+}
+
+func TestBatchProcessorStopsOnDispatchError(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+
+	saveBatch(t, tokenStore, "batchTx", true,
+		paymentAction(t, "invoice1"),
+		paymentAction(t, "invoice2"),
+	)
+
+	var dispatched []int
+	processor := service.NewBatchProcessor(tokenStore, paymentRegistry(tokenStore, func(tokenStore *store.TokenisationStore, tx store.OnChainTransaction) error {
+		dispatched = append(dispatched, tx.SubIndex)
+		return errors.New("not ready")
+	}))
+
+	err := processor.Process("batchTx")
+	assert.Assert(t, err != nil)
+	assert.DeepEqual(t, dispatched, []int{0})
+}
+
+func TestProcessIndependentBatchActionsSeparately(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+	rpcClient := test_support.NewTestDogeClient(t)
+
+	// An independent batch keeps its valid actions when a sibling is malformed
+	saveBatch(t, tokenStore, "batchTx", false,
+		protocol.NewMessageEnvelope(protocol.ACTION_TRANSFER, protocol.DEFAULT_VERSION, []byte{0xFF, 0xFF}),
+		paymentAction(t, "invoice1"),
+	)
+
+	processor := service.NewFractalEngineProcessor(tokenStore, rpcClient)
+	err := processor.Process()
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactionsByTxHash(ctx, "batchTx")
+	assert.NilError(t, err)
+	assert.Equal(t, len(txs), 1)
+	assert.Equal(t, txs[0].SubIndex, 1)
+	assert.Equal(t, txs[0].ActionType, uint8(protocol.ACTION_PAYMENT))
+}
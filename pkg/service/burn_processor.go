@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"log"
+
+	"dogecoin.org/fractal-engine/pkg/metrics"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"dogecoin.org/fractal-engine/pkg/validation"
+	"google.golang.org/protobuf/proto"
+)
+
+type BurnProcessor struct {
+	store *store.TokenisationStore
+}
+
+func NewBurnProcessor(store *store.TokenisationStore) *BurnProcessor {
+	return &BurnProcessor{store: store}
+}
+
+func (p *BurnProcessor) WithStore(store *store.TokenisationStore) ActionHandler {
+	return NewBurnProcessor(store)
+}
+
+/*
+* Burns are authorised by the owner spending their own outputs on L1.
+* If the mint requires asset manager signatures, the burn is held until enough
+* valid co-signatures for the burn hash have been received.
+* Malformed or unfunded burns are discarded.
+ */
+func (p *BurnProcessor) Process(tx store.OnChainTransaction) error {
+	ctx := context.Background()
+	burn := protocol.OnChainBurnMessage{}
+	err := proto.Unmarshal(tx.ActionData, &burn)
+	if err != nil {
+		log.Println("Error unmarshalling burn:", err)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	if err := validation.ValidateProtobufQuantity(burn.Quantity); err != nil {
+		log.Printf("Invalid quantity in protobuf: %v", err)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	if len(burn.BurnHash) != 32 || len(burn.MintHash) != 32 {
+		log.Println("Invalid hash in burn")
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	mint, err := p.store.GetMintByHash(ctx, hex.EncodeToString(burn.MintHash))
+	if err != nil {
+		log.Println("Error getting mint:", err)
+		return err
+	}
+
+	if mint.SignatureRequired() {
+		signatures, err := p.store.GetBurnSignatures(ctx, hex.EncodeToString(burn.BurnHash))
+		if err != nil {
+			log.Println("Error getting burn signatures:", err)
+			return err
+		}
+
+		if !mint.HasRequiredSignatureCount(countValidBurnSignatures(mint, tx, int(burn.Quantity), signatures)) {
+			log.Println("Invalid number of signatures")
+			return errors.New("Invalid number of signatures")
+		}
+	}
+
+	err = p.store.ProcessBurn(ctx, tx)
+	if errors.Is(err, store.ErrActionRejected) {
+		log.Println("Burn discarded:", err)
+		return discarded(tx)
+	}
+	if err != nil {
+		log.Println("Error processing burn:", err)
+		return err
+	}
+
+	log.Println("Matched burn:", tx.TxHash)
+	metrics.RecordProcessorOutcome(tx.ActionType, metrics.OutcomeMatched)
+	notifyBalanceChanged(ctx, p.store, tx, mint.Hash, int(burn.Quantity), tx.Address)
+	return nil
+}
+
+// countValidBurnSignatures counts distinct asset managers whose signature covers
+// exactly what was burned on chain.
+func countValidBurnSignatures(mint store.Mint, tx store.OnChainTransaction, quantity int, signatures []store.BurnSignature) int {
+	signers := map[string]bool{}
+
+	for _, signature := range signatures {
+		if signature.OwnerAddress != tx.Address || signature.Quantity != quantity {
+			continue
+		}
+
+		if err := signature.Validate(mint); err != nil {
+			log.Println("Ignoring burn signature:", err)
+			continue
+		}
+
+		signers[signature.PublicKey] = true
+	}
+
+	return len(signers)
+}
@@ -0,0 +1,113 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/service"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func TestNewBurnProcessor(t *testing.T) {
+	tokenStore := support.SetupTestDB(t)
+	processor := service.NewBurnProcessor(tokenStore)
+
+	assert.Assert(t, processor != nil, "Processor should be created")
+}
+
+func TestBurnProcessorRequiresAssetManagerSignatures(t *testing.T) {
+	tokenStore := support.SetupTestDB(t)
+	ctx := context.Background()
+	processor := service.NewBurnProcessor(tokenStore)
+
+	managerPrivHex, managerPubHex, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	mintHash := support.GenerateRandomHash()
+	ownerAddress := support.GenerateDogecoinAddress(true)
+	burnHash := support.GenerateRandomHash()
+
+	_, err = tokenStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:                    "Signature Required Mint",
+		Description:              "Test mint requiring signatures",
+		FractionCount:            1000,
+		Hash:                     mintHash,
+		OwnerAddress:             ownerAddress,
+		SignatureRequirementType: store.SignatureRequirementType_ONE_SIGNATURE,
+		AssetManagers: store.AssetManagers{
+			{Name: "Manager 1", PublicKey: managerPubHex, URL: "https://example.com"},
+		},
+	}, ownerAddress)
+	assert.NilError(t, err)
+
+	assert.NilError(t, tokenStore.UpsertTokenBalance(ctx, ownerAddress, mintHash, 500))
+
+	envelope := protocol.NewBurnTransactionEnvelope(burnHash, mintHash, 100, protocol.ACTION_BURN)
+	txId, err := tokenStore.SaveOnChainTransaction(ctx, "burnTx", 10, "blockHash", 0, protocol.ACTION_BURN, protocol.DEFAULT_VERSION, envelope.Data, ownerAddress, store.StringInterfaceMap{})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	tx := *findInvoiceTransactionById(txs, txId)
+
+	// Without a co-signature the burn is held
+	err = processor.Process(tx)
+	assert.ErrorContains(t, err, "Invalid number of signatures")
+	AssertTokenBalance(t, ctx, ownerAddress, mintHash, 500, tokenStore)
+
+	txs, err = tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(txs))
+
+	// A signature for a different quantity does not count
+	wrongBody := store.BurnSignatureBody{Hash: burnHash, MintHash: mintHash, OwnerAddress: ownerAddress, Quantity: 1}
+	wrongSignature, err := doge.SignPayload(wrongBody, managerPrivHex, managerPubHex)
+	assert.NilError(t, err)
+
+	_, err = tokenStore.SaveBurnSignature(ctx, &store.BurnSignature{
+		BurnHash:     burnHash,
+		MintHash:     mintHash,
+		OwnerAddress: ownerAddress,
+		Quantity:     1,
+		Signature:    wrongSignature,
+		PublicKey:    managerPubHex,
+		CreatedAt:    time.Now(),
+	})
+	assert.NilError(t, err)
+
+	err = processor.Process(tx)
+	assert.ErrorContains(t, err, "Invalid number of signatures")
+
+	// The asset manager replaces their signature with one covering the burned quantity
+	_, err = tokenStore.DB.Exec("DELETE FROM burn_signatures WHERE burn_hash = $1", burnHash)
+	assert.NilError(t, err)
+
+	body := store.BurnSignatureBody{Hash: burnHash, MintHash: mintHash, OwnerAddress: ownerAddress, Quantity: 100}
+	signature, err := doge.SignPayload(body, managerPrivHex, managerPubHex)
+	assert.NilError(t, err)
+
+	_, err = tokenStore.SaveBurnSignature(ctx, &store.BurnSignature{
+		BurnHash:     burnHash,
+		MintHash:     mintHash,
+		OwnerAddress: ownerAddress,
+		Quantity:     100,
+		Signature:    signature,
+		PublicKey:    managerPubHex,
+		CreatedAt:    time.Now(),
+	})
+	assert.NilError(t, err)
+
+	err = processor.Process(tx)
+	assert.NilError(t, err)
+
+	AssertTokenBalance(t, ctx, ownerAddress, mintHash, 400, tokenStore)
+
+	burned, err := tokenStore.GetBurnedSupply(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 100, burned)
+}
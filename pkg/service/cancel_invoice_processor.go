@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"log"
+
+	"dogecoin.org/fractal-engine/pkg/events"
+	"dogecoin.org/fractal-engine/pkg/metrics"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+)
+
+type CancelInvoiceProcessor struct {
+	store *store.TokenisationStore
+}
+
+func NewCancelInvoiceProcessor(store *store.TokenisationStore) *CancelInvoiceProcessor {
+	return &CancelInvoiceProcessor{store: store}
+}
+
+func (p *CancelInvoiceProcessor) WithStore(store *store.TokenisationStore) ActionHandler {
+	return NewCancelInvoiceProcessor(store)
+}
+
+/*
+* Invoice cancellations are authorised by the buyer or seller spending their own
+* outputs on L1. A confirmed invoice can be cancelled by either party until a payment
+* towards it has been applied or mined at or before the cancellation.
+* An on-chain invoice that has not been matched to its gossiped terms yet only names
+* the seller, so only the seller can cancel it.
+* If the invoice has not been seen yet, the on-chain transaction is kept so that it can
+* be matched on a later pass (until it is trimmed). Malformed or unauthorised
+* cancellations are discarded.
+ */
+func (p *CancelInvoiceProcessor) Process(tx store.OnChainTransaction) error {
+	ctx := context.Background()
+
+	message := protocol.OnChainCancelInvoiceMessage{}
+	if err := proto.Unmarshal(tx.ActionData, &message); err != nil {
+		log.Println("Error unmarshalling cancel invoice:", err)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	if len(message.InvoiceHash) != 32 {
+		log.Println("Invalid invoice hash in cancel invoice")
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	invoiceHash := hex.EncodeToString(message.InvoiceHash)
+
+	invoice, err := p.store.GetInvoiceByHash(ctx, invoiceHash)
+	if err == nil {
+		if !invoice.IsParty(tx.Address) || invoice.Status != store.InvoiceStatusOnChain {
+			log.Println("Cancel invoice discarded, invoice cannot be cancelled by:", tx.Address)
+			return discardOnChainTransaction(ctx, p.store, tx)
+		}
+
+		// A cancel cannot take back an invoice the buyer has started paying, or the
+		// seller would keep both the payment and the fractions
+		paid, err := p.store.HasInvoicePayments(ctx, invoice.Hash, tx.Height)
+		if err != nil {
+			return err
+		}
+
+		if paid {
+			log.Println("Cancel invoice discarded, invoice has payments:", invoice.Hash)
+			return discardOnChainTransaction(ctx, p.store, tx)
+		}
+
+		err = p.store.CancelInvoice(ctx, invoice, tx)
+		if err != nil {
+			return err
+		}
+
+		p.matched(ctx, tx, invoiceHash, invoice.MintHash, invoice.Quantity, []string{invoice.BuyerAddress, invoice.SellerAddress})
+		return nil
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	pendingTokenBalance, err := p.store.GetPendingTokenBalanceByInvoiceHash(ctx, invoiceHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		// The invoice may not have been written on chain yet
+		log.Println("Cancel invoice not matched yet:", tx.TxHash)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if pendingTokenBalance.OwnerAddress != tx.Address {
+		log.Println("Cancel invoice discarded, invoice cannot be cancelled by:", tx.Address)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	err = p.store.CancelPendingInvoice(ctx, pendingTokenBalance, tx)
+	if err != nil {
+		return err
+	}
+
+	p.matched(ctx, tx, invoiceHash, pendingTokenBalance.MintHash, pendingTokenBalance.Quantity, []string{tx.Address})
+	return nil
+}
+
+func (p *CancelInvoiceProcessor) matched(ctx context.Context, tx store.OnChainTransaction, invoiceHash string, mintHash string, quantity int, addresses []string) {
+	log.Println("Matched cancel invoice:", tx.TxHash)
+	metrics.RecordProcessorOutcome(tx.ActionType, metrics.OutcomeMatched)
+
+	notify(ctx, p.store, events.Event{
+		Type:        events.EventInvoiceCancelled,
+		MintHash:    mintHash,
+		Hash:        invoiceHash,
+		TxHash:      tx.TxHash,
+		Addresses:   addresses,
+		Quantity:    quantity,
+		BlockHeight: tx.Height,
+	})
+}
@@ -0,0 +1,208 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"dogecoin.org/fractal-engine/internal/test/support"
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/service"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func CreateOnChainCancelInvoiceMessage(t *testing.T, ctx context.Context, trxnHash string, blockHeight int64, address string, invoiceHash string, tokenisationStore *store.TokenisationStore) {
+	envelope := protocol.NewCancelInvoiceTransactionEnvelope(invoiceHash, protocol.ACTION_CANCEL_INVOICE)
+	_, err := tokenisationStore.SaveOnChainTransaction(ctx, trxnHash, blockHeight, "blockHash", 1, protocol.ACTION_CANCEL_INVOICE, protocol.DEFAULT_VERSION, envelope.Data, address, map[string]interface{}{})
+	assert.NilError(t, err)
+}
+
+func TestCancelInvoiceReleasesReservation(t *testing.T) {
+	tokenisationStore := test_support.SetupTestDB(t)
+	rpcClient := support.NewTestDogeClient(t)
+	ctx := context.Background()
+	SaveChainPosition(t, ctx, 100, tokenisationStore)
+
+	hash := CreateUnconfirmedMint(t, ctx, support.GenerateRandomHash(), tokenisationStore)
+
+	processor := service.NewFractalEngineProcessor(tokenisationStore, rpcClient)
+	processor.Process()
+
+	buyerAddress := support.GenerateDogecoinAddress(true)
+	invoiceHash := support.GenerateRandomHash()
+
+	CreateOnChainInvoiceMessage(t, ctx, support.GenerateRandomHash(), 1, 1, ownerAddress, invoiceHash, hash, 50, tokenisationStore)
+	SaveUnconfirmedInvoice(t, ctx, ownerAddress, buyerAddress, invoiceHash, hash, 50, tokenisationStore)
+	processor.Process()
+
+	AssertPendingTokenBalance(t, ctx, invoiceHash, hash, 50, tokenisationStore)
+
+	// Only the buyer or seller can cancel the invoice
+	CreateOnChainCancelInvoiceMessage(t, ctx, support.GenerateRandomHash(), 2, support.GenerateDogecoinAddress(true), invoiceHash, tokenisationStore)
+	processor.Process()
+
+	AssertPendingTokenBalance(t, ctx, invoiceHash, hash, 50, tokenisationStore)
+	count, err := tokenisationStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, count)
+
+	CreateOnChainCancelInvoiceMessage(t, ctx, support.GenerateRandomHash(), 3, buyerAddress, invoiceHash, tokenisationStore)
+	processor.Process()
+
+	AssertNoPendingTokenBalance(t, ctx, invoiceHash, hash, tokenisationStore)
+	invoice, err := tokenisationStore.GetInvoiceByHash(ctx, invoiceHash)
+	assert.NilError(t, err)
+	assert.Equal(t, store.InvoiceStatusCancelled, invoice.Status)
+
+	// A payment for a cancelled invoice is discarded
+	CreateOnChainPaymentMessage(t, ctx, support.GenerateRandomHash(), invoiceHash, buyerAddress, ownerAddress, 4, 1, 50*100*doge.KoinuPerDoge, tokenisationStore)
+	processor.Process()
+
+	AssertTokenBalance(t, ctx, buyerAddress, hash, 0, tokenisationStore)
+	AssertTokenBalance(t, ctx, ownerAddress, hash, 100, tokenisationStore)
+	count, err = tokenisationStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestCancelInvoiceAfterPaymentIsDiscarded(t *testing.T) {
+	tokenisationStore := test_support.SetupTestDB(t)
+	rpcClient := support.NewTestDogeClient(t)
+	ctx := context.Background()
+	SaveChainPosition(t, ctx, 4, tokenisationStore)
+
+	hash := CreateUnconfirmedMint(t, ctx, support.GenerateRandomHash(), tokenisationStore)
+
+	processor := service.NewFractalEngineProcessor(tokenisationStore, rpcClient)
+	processor.Process()
+
+	buyerAddress := support.GenerateDogecoinAddress(true)
+	invoiceHash := support.GenerateRandomHash()
+
+	CreateOnChainInvoiceMessage(t, ctx, support.GenerateRandomHash(), 1, 1, ownerAddress, invoiceHash, hash, 50, tokenisationStore)
+	SaveUnconfirmedInvoice(t, ctx, ownerAddress, buyerAddress, invoiceHash, hash, 50, tokenisationStore)
+	processor.Process()
+
+	AssertPendingTokenBalance(t, ctx, invoiceHash, hash, 50, tokenisationStore)
+
+	// The seller cancels in the block after the buyer's payment, which needs more
+	// confirmations than the cancellation
+	CreateOnChainPaymentMessage(t, ctx, support.GenerateRandomHash(), invoiceHash, buyerAddress, ownerAddress, 3, 1, 50*100*doge.KoinuPerDoge, tokenisationStore)
+	CreateOnChainCancelInvoiceMessage(t, ctx, support.GenerateRandomHash(), 4, ownerAddress, invoiceHash, tokenisationStore)
+	processor.Process()
+
+	AssertPendingTokenBalance(t, ctx, invoiceHash, hash, 50, tokenisationStore)
+	count, err := tokenisationStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, count)
+
+	SaveChainPosition(t, ctx, 10, tokenisationStore)
+	processor.Process()
+
+	AssertTokenBalance(t, ctx, buyerAddress, hash, 50, tokenisationStore)
+	AssertTokenBalance(t, ctx, ownerAddress, hash, 50, tokenisationStore)
+	invoice, err := tokenisationStore.GetInvoiceByHash(ctx, invoiceHash)
+	assert.NilError(t, err)
+	assert.Assert(t, invoice.Status != store.InvoiceStatusCancelled)
+	count, err = tokenisationStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestCancelPartiallyPaidInvoiceIsDiscarded(t *testing.T) {
+	tokenisationStore := test_support.SetupTestDB(t)
+	rpcClient := support.NewTestDogeClient(t)
+	ctx := context.Background()
+	SaveChainPosition(t, ctx, 100, tokenisationStore)
+
+	hash := CreateUnconfirmedMint(t, ctx, support.GenerateRandomHash(), tokenisationStore)
+
+	processor := service.NewFractalEngineProcessor(tokenisationStore, rpcClient)
+	processor.Process()
+
+	buyerAddress := support.GenerateDogecoinAddress(true)
+	invoiceHash := support.GenerateRandomHash()
+
+	CreateOnChainInvoiceMessage(t, ctx, support.GenerateRandomHash(), 1, 1, ownerAddress, invoiceHash, hash, 50, tokenisationStore)
+	SaveUnconfirmedInvoice(t, ctx, ownerAddress, buyerAddress, invoiceHash, hash, 50, tokenisationStore)
+	CreateOnChainPaymentMessage(t, ctx, support.GenerateRandomHash(), invoiceHash, buyerAddress, ownerAddress, 2, 1, 10*doge.KoinuPerDoge, tokenisationStore)
+	processor.Process()
+
+	// The partial payment keeps the invoice open for the rest of the payment
+	CreateOnChainCancelInvoiceMessage(t, ctx, support.GenerateRandomHash(), 3, buyerAddress, invoiceHash, tokenisationStore)
+	processor.Process()
+
+	AssertPendingTokenBalance(t, ctx, invoiceHash, hash, 50, tokenisationStore)
+	invoice, err := tokenisationStore.GetInvoiceByHash(ctx, invoiceHash)
+	assert.NilError(t, err)
+	assert.Equal(t, store.InvoiceStatusOnChain, invoice.Status)
+	count, err := tokenisationStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestCancelInvoiceBeforeItIsGossiped(t *testing.T) {
+	tokenisationStore := test_support.SetupTestDB(t)
+	rpcClient := support.NewTestDogeClient(t)
+	ctx := context.Background()
+
+	hash := CreateUnconfirmedMint(t, ctx, support.GenerateRandomHash(), tokenisationStore)
+
+	processor := service.NewFractalEngineProcessor(tokenisationStore, rpcClient)
+	processor.Process()
+
+	invoiceHash := support.GenerateRandomHash()
+
+	// A cancellation seen before its invoice waits for it
+	CreateOnChainCancelInvoiceMessage(t, ctx, support.GenerateRandomHash(), 2, ownerAddress, invoiceHash, tokenisationStore)
+	processor.Process()
+
+	count, err := tokenisationStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, count)
+
+	CreateOnChainInvoiceMessage(t, ctx, support.GenerateRandomHash(), 1, 1, ownerAddress, invoiceHash, hash, 50, tokenisationStore)
+	processor.Process()
+
+	AssertNoPendingTokenBalance(t, ctx, invoiceHash, hash, tokenisationStore)
+	count, err = tokenisationStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestCancelPendingInvoiceIsRestoredByRollback(t *testing.T) {
+	tokenisationStore := test_support.SetupTestDB(t)
+	rpcClient := support.NewTestDogeClient(t)
+	ctx := context.Background()
+
+	hash := CreateUnconfirmedMint(t, ctx, support.GenerateRandomHash(), tokenisationStore)
+
+	processor := service.NewFractalEngineProcessor(tokenisationStore, rpcClient)
+	processor.Process()
+
+	invoiceHash := support.GenerateRandomHash()
+
+	// The invoice is seen on chain before its gossiped terms and cancelled in the next block
+	CreateOnChainInvoiceMessage(t, ctx, support.GenerateRandomHash(), 1, 1, ownerAddress, invoiceHash, hash, 50, tokenisationStore)
+	processor.Process()
+	AssertPendingTokenBalance(t, ctx, invoiceHash, hash, 50, tokenisationStore)
+
+	CreateOnChainCancelInvoiceMessage(t, ctx, support.GenerateRandomHash(), 2, ownerAddress, invoiceHash, tokenisationStore)
+	processor.Process()
+	AssertNoPendingTokenBalance(t, ctx, invoiceHash, hash, tokenisationStore)
+
+	count, err := tokenisationStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, count)
+
+	// A reorg of the cancellation's block brings back the reservation and the on-chain invoice
+	assert.NilError(t, tokenisationStore.RollbackToHeight(ctx, 1))
+	AssertPendingTokenBalance(t, ctx, invoiceHash, hash, 50, tokenisationStore)
+
+	invoiceTxs, err := tokenisationStore.GetOnChainTransactionsByAction(ctx, protocol.ACTION_INVOICE)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(invoiceTxs))
+	assert.Equal(t, int64(1), invoiceTxs[0].Height)
+}
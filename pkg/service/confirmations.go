@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"dogecoin.org/fractal-engine/pkg/config"
+	"dogecoin.org/fractal-engine/pkg/metrics"
+	"dogecoin.org/fractal-engine/pkg/store"
+)
+
+// ErrAwaitingConfirmations is returned for an action whose block does not have enough
+// confirmations yet. The transaction is kept and retried on a later pass, and the
+// transactions mined after it wait for it.
+var ErrAwaitingConfirmations = errors.New("awaiting confirmations")
+
+/*
+* ConfirmationGate holds back on-chain actions until their block is deep enough. The
+* depth comes from the node's confirmation policy for the action, and a mint can raise
+* it for every action on its fractions. Confirmations are counted from the follower's
+* chain position rather than the node's tip, so an action is only applied once every
+* block up to the one that confirms it has been saved, and a replay of the same blocks
+* applies it at the same point.
+ */
+type ConfirmationGate struct {
+	store  *store.TokenisationStore
+	policy config.ConfirmationPolicy
+}
+
+func NewConfirmationGate(store *store.TokenisationStore, policy config.ConfirmationPolicy) *ConfirmationGate {
+	return &ConfirmationGate{store: store, policy: policy}
+}
+
+// Wrap returns a handler that only runs once the transaction has been confirmed.
+func (g *ConfirmationGate) Wrap(handler ActionHandler) ActionHandler {
+	return &gatedHandler{gate: g, handler: handler}
+}
+
+type gatedHandler struct {
+	gate    *ConfirmationGate
+	handler ActionHandler
+}
+
+func (h *gatedHandler) Process(tx store.OnChainTransaction) error {
+	err := h.gate.Check(context.Background(), tx)
+	if err != nil {
+		return err
+	}
+
+	return h.handler.Process(tx)
+}
+
+func (h *gatedHandler) WithStore(tokenStore *store.TokenisationStore) ActionHandler {
+	gate := NewConfirmationGate(tokenStore, h.gate.policy)
+	return gate.Wrap(bindHandler(h.handler, tokenStore))
+}
+
+// Check returns ErrAwaitingConfirmations while the transaction's block has fewer
+// confirmations at the follower's chain position than the action requires.
+func (g *ConfirmationGate) Check(ctx context.Context, tx store.OnChainTransaction) error {
+	mint, err := g.store.GetOnChainTransactionMint(ctx, tx)
+	if err != nil {
+		log.Println("GetOnChainTransactionMint", err)
+		return err
+	}
+
+	required := store.RequiredConfirmations(g.policy, tx.ActionType, mint)
+	if required == 0 {
+		return nil
+	}
+
+	chainHeight, _, _, err := g.store.GetChainPosition(ctx)
+	if err != nil {
+		log.Println("GetChainPosition", err)
+		return err
+	}
+
+	confirmations := max(chainHeight-tx.Height+1, 0)
+	if confirmations < int64(required) {
+		log.Printf("Minimum confirmations not met for %s %s: %d < %d", metrics.ActionName(tx.ActionType), tx.TxHash, confirmations, required)
+		return fmt.Errorf("%w: %d < %d", ErrAwaitingConfirmations, confirmations, required)
+	}
+
+	return nil
+}
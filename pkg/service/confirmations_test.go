@@ -0,0 +1,125 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/config"
+	"dogecoin.org/fractal-engine/pkg/metrics"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/service"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/protobuf/proto"
+	"gotest.tools/assert"
+)
+
+func saveMintAction(t *testing.T, tokenStore *store.TokenisationStore, requirements store.StringInterfaceMap, blockHeight int64) string {
+	ctx := context.Background()
+
+	mint := &store.MintWithoutID{
+		Title:         "Test Mint",
+		Description:   "Test Description",
+		FractionCount: 100,
+		Hash:          test_support.GenerateRandomHash(),
+		Requirements:  requirements,
+	}
+	_, err := tokenStore.SaveUnconfirmedMint(ctx, mint)
+	assert.NilError(t, err)
+
+	data, err := proto.Marshal(&protocol.OnChainMintMessage{Hash: mint.Hash})
+	assert.NilError(t, err)
+
+	_, err = tokenStore.SaveOnChainTransaction(ctx, test_support.GenerateRandomHash(), blockHeight, "blockHash", 1, protocol.ACTION_MINT, protocol.DEFAULT_VERSION, data, ownerAddress, map[string]interface{}{
+		ownerAddress: 100,
+	})
+	assert.NilError(t, err)
+
+	return mint.Hash
+}
+
+func TestProcessHoldsActionsUntilPolicyConfirmations(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	rpcClient := test_support.NewTestDogeClient(t)
+	ctx := context.Background()
+
+	saveMintAction(t, tokenStore, store.StringInterfaceMap{}, 1)
+
+	// The mint in block 1 has 7 confirmations at the follower's chain position
+	SaveChainPosition(t, ctx, 7, tokenStore)
+	processor := service.NewFractalEngineProcessorWithPolicy(tokenStore, rpcClient, config.ConfirmationPolicy{"mint": 8})
+	assert.NilError(t, processor.Process())
+
+	count, err := tokenStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, count, 1)
+
+	processor = service.NewFractalEngineProcessorWithPolicy(tokenStore, rpcClient, config.ConfirmationPolicy{"mint": 7})
+	assert.NilError(t, processor.Process())
+
+	mints, err := tokenStore.GetMints(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, len(mints), 1)
+}
+
+func TestProcessHoldsActionsUntilMintConfirmations(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	rpcClient := test_support.NewTestDogeClient(t)
+	ctx := context.Background()
+
+	heldHash := saveMintAction(t, tokenStore, store.StringInterfaceMap{"min_confirmations": float64(8)}, 1)
+	settledHash := saveMintAction(t, tokenStore, store.StringInterfaceMap{"min_confirmations": float64(7)}, 1)
+	SaveChainPosition(t, ctx, 7, tokenStore)
+
+	processor := service.NewFractalEngineProcessor(tokenStore, rpcClient)
+	assert.NilError(t, processor.Process())
+
+	held, err := tokenStore.GetMintByHash(ctx, heldHash)
+	assert.NilError(t, err)
+	assert.Equal(t, held.Hash, "")
+
+	settled, err := tokenStore.GetMintByHash(ctx, settledHash)
+	assert.NilError(t, err)
+	assert.Equal(t, settled.Hash, settledHash)
+
+	count, err := tokenStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, count, 1)
+}
+
+func TestProcessAppliesActionsInBlockOrder(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	rpcClient := test_support.NewTestDogeClient(t)
+	ctx := context.Background()
+
+	heldHash := saveMintAction(t, tokenStore, store.StringInterfaceMap{"min_confirmations": float64(3)}, 9)
+	laterHash := saveMintAction(t, tokenStore, store.StringInterfaceMap{}, 10)
+	SaveChainPosition(t, ctx, 10, tokenStore)
+
+	deferred := metrics.ProcessorOutcomes.WithLabelValues("mint", metrics.OutcomeDeferred)
+	failed := metrics.ProcessorOutcomes.WithLabelValues("mint", metrics.OutcomeError)
+	deferredBefore := testutil.ToFloat64(deferred)
+	failedBefore := testutil.ToFloat64(failed)
+
+	// The mint in block 10 needs no confirmations but waits for the one mined before it
+	processor := service.NewFractalEngineProcessor(tokenStore, rpcClient)
+	assert.NilError(t, processor.ProcessPending(ctx))
+
+	mints, err := tokenStore.GetMints(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, len(mints), 0)
+	assert.Equal(t, testutil.ToFloat64(deferred)-deferredBefore, float64(1))
+	assert.Equal(t, testutil.ToFloat64(failed)-failedBefore, float64(0))
+
+	SaveChainPosition(t, ctx, 11, tokenStore)
+	assert.NilError(t, processor.ProcessPending(ctx))
+
+	held, err := tokenStore.GetMintByHash(ctx, heldHash)
+	assert.NilError(t, err)
+	assert.Equal(t, held.Hash, heldHash)
+
+	later, err := tokenStore.GetMintByHash(ctx, laterHash)
+	assert.NilError(t, err)
+	assert.Equal(t, later.Hash, laterHash)
+}
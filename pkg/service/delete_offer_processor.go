@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+
+	"dogecoin.org/fractal-engine/pkg/events"
+	"dogecoin.org/fractal-engine/pkg/metrics"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+)
+
+type DeleteOfferProcessor struct {
+	store *store.TokenisationStore
+}
+
+func NewDeleteOfferProcessor(store *store.TokenisationStore) *DeleteOfferProcessor {
+	return &DeleteOfferProcessor{store: store}
+}
+
+func (p *DeleteOfferProcessor) WithStore(store *store.TokenisationStore) ActionHandler {
+	return NewDeleteOfferProcessor(store)
+}
+
+/*
+* Offer cancellations are authorised by the offerer spending their own outputs on L1.
+* If the offer has not been received over gossip yet, the on-chain transaction is kept
+* so that it can be matched on a later pass (until it is trimmed).
+* Malformed cancellations and cancellations of another address's offer are discarded.
+ */
+func (p *DeleteOfferProcessor) Process(tx store.OnChainTransaction) error {
+	ctx := context.Background()
+
+	var offerHash []byte
+	var match func(context.Context, store.OnChainTransaction) error
+
+	switch tx.ActionType {
+	case protocol.ACTION_DELETE_BUY_OFFER:
+		message := protocol.OnChainDeleteBuyOfferMessage{}
+		if err := proto.Unmarshal(tx.ActionData, &message); err != nil {
+			log.Println("Error unmarshalling delete buy offer:", err)
+			return discardOnChainTransaction(ctx, p.store, tx)
+		}
+		offerHash = message.OfferHash
+		match = p.store.MatchDeleteBuyOffer
+	case protocol.ACTION_DELETE_SELL_OFFER:
+		message := protocol.OnChainDeleteSellOfferMessage{}
+		if err := proto.Unmarshal(tx.ActionData, &message); err != nil {
+			log.Println("Error unmarshalling delete sell offer:", err)
+			return discardOnChainTransaction(ctx, p.store, tx)
+		}
+		offerHash = message.OfferHash
+		match = p.store.MatchDeleteSellOffer
+	default:
+		return fmt.Errorf("action type is not delete offer: %d", tx.ActionType)
+	}
+
+	if len(offerHash) != 32 {
+		log.Println("Invalid offer hash in delete offer")
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	// Look the offer up before it is removed so that the event carries its mint
+	mintHash := p.offerMintHash(ctx, tx.ActionType, hex.EncodeToString(offerHash))
+
+	err := match(ctx, tx)
+	if err != nil {
+		// A cancellation of another address's offer can never match
+		if errors.Is(err, store.ErrOfferNotOwned) {
+			log.Println("Delete offer not sent by the offerer:", tx.TxHash)
+			return discardOnChainTransaction(ctx, p.store, tx)
+		}
+
+		// The offer may not have been gossiped to this node yet
+		if errors.Is(err, store.ErrOfferNotFound) {
+			log.Println("Delete offer not matched yet:", tx.TxHash)
+			return nil
+		}
+		return err
+	}
+
+	log.Println("Matched delete offer:", tx.TxHash)
+	metrics.RecordProcessorOutcome(tx.ActionType, metrics.OutcomeMatched)
+
+	notify(ctx, p.store, events.Event{
+		Type:        events.EventOfferDeleted,
+		MintHash:    mintHash,
+		Hash:        hex.EncodeToString(offerHash),
+		TxHash:      tx.TxHash,
+		Addresses:   []string{tx.Address},
+		BlockHeight: tx.Height,
+	})
+
+	return nil
+}
+
+func (p *DeleteOfferProcessor) offerMintHash(ctx context.Context, actionType uint8, offerHash string) string {
+	if actionType == protocol.ACTION_DELETE_BUY_OFFER {
+		offer, err := p.store.GetBuyOfferByHash(ctx, offerHash)
+		if err != nil {
+			return ""
+		}
+		return offer.MintHash
+	}
+
+	offer, err := p.store.GetSellOfferByHash(ctx, offerHash)
+	if err != nil {
+		return ""
+	}
+	return offer.MintHash
+}
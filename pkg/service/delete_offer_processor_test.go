@@ -0,0 +1,147 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"dogecoin.org/fractal-engine/internal/test/support"
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/service"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func TestDeleteOfferProcessorDeletesBuyOffer(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+	processor := service.NewDeleteOfferProcessor(tokenStore)
+
+	mintHash := support.GenerateRandomHash()
+	offererAddress := support.GenerateDogecoinAddress(true)
+	sellerAddress := support.GenerateDogecoinAddress(true)
+
+	offer := &store.BuyOfferWithoutID{
+		OffererAddress: offererAddress,
+		SellerAddress:  sellerAddress,
+		MintHash:       mintHash,
+		Quantity:       10,
+		Price:          100,
+		PublicKey:      "publicKey",
+	}
+	hash, err := offer.GenerateHash()
+	assert.NilError(t, err)
+	offer.Hash = hash
+
+	_, err = tokenStore.SaveBuyOffer(ctx, offer)
+	assert.NilError(t, err)
+
+	// The offerer withdrew the offer before the cancellation was mined
+	assert.NilError(t, tokenStore.MarkBuyOfferDeletePending(ctx, hash, offer.PublicKey))
+
+	envelope := protocol.NewDeleteBuyOfferTransactionEnvelope(hash, protocol.ACTION_DELETE_BUY_OFFER)
+	id, err := tokenStore.SaveOnChainTransaction(ctx, "deleteTx", 2, "blockHash", 1, protocol.ACTION_DELETE_BUY_OFFER, protocol.DEFAULT_VERSION, envelope.Data, offererAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+
+	err = processor.Process(*findInvoiceTransactionById(txs, id))
+	assert.NilError(t, err)
+
+	count, err := tokenStore.CountBuyOffers(ctx, mintHash, offererAddress, sellerAddress)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, count)
+
+	_, err = tokenStore.GetBuyOfferByHash(ctx, hash)
+	assert.Assert(t, errors.Is(err, sql.ErrNoRows))
+
+	txs, err = tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(txs))
+}
+
+func TestDeleteOfferProcessorKeepsUnmatchedTransaction(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+	processor := service.NewDeleteOfferProcessor(tokenStore)
+
+	// The sell offer has not been gossiped to this node yet
+	envelope := protocol.NewDeleteSellOfferTransactionEnvelope(support.GenerateRandomHash(), protocol.ACTION_DELETE_SELL_OFFER)
+	id, err := tokenStore.SaveOnChainTransaction(ctx, "deleteTx", 2, "blockHash", 1, protocol.ACTION_DELETE_SELL_OFFER, protocol.DEFAULT_VERSION, envelope.Data, support.GenerateDogecoinAddress(true), map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+
+	err = processor.Process(*findInvoiceTransactionById(txs, id))
+	assert.NilError(t, err)
+
+	txs, err = tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(txs))
+}
+
+func TestDeleteOfferProcessorDiscardsInvalidHash(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+	processor := service.NewDeleteOfferProcessor(tokenStore)
+
+	envelope := protocol.NewDeleteSellOfferTransactionEnvelope("abcd", protocol.ACTION_DELETE_SELL_OFFER)
+	id, err := tokenStore.SaveOnChainTransaction(ctx, "deleteTx", 2, "blockHash", 1, protocol.ACTION_DELETE_SELL_OFFER, protocol.DEFAULT_VERSION, envelope.Data, support.GenerateDogecoinAddress(true), map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+
+	err = processor.Process(*findInvoiceTransactionById(txs, id))
+	assert.NilError(t, err)
+
+	txs, err = tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(txs))
+}
+
+func TestDeleteOfferProcessorDiscardsCancellationFromOtherAddress(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+	processor := service.NewDeleteOfferProcessor(tokenStore)
+
+	mintHash := support.GenerateRandomHash()
+	offererAddress := support.GenerateDogecoinAddress(true)
+
+	offer := &store.SellOfferWithoutID{
+		OffererAddress: offererAddress,
+		MintHash:       mintHash,
+		Quantity:       10,
+		Price:          100,
+		PublicKey:      "publicKey",
+	}
+	hash, err := offer.GenerateHash()
+	assert.NilError(t, err)
+	offer.Hash = hash
+
+	_, err = tokenStore.SaveSellOffer(ctx, offer)
+	assert.NilError(t, err)
+
+	envelope := protocol.NewDeleteSellOfferTransactionEnvelope(hash, protocol.ACTION_DELETE_SELL_OFFER)
+	id, err := tokenStore.SaveOnChainTransaction(ctx, "deleteTx", 2, "blockHash", 1, protocol.ACTION_DELETE_SELL_OFFER, protocol.DEFAULT_VERSION, envelope.Data, support.GenerateDogecoinAddress(true), map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+
+	err = processor.Process(*findInvoiceTransactionById(txs, id))
+	assert.NilError(t, err)
+
+	// The cancellation is dropped rather than retried and the offer stays open
+	txs, err = tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(txs))
+
+	saved, err := tokenStore.GetSellOfferByHash(ctx, hash)
+	assert.NilError(t, err)
+	assert.Equal(t, 10, saved.Quantity)
+}
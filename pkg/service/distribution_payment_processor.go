@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"log"
+	"slices"
+
+	"dogecoin.org/fractal-engine/pkg/events"
+	"dogecoin.org/fractal-engine/pkg/metrics"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+)
+
+type DistributionPaymentProcessor struct {
+	store *store.TokenisationStore
+}
+
+func NewDistributionPaymentProcessor(store *store.TokenisationStore) *DistributionPaymentProcessor {
+	return &DistributionPaymentProcessor{store: store}
+}
+
+func (p *DistributionPaymentProcessor) WithStore(store *store.TokenisationStore) ActionHandler {
+	return NewDistributionPaymentProcessor(store)
+}
+
+/*
+* Distribution payments are payouts to the holders of a mint. Anyone may pay a holder
+* out, so the payout is matched on the outputs of the transaction: every output to a
+* holder entitled to the distribution is credited towards their entitlement.
+* If the distribution has not been gossiped yet, the on-chain transaction is kept so
+* that it can be matched on a later pass (until it is trimmed). Malformed payouts,
+* payouts made before the record height and payouts that pay no holder are discarded.
+ */
+func (p *DistributionPaymentProcessor) Process(tx store.OnChainTransaction) error {
+	ctx := context.Background()
+
+	message := protocol.OnChainDistributionPaymentMessage{}
+	if err := proto.Unmarshal(tx.ActionData, &message); err != nil {
+		log.Println("Error unmarshalling distribution payment:", err)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	if len(message.DistributionHash) != 32 || len(message.MintHash) != 32 {
+		log.Println("Invalid hash in distribution payment")
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	distribution, err := p.store.GetDistribution(ctx, hex.EncodeToString(message.DistributionHash))
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Println("Distribution payment not matched yet:", tx.TxHash)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if distribution.MintHash != hex.EncodeToString(message.MintHash) {
+		log.Println("Distribution payment discarded, distribution is for a different mint:", distribution.Hash)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	// Holders are only known once the record height has passed
+	if tx.Height <= distribution.RecordHeight {
+		log.Println("Distribution payment discarded, paid before the record height:", tx.TxHash)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	entitlements, err := p.store.GetDistributionEntitlements(ctx, distribution)
+	if err != nil {
+		log.Println("Error getting distribution entitlements:", err)
+		return err
+	}
+
+	// Outputs back to the sender are change rather than a payout
+	paysHolder := slices.ContainsFunc(entitlements, func(entitlement store.DistributionEntitlement) bool {
+		amount, err := tx.Values.Koinu(entitlement.Address)
+		return err == nil && amount > 0 && entitlement.Address != tx.Address
+	})
+	if !paysHolder {
+		log.Println("Distribution payment discarded, it pays no holder:", tx.TxHash)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	payments, err := p.store.ProcessDistributionPayment(ctx, tx, distribution, entitlements)
+	if err != nil {
+		log.Println("Error processing distribution payment:", err)
+		return err
+	}
+
+	log.Println("Matched distribution payment:", tx.TxHash)
+	metrics.RecordProcessorOutcome(tx.ActionType, metrics.OutcomeMatched)
+
+	addresses := make([]string, 0, len(payments))
+	for _, payment := range payments {
+		addresses = append(addresses, payment.Address)
+	}
+
+	notify(ctx, p.store, events.Event{
+		Type:        events.EventDistributionPaid,
+		MintHash:    distribution.MintHash,
+		Hash:        distribution.Hash,
+		TxHash:      tx.TxHash,
+		Addresses:   addresses,
+		BlockHeight: tx.Height,
+	})
+	return nil
+}
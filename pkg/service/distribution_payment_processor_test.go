@@ -0,0 +1,90 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/service"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func TestDistributionPaymentProcessorCreditsHolders(t *testing.T) {
+	tokenStore := support.SetupTestDB(t)
+	ctx := context.Background()
+	processor := service.NewDistributionPaymentProcessor(tokenStore)
+
+	mintHash := support.GenerateRandomHash()
+	ownerAddress := support.GenerateDogecoinAddress(true)
+	holderAddress := support.GenerateDogecoinAddress(true)
+
+	tx, err := tokenStore.DB.BeginTx(ctx, nil)
+	assert.NilError(t, err)
+	assert.NilError(t, tokenStore.UpsertTokenBalanceAtBlock(ctx, ownerAddress, mintHash, 75, store.BlockRef{Height: 5, Hash: "block5"}, tx))
+	assert.NilError(t, tokenStore.UpsertTokenBalanceAtBlock(ctx, holderAddress, mintHash, 25, store.BlockRef{Height: 5, Hash: "block5"}, tx))
+	assert.NilError(t, tx.Commit())
+
+	distribution := store.Distribution{
+		MintHash:     mintHash,
+		TotalKoinu:   1000,
+		RecordHeight: 10,
+		PublicKey:    "publicKey",
+		Signature:    "signature",
+		CreatedAt:    time.Now(),
+	}
+	distribution.Hash, err = distribution.GenerateHash()
+	assert.NilError(t, err)
+
+	envelope := protocol.NewDistributionPaymentTransactionEnvelope(distribution.Hash, mintHash, protocol.ACTION_DISTRIBUTION_PAYMENT)
+	payout := store.StringInterfaceMap{holderAddress: int64(250), ownerAddress: int64(10000)}
+
+	processPayout := func(txHash string, height int64, values store.StringInterfaceMap) int {
+		txId, err := tokenStore.SaveOnChainTransaction(ctx, txHash, height, "blockHash", 0, protocol.ACTION_DISTRIBUTION_PAYMENT, protocol.DEFAULT_VERSION, envelope.Data, ownerAddress, values)
+		assert.NilError(t, err)
+
+		txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+		assert.NilError(t, err)
+		assert.NilError(t, processor.Process(*findInvoiceTransactionById(txs, txId)))
+
+		count, err := tokenStore.CountAllOnChainTransactions(ctx)
+		assert.NilError(t, err)
+		return count
+	}
+
+	// A payout written on chain before the distribution is gossiped waits for it
+	assert.Equal(t, 1, processPayout("earlyTx", 10, payout))
+
+	_, err = tokenStore.SaveDistribution(ctx, &distribution)
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+
+	// Holders are not known until the record height has passed
+	assert.NilError(t, processor.Process(txs[0]))
+	count, err := tokenStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, count)
+
+	// Paying only the sender is change, not a payout
+	assert.Equal(t, 0, processPayout("changeTx", 12, store.StringInterfaceMap{ownerAddress: int64(10000)}))
+
+	claimed, err := tokenStore.GetDistributionClaimedKoinu(ctx, distribution.Hash, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(claimed))
+
+	assert.Equal(t, 0, processPayout("payoutTx", 12, payout))
+
+	entitlements, err := tokenStore.GetDistributionEntitlements(ctx, distribution)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(entitlements))
+	assert.Equal(t, ownerAddress, entitlements[0].Address)
+	assert.Equal(t, int64(750), entitlements[0].EntitlementKoinu)
+	assert.Equal(t, int64(0), entitlements[0].ClaimedKoinu)
+	assert.Equal(t, holderAddress, entitlements[1].Address)
+	assert.Equal(t, int64(250), entitlements[1].EntitlementKoinu)
+	assert.Equal(t, int64(250), entitlements[1].ClaimedKoinu)
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"dogecoin.org/fractal-engine/pkg/events"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+)
+
+func notifyMintConfirmed(ctx context.Context, tokenStore *store.TokenisationStore, tx store.OnChainTransaction) {
+	message := protocol.OnChainMintMessage{}
+	if err := proto.Unmarshal(tx.ActionData, &message); err != nil {
+		log.Println("Error unmarshalling mint for event:", err)
+		return
+	}
+
+	mint, err := tokenStore.GetMintByHash(ctx, message.Hash)
+	if err != nil {
+		log.Println("Error getting mint for event:", err)
+		return
+	}
+
+	event := events.Event{
+		MintHash:    mint.Hash,
+		Hash:        mint.Hash,
+		TxHash:      tx.TxHash,
+		Addresses:   []string{tx.Address},
+		Quantity:    mint.FractionCount,
+		BlockHeight: tx.Height,
+	}
+
+	event.Type = events.EventMintConfirmed
+	notify(ctx, tokenStore, event)
+
+	event.Type = events.EventBalanceChanged
+	notify(ctx, tokenStore, event)
+}
+
+func notifyBalanceChanged(ctx context.Context, tokenStore *store.TokenisationStore, tx store.OnChainTransaction, mintHash string, quantity int, addresses ...string) {
+	notify(ctx, tokenStore, events.Event{
+		Type:        events.EventBalanceChanged,
+		MintHash:    mintHash,
+		Hash:        tx.TxHash,
+		TxHash:      tx.TxHash,
+		Addresses:   addresses,
+		Quantity:    quantity,
+		BlockHeight: tx.Height,
+	})
+}
+
+// notify pushes a processor outcome to event subscribers and to the webhook outbox.
+func notify(ctx context.Context, tokenStore *store.TokenisationStore, event events.Event) {
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	tokenStore.PublishEvent(event)
+
+	if err := tokenStore.EnqueueWebhookEvent(ctx, event); err != nil {
+		log.Println("Error enqueueing webhook event:", err)
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"log"
 	"strings"
 
+	"dogecoin.org/fractal-engine/pkg/events"
+	"dogecoin.org/fractal-engine/pkg/metrics"
 	"dogecoin.org/fractal-engine/pkg/protocol"
 	"dogecoin.org/fractal-engine/pkg/store"
 	"dogecoin.org/fractal-engine/pkg/validation"
@@ -21,6 +23,10 @@ func NewInvoiceProcessor(store *store.TokenisationStore) *InvoiceProcessor {
 	return &InvoiceProcessor{store: store}
 }
 
+func (p *InvoiceProcessor) WithStore(store *store.TokenisationStore) ActionHandler {
+	return NewInvoiceProcessor(store)
+}
+
 /*
 * Check if invoice already taken pending availability
 * If so, then attempt to match to existing invoice or unconfirmed invoice
@@ -44,6 +50,16 @@ func (p *InvoiceProcessor) Process(tx store.OnChainTransaction) error {
 		return err
 	}
 
+	// An invoice written on chain after its own expiry can never be paid
+	if invoice.ExpiresAtHeight > 0 && tx.Height > invoice.ExpiresAtHeight {
+		log.Println("Invoice discarded, mined after its expiry height:", tx.TxHash)
+		err = p.store.DiscardInvoiceTransaction(ctx, tx.Id, hex.EncodeToString(invoice.InvoiceHash), hex.EncodeToString(invoice.MintHash))
+		if err == nil {
+			err = discarded(tx)
+		}
+		return err
+	}
+
 	hasPendingTokenBalance, err := p.EnsurePendingTokenBalance(tx)
 	if err != nil {
 		return err
@@ -51,7 +67,7 @@ func (p *InvoiceProcessor) Process(tx store.OnChainTransaction) error {
 
 	if !hasPendingTokenBalance {
 		log.Println("Invoice discarded, not enough availability")
-		return nil
+		return discarded(tx)
 	}
 
 	mint, err := p.store.GetMintByHash(ctx, hex.EncodeToString(invoice.MintHash))
@@ -74,15 +90,61 @@ func (p *InvoiceProcessor) Process(tx store.OnChainTransaction) error {
 		}
 	}
 
+	// The buyer is only known once the invoice has been gossiped
+	unconfirmedInvoice, err := p.store.GetUnconfirmedInvoiceByHash(ctx, hex.EncodeToString(invoice.InvoiceHash))
+	if err == nil {
+		err = p.store.CheckMintRequirements(ctx, mint, unconfirmedInvoice.BuyerAddress, unconfirmedInvoice.Quantity, nil)
+		if errors.Is(err, store.ErrMintRequirementsNotMet) {
+			log.Println("Invoice discarded, buyer is not eligible:", err)
+			err = p.store.DiscardInvoiceTransaction(ctx, tx.Id, unconfirmedInvoice.Hash, unconfirmedInvoice.MintHash)
+			if err == nil {
+				err = discarded(tx)
+			}
+			return err
+		}
+
+		if err != nil {
+			log.Println("Error checking mint requirements:", err)
+			return err
+		}
+	}
+
+	invoiceEvent := events.Event{
+		Type:        events.EventInvoiceConfirmed,
+		MintHash:    hex.EncodeToString(invoice.MintHash),
+		Hash:        hex.EncodeToString(invoice.InvoiceHash),
+		TxHash:      tx.TxHash,
+		Addresses:   []string{tx.Address},
+		Quantity:    int(invoice.Quantity),
+		BlockHeight: tx.Height,
+	}
+	if unconfirmedInvoice.BuyerAddress != "" {
+		invoiceEvent.Addresses = append(invoiceEvent.Addresses, unconfirmedInvoice.BuyerAddress)
+	}
+
 	// Try to match confirmed invoice first
 	if p.store.MatchInvoice(ctx, tx) {
+		metrics.RecordProcessorOutcome(tx.ActionType, metrics.OutcomeMatched)
+		notify(ctx, p.store, invoiceEvent)
 		return nil
 	}
 
 	// Try to match unconfirmed invoice (already transaction-safe)
 	err = p.store.MatchUnconfirmedInvoice(ctx, tx)
+	// Invoices whose gossiped terms are expired or cancelled can no longer be confirmed
+	if errors.Is(err, store.ErrInvoiceExpiryMismatch) || errors.Is(err, store.ErrInvalidInvoiceTransition) {
+		log.Println("Invoice discarded:", err)
+		err = p.store.DiscardInvoiceTransaction(ctx, tx.Id, hex.EncodeToString(invoice.InvoiceHash), hex.EncodeToString(invoice.MintHash))
+		if err == nil {
+			err = discarded(tx)
+		}
+		return err
+	}
+
 	if err == nil {
 		log.Println("Matched invoice:", tx.TxHash)
+		metrics.RecordProcessorOutcome(tx.ActionType, metrics.OutcomeMatched)
+		notify(ctx, p.store, invoiceEvent)
 	} else {
 		log.Println("Error matching unconfirmed invoice:", err)
 		// If no unconfirmed invoice found, this is not necessarily an error
@@ -105,14 +167,14 @@ func (p *InvoiceProcessor) EnsurePendingTokenBalance(tx store.OnChainTransaction
 	}
 
 	// Start transaction for atomic operations
-	dbTx, err := p.store.DB.Begin()
+	dbTx, err := p.store.Begin(ctx)
 	if err != nil {
 		return false, err
 	}
 	defer dbTx.Rollback()
 
 	// Check if pending token balance already exists with lock
-	pendingTokenBalance, _ := p.store.GetPendingTokenBalance(ctx, hex.EncodeToString(invoice.InvoiceHash), hex.EncodeToString(invoice.MintHash), dbTx)
+	pendingTokenBalance, _ := p.store.GetPendingTokenBalance(ctx, hex.EncodeToString(invoice.InvoiceHash), hex.EncodeToString(invoice.MintHash), dbTx.Tx)
 	if pendingTokenBalance.InvoiceHash != "" {
 		log.Println("Pending token balance already exists")
 		dbTx.Commit()
@@ -136,13 +198,20 @@ func (p *InvoiceProcessor) EnsurePendingTokenBalance(tx store.OnChainTransaction
 		totalTokenBalance += tokenBalance.Quantity
 	}
 
-	tokenBalanceAvailable := totalTokenBalance - pendingTokenBalanceTotal
+	// Fractions still locked at the invoice's block cannot be reserved
+	lockedTokenBalance, err := p.store.GetLockedTokenBalance(ctx, tx.Address, hex.EncodeToString(invoice.MintHash), tx.Height, tx.BlockTime, dbTx.Tx)
+	if err != nil {
+		log.Println("Error getting locked token balance:", err)
+		return false, err
+	}
+
+	tokenBalanceAvailable := totalTokenBalance - pendingTokenBalanceTotal - lockedTokenBalance
 
 	if tokenBalanceAvailable >= int(invoice.Quantity) {
 		log.Println("Token balance is enough")
 
 		// Use transaction-aware UpsertPendingTokenBalance
-		err = p.store.UpsertPendingTokenBalanceWithTx(ctx, hex.EncodeToString(invoice.InvoiceHash), hex.EncodeToString(invoice.MintHash), int(invoice.Quantity), tx.Id, tx.Address, dbTx)
+		err = p.store.UpsertPendingTokenBalanceAtBlock(ctx, hex.EncodeToString(invoice.InvoiceHash), hex.EncodeToString(invoice.MintHash), int(invoice.Quantity), tx.Id, tx.Address, tx.BlockRef(), dbTx.Tx)
 		if err != nil {
 			log.Println("Error inserting pending token balance:", err)
 			return false, err
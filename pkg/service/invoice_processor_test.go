@@ -498,3 +498,129 @@ func TestInvoiceProcessorEnsurePendingTokenBalanceInsufficientBalance(t *testing
 	removedTx := findInvoiceTransactionById(txsAfter, invoiceTxId)
 	assert.Assert(t, removedTx == nil, "Transaction should be removed")
 }
+
+func TestInvoiceProcessorEnsurePendingTokenBalanceLockedTokens(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+	processor := service.NewInvoiceProcessor(tokenStore)
+
+	mintHash := support.GenerateRandomHash()
+	sellerAddress := support.GenerateDogecoinAddress(true)
+	invoiceHash := support.GenerateRandomHash()
+
+	// The mint's fractions are locked for 10 blocks after the mint at height 1
+	_, err := tokenStore.SaveUnconfirmedMint(ctx, &store.MintWithoutID{
+		Hash:          mintHash,
+		Title:         "Test Mint",
+		Description:   "Test Description",
+		FractionCount: 100,
+		LockupOptions: store.StringInterfaceMap{"type": "block_height", "blocks": float64(10)},
+	})
+	assert.NilError(t, err)
+
+	encodedMintMsg, _ := proto.Marshal(&protocol.OnChainMintMessage{Hash: mintHash})
+	mintTxId, err := tokenStore.SaveOnChainTransaction(ctx, "mintTx", 1, "blockHash", 1, protocol.ACTION_MINT, protocol.DEFAULT_VERSION, encodedMintMsg, sellerAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.NilError(t, tokenStore.MatchUnconfirmedMint(ctx, *findInvoiceTransactionById(txs, mintTxId)))
+
+	invoiceHashBytes, err := hex.DecodeString(invoiceHash)
+	assert.NilError(t, err)
+	mintHashBytes, err := hex.DecodeString(mintHash)
+	assert.NilError(t, err)
+
+	encodedInvoiceMsg, _ := proto.Marshal(&protocol.OnChainInvoiceMessage{
+		InvoiceHash: invoiceHashBytes,
+		MintHash:    mintHashBytes,
+		Quantity:    10,
+	})
+	invoiceTxId, err := tokenStore.SaveOnChainTransaction(ctx, "invoiceTx", 5, "blockHash", 1, protocol.ACTION_INVOICE, protocol.DEFAULT_VERSION, encodedInvoiceMsg, sellerAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err = tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	invoiceTx := findInvoiceTransactionById(txs, invoiceTxId)
+	assert.Assert(t, invoiceTx != nil)
+
+	hasPending, err := processor.EnsurePendingTokenBalance(*invoiceTx)
+	assert.NilError(t, err)
+	assert.Assert(t, !hasPending, "Locked tokens should not be reserved")
+
+	pending, err := tokenStore.GetPendingTokenBalance(ctx, invoiceHash, mintHash, nil)
+	assert.Assert(t, err != nil || pending.InvoiceHash == "")
+}
+
+func TestInvoiceProcessorProcessDiscardsIneligibleBuyer(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+	processor := service.NewInvoiceProcessor(tokenStore)
+
+	mintHash := support.GenerateRandomHash()
+	sellerAddress := support.GenerateDogecoinAddress(true)
+	buyerAddress := support.GenerateDogecoinAddress(true)
+	invoiceHash := support.GenerateRandomHash()
+
+	_, err := tokenStore.SaveUnconfirmedMint(ctx, &store.MintWithoutID{
+		Hash:          mintHash,
+		Title:         "Test Mint",
+		Description:   "Test Description",
+		FractionCount: 100,
+		Requirements:  store.StringInterfaceMap{"deny_list": []interface{}{buyerAddress}},
+	})
+	assert.NilError(t, err)
+
+	encodedMintMsg, _ := proto.Marshal(&protocol.OnChainMintMessage{Hash: mintHash})
+	mintTxId, err := tokenStore.SaveOnChainTransaction(ctx, "mintTx", 1, "blockHash", 1, protocol.ACTION_MINT, protocol.DEFAULT_VERSION, encodedMintMsg, sellerAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.NilError(t, tokenStore.MatchUnconfirmedMint(ctx, *findInvoiceTransactionById(txs, mintTxId)))
+
+	_, err = tokenStore.SaveUnconfirmedInvoice(ctx, &store.UnconfirmedInvoice{
+		Hash:           invoiceHash,
+		PaymentAddress: sellerAddress,
+		BuyerAddress:   buyerAddress,
+		MintHash:       mintHash,
+		Quantity:       10,
+		Price:          100,
+		CreatedAt:      time.Now(),
+		SellerAddress:  sellerAddress,
+		Status:         "draft",
+	})
+	assert.NilError(t, err)
+
+	invoiceHashBytes, err := hex.DecodeString(invoiceHash)
+	assert.NilError(t, err)
+	mintHashBytes, err := hex.DecodeString(mintHash)
+	assert.NilError(t, err)
+
+	encodedInvoiceMsg, _ := proto.Marshal(&protocol.OnChainInvoiceMessage{
+		InvoiceHash: invoiceHashBytes,
+		MintHash:    mintHashBytes,
+		Quantity:    10,
+	})
+	invoiceTxId, err := tokenStore.SaveOnChainTransaction(ctx, "invoiceTx", 2, "blockHash", 1, protocol.ACTION_INVOICE, protocol.DEFAULT_VERSION, encodedInvoiceMsg, sellerAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err = tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	invoiceTx := findInvoiceTransactionById(txs, invoiceTxId)
+	assert.Assert(t, invoiceTx != nil)
+
+	assert.NilError(t, processor.Process(*invoiceTx))
+
+	// The invoice is consumed and the seller's reservation released
+	txs, err = tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.Assert(t, findInvoiceTransactionById(txs, invoiceTxId) == nil)
+
+	pendingTotal, err := tokenStore.GetPendingTokenBalanceTotalForMintAndOwner(ctx, mintHash, sellerAddress)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, pendingTotal)
+
+	_, err = tokenStore.GetInvoiceByHash(ctx, invoiceHash)
+	assert.Assert(t, err != nil)
+}
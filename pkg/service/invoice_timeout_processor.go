@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"log"
 
+	"dogecoin.org/fractal-engine/pkg/events"
 	"dogecoin.org/fractal-engine/pkg/protocol"
 	"dogecoin.org/fractal-engine/pkg/store"
 	"google.golang.org/protobuf/proto"
@@ -48,9 +49,119 @@ func (p *InvoiceTimeoutProcessor) Process(oldestBlockHeight int) error {
 					log.Println("Error removing pending token balance:", err)
 					continue
 				}
+
+				notify(ctx, p.store, events.Event{
+					Type:        events.EventInvoiceTimedOut,
+					MintHash:    pendingTokenBalance.MintHash,
+					Hash:        pendingTokenBalance.InvoiceHash,
+					TxHash:      invoice.TxHash,
+					Addresses:   []string{pendingTokenBalance.OwnerAddress},
+					Quantity:    pendingTokenBalance.Quantity,
+					BlockHeight: invoice.Height,
+				})
+			}
+		}
+	}
+
+	return nil
+}
+
+/*
+* Expire closes the invoices whose declared expiry height is below the block height the
+* engine has processed up to, releasing the fractions reserved for them. Invoices are
+* expired at the first block after their expiry height so that a reorg rolls the expiry
+* back with the same height on every node.
+* An invoice with a payment mined in time that is still waiting for confirmations is
+* left open until the payment settles or is trimmed.
+ */
+func (p *InvoiceTimeoutProcessor) Expire(blockHeight int64) error {
+	ctx := context.Background()
+
+	invoices, err := p.store.GetExpiredInvoices(ctx, blockHeight)
+	if err != nil {
+		log.Println("Error getting expired invoices:", err)
+		return err
+	}
+
+	payments := make(map[string]int64)
+	if len(invoices) > 0 {
+		pendingPayments, err := p.store.GetOnChainTransactionsByAction(ctx, protocol.ACTION_PAYMENT)
+		if err != nil {
+			log.Println("Error getting pending payments:", err)
+			return err
+		}
+
+		for _, payment := range pendingPayments {
+			hash := payment.SubjectHash()
+			if _, ok := payments[hash]; !ok {
+				payments[hash] = payment.Height
 			}
 		}
 	}
 
+	for _, invoice := range invoices {
+		if paidAt, ok := payments[invoice.Hash]; ok && paidAt <= invoice.ExpiresAtHeight {
+			continue
+		}
+
+		err = p.store.ExpireInvoice(ctx, invoice, invoice.ExpiresAtHeight+1)
+		if err != nil {
+			log.Println("Error expiring invoice:", err)
+			continue
+		}
+
+		notify(ctx, p.store, events.Event{
+			Type:        events.EventInvoiceExpired,
+			MintHash:    invoice.MintHash,
+			Hash:        invoice.Hash,
+			Addresses:   []string{invoice.BuyerAddress, invoice.SellerAddress},
+			Quantity:    invoice.Quantity,
+			BlockHeight: invoice.ExpiresAtHeight + 1,
+		})
+	}
+
+	err = p.store.ExpireUnconfirmedInvoices(ctx, blockHeight)
+	if err != nil {
+		log.Println("Error expiring unconfirmed invoices:", err)
+		return err
+	}
+
+	// On-chain invoices that were never matched to their gossiped terms
+	pendingInvoices, err := p.store.GetOnChainTransactionsByAction(ctx, protocol.ACTION_INVOICE)
+	if err != nil {
+		log.Println("Error getting pending invoices:", err)
+		return err
+	}
+
+	for _, tx := range pendingInvoices {
+		invoiceMessage := protocol.OnChainInvoiceMessage{}
+		if err := proto.Unmarshal(tx.ActionData, &invoiceMessage); err != nil {
+			continue
+		}
+
+		if invoiceMessage.ExpiresAtHeight == 0 || invoiceMessage.ExpiresAtHeight >= blockHeight {
+			continue
+		}
+
+		invoiceHash := hex.EncodeToString(invoiceMessage.InvoiceHash)
+		mintHash := hex.EncodeToString(invoiceMessage.MintHash)
+
+		err = p.store.DiscardInvoiceTransaction(ctx, tx.Id, invoiceHash, mintHash)
+		if err != nil {
+			log.Println("Error discarding expired invoice:", err)
+			continue
+		}
+
+		notify(ctx, p.store, events.Event{
+			Type:        events.EventInvoiceExpired,
+			MintHash:    mintHash,
+			Hash:        invoiceHash,
+			TxHash:      tx.TxHash,
+			Addresses:   []string{tx.Address},
+			Quantity:    int(invoiceMessage.Quantity),
+			BlockHeight: invoiceMessage.ExpiresAtHeight + 1,
+		})
+	}
+
 	return nil
 }
@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/store"
+)
+
+// MatchingService crosses open buy and sell offers for each mint in price-time
+// priority and turns every fill into an unsigned invoice that reserves the seller's
+// fractions. The seller signs the invoice through CreateInvoice, which gossips it.
+type MatchingService struct {
+	store   *store.TokenisationStore
+	running bool
+}
+
+func NewMatchingService(store *store.TokenisationStore) *MatchingService {
+	return &MatchingService{store: store, running: false}
+}
+
+func (m *MatchingService) Start() {
+	m.running = true
+	ctx := context.Background()
+
+	for {
+		err := m.MatchAll(ctx)
+		if err != nil {
+			log.Println("Error matching offers:", err)
+		}
+
+		time.Sleep(10 * time.Second)
+
+		if !m.running {
+			break
+		}
+	}
+}
+
+func (m *MatchingService) Stop() {
+	fmt.Println("Stopping matching service")
+	m.running = false
+}
+
+func (m *MatchingService) MatchAll(ctx context.Context) error {
+	mintHashes, err := m.store.GetCrossableMintHashes(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, mintHash := range mintHashes {
+		_, err := m.MatchMint(ctx, mintHash)
+		if err != nil {
+			log.Println("Error matching offers for mint:", mintHash, err)
+		}
+	}
+
+	return nil
+}
+
+// MatchMint walks the order book of a mint from the best bid down. Each bid is
+// filled against the cheapest ask it crosses, oldest first within a price level,
+// until either side of the book is exhausted. The execution price is the price
+// of the resting (older) offer.
+func (m *MatchingService) MatchMint(ctx context.Context, mintHash string) ([]store.UnconfirmedInvoice, error) {
+	mint, err := m.store.GetMintByHash(ctx, mintHash)
+	if err != nil {
+		return nil, err
+	}
+
+	bids, asks, err := m.store.GetOrderBook(ctx, mintHash)
+	if err != nil {
+		return nil, err
+	}
+
+	invoices := []store.UnconfirmedInvoice{}
+
+	for i := range bids {
+		bid := &bids[i]
+
+		if err := m.store.CheckMintRequirements(ctx, mint, bid.OffererAddress, bid.Quantity, nil); err != nil {
+			if !errors.Is(err, store.ErrMintRequirementsNotMet) {
+				return invoices, err
+			}
+			continue
+		}
+
+		for j := range asks {
+			ask := &asks[j]
+
+			if bid.Quantity == 0 {
+				break
+			}
+
+			if ask.Price > bid.Price {
+				break
+			}
+
+			if !offersCross(*bid, *ask) {
+				continue
+			}
+
+			quantity := min(bid.Quantity, ask.Quantity)
+
+			available, err := m.unlockedTokenBalance(ctx, ask.OffererAddress, mintHash)
+			if err != nil {
+				return invoices, err
+			}
+
+			if available < quantity {
+				continue
+			}
+
+			invoice, err := m.fill(ctx, mint, *bid, *ask, quantity)
+			if err != nil {
+				return invoices, err
+			}
+
+			if invoice == nil {
+				continue
+			}
+
+			bid.Quantity -= quantity
+			ask.Quantity -= quantity
+			invoices = append(invoices, *invoice)
+		}
+	}
+
+	return invoices, nil
+}
+
+func (m *MatchingService) fill(ctx context.Context, mint store.Mint, bid store.BuyOffer, ask store.SellOffer, quantity int) (*store.UnconfirmedInvoice, error) {
+	// The resting offer sets the price and the taker completes the match
+	price := ask.Price
+	createdAt := bid.CreatedAt
+	if bid.CreatedAt.Before(ask.CreatedAt) {
+		price = bid.Price
+		createdAt = ask.CreatedAt
+	}
+
+	invoice := &store.UnconfirmedInvoice{
+		MintHash:       mint.Hash,
+		Quantity:       quantity,
+		Price:          int64(price) * doge.KoinuPerDoge,
+		BuyerAddress:   bid.OffererAddress,
+		PaymentAddress: ask.OffererAddress,
+		SellerAddress:  ask.OffererAddress,
+		PublicKey:      ask.PublicKey,
+		CreatedAt:      createdAt,
+		Status:         store.InitialInvoiceStatus(mint),
+	}
+
+	hash, err := invoice.GenerateHash()
+	if err != nil {
+		return nil, err
+	}
+	invoice.Hash = hash
+
+	// An earlier fill between the same buyer and seller with the same terms already
+	// owns this hash, so the pair is left for a later pass
+	if _, err := m.store.GetUnconfirmedInvoiceByHash(ctx, hash); err == nil {
+		log.Printf("Invoice %s already exists, skipping buy offer %s and sell offer %s", hash, bid.Hash, ask.Hash)
+		return nil, nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	fill := &store.OfferFill{
+		MintHash:      mint.Hash,
+		BuyOfferHash:  bid.Hash,
+		SellOfferHash: ask.Hash,
+		InvoiceHash:   hash,
+		Quantity:      quantity,
+		Price:         price,
+		CreatedAt:     createdAt,
+	}
+
+	id, err := m.store.FillOffers(ctx, bid, ask, fill, invoice)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice.Id = id
+
+	log.Printf("Matched buy offer %s with sell offer %s for %d at %d", bid.Hash, ask.Hash, quantity, price)
+
+	return invoice, nil
+}
+
+func (m *MatchingService) unlockedTokenBalance(ctx context.Context, address string, mintHash string) (int, error) {
+	available, err := m.store.GetAvailableTokenBalance(ctx, address, mintHash, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	blockHeight, _, _, err := m.store.GetChainPosition(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	locked, err := m.store.GetLockedTokenBalance(ctx, address, mintHash, blockHeight, time.Now(), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return available - locked, nil
+}
+
+// offersCross reports whether a bid can be filled by an ask, ignoring price. A bid
+// addressed to a specific seller only matches that seller's asks, and nobody is
+// matched against their own offers.
+func offersCross(bid store.BuyOffer, ask store.SellOffer) bool {
+	if ask.Quantity == 0 {
+		return false
+	}
+
+	if bid.SellerAddress != "" && bid.SellerAddress != ask.OffererAddress {
+		return false
+	}
+
+	return bid.OffererAddress != ask.OffererAddress
+}
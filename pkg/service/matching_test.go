@@ -0,0 +1,200 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/service"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func saveMatchingMint(t *testing.T, tokenStore *store.TokenisationStore) string {
+	mintHash := test_support.GenerateRandomHash()
+
+	_, err := tokenStore.SaveMint(context.Background(), &store.MintWithoutID{
+		Title:         "Test Mint",
+		Description:   "Test Description",
+		FractionCount: 1000,
+		Hash:          mintHash,
+	}, "owner")
+	assert.NilError(t, err)
+
+	return mintHash
+}
+
+func saveBid(t *testing.T, tokenStore *store.TokenisationStore, mintHash string, offerer string, seller string, quantity int, price int, createdAt time.Time) string {
+	hash := test_support.GenerateRandomHash()
+	_, err := tokenStore.SaveBuyOffer(context.Background(), &store.BuyOfferWithoutID{
+		Hash:           hash,
+		MintHash:       mintHash,
+		OffererAddress: offerer,
+		SellerAddress:  seller,
+		Quantity:       quantity,
+		Price:          price,
+		CreatedAt:      createdAt,
+	})
+	assert.NilError(t, err)
+	return hash
+}
+
+func saveAsk(t *testing.T, tokenStore *store.TokenisationStore, mintHash string, offerer string, quantity int, price int, createdAt time.Time) string {
+	hash := test_support.GenerateRandomHash()
+	_, err := tokenStore.SaveSellOffer(context.Background(), &store.SellOfferWithoutID{
+		Hash:           hash,
+		MintHash:       mintHash,
+		OffererAddress: offerer,
+		Quantity:       quantity,
+		Price:          price,
+		CreatedAt:      createdAt,
+		PublicKey:      offerer + "PublicKey",
+	})
+	assert.NilError(t, err)
+	return hash
+}
+
+func TestMatchMintPriceTimePriority(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+	mintHash := saveMatchingMint(t, tokenStore)
+
+	assert.NilError(t, tokenStore.UpsertTokenBalance(ctx, "sellerA", mintHash, 100))
+	assert.NilError(t, tokenStore.UpsertTokenBalance(ctx, "sellerB", mintHash, 100))
+	assert.NilError(t, tokenStore.UpsertTokenBalance(ctx, "sellerC", mintHash, 100))
+
+	start := time.Now().Add(-time.Hour)
+
+	// Two asks at the same price: the older one has priority. The cheaper ask beats both.
+	laterAsk := saveAsk(t, tokenStore, mintHash, "sellerA", 10, 40, start.Add(2*time.Minute))
+	olderAsk := saveAsk(t, tokenStore, mintHash, "sellerB", 10, 40, start.Add(time.Minute))
+	cheapAsk := saveAsk(t, tokenStore, mintHash, "sellerC", 5, 35, start.Add(3*time.Minute))
+
+	bid := saveBid(t, tokenStore, mintHash, "buyer", "", 12, 45, start.Add(4*time.Minute))
+
+	invoices, err := service.NewMatchingService(tokenStore).MatchMint(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(invoices))
+
+	// The incoming bid takes the resting asks at their prices
+	assert.Equal(t, "sellerC", invoices[0].SellerAddress)
+	assert.Equal(t, "sellerC", invoices[0].PaymentAddress)
+	assert.Equal(t, "sellerCPublicKey", invoices[0].PublicKey)
+	assert.Equal(t, "buyer", invoices[0].BuyerAddress)
+	assert.Equal(t, 5, invoices[0].Quantity)
+	assert.Equal(t, 35*doge.KoinuPerDoge, invoices[0].Price)
+	assert.Equal(t, "draft", invoices[0].Status)
+
+	assert.Equal(t, "sellerB", invoices[1].SellerAddress)
+	assert.Equal(t, 7, invoices[1].Quantity)
+	assert.Equal(t, 40*doge.KoinuPerDoge, invoices[1].Price)
+
+	// The invoice is hashed like one the seller created, so the seller can sign it
+	expected := store.UnconfirmedInvoice{MintHash: mintHash, Quantity: 5, Price: 35 * doge.KoinuPerDoge, BuyerAddress: "buyer", SellerAddress: "sellerC", PublicKey: "sellerCPublicKey"}
+	expectedHash, err := expected.GenerateHash()
+	assert.NilError(t, err)
+	assert.Equal(t, expectedHash, invoices[0].Hash)
+
+	saved, err := tokenStore.GetUnconfirmedInvoiceByHash(ctx, expectedHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 5, saved.Quantity)
+	assert.Equal(t, "", saved.Signature)
+
+	fills, err := tokenStore.GetOfferFills(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(fills))
+	assert.Equal(t, bid, fills[0].BuyOfferHash)
+	assert.Equal(t, cheapAsk, fills[0].SellOfferHash)
+	assert.Equal(t, expectedHash, fills[0].InvoiceHash)
+
+	// The bid is filled, the older ask is partially filled and the later ask is untouched
+	bids, asks, err := tokenStore.GetOrderBook(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(bids))
+	assert.Equal(t, 2, len(asks))
+	assert.Equal(t, olderAsk, asks[0].Hash)
+	assert.Equal(t, 3, asks[0].Quantity)
+	assert.Equal(t, laterAsk, asks[1].Hash)
+	assert.Equal(t, 10, asks[1].Quantity)
+}
+
+func TestMatchMintReservesSellerFractions(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+	mintHash := saveMatchingMint(t, tokenStore)
+
+	assert.NilError(t, tokenStore.UpsertTokenBalance(ctx, "seller", mintHash, 10))
+
+	// The seller offers the same ten fractions twice
+	start := time.Now().Add(-time.Hour)
+	saveAsk(t, tokenStore, mintHash, "seller", 10, 40, start)
+	saveAsk(t, tokenStore, mintHash, "seller", 10, 45, start.Add(time.Minute))
+	saveBid(t, tokenStore, mintHash, "buyerA", "", 10, 50, start.Add(2*time.Minute))
+	saveBid(t, tokenStore, mintHash, "buyerB", "", 10, 50, start.Add(3*time.Minute))
+
+	invoices, err := service.NewMatchingService(tokenStore).MatchMint(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(invoices))
+	assert.Equal(t, "buyerA", invoices[0].BuyerAddress)
+
+	reserved, err := tokenStore.GetPendingTokenBalance(ctx, invoices[0].Hash, mintHash, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, "seller", reserved.OwnerAddress)
+	assert.Equal(t, 10, reserved.Quantity)
+
+	// Nothing is left to fill the second bid until the reservation is released
+	invoices, err = service.NewMatchingService(tokenStore).MatchMint(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(invoices))
+}
+
+func TestMatchMintRestingBidSetsPrice(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+	mintHash := saveMatchingMint(t, tokenStore)
+
+	assert.NilError(t, tokenStore.UpsertTokenBalance(ctx, "seller", mintHash, 100))
+
+	start := time.Now().Add(-time.Hour)
+	saveBid(t, tokenStore, mintHash, "buyer", "", 10, 50, start)
+	saveAsk(t, tokenStore, mintHash, "seller", 4, 45, start.Add(time.Minute))
+
+	invoices, err := service.NewMatchingService(tokenStore).MatchMint(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(invoices))
+	assert.Equal(t, 50*doge.KoinuPerDoge, invoices[0].Price)
+	assert.Equal(t, 4, invoices[0].Quantity)
+
+	bids, _, err := tokenStore.GetOrderBook(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(bids))
+	assert.Equal(t, 6, bids[0].Quantity)
+}
+
+func TestMatchMintSkipsIneligibleOffers(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+	mintHash := saveMatchingMint(t, tokenStore)
+
+	assert.NilError(t, tokenStore.UpsertTokenBalance(ctx, "seller", mintHash, 100))
+
+	start := time.Now().Add(-time.Hour)
+
+	// A bid addressed to another seller, a self-match and a seller without tokens
+	saveBid(t, tokenStore, mintHash, "buyer", "otherSeller", 10, 50, start)
+	saveBid(t, tokenStore, mintHash, "seller", "", 10, 50, start)
+	saveAsk(t, tokenStore, mintHash, "seller", 10, 45, start)
+	saveBid(t, tokenStore, mintHash, "buyer2", "emptySeller", 10, 50, start)
+	saveAsk(t, tokenStore, mintHash, "emptySeller", 10, 45, start)
+
+	invoices, err := service.NewMatchingService(tokenStore).MatchMint(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(invoices))
+
+	bids, asks, err := tokenStore.GetOrderBookDepth(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []store.OrderBookLevel{{Price: 50, Quantity: 30, Orders: 3}}, bids)
+	assert.DeepEqual(t, []store.OrderBookLevel{{Price: 45, Quantity: 20, Orders: 2}}, asks)
+}
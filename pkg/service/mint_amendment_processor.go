@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"log"
+
+	"dogecoin.org/fractal-engine/pkg/events"
+	"dogecoin.org/fractal-engine/pkg/metrics"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+)
+
+type MintAmendmentProcessor struct {
+	store *store.TokenisationStore
+}
+
+func NewMintAmendmentProcessor(store *store.TokenisationStore) *MintAmendmentProcessor {
+	return &MintAmendmentProcessor{store: store}
+}
+
+func (p *MintAmendmentProcessor) WithStore(store *store.TokenisationStore) ActionHandler {
+	return NewMintAmendmentProcessor(store)
+}
+
+/*
+* Mint amendments are authorised by the mint owner spending their own outputs on L1.
+* If the mint requires asset manager signatures, the amendment is held until enough
+* valid co-signatures for the amendment hash have been received.
+* If the mint is not confirmed or the amendment has not been gossiped yet, the
+* on-chain transaction is kept so that it can be matched on a later pass (until it is
+* trimmed). Malformed, unauthorised or already applied amendments are discarded.
+ */
+func (p *MintAmendmentProcessor) Process(tx store.OnChainTransaction) error {
+	ctx := context.Background()
+
+	message := protocol.OnChainMintAmendmentMessage{}
+	if err := proto.Unmarshal(tx.ActionData, &message); err != nil {
+		log.Println("Error unmarshalling mint amendment:", err)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	if len(message.AmendmentHash) != 32 || len(message.MintHash) != 32 {
+		log.Println("Invalid hash in mint amendment")
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	mint, err := p.store.GetMintByHash(ctx, hex.EncodeToString(message.MintHash))
+	if err != nil {
+		log.Println("Error getting mint:", err)
+		return err
+	}
+
+	if mint.Hash == "" {
+		log.Println("Mint amendment not matched yet, mint is not confirmed:", tx.TxHash)
+		return nil
+	}
+
+	if mint.OwnerAddress != tx.Address {
+		log.Println("Mint amendment discarded, mint cannot be amended by:", tx.Address)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	amendment, err := p.store.GetMintAmendment(ctx, hex.EncodeToString(message.AmendmentHash))
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Println("Mint amendment not matched yet:", tx.TxHash)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if amendment.MintHash != mint.Hash || amendment.Version != 0 {
+		log.Println("Mint amendment discarded, amendment is for another mint or already applied:", amendment.Hash)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	if mint.SignatureRequired() {
+		signatures, err := p.store.GetMintAmendmentSignatures(ctx, amendment.Hash)
+		if err != nil {
+			log.Println("Error getting mint amendment signatures:", err)
+			return err
+		}
+
+		if !mint.HasRequiredSignatureCount(countValidMintAmendmentSignatures(mint, signatures)) {
+			log.Println("Invalid number of signatures")
+			return errors.New("Invalid number of signatures")
+		}
+	}
+
+	err = p.store.ApplyMintAmendment(ctx, amendment, tx)
+	if err != nil {
+		log.Println("Error applying mint amendment:", err)
+		return err
+	}
+
+	log.Println("Matched mint amendment:", tx.TxHash)
+	metrics.RecordProcessorOutcome(tx.ActionType, metrics.OutcomeMatched)
+
+	notify(ctx, p.store, events.Event{
+		Type:        events.EventMintAmended,
+		MintHash:    mint.Hash,
+		Hash:        amendment.Hash,
+		TxHash:      tx.TxHash,
+		Addresses:   []string{mint.OwnerAddress},
+		BlockHeight: tx.Height,
+	})
+	return nil
+}
+
+// countValidMintAmendmentSignatures counts distinct asset managers with a valid
+// signature over the amendment.
+func countValidMintAmendmentSignatures(mint store.Mint, signatures []store.MintAmendmentSignature) int {
+	signers := map[string]bool{}
+
+	for _, signature := range signatures {
+		if err := signature.Validate(mint); err != nil {
+			log.Println("Ignoring mint amendment signature:", err)
+			continue
+		}
+
+		signers[signature.PublicKey] = true
+	}
+
+	return len(signers)
+}
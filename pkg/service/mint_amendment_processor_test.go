@@ -0,0 +1,125 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/service"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func TestMintAmendmentProcessorRequiresOwnerAndAssetManagerSignatures(t *testing.T) {
+	tokenStore := support.SetupTestDB(t)
+	ctx := context.Background()
+	processor := service.NewMintAmendmentProcessor(tokenStore)
+
+	managerPrivHex, managerPubHex, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	mintHash := support.GenerateRandomHash()
+	ownerAddress := support.GenerateDogecoinAddress(true)
+
+	_, err = tokenStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:                    "Signature Required Mint",
+		Description:              "Original description",
+		FractionCount:            1000,
+		Hash:                     mintHash,
+		OwnerAddress:             ownerAddress,
+		SignatureRequirementType: store.SignatureRequirementType_ONE_SIGNATURE,
+		AssetManagers: store.AssetManagers{
+			{Name: "Manager 1", PublicKey: managerPubHex, URL: "https://example.com"},
+		},
+	}, ownerAddress)
+	assert.NilError(t, err)
+
+	amendment := store.MintAmendment{
+		MintHash:    mintHash,
+		Description: "Amended description",
+		CreatedAt:   time.Now(),
+	}
+	amendment.Hash, err = amendment.GenerateHash()
+	assert.NilError(t, err)
+
+	envelope := protocol.NewMintAmendmentTransactionEnvelope(amendment.Hash, mintHash, protocol.ACTION_AMEND_MINT)
+
+	// Only the mint owner can amend the mint
+	txId, err := tokenStore.SaveOnChainTransaction(ctx, "strangerTx", 10, "blockHash", 0, protocol.ACTION_AMEND_MINT, protocol.DEFAULT_VERSION, envelope.Data, support.GenerateDogecoinAddress(true), store.StringInterfaceMap{})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.NilError(t, processor.Process(*findInvoiceTransactionById(txs, txId)))
+
+	count, err := tokenStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, count)
+
+	// An amendment written on chain before it is gossiped waits for it
+	txId, err = tokenStore.SaveOnChainTransaction(ctx, "amendTx", 11, "blockHash", 0, protocol.ACTION_AMEND_MINT, protocol.DEFAULT_VERSION, envelope.Data, ownerAddress, store.StringInterfaceMap{})
+	assert.NilError(t, err)
+
+	txs, err = tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	tx := *findInvoiceTransactionById(txs, txId)
+	assert.NilError(t, processor.Process(tx))
+
+	count, err = tokenStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, count)
+
+	_, err = tokenStore.SaveMintAmendment(ctx, &amendment)
+	assert.NilError(t, err)
+
+	// Without a co-signature the amendment is held
+	err = processor.Process(tx)
+	assert.ErrorContains(t, err, "Invalid number of signatures")
+
+	mint, err := tokenStore.GetMintByHash(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, "Original description", mint.Description)
+
+	body := store.MintAmendmentSignatureBody{Hash: amendment.Hash, MintHash: mintHash}
+	signature, err := doge.SignPayload(body, managerPrivHex, managerPubHex)
+	assert.NilError(t, err)
+
+	_, err = tokenStore.SaveMintAmendmentSignature(ctx, &store.MintAmendmentSignature{
+		AmendmentHash: amendment.Hash,
+		MintHash:      mintHash,
+		Signature:     signature,
+		PublicKey:     managerPubHex,
+		CreatedAt:     time.Now(),
+	})
+	assert.NilError(t, err)
+
+	assert.NilError(t, processor.Process(tx))
+
+	mint, latest, err := tokenStore.GetMintVersion(ctx, mintHash, 0)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, latest)
+	assert.Equal(t, "Amended description", mint.Description)
+
+	count, err = tokenStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, count)
+
+	// Writing the same amendment on chain again is discarded
+	txId, err = tokenStore.SaveOnChainTransaction(ctx, "replayTx", 12, "blockHash", 0, protocol.ACTION_AMEND_MINT, protocol.DEFAULT_VERSION, envelope.Data, ownerAddress, store.StringInterfaceMap{})
+	assert.NilError(t, err)
+
+	txs, err = tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.NilError(t, processor.Process(*findInvoiceTransactionById(txs, txId)))
+
+	_, latest, err = tokenStore.GetMintVersion(ctx, mintHash, 0)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, latest)
+
+	count, err = tokenStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, count)
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"log"
+
+	"dogecoin.org/fractal-engine/pkg/events"
+	"dogecoin.org/fractal-engine/pkg/metrics"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+)
+
+type MintOwnershipTransferProcessor struct {
+	store *store.TokenisationStore
+}
+
+func NewMintOwnershipTransferProcessor(store *store.TokenisationStore) *MintOwnershipTransferProcessor {
+	return &MintOwnershipTransferProcessor{store: store}
+}
+
+func (p *MintOwnershipTransferProcessor) WithStore(store *store.TokenisationStore) ActionHandler {
+	return NewMintOwnershipTransferProcessor(store)
+}
+
+/*
+* Ownership transfers are authorised by the current owner spending their own outputs
+* on L1, and by the current and new owner signing the gossiped transfer.
+* If the mint is not confirmed or the transfer has not been gossiped yet, the on-chain
+* transaction is kept so that it can be matched on a later pass (until it is trimmed).
+* Malformed, unauthorised, stale or already applied transfers are discarded.
+ */
+func (p *MintOwnershipTransferProcessor) Process(tx store.OnChainTransaction) error {
+	ctx := context.Background()
+
+	message := protocol.OnChainMintOwnershipTransferMessage{}
+	if err := proto.Unmarshal(tx.ActionData, &message); err != nil {
+		log.Println("Error unmarshalling mint ownership transfer:", err)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	if len(message.TransferHash) != 32 || len(message.MintHash) != 32 {
+		log.Println("Invalid hash in mint ownership transfer")
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	mint, err := p.store.GetMintByHash(ctx, hex.EncodeToString(message.MintHash))
+	if err != nil {
+		log.Println("Error getting mint:", err)
+		return err
+	}
+
+	if mint.Hash == "" {
+		log.Println("Mint ownership transfer not matched yet, mint is not confirmed:", tx.TxHash)
+		return nil
+	}
+
+	if mint.OwnerAddress != tx.Address {
+		log.Println("Mint ownership transfer discarded, mint cannot be transferred by:", tx.Address)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	transfer, err := p.store.GetMintOwnershipTransfer(ctx, hex.EncodeToString(message.TransferHash))
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Println("Mint ownership transfer not matched yet:", tx.TxHash)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// A transfer signed by an earlier owner no longer speaks for the mint
+	if transfer.MintHash != mint.Hash || transfer.PublicKey != mint.PublicKey || transfer.BlockHeight != 0 {
+		log.Println("Mint ownership transfer discarded, transfer is stale or already applied:", transfer.Hash)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	if err := transfer.Validate(); err != nil {
+		log.Println("Mint ownership transfer discarded:", err)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	err = p.store.ApplyMintOwnershipTransfer(ctx, transfer, tx)
+	if err != nil {
+		log.Println("Error applying mint ownership transfer:", err)
+		return err
+	}
+
+	log.Println("Matched mint ownership transfer:", tx.TxHash)
+	metrics.RecordProcessorOutcome(tx.ActionType, metrics.OutcomeMatched)
+
+	notify(ctx, p.store, events.Event{
+		Type:        events.EventMintOwnershipTransferred,
+		MintHash:    mint.Hash,
+		Hash:        transfer.Hash,
+		TxHash:      tx.TxHash,
+		Addresses:   []string{mint.OwnerAddress, transfer.NewOwnerAddress},
+		BlockHeight: tx.Height,
+	})
+	return nil
+}
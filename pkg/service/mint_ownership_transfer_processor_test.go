@@ -0,0 +1,100 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/service"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func signedMintOwnershipTransfer(t *testing.T, mintHash string, ownerPrivHex string, ownerPubHex string, newOwnerPrivHex string, newOwnerPubHex string, newOwnerAddress string) store.MintOwnershipTransfer {
+	transfer := store.MintOwnershipTransfer{
+		MintHash:        mintHash,
+		NewOwnerAddress: newOwnerAddress,
+		NewPublicKey:    newOwnerPubHex,
+		PublicKey:       ownerPubHex,
+		CreatedAt:       time.Now(),
+	}
+
+	var err error
+	transfer.Signature, err = doge.SignPayload(transfer.Body(), ownerPrivHex, ownerPubHex)
+	assert.NilError(t, err)
+	transfer.NewOwnerSignature, err = doge.SignPayload(transfer.Body(), newOwnerPrivHex, newOwnerPubHex)
+	assert.NilError(t, err)
+	transfer.Hash, err = transfer.GenerateHash()
+	assert.NilError(t, err)
+	return transfer
+}
+
+func processMintOwnershipTransfer(t *testing.T, tokenStore *store.TokenisationStore, processor *service.MintOwnershipTransferProcessor, txHash string, height int64, address string, transfer store.MintOwnershipTransfer) {
+	ctx := context.Background()
+
+	envelope := protocol.NewMintOwnershipTransferTransactionEnvelope(transfer.Hash, transfer.MintHash, protocol.ACTION_TRANSFER_MINT_OWNERSHIP)
+	txId, err := tokenStore.SaveOnChainTransaction(ctx, txHash, height, "blockHash", 0, protocol.ACTION_TRANSFER_MINT_OWNERSHIP, protocol.DEFAULT_VERSION, envelope.Data, address, store.StringInterfaceMap{})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.NilError(t, processor.Process(*findInvoiceTransactionById(txs, txId)))
+}
+
+func TestMintOwnershipTransferProcessorHandsMintToNewOwner(t *testing.T) {
+	tokenStore := support.SetupTestDB(t)
+	ctx := context.Background()
+	processor := service.NewMintOwnershipTransferProcessor(tokenStore)
+
+	ownerPrivHex, ownerPubHex, ownerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	newOwnerPrivHex, newOwnerPubHex, newOwnerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	thirdPrivHex, thirdPubHex, thirdAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	mintHash := support.GenerateRandomHash()
+	_, err = tokenStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "Test Mint",
+		FractionCount: 1000,
+		Hash:          mintHash,
+		PublicKey:     ownerPubHex,
+	}, ownerAddress)
+	assert.NilError(t, err)
+
+	transfer := signedMintOwnershipTransfer(t, mintHash, ownerPrivHex, ownerPubHex, newOwnerPrivHex, newOwnerPubHex, newOwnerAddress)
+	_, err = tokenStore.SaveMintOwnershipTransfer(ctx, &transfer)
+	assert.NilError(t, err)
+
+	// Only the current owner can write the transfer on chain
+	processMintOwnershipTransfer(t, tokenStore, processor, "strangerTx", 10, newOwnerAddress, transfer)
+
+	mint, err := tokenStore.GetMintByHash(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, ownerAddress, mint.OwnerAddress)
+
+	processMintOwnershipTransfer(t, tokenStore, processor, "transferTx", 11, ownerAddress, transfer)
+
+	mint, err = tokenStore.GetMintByHash(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, newOwnerAddress, mint.OwnerAddress)
+	assert.Equal(t, newOwnerPubHex, mint.PublicKey)
+
+	// A transfer signed by the previous owner no longer applies
+	stale := signedMintOwnershipTransfer(t, mintHash, ownerPrivHex, ownerPubHex, thirdPrivHex, thirdPubHex, thirdAddress)
+	_, err = tokenStore.SaveMintOwnershipTransfer(ctx, &stale)
+	assert.NilError(t, err)
+
+	processMintOwnershipTransfer(t, tokenStore, processor, "staleTx", 12, newOwnerAddress, stale)
+
+	mint, err = tokenStore.GetMintByHash(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, newOwnerAddress, mint.OwnerAddress)
+
+	count, err := tokenStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, count)
+}
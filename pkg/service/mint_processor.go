@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"dogecoin.org/fractal-engine/pkg/metrics"
+	"dogecoin.org/fractal-engine/pkg/store"
+)
+
+type MintProcessor struct {
+	store *store.TokenisationStore
+}
+
+func NewMintProcessor(store *store.TokenisationStore) *MintProcessor {
+	return &MintProcessor{store: store}
+}
+
+func (p *MintProcessor) WithStore(store *store.TokenisationStore) ActionHandler {
+	return NewMintProcessor(store)
+}
+
+/*
+* A mint is confirmed by matching its on-chain transaction with the unconfirmed mint
+* received over gossip. If the mint has not been gossiped yet the transaction is kept
+* so that it can be matched on a later pass.
+ */
+func (p *MintProcessor) Process(tx store.OnChainTransaction) error {
+	ctx := context.Background()
+	if p.store.MatchMint(ctx, tx) {
+		metrics.RecordProcessorOutcome(tx.ActionType, metrics.OutcomeMatched)
+		return nil
+	}
+
+	err := p.store.MatchUnconfirmedMint(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	log.Println("Matched mint:", tx.TxHash)
+	metrics.RecordProcessorOutcome(tx.ActionType, metrics.OutcomeMatched)
+	notifyMintConfirmed(ctx, p.store, tx)
+	return nil
+}
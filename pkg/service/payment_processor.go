@@ -2,22 +2,24 @@ package service
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
-	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/events"
+	"dogecoin.org/fractal-engine/pkg/metrics"
 	"dogecoin.org/fractal-engine/pkg/store"
 )
 
-const MIN_CONFIRMATIONS_REQUIRED = 6
-
 type PaymentProcessor struct {
-	store      *store.TokenisationStore
-	dogeClient *doge.RpcClient
+	store *store.TokenisationStore
+}
+
+func NewPaymentProcessor(store *store.TokenisationStore) *PaymentProcessor {
+	return &PaymentProcessor{store: store}
 }
 
-func NewPaymentProcessor(store *store.TokenisationStore, dogeClient *doge.RpcClient) *PaymentProcessor {
-	return &PaymentProcessor{store: store, dogeClient: dogeClient}
+func (p *PaymentProcessor) WithStore(store *store.TokenisationStore) ActionHandler {
+	return NewPaymentProcessor(store)
 }
 
 func (p *PaymentProcessor) Process(tx store.OnChainTransaction) error {
@@ -28,33 +30,108 @@ func (p *PaymentProcessor) Process(tx store.OnChainTransaction) error {
 		return err
 	}
 
-	if tx.BlockHash == "" {
-		blockHash, err := p.dogeClient.GetBlockHash(ctx, int(tx.Height))
-		if err != nil {
-			log.Println("GetBlockHash", err)
-			return err
-		}
+	// A payment for an expired or cancelled invoice is never applied
+	if invoice.IsClosed() || invoice.ExpiredAt(tx.Height) {
+		log.Println("Payment discarded, invoice is closed:", invoice.Hash)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
 
-		tx.BlockHash = blockHash
+	amount, _, err := invoice.PaymentKoinu(tx.Values)
+	if err != nil {
+		return err
 	}
 
-	blockHeader, err := p.dogeClient.GetBlockHeader(ctx, tx.BlockHash)
+	paid, _, err := p.store.GetInvoicePaidKoinu(ctx, invoice.Hash, nil)
 	if err != nil {
-		log.Println("GetBlockHeader", err)
+		log.Println("GetInvoicePaidKoinu:", err)
 		return err
 	}
 
-	if blockHeader.Confirmations < MIN_CONFIRMATIONS_REQUIRED {
-		log.Println("Minimum confirmations not met:", err)
-		return fmt.Errorf("Minimum confirmations not met: %d < %d", blockHeader.Confirmations, MIN_CONFIRMATIONS_REQUIRED)
+	// Only the payment that settles the invoice moves fractions, so only it has to wait
+	// while the seller's fractions are locked at the payment's block
+	due := invoice.AmountDueKoinu()
+	if !invoice.PaidAt.Valid && paid < due && paid+amount >= due {
+		unlocked, err := p.unlockedTokenBalance(ctx, invoice.SellerAddress, invoice.MintHash, tx.Height, tx.BlockTime)
+		if err != nil {
+			log.Println("unlockedTokenBalance:", err)
+			return err
+		}
+
+		// The outcome is final for the payment's block, so it is kept as a refundable
+		// overpayment rather than retried
+		if unlocked < invoice.Quantity {
+			log.Printf("Seller tokens are locked: %d < %d, recording payment on invoice %s as overpaid", unlocked, invoice.Quantity, invoice.Hash)
+			payment, err := p.store.RecordOverpayment(ctx, tx, invoice)
+			if err != nil {
+				log.Println("RecordOverpayment:", err)
+				return err
+			}
+
+			metrics.RecordProcessorOutcome(tx.ActionType, metrics.OutcomeMatched)
+			log.Printf("Overpayment of %d koinu on invoice %s from %s", payment.OverpaidKoinu, invoice.Hash, payment.PayerAddress)
+			return nil
+		}
 	}
 
-	err = p.store.ProcessPayment(ctx, tx, invoice)
+	payment, settled, err := p.store.ProcessPayment(ctx, tx, invoice)
 	if err != nil {
 		log.Println("ProcessPayment:", err)
 		return err
 	}
 
+	metrics.RecordProcessorOutcome(tx.ActionType, metrics.OutcomeMatched)
+
+	if payment.OverpaidKoinu > 0 {
+		log.Printf("Overpayment of %d koinu on invoice %s from %s", payment.OverpaidKoinu, invoice.Hash, payment.PayerAddress)
+	}
+
+	if !settled {
+		log.Printf("Received payment of %d koinu towards invoice %s", payment.AmountKoinu, invoice.Hash)
+		notify(ctx, p.store, events.Event{
+			Type:        events.EventPaymentReceived,
+			MintHash:    invoice.MintHash,
+			Hash:        invoice.Hash,
+			TxHash:      tx.TxHash,
+			Addresses:   []string{invoice.BuyerAddress, invoice.SellerAddress},
+			Quantity:    invoice.Quantity,
+			BlockHeight: tx.Height,
+		})
+		return nil
+	}
+
 	log.Println("Matched payment:", tx.TxHash)
+
+	notify(ctx, p.store, events.Event{
+		Type:        events.EventPaymentMatched,
+		MintHash:    invoice.MintHash,
+		Hash:        invoice.Hash,
+		TxHash:      tx.TxHash,
+		Addresses:   []string{invoice.BuyerAddress, invoice.SellerAddress},
+		Quantity:    invoice.Quantity,
+		BlockHeight: tx.Height,
+	})
+	notifyBalanceChanged(ctx, p.store, tx, invoice.MintHash, invoice.Quantity, invoice.BuyerAddress, invoice.SellerAddress)
+
 	return nil
 }
+
+// unlockedTokenBalance evaluates the mint's lockup at the height and time of the block
+// the payment was mined in, so the outcome does not depend on when it is processed.
+func (p *PaymentProcessor) unlockedTokenBalance(ctx context.Context, address string, mintHash string, atHeight int64, atTime time.Time) (int, error) {
+	tokenBalances, err := p.store.GetTokenBalances(ctx, address, mintHash)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, tokenBalance := range tokenBalances {
+		total += tokenBalance.Quantity
+	}
+
+	locked, err := p.store.GetLockedTokenBalance(ctx, address, mintHash, atHeight, atTime, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return total - locked, nil
+}
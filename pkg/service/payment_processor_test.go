@@ -0,0 +1,115 @@
+package service_test
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/service"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+	"gotest.tools/assert"
+)
+
+func TestPaymentProcessorEvaluatesLockupAtPaymentBlock(t *testing.T) {
+	tokenStore := support.SetupTestDB(t)
+	ctx := context.Background()
+	processor := service.NewPaymentProcessor(tokenStore)
+
+	sellerAddress := support.GenerateDogecoinAddress(true)
+	buyerAddress := support.GenerateDogecoinAddress(true)
+	mintHash := support.GenerateRandomHash()
+	invoiceHash := support.GenerateRandomHash()
+
+	// The mint's fractions are locked for an hour after the block it was confirmed in,
+	// which was long before the test runs
+	mintTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := tokenStore.SaveUnconfirmedMint(ctx, &store.MintWithoutID{
+		Hash:          mintHash,
+		Title:         "Test Mint",
+		Description:   "Test Description",
+		FractionCount: 100,
+		LockupOptions: store.StringInterfaceMap{"type": "time", "seconds": float64(3600)},
+	})
+	assert.NilError(t, err)
+
+	encodedMintMsg, _ := proto.Marshal(&protocol.OnChainMintMessage{Hash: mintHash})
+	_, err = tokenStore.SaveOnChainTransactionAtTime(ctx, "mintTx", 1, "blockHash", 1, protocol.ACTION_MINT, protocol.DEFAULT_VERSION, encodedMintMsg, sellerAddress, map[string]interface{}{}, mintTime)
+	assert.NilError(t, err)
+
+	mintTxs, err := tokenStore.GetOnChainTransactionsByAction(ctx, protocol.ACTION_MINT)
+	assert.NilError(t, err)
+	assert.NilError(t, tokenStore.MatchUnconfirmedMint(ctx, mintTxs[0]))
+
+	_, err = tokenStore.SaveUnconfirmedInvoice(ctx, &store.UnconfirmedInvoice{
+		Hash:           invoiceHash,
+		PaymentAddress: sellerAddress,
+		BuyerAddress:   buyerAddress,
+		MintHash:       mintHash,
+		Quantity:       10,
+		Price:          100,
+		CreatedAt:      time.Now(),
+		SellerAddress:  sellerAddress,
+	})
+	assert.NilError(t, err)
+
+	invoiceHashBytes, err := hex.DecodeString(invoiceHash)
+	assert.NilError(t, err)
+	mintHashBytes, err := hex.DecodeString(mintHash)
+	assert.NilError(t, err)
+
+	encodedInvoiceMsg, _ := proto.Marshal(&protocol.OnChainInvoiceMessage{
+		InvoiceHash: invoiceHashBytes,
+		MintHash:    mintHashBytes,
+		Quantity:    10,
+	})
+	_, err = tokenStore.SaveOnChainTransactionAtTime(ctx, "invoiceTx", 2, "blockHash", 1, protocol.ACTION_INVOICE, protocol.DEFAULT_VERSION, encodedInvoiceMsg, sellerAddress, map[string]interface{}{}, mintTime.Add(2*time.Hour))
+	assert.NilError(t, err)
+
+	invoiceTxs, err := tokenStore.GetOnChainTransactionsByAction(ctx, protocol.ACTION_INVOICE)
+	assert.NilError(t, err)
+	assert.NilError(t, service.NewInvoiceProcessor(tokenStore).Process(invoiceTxs[0]))
+
+	invoice, err := tokenStore.GetInvoiceByHash(ctx, invoiceHash)
+	assert.NilError(t, err)
+
+	encodedPaymentMsg, _ := proto.Marshal(&protocol.OnChainPaymentMessage{Hash: invoiceHash})
+	_, err = tokenStore.SaveOnChainTransaction(ctx, "paymentTx", 3, "blockHash", 1, protocol.ACTION_PAYMENT, protocol.DEFAULT_VERSION, encodedPaymentMsg, buyerAddress, map[string]interface{}{
+		sellerAddress: invoice.AmountDueKoinu(),
+	})
+	assert.NilError(t, err)
+
+	paymentTxs, err := tokenStore.GetOnChainTransactionsByAction(ctx, protocol.ACTION_PAYMENT)
+	assert.NilError(t, err)
+	lockedPayment := paymentTxs[0]
+
+	// Mined inside the lockup, the payment is final however late it is processed: it is
+	// kept as a refundable overpayment and the invoice stays open
+	lockedPayment.BlockTime = mintTime.Add(10 * time.Minute)
+	assert.NilError(t, processor.Process(lockedPayment))
+	AssertTokenBalance(t, ctx, buyerAddress, mintHash, 0, tokenStore)
+
+	paid, overpaid, err := tokenStore.GetInvoicePaidKoinu(ctx, invoiceHash, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(0), paid)
+	assert.Equal(t, invoice.AmountDueKoinu(), overpaid)
+
+	paymentTxs, err = tokenStore.GetOnChainTransactionsByAction(ctx, protocol.ACTION_PAYMENT)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(paymentTxs))
+
+	// A payment mined after the lockup has elapsed settles the invoice
+	_, err = tokenStore.SaveOnChainTransactionAtTime(ctx, "paymentTx2", 4, "blockHash", 1, protocol.ACTION_PAYMENT, protocol.DEFAULT_VERSION, encodedPaymentMsg, buyerAddress, map[string]interface{}{
+		sellerAddress: invoice.AmountDueKoinu(),
+	}, mintTime.Add(2*time.Hour))
+	assert.NilError(t, err)
+
+	paymentTxs, err = tokenStore.GetOnChainTransactionsByAction(ctx, protocol.ACTION_PAYMENT)
+	assert.NilError(t, err)
+	assert.NilError(t, processor.Process(paymentTxs[0]))
+	AssertTokenBalance(t, ctx, buyerAddress, mintHash, 10, tokenStore)
+}
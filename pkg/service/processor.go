@@ -2,29 +2,62 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
 
+	"dogecoin.org/fractal-engine/pkg/config"
 	"dogecoin.org/fractal-engine/pkg/doge"
-	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/metrics"
 	"dogecoin.org/fractal-engine/pkg/store"
 )
 
 type FractalEngineProcessor struct {
 	store      *store.TokenisationStore
 	dogeClient *doge.RpcClient
+	registry   *ActionRegistry
 	Running    bool
 }
 
 func NewFractalEngineProcessor(store *store.TokenisationStore, dogeClient *doge.RpcClient) *FractalEngineProcessor {
-	return &FractalEngineProcessor{store: store, dogeClient: dogeClient}
+	return NewFractalEngineProcessorWithPolicy(store, dogeClient, config.DefaultConfirmationPolicy())
+}
+
+// NewFractalEngineProcessorWithPolicy creates a processor that applies each on-chain
+// action once it has the confirmations set by the policy.
+func NewFractalEngineProcessorWithPolicy(store *store.TokenisationStore, dogeClient *doge.RpcClient, policy config.ConfirmationPolicy) *FractalEngineProcessor {
+	gate := NewConfirmationGate(store, policy)
+	return &FractalEngineProcessor{store: store, dogeClient: dogeClient, registry: NewDefaultActionRegistry(store, gate)}
+}
+
+// Registry returns the action handlers used by the processor, so that new action
+// versions can be registered with their activation heights.
+func (p *FractalEngineProcessor) Registry() *ActionRegistry {
+	return p.registry
 }
 
 func (p *FractalEngineProcessor) Process() error {
-	ctx := context.Background()
+	return p.process(context.Background(), 5*time.Second)
+}
+
+// ProcessPending makes a single pass over the on-chain transactions without pausing
+// between pages, so that a reindex can apply each replayed block before the next.
+func (p *FractalEngineProcessor) ProcessPending(ctx context.Context) error {
+	return p.process(ctx, 0)
+}
+
+/*
+* process works through the on-chain transactions a page at a time, pausing between
+* pages. Transactions are applied in the order they were mined. Once one is waiting for
+* confirmations the pass ends after the rest of its transaction, so that an action
+* needing fewer confirmations never overtakes one mined before it.
+ */
+func (p *FractalEngineProcessor) process(ctx context.Context, pause time.Duration) error {
 	offset := 0
 	limit := 100
+	batches := make(map[string]bool)
+	var deferred *store.OnChainTransaction
 
 	for {
 		txs, err := p.store.GetOnChainTransactions(ctx, offset, limit)
@@ -37,37 +70,104 @@ func (p *FractalEngineProcessor) Process() error {
 		}
 
 		for _, tx := range txs {
-			fmt.Println("Processing transaction:", tx.TxHash)
+			if deferred != nil && minedAfter(tx, *deferred) {
+				return nil
+			}
 
-			if tx.ActionType == protocol.ACTION_MINT {
-				if p.store.MatchMint(ctx, tx) {
+			if tx.BatchAtomic {
+				// The whole batch is handled when its first action is reached
+				if batches[tx.TxHash] {
 					continue
 				}
-				err = p.store.MatchUnconfirmedMint(ctx, tx)
-				if err == nil {
-					log.Println("Matched mint:", tx.TxHash)
-				}
-			} else if tx.ActionType == protocol.ACTION_PAYMENT {
-				paymentProcessor := NewPaymentProcessor(p.store, p.dogeClient)
-				err = paymentProcessor.Process(tx)
+				batches[tx.TxHash] = true
+
+				err = NewBatchProcessor(p.store, p.registry).Process(tx.TxHash)
 				if err != nil {
-					log.Println("Error processing payment:", err)
+					log.Println("Error processing batch:", err)
 				}
-			} else if tx.ActionType == protocol.ACTION_INVOICE {
-				invoiceProcessor := NewInvoiceProcessor(p.store)
-				err = invoiceProcessor.Process(tx)
+			} else {
+				err = p.processTransaction(ctx, tx)
 				if err != nil {
-					log.Println("Error processing invoice:", err)
+					recordProcessorError(tx, err)
 				}
+			}
 
+			if errors.Is(err, ErrAwaitingConfirmations) && deferred == nil {
+				deferred = &tx
 			}
 		}
 
 		offset += limit
 
-		time.Sleep(5 * time.Second)
+		time.Sleep(pause)
+	}
+
+	return nil
+}
+
+// processTransaction dispatches a single on-chain action to the handler registered for
+// its action and version. Actions the registry does not know are rejected and discarded.
+func (p *FractalEngineProcessor) processTransaction(ctx context.Context, tx store.OnChainTransaction) error {
+	fmt.Println("Processing transaction:", tx.TxHash)
+
+	handler, err := p.registry.Lookup(tx.ActionType, tx.ActionVersion, tx.Height)
+	if err != nil {
+		log.Printf("Rejecting transaction %s: %v", tx.TxHash, err)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	err = handler.Process(tx)
+	if err != nil {
+		log.Printf("Error processing %s: %v", metrics.ActionName(tx.ActionType), err)
+	}
+
+	return err
+}
+
+// minedAfter reports whether a transaction comes from a later transaction in the chain
+// than another.
+func minedAfter(tx store.OnChainTransaction, other store.OnChainTransaction) bool {
+	if tx.Height != other.Height {
+		return tx.Height > other.Height
+	}
+
+	return tx.TransactionNumber > other.TransactionNumber
+}
+
+// recordProcessorError counts a pass that did not apply a transaction. Actions that are
+// only waiting for confirmations are counted as deferred rather than failed.
+func recordProcessorError(tx store.OnChainTransaction, err error) {
+	outcome := metrics.OutcomeError
+	if errors.Is(err, ErrAwaitingConfirmations) {
+		outcome = metrics.OutcomeDeferred
+	}
+
+	metrics.RecordProcessorOutcome(tx.ActionType, outcome)
+}
+
+// discardOnChainTransaction drops a transaction that can never be matched. An action of
+// an atomic batch is left for the batch processor to discard with the rest of its batch.
+func discardOnChainTransaction(ctx context.Context, tokenStore *store.TokenisationStore, tx store.OnChainTransaction) error {
+	if tx.BatchAtomic {
+		return fmt.Errorf("%w: %s %s", ErrActionDiscarded, metrics.ActionName(tx.ActionType), tx.TxHash)
+	}
+
+	err := tokenStore.RemoveOnChainTransaction(ctx, tx.Id)
+	if err == nil {
+		metrics.RecordProcessorOutcome(tx.ActionType, metrics.OutcomeDiscarded)
+	}
+
+	return err
+}
+
+// discarded counts a transaction a handler has already dropped. An action of an atomic
+// batch returns ErrActionDiscarded instead so that the whole batch is rolled back.
+func discarded(tx store.OnChainTransaction) error {
+	if tx.BatchAtomic {
+		return fmt.Errorf("%w: %s %s", ErrActionDiscarded, metrics.ActionName(tx.ActionType), tx.TxHash)
 	}
 
+	metrics.RecordProcessorOutcome(tx.ActionType, metrics.OutcomeDiscarded)
 	return nil
 }
 
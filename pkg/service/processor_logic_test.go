@@ -8,6 +8,7 @@ import (
 
 	"dogecoin.org/fractal-engine/internal/test/support"
 	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
 	"dogecoin.org/fractal-engine/pkg/protocol"
 	"dogecoin.org/fractal-engine/pkg/service"
 	"dogecoin.org/fractal-engine/pkg/store"
@@ -129,6 +130,7 @@ func TestProcessMintTransactionNotMatched(t *testing.T) {
 func TestProcessPaymentTransaction(t *testing.T) {
 	tokenStore := test_support.SetupTestDB(t)
 	ctx := context.Background()
+	SaveChainPosition(t, ctx, 100, tokenStore)
 	rpcClient := support.NewTestDogeClient(t)
 
 	processor := service.NewFractalEngineProcessor(tokenStore, rpcClient)
@@ -170,7 +172,7 @@ func TestProcessPaymentTransaction(t *testing.T) {
 		BuyerAddress:   buyerAddress,
 		MintHash:       mintHash,
 		Quantity:       50,
-		Price:          100,
+		Price:          100 * doge.KoinuPerDoge,
 		SellerAddress:  sellerAddress,
 	})
 	if err != nil {
@@ -204,7 +206,7 @@ func TestProcessPaymentTransaction(t *testing.T) {
 	}
 	encodedPaymentMsg, _ := proto.Marshal(paymentMsg)
 	_, err = tokenStore.SaveOnChainTransaction(ctx, "txPayment", 3, "blockHash", 1, protocol.ACTION_PAYMENT, protocol.DEFAULT_VERSION, encodedPaymentMsg, buyerAddress, map[string]interface{}{
-		sellerAddress: 5000,
+		sellerAddress: 5000 * doge.KoinuPerDoge,
 	})
 	if err != nil {
 		t.Fatalf("Failed to save payment transaction: %v", err)
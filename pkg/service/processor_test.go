@@ -3,11 +3,14 @@ package service_test
 import (
 	"context"
 	"encoding/hex"
+	"strings"
 	"testing"
 	"time"
 
 	"dogecoin.org/fractal-engine/internal/test/support"
 	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/events"
 	"dogecoin.org/fractal-engine/pkg/protocol"
 	"dogecoin.org/fractal-engine/pkg/service"
 	"dogecoin.org/fractal-engine/pkg/store"
@@ -34,6 +37,7 @@ func TestInvoiceMatch(t *testing.T) {
 	tokenisationStore := test_support.SetupTestDB(t)
 	rpcClient := support.NewTestDogeClient(t)
 	ctx := context.Background()
+	SaveChainPosition(t, ctx, 100, tokenisationStore)
 
 	hash := CreateUnconfirmedMint(t, ctx, support.GenerateRandomHash(), tokenisationStore)
 
@@ -61,7 +65,7 @@ func TestInvoiceMatch(t *testing.T) {
 	AssertTokenBalance(t, ctx, ownerAddress, hash, 100, tokenisationStore)
 	AssertPendingTokenBalance(t, ctx, invoiceHash, hash, 50, tokenisationStore)
 
-	CreateOnChainPaymentMessage(t, ctx, txHash4, invoiceHash, buyerAddress, ownerAddress, 1, 1, 50*100, tokenisationStore)
+	CreateOnChainPaymentMessage(t, ctx, txHash4, invoiceHash, buyerAddress, ownerAddress, 1, 1, 50*100*doge.KoinuPerDoge, tokenisationStore)
 	processor.Process()
 
 	AssertTokenBalance(t, ctx, buyerAddress, hash, 50, tokenisationStore)
@@ -100,6 +104,7 @@ func TestPaymentIsLessThanExpected(t *testing.T) {
 	tokenisationStore := test_support.SetupTestDB(t)
 	rpcClient := support.NewTestDogeClient(t)
 	ctx := context.Background()
+	SaveChainPosition(t, ctx, 100, tokenisationStore)
 
 	hash := CreateUnconfirmedMint(t, ctx, support.GenerateRandomHash(), tokenisationStore)
 
@@ -125,6 +130,12 @@ func TestPaymentIsLessThanExpected(t *testing.T) {
 
 	AssertTokenBalance(t, ctx, buyerAddress, hash, 0, tokenisationStore)
 	AssertTokenBalance(t, ctx, ownerAddress, hash, 100, tokenisationStore)
+
+	// The shortfall is recorded as a partial payment towards the invoice
+	paid, overpaid, err := tokenisationStore.GetInvoicePaidKoinu(ctx, invoiceHash, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(49), paid)
+	assert.Equal(t, int64(0), overpaid)
 }
 
 func TestInvoiceTimesOutAfter14BlockDays(t *testing.T) {
@@ -148,9 +159,106 @@ func TestInvoiceTimesOutAfter14BlockDays(t *testing.T) {
 
 	AssertPendingTokenBalance(t, ctx, invoiceHash, hash, 50, tokenisationStore)
 
+	_, err := tokenisationStore.SaveWebhook(ctx, &store.Webhook{Url: "http://localhost/hook", Secret: "secret", EventTypes: []string{string(events.EventInvoiceTimedOut)}, CreatedAt: time.Now()})
+	assert.NilError(t, err)
+
 	invoiceTimeoutProcessor.Process(4)
 
 	AssertNoPendingTokenBalance(t, ctx, invoiceHash, hash, tokenisationStore)
+
+	deliveries, err := tokenisationStore.GetWebhookDeliveries(ctx, store.WebhookDeliveryPending, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(deliveries))
+	assert.Equal(t, string(events.EventInvoiceTimedOut), deliveries[0].EventType)
+	assert.Assert(t, strings.Contains(deliveries[0].Payload, invoiceHash))
+}
+
+func TestInvoiceExpiresAfterDeclaredHeight(t *testing.T) {
+	tokenisationStore := test_support.SetupTestDB(t)
+	rpcClient := support.NewTestDogeClient(t)
+	ctx := context.Background()
+	SaveChainPosition(t, ctx, 100, tokenisationStore)
+
+	hash := CreateUnconfirmedMint(t, ctx, support.GenerateRandomHash(), tokenisationStore)
+
+	invoiceTimeoutProcessor := service.NewInvoiceTimeoutProcessor(tokenisationStore)
+	processor := service.NewFractalEngineProcessor(tokenisationStore, rpcClient)
+	processor.Process()
+
+	buyerAddress := support.GenerateDogecoinAddress(true)
+	invoiceHash := support.GenerateRandomHash()
+
+	_, err := tokenisationStore.SaveUnconfirmedInvoice(ctx, &store.UnconfirmedInvoice{
+		Hash:            invoiceHash,
+		PaymentAddress:  ownerAddress,
+		BuyerAddress:    buyerAddress,
+		MintHash:        hash,
+		Quantity:        50,
+		Price:           100 * doge.KoinuPerDoge,
+		CreatedAt:       time.Now(),
+		SellerAddress:   ownerAddress,
+		ExpiresAtHeight: 10,
+	})
+	assert.NilError(t, err)
+
+	envelope := protocol.NewExpiringInvoiceTransactionEnvelope(invoiceHash, hash, 50, 10, protocol.ACTION_INVOICE)
+	assert.Equal(t, uint8(protocol.INVOICE_EXPIRY_VERSION), envelope.Version)
+	_, err = tokenisationStore.SaveOnChainTransaction(ctx, support.GenerateRandomHash(), 3, "blockHash", 1, protocol.ACTION_INVOICE, envelope.Version, envelope.Data, ownerAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+	processor.Process()
+
+	AssertPendingTokenBalance(t, ctx, invoiceHash, hash, 50, tokenisationStore)
+
+	_, err = tokenisationStore.SaveWebhook(ctx, &store.Webhook{Url: "http://localhost/hook", Secret: "secret", EventTypes: []string{string(events.EventInvoiceExpired)}, CreatedAt: time.Now()})
+	assert.NilError(t, err)
+
+	// The invoice can still be paid in its expiry block
+	assert.NilError(t, invoiceTimeoutProcessor.Expire(10))
+	AssertPendingTokenBalance(t, ctx, invoiceHash, hash, 50, tokenisationStore)
+
+	assert.NilError(t, invoiceTimeoutProcessor.Expire(11))
+	AssertNoPendingTokenBalance(t, ctx, invoiceHash, hash, tokenisationStore)
+
+	invoice, err := tokenisationStore.GetInvoiceByHash(ctx, invoiceHash)
+	assert.NilError(t, err)
+	assert.Equal(t, store.InvoiceStatusExpired, invoice.Status)
+
+	deliveries, err := tokenisationStore.GetWebhookDeliveries(ctx, store.WebhookDeliveryPending, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(deliveries))
+	assert.Equal(t, string(events.EventInvoiceExpired), deliveries[0].EventType)
+
+	// A payment mined after the expiry is discarded
+	CreateOnChainPaymentMessage(t, ctx, support.GenerateRandomHash(), invoiceHash, buyerAddress, ownerAddress, 11, 1, 50*100*doge.KoinuPerDoge, tokenisationStore)
+	processor.Process()
+
+	AssertTokenBalance(t, ctx, buyerAddress, hash, 0, tokenisationStore)
+	AssertTokenBalance(t, ctx, ownerAddress, hash, 100, tokenisationStore)
+	count, err := tokenisationStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestInvoiceMinedAfterExpiryIsDiscarded(t *testing.T) {
+	tokenisationStore := test_support.SetupTestDB(t)
+	rpcClient := support.NewTestDogeClient(t)
+	ctx := context.Background()
+
+	hash := CreateUnconfirmedMint(t, ctx, support.GenerateRandomHash(), tokenisationStore)
+
+	processor := service.NewFractalEngineProcessor(tokenisationStore, rpcClient)
+	processor.Process()
+
+	invoiceHash := support.GenerateRandomHash()
+	envelope := protocol.NewExpiringInvoiceTransactionEnvelope(invoiceHash, hash, 50, 10, protocol.ACTION_INVOICE)
+	_, err := tokenisationStore.SaveOnChainTransaction(ctx, support.GenerateRandomHash(), 11, "blockHash", 1, protocol.ACTION_INVOICE, envelope.Version, envelope.Data, ownerAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+	processor.Process()
+
+	AssertNoPendingTokenBalance(t, ctx, invoiceHash, hash, tokenisationStore)
+	count, err := tokenisationStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, count)
 }
 
 func AssertNoPendingTokenBalance(t *testing.T, ctx context.Context, invoiceHash string, mintHash string, tokenisationStore *store.TokenisationStore) {
@@ -195,7 +303,15 @@ func AssertTokenBalance(t *testing.T, ctx context.Context, s, hash string, i int
 	assert.Equal(t, i, totalQuantity)
 }
 
-func CreateOnChainPaymentMessage(t *testing.T, ctx context.Context, trxnHash string, invoiceHash string, buyerAddress string, sellerAddress string, blockHeight int64, trxnNo int, value float64, tokenisationStore *store.TokenisationStore) {
+// SaveChainPosition moves the follower to a height, which confirms the blocks below it.
+func SaveChainPosition(t *testing.T, ctx context.Context, blockHeight int64, tokenisationStore *store.TokenisationStore) {
+	err := tokenisationStore.UpsertChainPosition(ctx, blockHeight, "blockHash", false)
+	if err != nil {
+		t.Fatalf("Failed to save chain position: %v", err)
+	}
+}
+
+func CreateOnChainPaymentMessage(t *testing.T, ctx context.Context, trxnHash string, invoiceHash string, buyerAddress string, sellerAddress string, blockHeight int64, trxnNo int, koinu int64, tokenisationStore *store.TokenisationStore) {
 	message3 := protocol.OnChainPaymentMessage{
 		Hash: invoiceHash,
 	}
@@ -206,7 +322,7 @@ func CreateOnChainPaymentMessage(t *testing.T, ctx context.Context, trxnHash str
 	}
 
 	_, err = tokenisationStore.SaveOnChainTransaction(ctx, trxnHash, blockHeight, "blockHash", trxnNo, protocol.ACTION_PAYMENT, protocol.DEFAULT_VERSION, encodedMessage3, buyerAddress, map[string]interface{}{
-		sellerAddress: value,
+		sellerAddress: koinu,
 	})
 	if err != nil {
 		t.Fatalf("Failed to save on chain transaction: %v", err)
@@ -220,7 +336,7 @@ func SaveUnconfirmedInvoice(t *testing.T, ctx context.Context, ownerAddress stri
 		BuyerAddress:   buyerAddress,
 		MintHash:       mintHash,
 		Quantity:       quantity,
-		Price:          100,
+		Price:          100 * doge.KoinuPerDoge,
 		CreatedAt:      time.Now(),
 		SellerAddress:  ownerAddress,
 	})
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"dogecoin.org/fractal-engine/pkg/metrics"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+)
+
+var (
+	ErrUnknownAction        = errors.New("unknown action")
+	ErrUnknownActionVersion = errors.New("unknown action version")
+)
+
+// ActionHandler processes one on-chain action. Each action's processor implements it.
+type ActionHandler interface {
+	Process(tx store.OnChainTransaction) error
+}
+
+// StoreBinder is implemented by handlers that can be rebound to another store. The
+// actions of an atomic batch are applied by handlers bound to a store scoped to one
+// database transaction.
+type StoreBinder interface {
+	WithStore(tokenStore *store.TokenisationStore) ActionHandler
+}
+
+// bindHandler returns the handler bound to the store, or the handler itself when it
+// cannot be rebound.
+func bindHandler(handler ActionHandler, tokenStore *store.TokenisationStore) ActionHandler {
+	if binder, ok := handler.(StoreBinder); ok {
+		return binder.WithStore(tokenStore)
+	}
+
+	return handler
+}
+
+// ActionHandlerFunc adapts a function to an ActionHandler.
+type ActionHandlerFunc func(tx store.OnChainTransaction) error
+
+func (f ActionHandlerFunc) Process(tx store.OnChainTransaction) error {
+	return f(tx)
+}
+
+type actionKey struct {
+	action  uint8
+	version uint8
+}
+
+type actionRule struct {
+	activationHeight int64
+	handler          ActionHandler
+}
+
+/*
+* ActionRegistry maps an (action, version) pair to the handler that applies it.
+* Every handler has an activation height: a transaction mined below it is treated as
+* if the version did not exist. Registering the same version again with a later
+* activation height switches to the new rules from that height, and registering a
+* nil handler retires the version. This lets message formats evolve by agreeing on
+* a height instead of upgrading every node at once.
+ */
+type ActionRegistry struct {
+	rules map[actionKey][]actionRule
+}
+
+func NewActionRegistry() *ActionRegistry {
+	return &ActionRegistry{rules: make(map[actionKey][]actionRule)}
+}
+
+// NewDefaultActionRegistry registers the processors for every on-chain action of the
+// current protocol version from the genesis block. Each processor waits for the
+// confirmations the gate requires before it runs.
+func NewDefaultActionRegistry(tokenStore *store.TokenisationStore, gate *ConfirmationGate) *ActionRegistry {
+	registry := NewActionRegistry()
+
+	registry.Register(protocol.ACTION_MINT, protocol.DEFAULT_VERSION, 0, gate.Wrap(NewMintProcessor(tokenStore)))
+	registry.Register(protocol.ACTION_INVOICE, protocol.DEFAULT_VERSION, 0, gate.Wrap(NewInvoiceProcessor(tokenStore)))
+	registry.Register(protocol.ACTION_INVOICE, protocol.INVOICE_EXPIRY_VERSION, 0, gate.Wrap(NewInvoiceProcessor(tokenStore)))
+	registry.Register(protocol.ACTION_PAYMENT, protocol.DEFAULT_VERSION, 0, gate.Wrap(NewPaymentProcessor(tokenStore)))
+	registry.Register(protocol.ACTION_TRANSFER, protocol.DEFAULT_VERSION, 0, gate.Wrap(NewTransferProcessor(tokenStore)))
+	registry.Register(protocol.ACTION_DELETE_BUY_OFFER, protocol.DEFAULT_VERSION, 0, gate.Wrap(NewDeleteOfferProcessor(tokenStore)))
+	registry.Register(protocol.ACTION_DELETE_SELL_OFFER, protocol.DEFAULT_VERSION, 0, gate.Wrap(NewDeleteOfferProcessor(tokenStore)))
+	registry.Register(protocol.ACTION_BURN, protocol.DEFAULT_VERSION, 0, gate.Wrap(NewBurnProcessor(tokenStore)))
+	registry.Register(protocol.ACTION_CANCEL_INVOICE, protocol.DEFAULT_VERSION, 0, gate.Wrap(NewCancelInvoiceProcessor(tokenStore)))
+	registry.Register(protocol.ACTION_AMEND_MINT, protocol.DEFAULT_VERSION, 0, gate.Wrap(NewMintAmendmentProcessor(tokenStore)))
+	registry.Register(protocol.ACTION_TRANSFER_MINT_OWNERSHIP, protocol.DEFAULT_VERSION, 0, gate.Wrap(NewMintOwnershipTransferProcessor(tokenStore)))
+	registry.Register(protocol.ACTION_DISTRIBUTION_PAYMENT, protocol.DEFAULT_VERSION, 0, gate.Wrap(NewDistributionPaymentProcessor(tokenStore)))
+
+	return registry
+}
+
+// Register sets the handler for an action version from the activation height onwards.
+func (r *ActionRegistry) Register(action uint8, version uint8, activationHeight int64, handler ActionHandler) {
+	key := actionKey{action: action, version: version}
+
+	rules := r.rules[key]
+	for i, rule := range rules {
+		if rule.activationHeight == activationHeight {
+			rules[i].handler = handler
+			return
+		}
+	}
+
+	rules = append(rules, actionRule{activationHeight: activationHeight, handler: handler})
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].activationHeight < rules[j].activationHeight
+	})
+	r.rules[key] = rules
+}
+
+// Lookup returns the handler for an action version at a block height. It returns
+// ErrUnknownAction when no version of the action is registered and
+// ErrUnknownActionVersion when the version is unknown, not yet active or retired.
+func (r *ActionRegistry) Lookup(action uint8, version uint8, height int64) (ActionHandler, error) {
+	rules, ok := r.rules[actionKey{action: action, version: version}]
+	if !ok {
+		if r.hasAction(action) {
+			return nil, fmt.Errorf("%w: %s version %d", ErrUnknownActionVersion, metrics.ActionName(action), version)
+		}
+
+		return nil, fmt.Errorf("%w: %d", ErrUnknownAction, action)
+	}
+
+	var handler ActionHandler
+	for _, rule := range rules {
+		if rule.activationHeight > height {
+			break
+		}
+		handler = rule.handler
+	}
+
+	if handler == nil {
+		return nil, fmt.Errorf("%w: %s version %d is not active at height %d", ErrUnknownActionVersion, metrics.ActionName(action), version, height)
+	}
+
+	return handler, nil
+}
+
+func (r *ActionRegistry) hasAction(action uint8) bool {
+	for key := range r.rules {
+		if key.action == action {
+			return true
+		}
+	}
+
+	return false
+}
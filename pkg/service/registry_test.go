@@ -0,0 +1,101 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/service"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func versionHandler(version string, processed *string) service.ActionHandler {
+	return service.ActionHandlerFunc(func(tx store.OnChainTransaction) error {
+		*processed = version
+		return nil
+	})
+}
+
+func TestActionRegistryRejectsUnknownActionsAndVersions(t *testing.T) {
+	var processed string
+	registry := service.NewActionRegistry()
+	registry.Register(protocol.ACTION_INVOICE, 1, 0, versionHandler("v1", &processed))
+
+	_, err := registry.Lookup(protocol.ACTION_PAYMENT, 1, 100)
+	assert.Assert(t, errors.Is(err, service.ErrUnknownAction))
+
+	_, err = registry.Lookup(protocol.ACTION_INVOICE, 2, 100)
+	assert.Assert(t, errors.Is(err, service.ErrUnknownActionVersion))
+
+	handler, err := registry.Lookup(protocol.ACTION_INVOICE, 1, 100)
+	assert.NilError(t, err)
+	assert.NilError(t, handler.Process(store.OnChainTransaction{}))
+	assert.Equal(t, processed, "v1")
+}
+
+func TestActionRegistryActivationHeights(t *testing.T) {
+	var processed string
+	registry := service.NewActionRegistry()
+	registry.Register(protocol.ACTION_INVOICE, 1, 0, versionHandler("v1", &processed))
+	registry.Register(protocol.ACTION_INVOICE, 2, 1000, versionHandler("v2", &processed))
+
+	// Version 2 is unknown until its activation height
+	_, err := registry.Lookup(protocol.ACTION_INVOICE, 2, 999)
+	assert.Assert(t, errors.Is(err, service.ErrUnknownActionVersion))
+
+	handler, err := registry.Lookup(protocol.ACTION_INVOICE, 2, 1000)
+	assert.NilError(t, err)
+	assert.NilError(t, handler.Process(store.OnChainTransaction{}))
+	assert.Equal(t, processed, "v2")
+
+	// Rules for a version can be switched at a height, and a nil handler retires it
+	registry.Register(protocol.ACTION_INVOICE, 1, 500, versionHandler("v1 amended", &processed))
+	registry.Register(protocol.ACTION_INVOICE, 1, 2000, nil)
+
+	handler, err = registry.Lookup(protocol.ACTION_INVOICE, 1, 499)
+	assert.NilError(t, err)
+	assert.NilError(t, handler.Process(store.OnChainTransaction{}))
+	assert.Equal(t, processed, "v1")
+
+	handler, err = registry.Lookup(protocol.ACTION_INVOICE, 1, 1500)
+	assert.NilError(t, err)
+	assert.NilError(t, handler.Process(store.OnChainTransaction{}))
+	assert.Equal(t, processed, "v1 amended")
+
+	_, err = registry.Lookup(protocol.ACTION_INVOICE, 1, 2000)
+	assert.Assert(t, errors.Is(err, service.ErrUnknownActionVersion))
+}
+
+func TestProcessDispatchesByActionVersion(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+	rpcClient := test_support.NewTestDogeClient(t)
+
+	processor := service.NewFractalEngineProcessor(tokenStore, rpcClient)
+
+	var processed []string
+	processor.Registry().Register(protocol.ACTION_INVOICE, 3, 10, service.ActionHandlerFunc(func(tx store.OnChainTransaction) error {
+		processed = append(processed, tx.TxHash)
+		return tokenStore.RemoveOnChainTransaction(ctx, tx.Id)
+	}))
+
+	// A version 3 invoice before its activation height and a version 4 invoice are rejected
+	_, err := tokenStore.SaveOnChainTransaction(ctx, "txEarlyV3", 5, "blockHash", 0, protocol.ACTION_INVOICE, 3, []byte{}, "ownerAddress", map[string]interface{}{})
+	assert.NilError(t, err)
+	_, err = tokenStore.SaveOnChainTransaction(ctx, "txV3", 10, "blockHash", 0, protocol.ACTION_INVOICE, 3, []byte{}, "ownerAddress", map[string]interface{}{})
+	assert.NilError(t, err)
+	_, err = tokenStore.SaveOnChainTransaction(ctx, "txV4", 11, "blockHash", 0, protocol.ACTION_INVOICE, 4, []byte{}, "ownerAddress", map[string]interface{}{})
+	assert.NilError(t, err)
+
+	err = processor.Process()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, processed, []string{"txV3"})
+
+	count, err := tokenStore.CountAllOnChainTransactions(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, count, 0)
+}
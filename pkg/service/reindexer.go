@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"dogecoin.org/fractal-engine/pkg/config"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/followerer"
+	"dogecoin.org/fractal-engine/pkg/store"
+
+	cfconfig "github.com/dogecoinfoundation/chainfollower/pkg/config"
+	"github.com/dogecoinfoundation/chainfollower/pkg/rpc"
+	"github.com/dogecoinfoundation/chainfollower/pkg/types"
+)
+
+// BlockSource fetches blocks of the best chain from the Dogecoin node.
+type BlockSource interface {
+	GetBlockHash(height int64) (string, error)
+	GetBlock(hash string) (*types.Block, error)
+}
+
+/*
+* The Reindexer rebuilds the derived state from a given height after a processor bug has
+* corrupted it. The state is rolled back to the height, which returns mints and invoices
+* confirmed above it to their unconfirmed tables and leaves gossip-only data such as
+* signatures untouched. The blocks up to the previous chain position are then fetched
+* from the node again and replayed one at a time, each saved exactly as the follower
+* saves it and processed before the next, so the replay is deterministic. The engine
+* must not be running while a reindex is in progress.
+ */
+type Reindexer struct {
+	store     *store.TokenisationStore
+	processor *FractalEngineProcessor
+	blocks    BlockSource
+	prevOuts  followerer.PrevOutResolver
+	prefix    byte
+	timeouts  *InvoiceTimeoutProcessor
+}
+
+func NewReindexer(cfg *config.Config, store *store.TokenisationStore, dogeClient *doge.RpcClient) *Reindexer {
+	rpcClient := rpc.NewRpcTransport(&cfconfig.Config{
+		RpcUrl:  cfg.DogeScheme + "://" + cfg.DogeHost + ":" + cfg.DogePort,
+		RpcUser: cfg.DogeUser,
+		RpcPass: cfg.DogePassword,
+	})
+
+	processor := NewFractalEngineProcessorWithPolicy(store, dogeClient, cfg.Confirmations)
+
+	return NewReindexerWithBlockSource(cfg, store, processor, rpcClient, followerer.NewRpcPrevOutResolver(rpcClient))
+}
+
+func NewReindexerWithBlockSource(cfg *config.Config, store *store.TokenisationStore, processor *FractalEngineProcessor, blocks BlockSource, prevOuts followerer.PrevOutResolver) *Reindexer {
+	return &Reindexer{store: store, processor: processor, blocks: blocks, prevOuts: prevOuts, prefix: followerer.AddressPrefix(cfg.DogeNetChain), timeouts: NewInvoiceTimeoutProcessor(store)}
+}
+
+// Reindex rolls the state back to the given height and replays the blocks above it up
+// to the chain position the engine had reached. It returns the height replayed to.
+func (r *Reindexer) Reindex(ctx context.Context, fromHeight int64) (int64, error) {
+	toHeight, _, _, err := r.store.GetChainPosition(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if fromHeight < 0 || fromHeight > toHeight {
+		return 0, fmt.Errorf("cannot reindex from height %d, the chain position is %d", fromHeight, toHeight)
+	}
+
+	blockHash, err := r.blocks.GetBlockHash(fromHeight)
+	if err != nil {
+		return 0, fmt.Errorf("block hash at height %d: %w", fromHeight, err)
+	}
+
+	err = r.store.RollbackToHeight(ctx, fromHeight)
+	if err != nil {
+		return 0, err
+	}
+
+	err = r.store.UpsertChainPosition(ctx, fromHeight, blockHash, false)
+	if err != nil {
+		return 0, err
+	}
+
+	// Transactions left over from below the height are retried before the first block
+	err = r.processor.ProcessPending(ctx)
+	if err != nil {
+		return fromHeight, err
+	}
+
+	for height := fromHeight + 1; height <= toHeight; height++ {
+		err = r.replayBlock(ctx, height)
+		if err != nil {
+			return height - 1, err
+		}
+
+		if height%1000 == 0 {
+			log.Println("Reindexed to height:", height)
+		}
+	}
+
+	return toHeight, nil
+}
+
+// replayBlock saves the block at a height and moves the chain position to it before
+// processing, as the follower does, so that confirmations are counted from the block
+// being replayed and the follower carries on from it if the reindex is interrupted.
+// Invoices are then expired at the height, as the trimmer does once the block has been
+// processed, so that expiries rolled back above the fork point are applied again.
+func (r *Reindexer) replayBlock(ctx context.Context, height int64) error {
+	blockHash, err := r.blocks.GetBlockHash(height)
+	if err != nil {
+		return fmt.Errorf("block hash at height %d: %w", height, err)
+	}
+
+	block, err := r.blocks.GetBlock(blockHash)
+	if err != nil {
+		return fmt.Errorf("block %s: %w", blockHash, err)
+	}
+
+	err = followerer.SaveBlock(ctx, r.store, block, r.prevOuts, r.prefix)
+	if err != nil {
+		return fmt.Errorf("block %s: %w", blockHash, err)
+	}
+
+	err = r.store.UpsertChainPosition(ctx, height, blockHash, false)
+	if err != nil {
+		return err
+	}
+
+	err = r.processor.ProcessPending(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.timeouts.Expire(height)
+}
@@ -0,0 +1,286 @@
+package service_test
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+	"testing"
+	"time"
+
+	"dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/config"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/followerer"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/service"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"github.com/dogecoinfoundation/chainfollower/pkg/rpc"
+	"github.com/dogecoinfoundation/chainfollower/pkg/types"
+	"github.com/shopspring/decimal"
+	"gotest.tools/assert"
+)
+
+type ownerPrevOutResolver struct {
+	address string
+}
+
+func (r *ownerPrevOutResolver) GetPrevOut(txId string, vout int) (types.RawTxnVOut, error) {
+	return types.RawTxnVOut{
+		N:            vout,
+		ScriptPubKey: types.RawTxnScriptPubKey{Type: "pubkeyhash", Addresses: []string{r.address}},
+	}, nil
+}
+
+func TestReindexRebuildsStateFromHeight(t *testing.T) {
+	tokenisationStore := support.SetupTestDB(t)
+	rpcClient := support.NewTestDogeClient(t)
+	ctx := context.Background()
+
+	mint := store.MintWithoutID{
+		Title:         "Reindexed Mint",
+		FractionCount: 100,
+	}
+	mintHash, err := mint.GenerateHash()
+	assert.NilError(t, err)
+	mint.Hash = mintHash
+
+	_, err = tokenisationStore.SaveUnconfirmedMint(ctx, &mint)
+	assert.NilError(t, err)
+
+	envelope := protocol.NewMintTransactionEnvelope(mintHash, protocol.ACTION_MINT)
+
+	// The mint is confirmed in block 2 of a four block chain
+	blocks := rpc.NewTestRpcTransport()
+	for height := int64(0); height <= 3; height++ {
+		block := &types.Block{Hash: "block" + strconv.FormatInt(height, 10), Height: height}
+		if height == 2 {
+			block.Tx = []types.RawTxn{{
+				Hash: "mintTx",
+				VIn:  []types.RawTxnVIn{{TxID: "PREVTX", VOut: 0}},
+				VOut: []types.RawTxnVOut{{
+					ScriptPubKey: types.RawTxnScriptPubKey{Asm: "OP_RETURN " + hex.EncodeToString(envelope.Serialize())},
+					Value:        decimal.Zero,
+				}},
+			}}
+		}
+		assert.NilError(t, blocks.AddBlockAndHeader(block, &types.BlockHeader{Hash: block.Hash}))
+	}
+	assert.NilError(t, blocks.SetBlockCount(3))
+
+	processor := service.NewFractalEngineProcessor(tokenisationStore, rpcClient)
+	reindexer := service.NewReindexerWithBlockSource(&config.Config{}, tokenisationStore, processor, blocks, &ownerPrevOutResolver{address: ownerAddress})
+
+	assert.NilError(t, tokenisationStore.UpsertChainPosition(ctx, 3, "block3", false))
+
+	height, err := reindexer.Reindex(ctx, 0)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(3), height)
+	AssertTokenBalance(t, ctx, ownerAddress, mintHash, 100, tokenisationStore)
+
+	// A processor bug over-issues fractions of the mint in block 2
+	holderAddress := support.GenerateDogecoinAddress(true)
+	assert.NilError(t, tokenisationStore.PostToLedger(ctx, store.LedgerPosting{
+		MintHash:    mintHash,
+		FromAccount: store.LedgerAccountIssued,
+		ToAccount:   holderAddress,
+		Quantity:    5,
+		ActionType:  protocol.ACTION_MINT,
+		Block:       store.BlockRef{Height: 2, Hash: "block2", TransactionHash: "mintTx"},
+	}, nil))
+	assert.ErrorContains(t, tokenisationStore.CheckLedgerInvariants(ctx, mintHash), "105 fractions held, expected 100")
+
+	// The gossiped mint is kept by the rollback so the replayed block confirms it again
+	height, err = reindexer.Reindex(ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(3), height)
+	assert.NilError(t, tokenisationStore.CheckLedgerInvariants(ctx, mintHash))
+	AssertTokenBalance(t, ctx, ownerAddress, mintHash, 100, tokenisationStore)
+
+	holder, err := tokenisationStore.GetLedgerBalance(ctx, holderAddress, mintHash, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, holder)
+
+	mints, err := tokenisationStore.GetMints(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(mints))
+	assert.Equal(t, "mintTx", mints[0].TransactionHash)
+
+	blockHeight, blockHash, _, err := tokenisationStore.GetChainPosition(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(3), blockHeight)
+	assert.Equal(t, "block3", blockHash)
+
+	_, err = reindexer.Reindex(ctx, 4)
+	assert.ErrorContains(t, err, "cannot reindex from height 4")
+}
+
+func TestReindexExpiresInvoicesAgain(t *testing.T) {
+	tokenisationStore := support.SetupTestDB(t)
+	rpcClient := support.NewTestDogeClient(t)
+	ctx := context.Background()
+
+	hash := CreateUnconfirmedMint(t, ctx, support.GenerateRandomHash(), tokenisationStore)
+
+	processor := service.NewFractalEngineProcessor(tokenisationStore, rpcClient)
+	processor.Process()
+
+	invoiceHash := support.GenerateRandomHash()
+	_, err := tokenisationStore.SaveUnconfirmedInvoice(ctx, &store.UnconfirmedInvoice{
+		Hash:            invoiceHash,
+		PaymentAddress:  ownerAddress,
+		BuyerAddress:    support.GenerateDogecoinAddress(true),
+		MintHash:        hash,
+		Quantity:        50,
+		Price:           100 * doge.KoinuPerDoge,
+		CreatedAt:       time.Now(),
+		SellerAddress:   ownerAddress,
+		ExpiresAtHeight: 1,
+	})
+	assert.NilError(t, err)
+
+	// The invoice is confirmed in block 1 and expires at block 2
+	envelope := protocol.NewExpiringInvoiceTransactionEnvelope(invoiceHash, hash, 50, 1, protocol.ACTION_INVOICE)
+	_, err = tokenisationStore.SaveOnChainTransaction(ctx, support.GenerateRandomHash(), 1, "blockHash", 1, protocol.ACTION_INVOICE, envelope.Version, envelope.Data, ownerAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+	processor.Process()
+
+	assert.NilError(t, service.NewInvoiceTimeoutProcessor(tokenisationStore).Expire(2))
+	AssertNoPendingTokenBalance(t, ctx, invoiceHash, hash, tokenisationStore)
+
+	blocks := rpc.NewTestRpcTransport()
+	for height := int64(0); height <= 3; height++ {
+		block := &types.Block{Hash: "block" + strconv.FormatInt(height, 10), Height: height}
+		assert.NilError(t, blocks.AddBlockAndHeader(block, &types.BlockHeader{Hash: block.Hash}))
+	}
+	assert.NilError(t, blocks.SetBlockCount(3))
+	assert.NilError(t, tokenisationStore.UpsertChainPosition(ctx, 3, "block3", false))
+
+	// The rollback reopens the invoice and the replayed blocks expire it again
+	reindexer := service.NewReindexerWithBlockSource(&config.Config{}, tokenisationStore, processor, blocks, &ownerPrevOutResolver{address: ownerAddress})
+	_, err = reindexer.Reindex(ctx, 1)
+	assert.NilError(t, err)
+
+	AssertNoPendingTokenBalance(t, ctx, invoiceHash, hash, tokenisationStore)
+
+	invoice, err := tokenisationStore.GetInvoiceByHash(ctx, invoiceHash)
+	assert.NilError(t, err)
+	assert.Equal(t, store.InvoiceStatusExpired, invoice.Status)
+}
+
+type reindexedState struct {
+	SenderBalance   int
+	ReceiverBalance int
+	MintTxHashes    []string
+	PendingTxs      int
+	ChainHeight     int64
+	ChainHash       string
+}
+
+func snapshotReindexedState(t *testing.T, ctx context.Context, tokenisationStore *store.TokenisationStore, mintHash string, senderAddress string, receiverAddress string) reindexedState {
+	var state reindexedState
+	var err error
+
+	state.SenderBalance, err = tokenisationStore.GetLedgerBalance(ctx, senderAddress, mintHash, nil)
+	assert.NilError(t, err)
+	state.ReceiverBalance, err = tokenisationStore.GetLedgerBalance(ctx, receiverAddress, mintHash, nil)
+	assert.NilError(t, err)
+
+	mints, err := tokenisationStore.GetMints(ctx, 0, 10)
+	assert.NilError(t, err)
+	for _, mint := range mints {
+		state.MintTxHashes = append(state.MintTxHashes, mint.TransactionHash)
+	}
+
+	txs, err := tokenisationStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	state.PendingTxs = len(txs)
+
+	state.ChainHeight, state.ChainHash, _, err = tokenisationStore.GetChainPosition(ctx)
+	assert.NilError(t, err)
+
+	return state
+}
+
+func TestReindexMatchesTheStateFollowedLive(t *testing.T) {
+	tokenisationStore := support.SetupTestDB(t)
+	rpcClient := support.NewTestDogeClient(t)
+	ctx := context.Background()
+
+	privHex, pubHex, senderAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	_, _, receiverAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	mint := store.MintWithoutID{
+		Title:         "Followed Mint",
+		FractionCount: 100,
+	}
+	mintHash, err := mint.GenerateHash()
+	assert.NilError(t, err)
+	mint.Hash = mintHash
+
+	_, err = tokenisationStore.SaveUnconfirmedMint(ctx, &mint)
+	assert.NilError(t, err)
+
+	transfer := newSignedTransfer(t, privHex, pubHex, senderAddress, receiverAddress, mintHash, 40)
+	_, err = tokenisationStore.SaveTokenTransfer(ctx, &transfer)
+	assert.NilError(t, err)
+
+	envelopes := map[int64]protocol.MessageEnvelope{
+		2: protocol.NewMintTransactionEnvelope(mintHash, protocol.ACTION_MINT),
+		3: protocol.NewTransferTransactionEnvelope(transfer.Hash, mintHash, protocol.ACTION_TRANSFER),
+	}
+
+	// The mint is confirmed in block 2 and the transfer in block 3, the chain tip
+	blocks := rpc.NewTestRpcTransport()
+	for height := int64(0); height <= 3; height++ {
+		block := &types.Block{Hash: "block" + strconv.FormatInt(height, 10), Height: height, Time: int(1700000000 + height*60)}
+		if envelope, ok := envelopes[height]; ok {
+			block.Tx = []types.RawTxn{{
+				Hash: "tx" + strconv.FormatInt(height, 10),
+				VIn:  []types.RawTxnVIn{{TxID: "PREVTX", VOut: 0}},
+				VOut: []types.RawTxnVOut{{
+					ScriptPubKey: types.RawTxnScriptPubKey{Asm: "OP_RETURN " + hex.EncodeToString(envelope.Serialize())},
+					Value:        decimal.Zero,
+				}},
+			}}
+		}
+		assert.NilError(t, blocks.AddBlockAndHeader(block, &types.BlockHeader{Hash: block.Hash}))
+	}
+	assert.NilError(t, blocks.SetBlockCount(3))
+
+	// Each action is applied once it has a confirmation, counting its own block
+	processor := service.NewFractalEngineProcessorWithPolicy(tokenisationStore, rpcClient, config.ConfirmationPolicy{"mint": 1, "transfer": 1})
+	resolver := &ownerPrevOutResolver{address: senderAddress}
+	prefix := followerer.AddressPrefix("")
+
+	// Follow the chain as the engine does: save the block, move to it, then process
+	for height := int64(0); height <= 3; height++ {
+		blockHash, err := blocks.GetBlockHash(height)
+		assert.NilError(t, err)
+		block, err := blocks.GetBlock(blockHash)
+		assert.NilError(t, err)
+
+		assert.NilError(t, followerer.SaveBlock(ctx, tokenisationStore, block, resolver, prefix))
+		assert.NilError(t, tokenisationStore.UpsertChainPosition(ctx, height, blockHash, false))
+		assert.NilError(t, processor.ProcessPending(ctx))
+	}
+
+	followed := snapshotReindexedState(t, ctx, tokenisationStore, mintHash, senderAddress, receiverAddress)
+	assert.DeepEqual(t, reindexedState{
+		SenderBalance:   60,
+		ReceiverBalance: 40,
+		MintTxHashes:    []string{"tx2"},
+		PendingTxs:      0,
+		ChainHeight:     3,
+		ChainHash:       "block3",
+	}, followed)
+
+	reindexer := service.NewReindexerWithBlockSource(&config.Config{}, tokenisationStore, processor, blocks, resolver)
+
+	height, err := reindexer.Reindex(ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(3), height)
+	assert.DeepEqual(t, followed, snapshotReindexedState(t, ctx, tokenisationStore, mintHash, senderAddress, receiverAddress))
+	assert.NilError(t, tokenisationStore.CheckLedgerInvariants(ctx, mintHash))
+}
@@ -17,14 +17,17 @@ import (
 
 type TokenisationService struct {
 	governor.ServiceCtx
-	RpcServer      *rpc.RpcServer
-	Store          *store.TokenisationStore
-	DogeNetClient  *dogenet.DogeNetClient
-	DogeClient     *doge.RpcClient
-	Follower       *followerer.DogeFollower
-	TrimmerService *TrimmerService
-	Processor      *FractalEngineProcessor
-	HealthService  *health.HealthService
+	RpcServer       *rpc.RpcServer
+	Store           *store.TokenisationStore
+	DogeNetClient   *dogenet.DogeNetClient
+	DogeClient      *doge.RpcClient
+	Follower        *followerer.DogeFollower
+	TrimmerService  *TrimmerService
+	MatchingService *MatchingService
+	WebhookService  *WebhookService
+	Processor       *FractalEngineProcessor
+	HealthService   *health.HealthService
+	Auditor         *Auditor
 }
 
 func NewTokenisationService(cfg *config.Config, dogenetClient *dogenet.DogeNetClient, tokenStore *store.TokenisationStore) *TokenisationService {
@@ -32,18 +35,24 @@ func NewTokenisationService(cfg *config.Config, dogenetClient *dogenet.DogeNetCl
 	follower := followerer.NewFollower(cfg, tokenStore)
 
 	trimmerService := NewTrimmerService(20160, 100, tokenStore, dogeClient)
-	processor := NewFractalEngineProcessor(tokenStore, dogeClient)
+	matchingService := NewMatchingService(tokenStore)
+	webhookService := NewWebhookService(tokenStore)
+	processor := NewFractalEngineProcessorWithPolicy(tokenStore, dogeClient, cfg.Confirmations)
 	healthService := health.NewHealthService(dogeClient, tokenStore)
+	auditor := NewAuditor(tokenStore, 5*time.Minute)
 
 	return &TokenisationService{
-		RpcServer:      rpc.NewRpcServer(cfg, tokenStore, dogenetClient, dogeClient),
-		Store:          tokenStore,
-		DogeNetClient:  dogenetClient,
-		DogeClient:     dogeClient,
-		Follower:       follower,
-		TrimmerService: trimmerService,
-		Processor:      processor,
-		HealthService:  healthService,
+		RpcServer:       rpc.NewRpcServer(cfg, tokenStore, dogenetClient, dogeClient),
+		Store:           tokenStore,
+		DogeNetClient:   dogenetClient,
+		DogeClient:      dogeClient,
+		Follower:        follower,
+		TrimmerService:  trimmerService,
+		MatchingService: matchingService,
+		WebhookService:  webhookService,
+		Processor:       processor,
+		HealthService:   healthService,
+		Auditor:         auditor,
 	}
 }
 
@@ -72,11 +81,19 @@ func (s *TokenisationService) Run() {
 
 	log.Println("Migration successful")
 
+	err := s.Follower.CheckTxIndex()
+	if err != nil {
+		log.Fatalf("Cannot follow the chain: %v", err)
+	}
+
 	go s.HealthService.Start()
 	go s.RpcServer.Start()
 	go s.Follower.Start()
 	go s.TrimmerService.Start()
+	go s.MatchingService.Start()
+	go s.WebhookService.Start()
 	go s.Processor.Start()
+	go s.Auditor.Start()
 }
 
 func (s *TokenisationService) waitForFollower() {
@@ -110,9 +127,12 @@ func (s *TokenisationService) Stop() {
 	ctx := context.Background()
 
 	s.HealthService.Stop()
+	s.Auditor.Stop()
 	s.Processor.Stop()
 	s.Follower.Stop()
 	s.Store.Close(ctx)
 	s.RpcServer.Stop()
 	s.TrimmerService.Stop()
+	s.MatchingService.Stop()
+	s.WebhookService.Stop()
 }
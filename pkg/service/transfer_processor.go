@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"log"
+
+	"dogecoin.org/fractal-engine/pkg/metrics"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"dogecoin.org/fractal-engine/pkg/validation"
+	"google.golang.org/protobuf/proto"
+)
+
+type TransferProcessor struct {
+	store *store.TokenisationStore
+}
+
+func NewTransferProcessor(store *store.TokenisationStore) *TransferProcessor {
+	return &TransferProcessor{store: store}
+}
+
+func (p *TransferProcessor) WithStore(store *store.TokenisationStore) ActionHandler {
+	return NewTransferProcessor(store)
+}
+
+/*
+* Transfers are authorised by the sender signing the gossiped transfer with a key that
+* may sign for the debited address, and by the sender spending their own outputs on L1.
+* If the transfer has not been gossiped yet, the on-chain transaction is kept so that
+* it can be matched on a later pass (until it is trimmed).
+* Malformed, unauthorised, already applied or unfunded transfers are discarded.
+ */
+func (p *TransferProcessor) Process(tx store.OnChainTransaction) error {
+	ctx := context.Background()
+	message := protocol.OnChainTransferMessage{}
+	err := proto.Unmarshal(tx.ActionData, &message)
+	if err != nil {
+		log.Println("Error unmarshalling transfer:", err)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	if len(message.TransferHash) != 32 || len(message.MintHash) != 32 {
+		log.Println("Invalid hash in transfer")
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	transfer, err := p.store.GetTokenTransfer(ctx, hex.EncodeToString(message.TransferHash))
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Println("Transfer not matched yet:", tx.TxHash)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if transfer.MintHash != hex.EncodeToString(message.MintHash) || transfer.BlockHeight != 0 {
+		log.Println("Transfer discarded, transfer is for another mint or already applied:", transfer.Hash)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	if transfer.FromAddress != tx.Address {
+		log.Println("Transfer discarded, tokens cannot be transferred by:", tx.Address)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	if err := transfer.Validate(); err != nil {
+		log.Println("Transfer discarded:", err)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	if err := validation.ValidateOwnerPublicKey(transfer.FromAddress, transfer.PublicKey, transfer.RedeemScript); err != nil {
+		log.Println("Transfer discarded, not signed for the sender:", err)
+		return discardOnChainTransaction(ctx, p.store, tx)
+	}
+
+	err = p.store.ProcessTransfer(ctx, transfer, tx)
+	if errors.Is(err, store.ErrActionRejected) {
+		log.Println("Transfer discarded:", err)
+		return discarded(tx)
+	}
+	if err != nil {
+		log.Println("Error processing transfer:", err)
+		return err
+	}
+
+	log.Println("Matched transfer:", tx.TxHash)
+	metrics.RecordProcessorOutcome(tx.ActionType, metrics.OutcomeMatched)
+	notifyBalanceChanged(ctx, p.store, tx, transfer.MintHash, transfer.Quantity, transfer.FromAddress, transfer.ToAddress)
+	return nil
+}
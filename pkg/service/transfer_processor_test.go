@@ -0,0 +1,152 @@
+package service_test
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"dogecoin.org/fractal-engine/internal/test/support"
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/events"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/service"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+	"gotest.tools/assert"
+)
+
+func newSignedTransfer(t *testing.T, privHex string, pubHex string, fromAddress string, toAddress string, mintHash string, quantity int) store.TokenTransfer {
+	transfer := store.TokenTransfer{
+		MintHash:    mintHash,
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		Quantity:    quantity,
+		Nonce:       time.Now().UnixNano(),
+		PublicKey:   pubHex,
+		CreatedAt:   time.Now(),
+	}
+
+	signature, err := doge.SignPayload(transfer.Body(), privHex, pubHex)
+	assert.NilError(t, err)
+	transfer.Signature = signature
+
+	transfer.Hash, err = transfer.GenerateHash()
+	assert.NilError(t, err)
+
+	return transfer
+}
+
+func saveOnChainTransfer(t *testing.T, ctx context.Context, tokenStore *store.TokenisationStore, senderAddress string, transfer store.TokenTransfer) store.OnChainTransaction {
+	transferHashBytes, err := hex.DecodeString(transfer.Hash)
+	assert.NilError(t, err)
+	mintHashBytes, err := hex.DecodeString(transfer.MintHash)
+	assert.NilError(t, err)
+
+	encodedMessage, err := proto.Marshal(&protocol.OnChainTransferMessage{
+		TransferHash: transferHashBytes,
+		MintHash:     mintHashBytes,
+	})
+	assert.NilError(t, err)
+
+	id, err := tokenStore.SaveOnChainTransaction(ctx, support.GenerateRandomHash(), 2, "blockHash", 1, protocol.ACTION_TRANSFER, protocol.DEFAULT_VERSION, encodedMessage, senderAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+
+	return *findInvoiceTransactionById(txs, id)
+}
+
+func TestNewTransferProcessor(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	processor := service.NewTransferProcessor(tokenStore)
+
+	assert.Assert(t, processor != nil, "Processor should be created")
+}
+
+func TestTransferProcessorProcessSuccess(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+	processor := service.NewTransferProcessor(tokenStore)
+
+	mintHash := support.GenerateRandomHash()
+	privHex, pubHex, fromAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	_, _, toAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	assert.NilError(t, tokenStore.UpsertTokenBalance(ctx, fromAddress, mintHash, 100))
+
+	// The transfer waits on chain until its signed record has been gossiped
+	transfer := newSignedTransfer(t, privHex, pubHex, fromAddress, toAddress, mintHash, 25)
+	tx := saveOnChainTransfer(t, ctx, tokenStore, fromAddress, transfer)
+
+	assert.NilError(t, processor.Process(tx))
+	AssertTokenBalance(t, ctx, toAddress, mintHash, 0, tokenStore)
+
+	_, err = tokenStore.SaveTokenTransfer(ctx, &transfer)
+	assert.NilError(t, err)
+
+	balanceEvents, unsubscribe := tokenStore.Events.Subscribe(events.Filter{Address: toAddress})
+	defer unsubscribe()
+
+	err = processor.Process(tx)
+	assert.NilError(t, err)
+
+	event := <-balanceEvents
+	assert.Equal(t, events.EventBalanceChanged, event.Type)
+	assert.Equal(t, mintHash, event.MintHash)
+	assert.DeepEqual(t, []string{fromAddress, toAddress}, event.Addresses)
+	assert.Equal(t, 25, event.Quantity)
+
+	AssertTokenBalance(t, ctx, fromAddress, mintHash, 75, tokenStore)
+	AssertTokenBalance(t, ctx, toAddress, mintHash, 25, tokenStore)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(txs))
+
+	// Writing the same signed transfer on chain again does not move the fractions twice
+	replay := saveOnChainTransfer(t, ctx, tokenStore, fromAddress, transfer)
+	assert.NilError(t, processor.Process(replay))
+
+	AssertTokenBalance(t, ctx, fromAddress, mintHash, 75, tokenStore)
+	AssertTokenBalance(t, ctx, toAddress, mintHash, 25, tokenStore)
+
+	txs, err = tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(txs))
+}
+
+func TestTransferProcessorRejectsTransfersNotSignedBySender(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+	processor := service.NewTransferProcessor(tokenStore)
+
+	mintHash := support.GenerateRandomHash()
+	_, _, victimAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	attackerPrivHex, attackerPubHex, attackerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	assert.NilError(t, tokenStore.UpsertTokenBalance(ctx, victimAddress, mintHash, 100))
+
+	// Signed with a key that cannot sign for the victim's address
+	forged := newSignedTransfer(t, attackerPrivHex, attackerPubHex, victimAddress, attackerAddress, mintHash, 100)
+	_, err = tokenStore.SaveTokenTransfer(ctx, &forged)
+	assert.NilError(t, err)
+
+	assert.NilError(t, processor.Process(saveOnChainTransfer(t, ctx, tokenStore, victimAddress, forged)))
+
+	// Written on chain by someone other than the sender
+	assert.NilError(t, processor.Process(saveOnChainTransfer(t, ctx, tokenStore, attackerAddress, forged)))
+
+	AssertTokenBalance(t, ctx, victimAddress, mintHash, 100, tokenStore)
+	AssertTokenBalance(t, ctx, attackerAddress, mintHash, 0, tokenStore)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(txs))
+}
@@ -50,6 +50,18 @@ func (t *TrimmerService) Start() {
 			log.Println("Error processing invoice timeout:", err)
 		}
 
+		// Expiry is measured against the blocks the engine has processed, so that
+		// payments mined before an invoice expired are always seen first
+		processedBlockHeight, _, _, err := t.store.GetChainPosition(ctx)
+		if err != nil {
+			log.Println("Error getting chain position:", err)
+		} else {
+			err = t.invoiceTimeoutProcessor.Expire(processedBlockHeight)
+			if err != nil {
+				log.Println("Error processing invoice expiry:", err)
+			}
+		}
+
 		err = t.store.TrimOldUnconfirmedMints(ctx, t.unconfirmedMintsToKeep)
 		if err != nil {
 			log.Println("Error trimming unconfirmed mints:", err)
@@ -60,6 +72,11 @@ func (t *TrimmerService) Start() {
 			log.Println("Error trimming on chain transactions:", err)
 		}
 
+		err = t.store.TrimDeletedOffers(ctx, oldestBlockHeight)
+		if err != nil {
+			log.Println("Error trimming deleted offers:", err)
+		}
+
 		time.Sleep(10 * time.Second)
 
 		if !t.running {
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"dogecoin.org/fractal-engine/pkg/store"
+)
+
+const (
+	WEBHOOK_SIGNATURE_HEADER = "X-Fractal-Signature"
+	WEBHOOK_EVENT_HEADER     = "X-Fractal-Event"
+	WEBHOOK_DELIVERY_HEADER  = "X-Fractal-Delivery"
+
+	WEBHOOK_MAX_ATTEMPTS = 8
+)
+
+// SignWebhookPayload returns the hex HMAC-SHA256 of the body keyed by the webhook's
+// secret. Receivers recompute it to authenticate the request.
+func SignWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookBackoff is the delay before the next attempt after the given number of
+// failed attempts: 10s doubling up to one hour.
+func WebhookBackoff(attempts int) time.Duration {
+	delay := 10 * time.Second
+	for i := 1; i < attempts && delay < time.Hour; i++ {
+		delay *= 2
+	}
+
+	return min(delay, time.Hour)
+}
+
+// WebhookService drains the webhook outbox. Failed deliveries are retried with
+// exponential backoff and moved to the dead-letter list after WEBHOOK_MAX_ATTEMPTS.
+type WebhookService struct {
+	store      *store.TokenisationStore
+	httpClient *http.Client
+	running    bool
+}
+
+func NewWebhookService(store *store.TokenisationStore) *WebhookService {
+	return &WebhookService{store: store, httpClient: &http.Client{Timeout: 10 * time.Second}, running: false}
+}
+
+func (w *WebhookService) Start() {
+	w.running = true
+	ctx := context.Background()
+
+	for {
+		err := w.DeliverDue(ctx, time.Now())
+		if err != nil {
+			log.Println("Error delivering webhooks:", err)
+		}
+
+		time.Sleep(5 * time.Second)
+
+		if !w.running {
+			break
+		}
+	}
+}
+
+func (w *WebhookService) Stop() {
+	fmt.Println("Stopping webhook service")
+	w.running = false
+}
+
+func (w *WebhookService) DeliverDue(ctx context.Context, now time.Time) error {
+	deliveries, err := w.store.GetDueWebhookDeliveries(ctx, now, 50)
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		attempts := delivery.Attempts + 1
+
+		err := w.deliver(ctx, delivery)
+		if err == nil {
+			err = w.store.MarkWebhookDelivered(ctx, delivery.Id, attempts, time.Now())
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		log.Println("Webhook delivery failed:", delivery.Id, err)
+
+		dead := attempts >= WEBHOOK_MAX_ATTEMPTS
+		err = w.store.MarkWebhookDeliveryFailed(ctx, delivery.Id, attempts, now.Add(WebhookBackoff(attempts)), err.Error(), dead)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *WebhookService) deliver(ctx context.Context, delivery store.WebhookDelivery) error {
+	body := []byte(delivery.Payload)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WEBHOOK_SIGNATURE_HEADER, "sha256="+SignWebhookPayload(delivery.Secret, body))
+	req.Header.Set(WEBHOOK_EVENT_HEADER, delivery.EventType)
+	req.Header.Set(WEBHOOK_DELIVERY_HEADER, delivery.Id)
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	return nil
+}
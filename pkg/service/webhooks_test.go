@@ -0,0 +1,136 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/events"
+	"dogecoin.org/fractal-engine/pkg/service"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+type webhookReceiver struct {
+	mu         sync.Mutex
+	statusCode int
+	bodies     [][]byte
+	signatures []string
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	body, _ := io.ReadAll(req.Body)
+	r.bodies = append(r.bodies, body)
+	r.signatures = append(r.signatures, req.Header.Get(service.WEBHOOK_SIGNATURE_HEADER))
+	w.WriteHeader(r.statusCode)
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	assert.Equal(t, 10*time.Second, service.WebhookBackoff(1))
+	assert.Equal(t, 20*time.Second, service.WebhookBackoff(2))
+	assert.Equal(t, 80*time.Second, service.WebhookBackoff(4))
+	assert.Equal(t, time.Hour, service.WebhookBackoff(20))
+}
+
+func TestWebhookServiceDeliversSignedPayload(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	receiver := &webhookReceiver{statusCode: http.StatusOK}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	_, err := tokenStore.SaveWebhook(ctx, &store.Webhook{Url: server.URL, Secret: "secret", EventTypes: []string{string(events.EventPaymentMatched)}, CreatedAt: time.Now()})
+	assert.NilError(t, err)
+
+	// Only the subscribed event type is queued
+	assert.NilError(t, tokenStore.EnqueueWebhookEvent(ctx, events.Event{Type: events.EventMintConfirmed, MintHash: "mint"}))
+	assert.NilError(t, tokenStore.EnqueueWebhookEvent(ctx, events.Event{Type: events.EventPaymentMatched, MintHash: "mint", Hash: "invoice", Quantity: 5}))
+
+	webhookService := service.NewWebhookService(tokenStore)
+	assert.NilError(t, webhookService.DeliverDue(ctx, time.Now()))
+
+	assert.Equal(t, 1, len(receiver.bodies))
+	assert.Equal(t, "sha256="+service.SignWebhookPayload("secret", receiver.bodies[0]), receiver.signatures[0])
+
+	var payload store.WebhookPayload
+	assert.NilError(t, json.Unmarshal(receiver.bodies[0], &payload))
+	assert.Equal(t, string(events.EventPaymentMatched), payload.Type)
+	assert.Equal(t, "invoice", payload.Data.Hash)
+	assert.Equal(t, 5, payload.Data.Quantity)
+
+	deliveries, err := tokenStore.GetWebhookDeliveries(ctx, store.WebhookDeliveryDelivered, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(deliveries))
+	assert.Equal(t, payload.Id, deliveries[0].Id)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+	assert.Assert(t, deliveries[0].DeliveredAt != nil)
+
+	// Delivered events are not sent again
+	assert.NilError(t, webhookService.DeliverDue(ctx, time.Now()))
+	assert.Equal(t, 1, len(receiver.bodies))
+}
+
+func TestWebhookServiceRetriesThenDeadLetters(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	receiver := &webhookReceiver{statusCode: http.StatusInternalServerError}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	_, err := tokenStore.SaveWebhook(ctx, &store.Webhook{Url: server.URL, Secret: "secret", CreatedAt: time.Now()})
+	assert.NilError(t, err)
+	assert.NilError(t, tokenStore.EnqueueWebhookEvent(ctx, events.Event{Type: events.EventInvoiceTimedOut, Hash: "invoice"}))
+
+	webhookService := service.NewWebhookService(tokenStore)
+
+	now := time.Now()
+	assert.NilError(t, webhookService.DeliverDue(ctx, now))
+
+	pending, err := tokenStore.GetWebhookDeliveries(ctx, store.WebhookDeliveryPending, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(pending))
+	assert.Equal(t, 1, pending[0].Attempts)
+	assert.Assert(t, strings.Contains(pending[0].LastError, "500"))
+
+	// The retry is not due until the backoff has elapsed
+	assert.NilError(t, webhookService.DeliverDue(ctx, now.Add(5*time.Second)))
+	assert.Equal(t, 1, len(receiver.bodies))
+
+	for attempt := 2; attempt <= service.WEBHOOK_MAX_ATTEMPTS; attempt++ {
+		now = now.Add(2 * time.Hour)
+		assert.NilError(t, webhookService.DeliverDue(ctx, now))
+	}
+	assert.Equal(t, service.WEBHOOK_MAX_ATTEMPTS, len(receiver.bodies))
+
+	dead, err := tokenStore.GetWebhookDeliveries(ctx, store.WebhookDeliveryDead, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(dead))
+	assert.Equal(t, service.WEBHOOK_MAX_ATTEMPTS, dead[0].Attempts)
+
+	// Dead deliveries stay put until replayed
+	assert.NilError(t, webhookService.DeliverDue(ctx, now.Add(2*time.Hour)))
+	assert.Equal(t, service.WEBHOOK_MAX_ATTEMPTS, len(receiver.bodies))
+
+	receiver.statusCode = http.StatusNoContent
+	assert.NilError(t, tokenStore.ReplayWebhookDelivery(ctx, dead[0].Id))
+	assert.ErrorContains(t, tokenStore.ReplayWebhookDelivery(ctx, dead[0].Id), "no dead webhook delivery found")
+
+	assert.NilError(t, webhookService.DeliverDue(ctx, time.Now().Add(time.Second)))
+
+	delivered, err := tokenStore.GetWebhookDeliveries(ctx, store.WebhookDeliveryDelivered, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(delivered))
+	assert.Equal(t, 1, delivered[0].Attempts)
+}
@@ -0,0 +1,49 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+func (s *TokenisationStore) SaveAttestation(ctx context.Context, attestation *Attestation) (string, error) {
+	id := uuid.New().String()
+
+	_, err := s.conn().ExecContext(ctx, `
+	INSERT INTO attestations (id, mint_hash, address, signature, public_key, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	`, id, attestation.MintHash, attestation.Address, attestation.Signature, attestation.PublicKey, attestation.CreatedAt)
+	return id, err
+}
+
+func (s *TokenisationStore) GetAttestations(ctx context.Context, mintHash string, address string, tx *sql.Tx) ([]Attestation, error) {
+	query := "SELECT id, mint_hash, address, signature, public_key, created_at FROM attestations WHERE mint_hash = $1 AND address = $2"
+
+	var rows *sql.Rows
+	var err error
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, mintHash, address)
+	} else {
+		rows, err = s.conn().QueryContext(ctx, query, mintHash, address)
+	}
+	if err != nil {
+		return []Attestation{}, err
+	}
+	defer rows.Close()
+
+	var attestations []Attestation
+	for rows.Next() {
+		var attestation Attestation
+		if err := rows.Scan(&attestation.Id, &attestation.MintHash, &attestation.Address, &attestation.Signature, &attestation.PublicKey, &attestation.CreatedAt); err != nil {
+			return []Attestation{}, err
+		}
+		attestations = append(attestations, attestation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []Attestation{}, err
+	}
+
+	return attestations, nil
+}
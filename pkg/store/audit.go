@@ -0,0 +1,207 @@
+package store
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Checks run by the state auditor
+const (
+	AuditCheckNegativeBalance        = "negative_balance"
+	AuditCheckOrphanedPendingBalance = "orphaned_pending_balance"
+	AuditCheckUnknownInvoiceMint     = "unknown_invoice_mint"
+	AuditCheckSupply                 = "supply"
+)
+
+// AuditViolation is a piece of derived state that breaks one of the audited invariants.
+// The subject identifies the offending record within the mint, e.g. an address or an
+// invoice hash.
+type AuditViolation struct {
+	Check    string `json:"check"`
+	MintHash string `json:"mint_hash"`
+	Subject  string `json:"subject,omitempty"`
+	Message  string `json:"message"`
+}
+
+type AuditViolations []AuditViolation
+
+// Value implements driver.Valuer — converts the slice to JSON for storage.
+func (a AuditViolations) Value() (driver.Value, error) {
+	b, err := json.Marshal(a)
+	if err != nil {
+		return nil, fmt.Errorf("marshal AuditViolations: %w", err)
+	}
+	return string(b), nil
+}
+
+// Scan implements sql.Scanner — converts DB value to the slice.
+func (a *AuditViolations) Scan(src interface{}) error {
+	if a == nil {
+		return fmt.Errorf("AuditViolations: Scan on nil pointer")
+	}
+	if src == nil {
+		*a = nil
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fmt.Errorf("unsupported scan type for AuditViolations: %T", src)
+	}
+
+	if len(data) == 0 {
+		*a = nil
+		return nil
+	}
+
+	return json.Unmarshal(data, a)
+}
+
+// AuditReport is the outcome of one run of the state auditor.
+type AuditReport struct {
+	CheckedAt    time.Time       `json:"checked_at"`
+	BlockHeight  int64           `json:"block_height"`
+	MintsChecked int             `json:"mints_checked"`
+	Violations   AuditViolations `json:"violations"`
+}
+
+func (r AuditReport) Healthy() bool {
+	return len(r.Violations) == 0
+}
+
+// GetNegativeTokenBalances returns the addresses whose materialized balance of a mint
+// is below zero. The issuance account is negative by design and is left out.
+func (s *TokenisationStore) GetNegativeTokenBalances(ctx context.Context) ([]TokenBalance, error) {
+	rows, err := s.conn().QueryContext(ctx, `
+		SELECT mint_hash, account, quantity, updated_at FROM ledger_balances
+		WHERE quantity < 0 AND account <> $1
+		ORDER BY mint_hash ASC, account ASC
+	`, LedgerAccountIssued)
+	if err != nil {
+		return []TokenBalance{}, err
+	}
+
+	defer rows.Close()
+
+	balances := []TokenBalance{}
+	for rows.Next() {
+		var balance TokenBalance
+		err := rows.Scan(&balance.MintHash, &balance.Address, &balance.Quantity, &balance.UpdatedAt)
+		if err != nil {
+			return []TokenBalance{}, err
+		}
+
+		balances = append(balances, balance)
+	}
+
+	return balances, rows.Err()
+}
+
+// GetPendingTokenBalancesWithoutInvoices returns the reservations that neither a confirmed
+// invoice nor an on-chain invoice waiting to be matched accounts for, so the fractions
+// they hold back can never be released.
+func (s *TokenisationStore) GetPendingTokenBalancesWithoutInvoices(ctx context.Context) ([]PendingTokenBalance, error) {
+	rows, err := s.conn().QueryContext(ctx, `
+		SELECT p.invoice_hash, p.mint_hash, p.quantity, p.owner_address, p.created_at FROM pending_token_balances p
+		WHERE NOT EXISTS (SELECT 1 FROM invoices i WHERE i.hash = p.invoice_hash AND i.mint_hash = p.mint_hash)
+		AND NOT EXISTS (SELECT 1 FROM onchain_transactions o WHERE o.id = p.onchain_transaction_id)
+		ORDER BY p.mint_hash ASC, p.invoice_hash ASC
+	`)
+	if err != nil {
+		return []PendingTokenBalance{}, err
+	}
+
+	defer rows.Close()
+
+	pending := []PendingTokenBalance{}
+	for rows.Next() {
+		var balance PendingTokenBalance
+		err := rows.Scan(&balance.InvoiceHash, &balance.MintHash, &balance.Quantity, &balance.OwnerAddress, &balance.CreatedAt)
+		if err != nil {
+			return []PendingTokenBalance{}, err
+		}
+
+		pending = append(pending, balance)
+	}
+
+	return pending, rows.Err()
+}
+
+// GetInvoicesWithUnknownMints returns the confirmed invoices whose mint is not confirmed.
+// Only the identifying fields of the invoices are filled in.
+func (s *TokenisationStore) GetInvoicesWithUnknownMints(ctx context.Context) ([]Invoice, error) {
+	rows, err := s.conn().QueryContext(ctx, `
+		SELECT i.id, i.hash, i.mint_hash, i.seller_address, COALESCE(i.block_height, 0) FROM invoices i
+		WHERE NOT EXISTS (SELECT 1 FROM mints m WHERE m.hash = i.mint_hash)
+		ORDER BY i.mint_hash ASC, i.hash ASC
+	`)
+	if err != nil {
+		return []Invoice{}, err
+	}
+
+	defer rows.Close()
+
+	invoices := []Invoice{}
+	for rows.Next() {
+		var invoice Invoice
+		err := rows.Scan(&invoice.Id, &invoice.Hash, &invoice.MintHash, &invoice.SellerAddress, &invoice.BlockHeight)
+		if err != nil {
+			return []Invoice{}, err
+		}
+
+		invoices = append(invoices, invoice)
+	}
+
+	return invoices, rows.Err()
+}
+
+// SaveAuditReport replaces the last audit report with the given one.
+func (s *TokenisationStore) SaveAuditReport(ctx context.Context, report AuditReport) error {
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM audit_reports")
+	if err != nil {
+		return err
+	}
+
+	violations := report.Violations
+	if violations == nil {
+		violations = AuditViolations{}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO audit_reports (checked_at, block_height, mints_checked, violation_count, violations)
+	VALUES ($1, $2, $3, $4, $5)
+	`, report.CheckedAt, report.BlockHeight, report.MintsChecked, len(violations), violations)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetAuditReport returns the last audit report, or sql.ErrNoRows if the auditor has
+// not run yet.
+func (s *TokenisationStore) GetAuditReport(ctx context.Context) (AuditReport, error) {
+	var report AuditReport
+
+	err := s.conn().QueryRowContext(ctx, "SELECT checked_at, block_height, mints_checked, violations FROM audit_reports").Scan(&report.CheckedAt, &report.BlockHeight, &report.MintsChecked, &report.Violations)
+	if err != nil {
+		return AuditReport{}, err
+	}
+
+	return report, nil
+}
@@ -6,22 +6,16 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math"
 	"time"
+
+	"dogecoin.org/fractal-engine/pkg/protocol"
 )
 
+// UpsertTokenBalance credits (or, for a negative quantity, debits) an address against the
+// issuance account of the ledger, outside of any block.
 func (s *TokenisationStore) UpsertTokenBalance(ctx context.Context, address, mintHash string, quantity int) error {
-	log.Println("Upserting token balance:", address, mintHash, quantity)
-
-	_, err := s.DB.ExecContext(ctx, `
-	INSERT INTO token_balances (address, mint_hash, quantity, created_at, updated_at)
-	VALUES ($1, $2, $3, $4, $5)
-	`, address, mintHash, quantity, time.Now(), time.Now())
-
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return s.UpsertTokenBalanceAtBlock(ctx, address, mintHash, quantity, BlockRef{}, nil)
 }
 
 func (s *TokenisationStore) UpsertPendingTokenBalance(ctx context.Context, invoiceHash, mintHash string, quantity int, onchainTransactionId string, ownerAddress string) error {
@@ -29,27 +23,33 @@ func (s *TokenisationStore) UpsertPendingTokenBalance(ctx context.Context, invoi
 }
 
 func (s *TokenisationStore) UpsertPendingTokenBalanceWithTx(ctx context.Context, invoiceHash, mintHash string, quantity int, onchainTransactionId string, ownerAddress string, tx *sql.Tx) error {
+	return s.UpsertPendingTokenBalanceAtBlock(ctx, invoiceHash, mintHash, quantity, onchainTransactionId, ownerAddress, BlockRef{}, tx)
+}
+
+// UpsertPendingTokenBalanceAtBlock records the pending balance against the block that
+// reserved it, so RollbackToHeight can release it if that block is reorganised away.
+func (s *TokenisationStore) UpsertPendingTokenBalanceAtBlock(ctx context.Context, invoiceHash, mintHash string, quantity int, onchainTransactionId string, ownerAddress string, block BlockRef, tx *sql.Tx) error {
 	log.Println("Upserting pending token balance:", invoiceHash, mintHash, quantity, onchainTransactionId, ownerAddress)
 
 	query := `
-	INSERT INTO pending_token_balances (invoice_hash, mint_hash, quantity, onchain_transaction_id, created_at, owner_address)
-	VALUES ($1, $2, $3, $4, $5, $6)
+	INSERT INTO pending_token_balances (invoice_hash, mint_hash, quantity, onchain_transaction_id, created_at, owner_address, block_height, block_hash)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	ON CONFLICT (invoice_hash, mint_hash)
 	DO UPDATE SET quantity = $3
 	`
 
 	var err error
 	if tx != nil {
-		_, err = tx.ExecContext(ctx, query, invoiceHash, mintHash, quantity, onchainTransactionId, time.Now(), ownerAddress)
+		_, err = tx.ExecContext(ctx, query, invoiceHash, mintHash, quantity, onchainTransactionId, time.Now(), ownerAddress, block.Height, block.Hash)
 	} else {
-		_, err = s.DB.ExecContext(ctx, query, invoiceHash, mintHash, quantity, onchainTransactionId, time.Now(), ownerAddress)
+		_, err = s.conn().ExecContext(ctx, query, invoiceHash, mintHash, quantity, onchainTransactionId, time.Now(), ownerAddress, block.Height, block.Hash)
 	}
 
 	return err
 }
 
 func (s *TokenisationStore) HasPendingTokenBalance(ctx context.Context, invoiceHash, mintHash string, onChainTransactionId string) (bool, error) {
-	rows, err := s.DB.QueryContext(ctx, `
+	rows, err := s.conn().QueryContext(ctx, `
 		SELECT COUNT(*) FROM pending_token_balances WHERE invoice_hash = $1 AND mint_hash = $2 AND onchain_transaction_id = $3
 	`, invoiceHash, mintHash, onChainTransactionId)
 	if err != nil {
@@ -71,7 +71,7 @@ func (s *TokenisationStore) HasPendingTokenBalance(ctx context.Context, invoiceH
 }
 
 func (s *TokenisationStore) RemovePendingTokenBalance(ctx context.Context, invoiceHash, mintHash string) error {
-	_, err := s.DB.ExecContext(ctx, `
+	_, err := s.conn().ExecContext(ctx, `
 		DELETE FROM pending_token_balances WHERE invoice_hash = $1 AND mint_hash = $2
 	`, invoiceHash, mintHash)
 	return err
@@ -82,7 +82,7 @@ func (s *TokenisationStore) GetPendingTokenBalance(ctx context.Context, invoiceH
 	var err error
 
 	if tx == nil {
-		rows, err = s.DB.QueryContext(ctx, `
+		rows, err = s.conn().QueryContext(ctx, `
 		SELECT quantity, invoice_hash, mint_hash, owner_address FROM pending_token_balances WHERE invoice_hash = $1 AND mint_hash = $2
 	`, invoiceHash, mintHash)
 	} else {
@@ -117,7 +117,7 @@ func (s *TokenisationStore) GetPendingTokenBalanceForQuantity(ctx context.Contex
 	fmt.Println("Getting token balance", invoiceHash, mintHash, quantity)
 
 	if tx == nil {
-		rows, err = s.DB.QueryContext(ctx, `
+		rows, err = s.conn().QueryContext(ctx, `
 		SELECT quantity, invoice_hash, mint_hash, owner_address FROM pending_token_balances WHERE invoice_hash = $1 AND mint_hash = $2 and quantity = $3
 	`, invoiceHash, mintHash, quantity)
 	} else {
@@ -146,7 +146,7 @@ func (s *TokenisationStore) GetPendingTokenBalanceForQuantity(ctx context.Contex
 }
 
 func (s *TokenisationStore) GetPendingTokenBalanceTotalForMintAndOwner(ctx context.Context, mintHash string, ownerAddress string) (int, error) {
-	rows, err := s.DB.QueryContext(ctx, `
+	rows, err := s.conn().QueryContext(ctx, `
 		SELECT COALESCE(SUM(quantity), 0) FROM pending_token_balances WHERE mint_hash = $1 AND owner_address = $2
 	`, mintHash, ownerAddress)
 	if err != nil {
@@ -167,7 +167,18 @@ func (s *TokenisationStore) GetPendingTokenBalanceTotalForMintAndOwner(ctx conte
 }
 
 func (s *TokenisationStore) GetMyMintTokenBalances(ctx context.Context, address string, offset int, limit int) ([]TokenBalanceWithMint, error) {
-	rows, err := s.DB.QueryContext(ctx, `
+	return s.GetMyMintTokenBalancesAtHeight(ctx, address, 0, offset, limit)
+}
+
+// GetMyMintTokenBalancesAtHeight returns the balances an address held once the block at
+// the given height was applied, with the mints they are for. A height of 0 returns the
+// current balances.
+func (s *TokenisationStore) GetMyMintTokenBalancesAtHeight(ctx context.Context, address string, height int64, offset int, limit int) ([]TokenBalanceWithMint, error) {
+	if height <= 0 {
+		height = math.MaxInt64
+	}
+
+	rows, err := s.conn().QueryContext(ctx, `
 		SELECT
   m.id,
   m.created_at,
@@ -190,15 +201,15 @@ FROM mints m
 INNER JOIN (
   SELECT
     mint_hash,
-    address,
-    SUM(quantity) AS balance_quantity
-  FROM token_balances
-  WHERE address = $1
-  GROUP BY mint_hash, address
+    account AS address,
+    SUM(credit - debit) AS balance_quantity
+  FROM ledger_entries
+  WHERE account = $1 AND COALESCE(block_height, 0) <= $2
+  GROUP BY mint_hash, account
 ) tb
   ON m.hash = tb.mint_hash
-LIMIT $2 OFFSET $3
-	`, address, limit, offset)
+LIMIT $3 OFFSET $4
+	`, address, height, limit, offset)
 
 	if err != nil {
 		return []TokenBalanceWithMint{}, err
@@ -241,9 +252,26 @@ LIMIT $2 OFFSET $3
 }
 
 func (s *TokenisationStore) GetTokenBalances(ctx context.Context, address string, mintHash string) ([]TokenBalance, error) {
-	rows, err := s.DB.QueryContext(ctx, `
-		SELECT quantity FROM token_balances WHERE address = $1 AND mint_hash = $2
-	`, address, mintHash)
+	return s.GetTokenBalancesAtHeight(ctx, address, mintHash, 0)
+}
+
+/*
+* GetTokenBalancesAtHeight returns the balance changes of an address for a mint up to and
+* including the block at the given height, oldest first. Every credit and debit is a
+* separate entry stamped with the block height and transaction that caused it, so the
+* entries are a ledger of the balance and their sum is the balance at that height. A
+* height of 0 returns every entry.
+ */
+func (s *TokenisationStore) GetTokenBalancesAtHeight(ctx context.Context, address string, mintHash string, height int64) ([]TokenBalance, error) {
+	if height <= 0 {
+		height = math.MaxInt64
+	}
+
+	rows, err := s.conn().QueryContext(ctx, `
+		SELECT credit - debit, COALESCE(block_height, 0), COALESCE(transaction_hash, ''), created_at FROM ledger_entries
+		WHERE account = $1 AND mint_hash = $2 AND COALESCE(block_height, 0) <= $3
+		ORDER BY COALESCE(block_height, 0) ASC, created_at ASC
+	`, address, mintHash, height)
 
 	if err != nil {
 		return []TokenBalance{}, err
@@ -254,26 +282,57 @@ func (s *TokenisationStore) GetTokenBalances(ctx context.Context, address string
 	tokenBalances := []TokenBalance{}
 
 	for rows.Next() {
+		tokenBalance := TokenBalance{
+			Address:  address,
+			MintHash: mintHash,
+		}
+		err := rows.Scan(&tokenBalance.Quantity, &tokenBalance.BlockHeight, &tokenBalance.TransactionHash, &tokenBalance.CreatedAt)
+		if err != nil {
+			return []TokenBalance{}, err
+		}
+		tokenBalances = append(tokenBalances, tokenBalance)
+	}
 
-		var quantity int
-		err := rows.Scan(&quantity)
+	return tokenBalances, rows.Err()
+}
+
+// GetTokenHoldersAtHeight returns the cap table of a mint: its holders and their
+// balances once the block at the given height was applied, largest holder first.
+// Balance entries are only ever added or rolled back by block, so the holders at a
+// height that has been processed do not change unless that block is reorganised away.
+func (s *TokenisationStore) GetTokenHoldersAtHeight(ctx context.Context, mintHash string, height int64) ([]TokenBalance, error) {
+	rows, err := s.conn().QueryContext(ctx, `
+		SELECT account, SUM(credit - debit) FROM ledger_entries
+		WHERE mint_hash = $1 AND COALESCE(block_height, 0) <= $2 AND account NOT IN ($3, $4)
+		GROUP BY account
+		HAVING SUM(credit - debit) > 0
+		ORDER BY SUM(credit - debit) DESC, account ASC
+	`, mintHash, height, LedgerAccountIssued, LedgerAccountBurned)
+	if err != nil {
+		return []TokenBalance{}, err
+	}
+
+	defer rows.Close()
+
+	holders := []TokenBalance{}
+
+	for rows.Next() {
+		holder := TokenBalance{MintHash: mintHash}
+		err := rows.Scan(&holder.Address, &holder.Quantity)
 		if err != nil {
 			return []TokenBalance{}, err
 		}
-		tokenBalances = append(tokenBalances, TokenBalance{
-			Address:  address,
-			MintHash: mintHash,
-			Quantity: quantity,
-		})
+
+		holders = append(holders, holder)
 	}
 
-	return tokenBalances, nil
+	return holders, rows.Err()
 }
 
 func (s *TokenisationStore) GetPendingTokenBalances(ctx context.Context, address string, mintHash string) ([]TokenBalance, error) {
 	log.Println("Getting token balance: ADDRESS", address, "MINT HASH", mintHash)
 
-	rows, err := s.DB.QueryContext(ctx, `
+	rows, err := s.conn().QueryContext(ctx, `
 		SELECT quantity FROM pending_token_balances WHERE owner_address = $1 AND mint_hash = $2
 	`, address, mintHash)
 
@@ -303,27 +362,41 @@ func (s *TokenisationStore) GetPendingTokenBalances(ctx context.Context, address
 }
 
 func (s *TokenisationStore) UpsertTokenBalanceWithTransaction(ctx context.Context, address, mintHash string, quantity int, tx *sql.Tx) error {
-	log.Println("Upserting token balance with transaction:", address, mintHash, quantity)
+	return s.UpsertTokenBalanceAtBlock(ctx, address, mintHash, quantity, BlockRef{}, tx)
+}
 
-	_, err := tx.ExecContext(ctx, `
-	INSERT INTO token_balances (address, mint_hash, quantity, created_at, updated_at)
-	VALUES ($1, $2, $3, $4, $5)
-	`, address, mintHash, quantity, time.Now(), time.Now())
+// UpsertTokenBalanceAtBlock posts an adjustment between an address and the issuance
+// account of the ledger, stamped with the block that caused it. Balance changes made by
+// actions are posted between the accounts involved with PostToLedger instead.
+func (s *TokenisationStore) UpsertTokenBalanceAtBlock(ctx context.Context, address, mintHash string, quantity int, block BlockRef, tx *sql.Tx) error {
+	posting := LedgerPosting{
+		MintHash:    mintHash,
+		FromAccount: LedgerAccountIssued,
+		ToAccount:   address,
+		Quantity:    quantity,
+		Block:       block,
+	}
 
-	return err
+	if quantity < 0 {
+		posting.FromAccount, posting.ToAccount, posting.Quantity = address, LedgerAccountIssued, -quantity
+	}
+
+	return s.PostToLedger(ctx, posting, tx)
 }
 
 func (s *TokenisationStore) MovePendingToTokenBalance(ctx context.Context, pendingTokenBalance PendingTokenBalance, buyerAddress string, tx *sql.Tx) error {
-	_, err := tx.ExecContext(ctx, `
-	INSERT INTO token_balances (address, mint_hash, quantity, created_at, updated_at)
-	VALUES ($1, $2, $3, $4, $5)
-	`, buyerAddress, pendingTokenBalance.MintHash, pendingTokenBalance.Quantity, time.Now(), time.Now())
-
-	if err != nil {
-		return err
-	}
+	return s.MovePendingToTokenBalanceAtBlock(ctx, pendingTokenBalance, buyerAddress, BlockRef{}, tx)
+}
 
-	err = s.UpsertTokenBalanceWithTransaction(ctx, pendingTokenBalance.OwnerAddress, pendingTokenBalance.MintHash, -pendingTokenBalance.Quantity, tx)
+func (s *TokenisationStore) MovePendingToTokenBalanceAtBlock(ctx context.Context, pendingTokenBalance PendingTokenBalance, buyerAddress string, block BlockRef, tx *sql.Tx) error {
+	err := s.PostToLedger(ctx, LedgerPosting{
+		MintHash:    pendingTokenBalance.MintHash,
+		FromAccount: pendingTokenBalance.OwnerAddress,
+		ToAccount:   buyerAddress,
+		Quantity:    pendingTokenBalance.Quantity,
+		ActionType:  protocol.ACTION_PAYMENT,
+		Block:       block,
+	}, tx)
 	if err != nil {
 		return err
 	}
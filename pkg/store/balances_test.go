@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/store"
 	"gotest.tools/assert"
 )
 
@@ -383,3 +384,60 @@ func TestTransactionRollback(t *testing.T) {
 	assert.Equal(t, len(ownerBalances), 1)
 	assert.Equal(t, ownerBalances[0].Quantity, 500)
 }
+
+func TestTokenBalancesAtHeight(t *testing.T) {
+	db := support.SetupTestDB(t)
+
+	_, err := db.SaveMint(balancesTestCtx, &store.MintWithoutID{
+		Title:         "mint1",
+		FractionCount: 100,
+		Hash:          "mintHash1",
+	}, "issuer")
+	assert.NilError(t, err)
+
+	tx, err := db.DB.BeginTx(balancesTestCtx, nil)
+	assert.NilError(t, err)
+	assert.NilError(t, db.UpsertTokenBalanceAtBlock(balancesTestCtx, "issuer", "mintHash1", 100, store.BlockRef{Height: 5, Hash: "block5", TransactionHash: "mintTx"}, tx))
+	assert.NilError(t, db.UpsertTokenBalanceAtBlock(balancesTestCtx, "issuer", "mintHash1", -40, store.BlockRef{Height: 8, Hash: "block8", TransactionHash: "transferTx"}, tx))
+	assert.NilError(t, db.UpsertTokenBalanceAtBlock(balancesTestCtx, "holder", "mintHash1", 40, store.BlockRef{Height: 8, Hash: "block8", TransactionHash: "transferTx"}, tx))
+	assert.NilError(t, db.UpsertTokenBalanceAtBlock(balancesTestCtx, "holder", "mintHash1", -40, store.BlockRef{Height: 12, Hash: "block12", TransactionHash: "secondTransferTx"}, tx))
+	assert.NilError(t, db.UpsertTokenBalanceAtBlock(balancesTestCtx, "buyer", "mintHash1", 40, store.BlockRef{Height: 12, Hash: "block12", TransactionHash: "secondTransferTx"}, tx))
+	assert.NilError(t, tx.Commit())
+
+	// Every change is kept with the block and transaction that caused it
+	balances, err := db.GetTokenBalancesAtHeight(balancesTestCtx, "issuer", "mintHash1", 0)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(balances))
+	assert.Equal(t, int64(5), balances[0].BlockHeight)
+	assert.Equal(t, "mintTx", balances[0].TransactionHash)
+	assert.Equal(t, -40, balances[1].Quantity)
+	assert.Equal(t, "transferTx", balances[1].TransactionHash)
+
+	balances, err = db.GetTokenBalancesAtHeight(balancesTestCtx, "issuer", "mintHash1", 7)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(balances))
+	assert.Equal(t, 100, balances[0].Quantity)
+
+	mints, err := db.GetMyMintTokenBalancesAtHeight(balancesTestCtx, "holder", 10, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(mints))
+	assert.Equal(t, 40, mints[0].Quantity)
+
+	mints, err = db.GetMyMintTokenBalances(balancesTestCtx, "holder", 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(mints))
+	assert.Equal(t, 0, mints[0].Quantity)
+
+	holders, err := db.GetTokenHoldersAtHeight(balancesTestCtx, "mintHash1", 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(holders))
+	assert.Equal(t, "issuer", holders[0].Address)
+	assert.Equal(t, 60, holders[0].Quantity)
+	assert.Equal(t, "holder", holders[1].Address)
+	assert.Equal(t, 40, holders[1].Quantity)
+
+	holders, err = db.GetTokenHoldersAtHeight(balancesTestCtx, "mintHash1", 12)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(holders))
+	assert.Equal(t, "buyer", holders[1].Address)
+}
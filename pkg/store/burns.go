@@ -0,0 +1,156 @@
+package store
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// ProcessBurn applies a confirmed ACTION_BURN. The on-chain transaction is always
+// consumed; the fractions are only destroyed if the sender has enough available
+// tokens. Burned fractions are debited from the owner and recorded in the burns ledger.
+func (s *TokenisationStore) ProcessBurn(ctx context.Context, onchainTransaction OnChainTransaction) error {
+	if onchainTransaction.ActionType != protocol.ACTION_BURN {
+		return fmt.Errorf("action type is not burn: %d", onchainTransaction.ActionType)
+	}
+
+	var onchainMessage protocol.OnChainBurnMessage
+	err := proto.Unmarshal(onchainTransaction.ActionData, &onchainMessage)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id = $1", onchainTransaction.Id)
+	if err != nil {
+		log.Println("Error deleting onchain transaction:", err)
+		return err
+	}
+
+	burnHash := hex.EncodeToString(onchainMessage.BurnHash)
+	mintHash := hex.EncodeToString(onchainMessage.MintHash)
+	quantity := int(onchainMessage.Quantity)
+
+	var existing int
+	err = tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM burns WHERE hash = $1", burnHash).Scan(&existing)
+	if err != nil {
+		return err
+	}
+
+	available, err := s.GetAvailableTokenBalance(ctx, onchainTransaction.Address, mintHash, tx.Tx)
+	if err != nil {
+		return err
+	}
+
+	var burnErr error
+	if existing > 0 {
+		burnErr = fmt.Errorf("%w: burn already processed: %s", ErrActionRejected, burnHash)
+	} else if available < quantity {
+		burnErr = fmt.Errorf("%w: insufficient available balance for burn: %d < %d", ErrActionRejected, available, quantity)
+	} else {
+		block := onchainTransaction.BlockRef()
+
+		err = s.PostToLedger(ctx, LedgerPosting{
+			MintHash:    mintHash,
+			FromAccount: onchainTransaction.Address,
+			ToAccount:   LedgerAccountBurned,
+			Quantity:    quantity,
+			ActionType:  protocol.ACTION_BURN,
+			Block:       block,
+		}, tx.Tx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+		INSERT INTO burns (id, hash, mint_hash, owner_address, quantity, transaction_hash, block_height, block_hash, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`, uuid.New().String(), burnHash, mintHash, onchainTransaction.Address, quantity, block.TransactionHash, block.Height, block.Hash, time.Now())
+		if err != nil {
+			log.Println("Error inserting burn:", err)
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return burnErr
+}
+
+func (s *TokenisationStore) GetBurns(ctx context.Context, mintHash string) ([]Burn, error) {
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, hash, mint_hash, owner_address, quantity, transaction_hash, block_height, created_at FROM burns WHERE mint_hash = $1 ORDER BY block_height", mintHash)
+	if err != nil {
+		return []Burn{}, err
+	}
+	defer rows.Close()
+
+	var burns []Burn
+	for rows.Next() {
+		var burn Burn
+		if err := rows.Scan(&burn.Id, &burn.Hash, &burn.MintHash, &burn.OwnerAddress, &burn.Quantity, &burn.TransactionHash, &burn.BlockHeight, &burn.CreatedAt); err != nil {
+			return []Burn{}, err
+		}
+		burns = append(burns, burn)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []Burn{}, err
+	}
+
+	return burns, nil
+}
+
+// GetBurnedSupply returns the total number of fractions of a mint that have been burned.
+func (s *TokenisationStore) GetBurnedSupply(ctx context.Context, mintHash string) (int, error) {
+	var burned int
+	err := s.conn().QueryRowContext(ctx, "SELECT COALESCE(SUM(quantity), 0) FROM burns WHERE mint_hash = $1", mintHash).Scan(&burned)
+	return burned, err
+}
+
+func (s *TokenisationStore) SaveBurnSignature(ctx context.Context, signature *BurnSignature) (string, error) {
+	id := uuid.New().String()
+
+	_, err := s.conn().ExecContext(ctx, `
+	INSERT INTO burn_signatures (id, burn_hash, mint_hash, owner_address, quantity, signature, public_key, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, id, signature.BurnHash, signature.MintHash, signature.OwnerAddress, signature.Quantity, signature.Signature, signature.PublicKey, signature.CreatedAt)
+	return id, err
+}
+
+func (s *TokenisationStore) GetBurnSignatures(ctx context.Context, burnHash string) ([]BurnSignature, error) {
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, burn_hash, mint_hash, owner_address, quantity, signature, public_key, created_at FROM burn_signatures WHERE burn_hash = $1", burnHash)
+	if err != nil {
+		return []BurnSignature{}, err
+	}
+	defer rows.Close()
+
+	var signatures []BurnSignature
+	for rows.Next() {
+		var signature BurnSignature
+		if err := rows.Scan(&signature.Id, &signature.BurnHash, &signature.MintHash, &signature.OwnerAddress, &signature.Quantity, &signature.Signature, &signature.PublicKey, &signature.CreatedAt); err != nil {
+			return []BurnSignature{}, err
+		}
+		signatures = append(signatures, signature)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []BurnSignature{}, err
+	}
+
+	return signatures, nil
+}
@@ -0,0 +1,84 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func saveBurnTransaction(t *testing.T, tokenStore *store.TokenisationStore, txHash string, blockHeight int64, ownerAddress string, burnHash string, mintHash string, quantity int) store.OnChainTransaction {
+	envelope := protocol.NewBurnTransactionEnvelope(burnHash, mintHash, int32(quantity), protocol.ACTION_BURN)
+
+	id, err := tokenStore.SaveOnChainTransaction(context.Background(), txHash, blockHeight, "block", 0, protocol.ACTION_BURN, protocol.DEFAULT_VERSION, envelope.Data, ownerAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(context.Background(), 0, 100)
+	assert.NilError(t, err)
+
+	return *findTransactionById(txs, id)
+}
+
+func TestProcessBurn(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mintHash := test_support.GenerateRandomHash()
+	burnHash := test_support.GenerateRandomHash()
+	ownerAddress := test_support.GenerateDogecoinAddress(true)
+
+	assert.NilError(t, tokenStore.UpsertTokenBalance(ctx, ownerAddress, mintHash, 100))
+
+	burn := saveBurnTransaction(t, tokenStore, "burnTx", 2, ownerAddress, burnHash, mintHash, 40)
+	assert.NilError(t, tokenStore.ProcessBurn(ctx, burn))
+
+	assert.Equal(t, 60, sumBalances(t, tokenStore, ownerAddress, mintHash))
+	assert.Equal(t, 0, countRows(t, tokenStore, "SELECT COUNT(*) FROM onchain_transactions WHERE id = $1", burn.Id))
+
+	burned, err := tokenStore.GetBurnedSupply(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 40, burned)
+
+	burns, err := tokenStore.GetBurns(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(burns))
+	assert.Equal(t, burnHash, burns[0].Hash)
+	assert.Equal(t, ownerAddress, burns[0].OwnerAddress)
+	assert.Equal(t, "burnTx", burns[0].TransactionHash)
+
+	// Replaying the same burn hash does not burn twice
+	replay := saveBurnTransaction(t, tokenStore, "burnTx2", 3, ownerAddress, burnHash, mintHash, 40)
+	assert.ErrorContains(t, tokenStore.ProcessBurn(ctx, replay), "burn already processed")
+	assert.Equal(t, 60, sumBalances(t, tokenStore, ownerAddress, mintHash))
+
+	// A reorg below the burn block restores the fractions
+	assert.NilError(t, tokenStore.RollbackToHeight(ctx, 1))
+
+	burned, err = tokenStore.GetBurnedSupply(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, burned)
+	assert.Equal(t, 100, sumBalances(t, tokenStore, ownerAddress, mintHash))
+}
+
+func TestProcessBurnWithInsufficientAvailableBalance(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mintHash := test_support.GenerateRandomHash()
+	ownerAddress := test_support.GenerateDogecoinAddress(true)
+
+	assert.NilError(t, tokenStore.UpsertTokenBalance(ctx, ownerAddress, mintHash, 10))
+
+	burn := saveBurnTransaction(t, tokenStore, "burnTx", 2, ownerAddress, test_support.GenerateRandomHash(), mintHash, 40)
+	assert.ErrorContains(t, tokenStore.ProcessBurn(ctx, burn), "insufficient available balance")
+
+	assert.Equal(t, 10, sumBalances(t, tokenStore, ownerAddress, mintHash))
+	assert.Equal(t, 0, countRows(t, tokenStore, "SELECT COUNT(*) FROM onchain_transactions WHERE id = $1", burn.Id))
+
+	burned, err := tokenStore.GetBurnedSupply(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, burned)
+}
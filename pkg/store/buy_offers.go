@@ -3,11 +3,24 @@ package store
 import (
 	"context"
 	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"log"
 
+	"dogecoin.org/fractal-engine/pkg/protocol"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 )
 
+// ErrOfferNotFound is returned when an on-chain offer cancellation names an offer this
+// node does not hold for the sender, e.g. because it has not been gossiped yet.
+var ErrOfferNotFound = errors.New("offer not found")
+
+// ErrOfferNotOwned is returned when an on-chain offer cancellation names an offer that
+// was made by another address. Such a cancellation can never match.
+var ErrOfferNotOwned = errors.New("offer not owned by sender")
+
 func (s *TokenisationStore) SaveBuyOffer(ctx context.Context, d *BuyOfferWithoutID) (string, error) {
 	log.Println("SaveBuyOffer", d.OffererAddress, d.SellerAddress, d.Hash, d.MintHash, d.Quantity, d.Price, d.CreatedAt, d.PublicKey, d.Signature)
 	return s.SaveBuyOfferWithTx(ctx, d, nil)
@@ -25,24 +38,32 @@ func (s *TokenisationStore) SaveBuyOfferWithTx(ctx context.Context, d *BuyOfferW
 	if tx != nil {
 		_, err = tx.ExecContext(ctx, query, id, d.OffererAddress, d.SellerAddress, d.Hash, d.MintHash, d.Quantity, d.Price, d.CreatedAt, d.PublicKey, d.Signature)
 	} else {
-		_, err = s.DB.ExecContext(ctx, query, id, d.OffererAddress, d.SellerAddress, d.Hash, d.MintHash, d.Quantity, d.Price, d.CreatedAt, d.PublicKey, d.Signature)
+		_, err = s.conn().ExecContext(ctx, query, id, d.OffererAddress, d.SellerAddress, d.Hash, d.MintHash, d.Quantity, d.Price, d.CreatedAt, d.PublicKey, d.Signature)
 	}
 
 	return id, err
 }
 
 func (s *TokenisationStore) CountBuyOffers(ctx context.Context, mintHash string, offererAddress string, sellerAddress string) (int, error) {
-	row := s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM buy_offers WHERE mint_hash = $1 AND offerer_address = $2 AND seller_address = $3", mintHash, offererAddress, sellerAddress)
+	row := s.conn().QueryRowContext(ctx, "SELECT COUNT(*) FROM buy_offers WHERE mint_hash = $1 AND offerer_address = $2 AND seller_address = $3 AND quantity > 0 AND delete_pending = FALSE AND deleted_block_height IS NULL", mintHash, offererAddress, sellerAddress)
 	var count int
 	err := row.Scan(&count)
 	return count, err
 }
 
-func (s *TokenisationStore) DeleteBuyOffer(ctx context.Context, hash string, publicKey string) error {
-	_, err := s.DB.ExecContext(ctx, "DELETE FROM buy_offers WHERE hash = $1 AND public_key = $2", hash, publicKey)
+// MarkBuyOfferDeletePending withdraws a buy offer its offerer has asked to delete. The
+// offer is kept, hidden from the order book, until the on-chain cancellation is matched.
+func (s *TokenisationStore) MarkBuyOfferDeletePending(ctx context.Context, hash string, publicKey string) error {
+	_, err := s.conn().ExecContext(ctx, "UPDATE buy_offers SET delete_pending = TRUE WHERE hash = $1 AND public_key = $2", hash, publicKey)
 	return err
 }
 
+func (s *TokenisationStore) GetBuyOfferByHash(ctx context.Context, hash string) (BuyOffer, error) {
+	var offer BuyOffer
+	err := s.conn().QueryRowContext(ctx, "SELECT id, created_at, offerer_address, seller_address, hash, mint_hash, quantity, price, public_key, signature FROM buy_offers WHERE hash = $1 AND deleted_block_height IS NULL", hash).Scan(&offer.Id, &offer.CreatedAt, &offer.OffererAddress, &offer.SellerAddress, &offer.Hash, &offer.MintHash, &offer.Quantity, &offer.Price, &offer.PublicKey, &offer.Signature)
+	return offer, err
+}
+
 func (s *TokenisationStore) GetBuyOffersByMintAndSellerAddress(ctx context.Context, offset int, limit int, mintHash string, sellerAddress string) ([]BuyOffer, error) {
 	var rows *sql.Rows
 	var err error
@@ -50,9 +71,9 @@ func (s *TokenisationStore) GetBuyOffersByMintAndSellerAddress(ctx context.Conte
 	log.Println("GetBuyOffersByMintAndSellerAddress", mintHash, sellerAddress)
 
 	if sellerAddress == "" {
-		rows, err = s.DB.QueryContext(ctx, "SELECT id, created_at, offerer_address, seller_address, hash, mint_hash, quantity, price, public_key, signature FROM buy_offers WHERE mint_hash = $1 LIMIT $2 OFFSET $3", mintHash, limit, offset)
+		rows, err = s.conn().QueryContext(ctx, "SELECT id, created_at, offerer_address, seller_address, hash, mint_hash, quantity, price, public_key, signature FROM buy_offers WHERE mint_hash = $1 AND quantity > 0 AND delete_pending = FALSE AND deleted_block_height IS NULL LIMIT $2 OFFSET $3", mintHash, limit, offset)
 	} else {
-		rows, err = s.DB.QueryContext(ctx, "SELECT id, created_at, offerer_address, seller_address, hash, mint_hash, quantity, price, public_key, signature FROM buy_offers WHERE mint_hash = $1 AND seller_address = $2 LIMIT $3 OFFSET $4", mintHash, sellerAddress, limit, offset)
+		rows, err = s.conn().QueryContext(ctx, "SELECT id, created_at, offerer_address, seller_address, hash, mint_hash, quantity, price, public_key, signature FROM buy_offers WHERE mint_hash = $1 AND seller_address = $2 AND quantity > 0 AND delete_pending = FALSE AND deleted_block_height IS NULL LIMIT $3 OFFSET $4", mintHash, sellerAddress, limit, offset)
 	}
 
 	if err != nil {
@@ -77,3 +98,61 @@ func (s *TokenisationStore) GetBuyOffersByMintAndSellerAddress(ctx context.Conte
 
 	return offers, nil
 }
+
+// MatchDeleteBuyOffer applies a confirmed ACTION_DELETE_BUY_OFFER. Only the offerer, identified
+// by the sender of the on-chain transaction, can cancel the offer, and a cancellation
+// of an offer made by another address returns ErrOfferNotOwned. The offer is marked
+// deleted at the block height so that a rollback can restore it, and the on-chain
+// transaction is consumed once the offer has been removed.
+func (s *TokenisationStore) MatchDeleteBuyOffer(ctx context.Context, onchainTransaction OnChainTransaction) error {
+	if onchainTransaction.ActionType != protocol.ACTION_DELETE_BUY_OFFER {
+		return fmt.Errorf("action type is not delete buy offer: %d", onchainTransaction.ActionType)
+	}
+
+	var onchainMessage protocol.OnChainDeleteBuyOfferMessage
+	err := proto.Unmarshal(onchainTransaction.ActionData, &onchainMessage)
+	if err != nil {
+		return err
+	}
+
+	offerHash := hex.EncodeToString(onchainMessage.OfferHash)
+
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "UPDATE buy_offers SET deleted_block_height = $1 WHERE hash = $2 AND offerer_address = $3 AND deleted_block_height IS NULL", onchainTransaction.Height, offerHash, onchainTransaction.Address)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		var others int
+		err = tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM buy_offers WHERE hash = $1 AND offerer_address != $2", offerHash, onchainTransaction.Address).Scan(&others)
+		if err != nil {
+			return err
+		}
+
+		if others > 0 {
+			return fmt.Errorf("%w: buy offer %s", ErrOfferNotOwned, offerHash)
+		}
+
+		return fmt.Errorf("%w: no buy offer found for hash: %s", ErrOfferNotFound, offerHash)
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id = $1", onchainTransaction.Id)
+	if err != nil {
+		log.Println("Error deleting onchain transaction:", err)
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,153 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+
+	"dogecoin.org/fractal-engine/pkg/config"
+	"dogecoin.org/fractal-engine/pkg/metrics"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// RequiredConfirmations returns the confirmation depth of an action on a mint: the
+// node's policy for the action, raised by the mint's min_confirmations requirement.
+// A mint can ask for more confirmations than the node's policy but never fewer.
+func RequiredConfirmations(policy config.ConfirmationPolicy, actionType uint8, mint Mint) int {
+	required := policy.Required(metrics.ActionName(actionType))
+
+	requirements, err := ParseMintRequirements(mint.Requirements)
+	if err == nil && requirements != nil {
+		required = max(required, requirements.MinConfirmations)
+	}
+
+	return required
+}
+
+// ConfirmationsRemaining returns how many more blocks an action mined at a height needs
+// before it has the required confirmations, with the chain tip at tipHeight. An
+// action that has not been mined yet needs all of them.
+func ConfirmationsRemaining(required int, height int64, tipHeight int64) int {
+	confirmations := 0
+	if height > 0 && tipHeight >= height {
+		confirmations = int(tipHeight - height + 1)
+	}
+
+	return max(required-confirmations, 0)
+}
+
+// SubjectHash returns the hash of the mint, invoice, burn, mint amendment, ownership
+// transfer or distribution that an on-chain action refers to. For payments and
+// cancellations this is the hash of the invoice.
+func (t *OnChainTransaction) SubjectHash() string {
+	switch t.ActionType {
+	case protocol.ACTION_MINT:
+		var message protocol.OnChainMintMessage
+		if proto.Unmarshal(t.ActionData, &message) == nil {
+			return message.Hash
+		}
+	case protocol.ACTION_INVOICE:
+		var message protocol.OnChainInvoiceMessage
+		if proto.Unmarshal(t.ActionData, &message) == nil {
+			return hex.EncodeToString(message.InvoiceHash)
+		}
+	case protocol.ACTION_PAYMENT:
+		var message protocol.OnChainPaymentMessage
+		if proto.Unmarshal(t.ActionData, &message) == nil {
+			return message.Hash
+		}
+	case protocol.ACTION_BURN:
+		var message protocol.OnChainBurnMessage
+		if proto.Unmarshal(t.ActionData, &message) == nil {
+			return hex.EncodeToString(message.BurnHash)
+		}
+	case protocol.ACTION_CANCEL_INVOICE:
+		var message protocol.OnChainCancelInvoiceMessage
+		if proto.Unmarshal(t.ActionData, &message) == nil {
+			return hex.EncodeToString(message.InvoiceHash)
+		}
+	case protocol.ACTION_AMEND_MINT:
+		var message protocol.OnChainMintAmendmentMessage
+		if proto.Unmarshal(t.ActionData, &message) == nil {
+			return hex.EncodeToString(message.AmendmentHash)
+		}
+	case protocol.ACTION_TRANSFER_MINT_OWNERSHIP:
+		var message protocol.OnChainMintOwnershipTransferMessage
+		if proto.Unmarshal(t.ActionData, &message) == nil {
+			return hex.EncodeToString(message.TransferHash)
+		}
+	case protocol.ACTION_DISTRIBUTION_PAYMENT:
+		var message protocol.OnChainDistributionPaymentMessage
+		if proto.Unmarshal(t.ActionData, &message) == nil {
+			return hex.EncodeToString(message.DistributionHash)
+		}
+	}
+
+	return ""
+}
+
+// GetOnChainTransactionMint returns the mint that an on-chain action is for, looking
+// in the confirmed mints first and then in the gossiped ones. The mint is empty when
+// the action does not refer to a mint this node knows about.
+func (s *TokenisationStore) GetOnChainTransactionMint(ctx context.Context, tx OnChainTransaction) (Mint, error) {
+	mintHash := ""
+
+	switch tx.ActionType {
+	case protocol.ACTION_MINT:
+		mintHash = tx.SubjectHash()
+	case protocol.ACTION_INVOICE:
+		var message protocol.OnChainInvoiceMessage
+		if proto.Unmarshal(tx.ActionData, &message) == nil {
+			mintHash = hex.EncodeToString(message.MintHash)
+		}
+	case protocol.ACTION_PAYMENT, protocol.ACTION_CANCEL_INVOICE:
+		invoice, err := s.GetInvoiceByHash(ctx, tx.SubjectHash())
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return Mint{}, err
+		}
+		mintHash = invoice.MintHash
+	case protocol.ACTION_TRANSFER:
+		var message protocol.OnChainTransferMessage
+		if proto.Unmarshal(tx.ActionData, &message) == nil {
+			mintHash = hex.EncodeToString(message.MintHash)
+		}
+	case protocol.ACTION_BURN:
+		var message protocol.OnChainBurnMessage
+		if proto.Unmarshal(tx.ActionData, &message) == nil {
+			mintHash = hex.EncodeToString(message.MintHash)
+		}
+	case protocol.ACTION_AMEND_MINT:
+		var message protocol.OnChainMintAmendmentMessage
+		if proto.Unmarshal(tx.ActionData, &message) == nil {
+			mintHash = hex.EncodeToString(message.MintHash)
+		}
+	case protocol.ACTION_TRANSFER_MINT_OWNERSHIP:
+		var message protocol.OnChainMintOwnershipTransferMessage
+		if proto.Unmarshal(tx.ActionData, &message) == nil {
+			mintHash = hex.EncodeToString(message.MintHash)
+		}
+	case protocol.ACTION_DISTRIBUTION_PAYMENT:
+		var message protocol.OnChainDistributionPaymentMessage
+		if proto.Unmarshal(tx.ActionData, &message) == nil {
+			mintHash = hex.EncodeToString(message.MintHash)
+		}
+	}
+
+	if mintHash == "" {
+		return Mint{}, nil
+	}
+
+	mint, err := s.GetMintByHash(ctx, mintHash)
+	if err != nil || mint.Hash != "" {
+		return mint, err
+	}
+
+	mint, err = s.GetUnconfirmedMintByHash(ctx, mintHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Mint{}, nil
+	}
+
+	return mint, err
+}
@@ -0,0 +1,69 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/config"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+	"gotest.tools/assert"
+)
+
+func TestRequiredConfirmations(t *testing.T) {
+	policy := config.ConfirmationPolicy{"mint": 1, "payment": 6}
+
+	assert.Equal(t, store.RequiredConfirmations(policy, protocol.ACTION_MINT, store.Mint{}), 1)
+	assert.Equal(t, store.RequiredConfirmations(policy, protocol.ACTION_INVOICE, store.Mint{}), 0)
+
+	// A high value mint can ask for more confirmations than the policy but never fewer
+	mint := store.Mint{MintWithoutID: store.MintWithoutID{Requirements: store.StringInterfaceMap{"min_confirmations": float64(20)}}}
+	assert.Equal(t, store.RequiredConfirmations(policy, protocol.ACTION_INVOICE, mint), 20)
+	assert.Equal(t, store.RequiredConfirmations(policy, protocol.ACTION_PAYMENT, mint), 20)
+
+	mint.Requirements = store.StringInterfaceMap{"min_confirmations": float64(2)}
+	assert.Equal(t, store.RequiredConfirmations(policy, protocol.ACTION_PAYMENT, mint), 6)
+}
+
+func TestConfirmationsRemaining(t *testing.T) {
+	assert.Equal(t, store.ConfirmationsRemaining(6, 0, 100), 6)
+	assert.Equal(t, store.ConfirmationsRemaining(6, 100, 100), 5)
+	assert.Equal(t, store.ConfirmationsRemaining(6, 96, 100), 1)
+	assert.Equal(t, store.ConfirmationsRemaining(6, 95, 100), 0)
+	assert.Equal(t, store.ConfirmationsRemaining(6, 90, 100), 0)
+}
+
+func TestGetOnChainTransactionMintFallsBackToUnconfirmedMint(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mint := &store.MintWithoutID{
+		Title:         "Test Mint",
+		FractionCount: 100,
+		Hash:          test_support.GenerateRandomHash(),
+		Requirements:  store.StringInterfaceMap{"min_confirmations": float64(10)},
+	}
+	_, err := tokenStore.SaveUnconfirmedMint(ctx, mint)
+	assert.NilError(t, err)
+
+	data, err := proto.Marshal(&protocol.OnChainMintMessage{Hash: mint.Hash})
+	assert.NilError(t, err)
+
+	tx := store.OnChainTransaction{ActionType: protocol.ACTION_MINT, ActionData: data}
+	assert.Equal(t, tx.SubjectHash(), mint.Hash)
+
+	found, err := tokenStore.GetOnChainTransactionMint(ctx, tx)
+	assert.NilError(t, err)
+	assert.Equal(t, found.Hash, mint.Hash)
+	assert.Equal(t, store.RequiredConfirmations(config.DefaultConfirmationPolicy(), tx.ActionType, found), 10)
+
+	// An action for a mint this node has never seen needs only the policy depth
+	data, err = proto.Marshal(&protocol.OnChainMintMessage{Hash: test_support.GenerateRandomHash()})
+	assert.NilError(t, err)
+
+	found, err = tokenStore.GetOnChainTransactionMint(ctx, store.OnChainTransaction{ActionType: protocol.ACTION_MINT, ActionData: data})
+	assert.NilError(t, err)
+	assert.Equal(t, found.Hash, "")
+}
@@ -0,0 +1,192 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (s *TokenisationStore) SaveDistribution(ctx context.Context, distribution *Distribution) (string, error) {
+	id := uuid.New().String()
+
+	_, err := s.conn().ExecContext(ctx, `
+	INSERT INTO distributions (id, hash, mint_hash, total_koinu, record_height, public_key, signature, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, id, distribution.Hash, distribution.MintHash, distribution.TotalKoinu, distribution.RecordHeight, distribution.PublicKey, distribution.Signature, distribution.CreatedAt)
+	return id, err
+}
+
+func (s *TokenisationStore) GetDistribution(ctx context.Context, hash string) (Distribution, error) {
+	var distribution Distribution
+
+	err := s.conn().QueryRowContext(ctx, "SELECT id, hash, mint_hash, total_koinu, record_height, public_key, signature, created_at FROM distributions WHERE hash = $1", hash).Scan(
+		&distribution.Id, &distribution.Hash, &distribution.MintHash, &distribution.TotalKoinu, &distribution.RecordHeight, &distribution.PublicKey, &distribution.Signature, &distribution.CreatedAt)
+	if err != nil {
+		return Distribution{}, err
+	}
+
+	return distribution, nil
+}
+
+// GetDistributions returns the distributions declared for a mint, latest record height
+// first.
+func (s *TokenisationStore) GetDistributions(ctx context.Context, mintHash string, offset int, limit int) ([]Distribution, error) {
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, hash, mint_hash, total_koinu, record_height, public_key, signature, created_at FROM distributions WHERE mint_hash = $1 ORDER BY record_height DESC, created_at DESC LIMIT $2 OFFSET $3", mintHash, limit, offset)
+	if err != nil {
+		return []Distribution{}, err
+	}
+
+	defer rows.Close()
+
+	distributions := []Distribution{}
+
+	for rows.Next() {
+		var distribution Distribution
+		err := rows.Scan(&distribution.Id, &distribution.Hash, &distribution.MintHash, &distribution.TotalKoinu, &distribution.RecordHeight, &distribution.PublicKey, &distribution.Signature, &distribution.CreatedAt)
+		if err != nil {
+			return []Distribution{}, err
+		}
+
+		distributions = append(distributions, distribution)
+	}
+
+	return distributions, rows.Err()
+}
+
+/*
+* GetDistributionEntitlements snapshots the holders of the mint at the record height of
+* the distribution and returns what each of them is entitled to, together with what
+* has been paid out to them so far. Holders that were paid more than their entitlement
+* are only counted as claimed up to it.
+ */
+func (s *TokenisationStore) GetDistributionEntitlements(ctx context.Context, distribution Distribution) ([]DistributionEntitlement, error) {
+	holders, err := s.GetTokenHoldersAtHeight(ctx, distribution.MintHash, distribution.RecordHeight)
+	if err != nil {
+		return []DistributionEntitlement{}, err
+	}
+
+	claimed, err := s.GetDistributionClaimedKoinu(ctx, distribution.Hash, nil)
+	if err != nil {
+		return []DistributionEntitlement{}, err
+	}
+
+	entitlements := distribution.Entitlements(holders)
+	for i := range entitlements {
+		entitlements[i].ClaimedKoinu = claimed[entitlements[i].Address]
+	}
+
+	return entitlements, nil
+}
+
+/*
+* ProcessDistributionPayment records the outputs of a payout transaction that pay the
+* holders entitled to a distribution. Each output is applied to what the holder is
+* still owed and anything above that is recorded as overpaid. Outputs to the sender
+* are change, and outputs to addresses without an entitlement are not part of the
+* payout. It returns the recorded payments and consumes the on-chain transaction.
+ */
+func (s *TokenisationStore) ProcessDistributionPayment(ctx context.Context, onchainTransaction OnChainTransaction, distribution Distribution, entitlements []DistributionEntitlement) ([]DistributionPayment, error) {
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return []DistributionPayment{}, err
+	}
+
+	defer tx.Rollback()
+
+	claimed, err := s.GetDistributionClaimedKoinu(ctx, distribution.Hash, tx.Tx)
+	if err != nil {
+		return []DistributionPayment{}, err
+	}
+
+	payments := []DistributionPayment{}
+
+	for _, entitlement := range entitlements {
+		if entitlement.Address == onchainTransaction.Address {
+			continue
+		}
+
+		amount, err := onchainTransaction.Values.Koinu(entitlement.Address)
+		if err != nil {
+			return []DistributionPayment{}, err
+		}
+
+		if amount <= 0 {
+			continue
+		}
+
+		applied := min(amount, max(entitlement.EntitlementKoinu-claimed[entitlement.Address], 0))
+
+		payment := DistributionPayment{
+			Id:               uuid.New().String(),
+			DistributionHash: distribution.Hash,
+			TransactionHash:  onchainTransaction.TxHash,
+			Address:          entitlement.Address,
+			AmountKoinu:      amount,
+			AppliedKoinu:     applied,
+			OverpaidKoinu:    amount - applied,
+			BlockHeight:      onchainTransaction.Height,
+			BlockHash:        onchainTransaction.BlockHash,
+			CreatedAt:        time.Now().UTC(),
+		}
+
+		_, err = tx.ExecContext(ctx, `
+		INSERT INTO distribution_payments (id, distribution_hash, transaction_hash, address, amount_koinu, applied_koinu, overpaid_koinu, block_height, block_hash, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		`, payment.Id, payment.DistributionHash, payment.TransactionHash, payment.Address, payment.AmountKoinu, payment.AppliedKoinu, payment.OverpaidKoinu, payment.BlockHeight, payment.BlockHash, payment.CreatedAt)
+		if err != nil {
+			log.Println("Error saving distribution payment:", err)
+			return []DistributionPayment{}, err
+		}
+
+		payments = append(payments, payment)
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id = $1", onchainTransaction.Id)
+	if err != nil {
+		log.Println("Error deleting onchain transaction:", err)
+		return []DistributionPayment{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return []DistributionPayment{}, err
+	}
+
+	return payments, nil
+}
+
+// GetDistributionClaimedKoinu returns the koinu applied towards each holder's
+// entitlement to a distribution. A nil tx reads outside of a transaction.
+func (s *TokenisationStore) GetDistributionClaimedKoinu(ctx context.Context, distributionHash string, tx *sql.Tx) (map[string]int64, error) {
+	query := "SELECT address, COALESCE(SUM(applied_koinu), 0) FROM distribution_payments WHERE distribution_hash = $1 GROUP BY address"
+
+	var rows *sql.Rows
+	var err error
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, distributionHash)
+	} else {
+		rows, err = s.conn().QueryContext(ctx, query, distributionHash)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	claimed := map[string]int64{}
+
+	for rows.Next() {
+		var address string
+		var amount int64
+		if err := rows.Scan(&address, &amount); err != nil {
+			return nil, err
+		}
+
+		claimed[address] = amount
+	}
+
+	return claimed, rows.Err()
+}
@@ -0,0 +1,94 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func TestDistributionEntitlementsUseHoldersAtRecordHeight(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mintHash := test_support.GenerateRandomHash()
+	alice := test_support.GenerateDogecoinAddress(true)
+	bob := test_support.GenerateDogecoinAddress(true)
+	carol := test_support.GenerateDogecoinAddress(true)
+	dave := test_support.GenerateDogecoinAddress(true)
+
+	tx, err := tokenStore.DB.BeginTx(ctx, nil)
+	assert.NilError(t, err)
+	assert.NilError(t, tokenStore.UpsertTokenBalanceAtBlock(ctx, alice, mintHash, 60, store.BlockRef{Height: 5, Hash: "block5"}, tx))
+	assert.NilError(t, tokenStore.UpsertTokenBalanceAtBlock(ctx, bob, mintHash, 30, store.BlockRef{Height: 5, Hash: "block5"}, tx))
+	assert.NilError(t, tokenStore.UpsertTokenBalanceAtBlock(ctx, carol, mintHash, 10, store.BlockRef{Height: 5, Hash: "block5"}, tx))
+	// Fractions that change hands after the record height do not move the entitlement
+	assert.NilError(t, tokenStore.UpsertTokenBalanceAtBlock(ctx, alice, mintHash, -20, store.BlockRef{Height: 12, Hash: "block12"}, tx))
+	assert.NilError(t, tokenStore.UpsertTokenBalanceAtBlock(ctx, dave, mintHash, 20, store.BlockRef{Height: 12, Hash: "block12"}, tx))
+	assert.NilError(t, tx.Commit())
+
+	distribution := store.Distribution{
+		MintHash:     mintHash,
+		TotalKoinu:   1001,
+		RecordHeight: 10,
+		PublicKey:    "publicKey",
+		Signature:    "signature",
+		CreatedAt:    time.Now(),
+	}
+	distribution.Hash, err = distribution.GenerateHash()
+	assert.NilError(t, err)
+
+	_, err = tokenStore.SaveDistribution(ctx, &distribution)
+	assert.NilError(t, err)
+
+	distribution, err = tokenStore.GetDistribution(ctx, distribution.Hash)
+	assert.NilError(t, err)
+
+	entitlements, err := tokenStore.GetDistributionEntitlements(ctx, distribution)
+	assert.NilError(t, err)
+	assert.Equal(t, 3, len(entitlements))
+
+	// The koinu lost to rounding go to the largest holder
+	assert.Equal(t, alice, entitlements[0].Address)
+	assert.Equal(t, 60, entitlements[0].Quantity)
+	assert.Equal(t, int64(601), entitlements[0].EntitlementKoinu)
+	assert.Equal(t, bob, entitlements[1].Address)
+	assert.Equal(t, int64(300), entitlements[1].EntitlementKoinu)
+	assert.Equal(t, carol, entitlements[2].Address)
+	assert.Equal(t, int64(100), entitlements[2].EntitlementKoinu)
+
+	// Alice pays out, so her own output is change
+	payout := store.OnChainTransaction{
+		Id:        "payoutTx",
+		TxHash:    "payoutTx",
+		Height:    15,
+		BlockHash: "block15",
+		Address:   alice,
+		Values:    store.StringInterfaceMap{alice: int64(5000), bob: int64(300), carol: int64(150), dave: int64(50)},
+	}
+
+	payments, err := tokenStore.ProcessDistributionPayment(ctx, payout, distribution, entitlements)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(payments))
+	assert.Equal(t, bob, payments[0].Address)
+	assert.Equal(t, int64(300), payments[0].AppliedKoinu)
+	assert.Equal(t, carol, payments[1].Address)
+	assert.Equal(t, int64(100), payments[1].AppliedKoinu)
+	assert.Equal(t, int64(50), payments[1].OverpaidKoinu)
+
+	entitlements, err = tokenStore.GetDistributionEntitlements(ctx, distribution)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(0), entitlements[0].ClaimedKoinu)
+	assert.Equal(t, int64(300), entitlements[1].ClaimedKoinu)
+	assert.Equal(t, int64(100), entitlements[2].ClaimedKoinu)
+
+	// A reorg below the payout forgets it
+	assert.NilError(t, tokenStore.RollbackToHeight(ctx, 14))
+
+	claimed, err := tokenStore.GetDistributionClaimedKoinu(ctx, distribution.Hash, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(claimed))
+}
@@ -7,7 +7,7 @@ import (
 )
 
 func (s *TokenisationStore) GetHealth(ctx context.Context) (int64, int64, string, bool, time.Time, error) {
-	rows, err := s.DB.QueryContext(ctx, "SELECT current_block_height, latest_block_height, chain, wallets_enabled, updated_at FROM health")
+	rows, err := s.conn().QueryContext(ctx, "SELECT current_block_height, latest_block_height, chain, wallets_enabled, updated_at FROM health")
 	if err != nil {
 		return 0, 0, "", false, time.Time{}, err
 	}
@@ -33,7 +33,7 @@ func (s *TokenisationStore) GetHealth(ctx context.Context) (int64, int64, string
 }
 
 func (s *TokenisationStore) UpsertHealth(ctx context.Context, currentBlockHeight int64, latestBlockHeight int64, chain string, walletsEnabled bool) error {
-	tx, err := s.DB.BeginTx(ctx, nil)
+	tx, err := s.Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,221 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+)
+
+// ErrInvoiceExpiryMismatch is returned when an on-chain invoice declares a different
+// expiry height from the invoice that was gossiped and signed.
+var ErrInvoiceExpiryMismatch = errors.New("invoice expiry height does not match")
+
+// GetPendingTokenBalanceByInvoiceHash returns the fractions reserved for an on-chain
+// invoice that has not been matched to its gossiped terms yet.
+func (s *TokenisationStore) GetPendingTokenBalanceByInvoiceHash(ctx context.Context, invoiceHash string) (PendingTokenBalance, error) {
+	var pendingTokenBalance PendingTokenBalance
+	err := s.conn().QueryRowContext(ctx, "SELECT quantity, invoice_hash, mint_hash, owner_address FROM pending_token_balances WHERE invoice_hash = $1", invoiceHash).Scan(&pendingTokenBalance.Quantity, &pendingTokenBalance.InvoiceHash, &pendingTokenBalance.MintHash, &pendingTokenBalance.OwnerAddress)
+	return pendingTokenBalance, err
+}
+
+// GetExpiredInvoices returns the open invoices whose expiry height is below the given
+// block height.
+func (s *TokenisationStore) GetExpiredInvoices(ctx context.Context, height int64) ([]Invoice, error) {
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, doge_price, created_at, seller_address, public_key, signature, paid_at, splits, expires_at_height, status FROM invoices WHERE status = $1 AND expires_at_height > 0 AND expires_at_height < $2", InvoiceStatusOnChain, height)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invoices []Invoice
+	for rows.Next() {
+		var invoice Invoice
+		if err := rows.Scan(&invoice.Id, &invoice.Hash, &invoice.PaymentAddress, &invoice.BuyerAddress, &invoice.MintHash, &invoice.Quantity, &invoice.Price, &invoice.DogePrice, &invoice.CreatedAt, &invoice.SellerAddress, &invoice.PublicKey, &invoice.Signature, &invoice.PaidAt, &invoice.Splits, &invoice.ExpiresAtHeight, &invoice.Status); err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, invoice)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return invoices, nil
+}
+
+// ExpireInvoice moves an open invoice to the expired status at a block height and
+// releases the fractions reserved for it.
+func (s *TokenisationStore) ExpireInvoice(ctx context.Context, invoice Invoice, height int64) error {
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	err = closeInvoice(ctx, tx.Tx, invoice, InvoiceStatusExpired, BlockRef{Height: height})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ExpireUnconfirmedInvoices marks the gossiped invoices whose expiry height is below
+// the given block height as expired, so they are no longer offered for confirmation.
+// Each invoice is recorded as expiring at the block after its expiry height.
+func (s *TokenisationStore) ExpireUnconfirmedInvoices(ctx context.Context, height int64) error {
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, "SELECT hash, expires_at_height FROM unconfirmed_invoices WHERE expires_at_height > 0 AND expires_at_height < $1 AND status NOT IN ($2, $3)", height, InvoiceStatusExpired, InvoiceStatusCancelled)
+	if err != nil {
+		return err
+	}
+
+	expired := map[string]int64{}
+	for rows.Next() {
+		var hash string
+		var expiresAtHeight int64
+		if err := rows.Scan(&hash, &expiresAtHeight); err != nil {
+			rows.Close()
+			return err
+		}
+		expired[hash] = expiresAtHeight
+	}
+	rows.Close()
+
+	for hash, expiresAtHeight := range expired {
+		err = transitionUnconfirmedInvoice(ctx, tx.Tx, hash, InvoiceStatusExpired, BlockRef{Height: expiresAtHeight + 1})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// CancelUnconfirmedInvoice marks a gossiped invoice as cancelled. Fractions reserved
+// for it by the order book, rather than by an on-chain invoice, are released.
+func (s *TokenisationStore) CancelUnconfirmedInvoice(ctx context.Context, hash string) error {
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	err = transitionUnconfirmedInvoice(ctx, tx.Tx, hash, InvoiceStatusCancelled, BlockRef{})
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM pending_token_balances WHERE invoice_hash = $1 AND onchain_transaction_id = ''", hash)
+	if err != nil {
+		log.Println("Error deleting matched reservation:", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// CancelInvoice applies an on-chain cancellation to a confirmed invoice. The invoice
+// moves to the cancelled status, the fractions reserved for it are released and the
+// cancellation transaction is consumed.
+func (s *TokenisationStore) CancelInvoice(ctx context.Context, invoice Invoice, onchainTransaction OnChainTransaction) error {
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	err = closeInvoice(ctx, tx.Tx, invoice, InvoiceStatusCancelled, onchainTransaction.BlockRef())
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id = $1", onchainTransaction.Id)
+	if err != nil {
+		log.Println("Error deleting onchain transaction:", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// CancelPendingInvoice applies an on-chain cancellation to an invoice that has been
+// seen on chain but not matched to its gossiped terms yet. The reservation and the
+// on-chain invoice that made it are moved aside, marked with the cancellation's block
+// height so that a rollback can restore them, and the cancellation is consumed.
+func (s *TokenisationStore) CancelPendingInvoice(ctx context.Context, pendingTokenBalance PendingTokenBalance, onchainTransaction OnChainTransaction) error {
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	var invoiceTransactionId string
+	err = tx.QueryRowContext(ctx, "SELECT onchain_transaction_id FROM pending_token_balances WHERE invoice_hash = $1 AND mint_hash = $2", pendingTokenBalance.InvoiceHash, pendingTokenBalance.MintHash).Scan(&invoiceTransactionId)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO cancelled_pending_invoices (invoice_hash, mint_hash, owner_address, quantity, pending_created_at, pending_block_height, pending_block_hash, onchain_transaction_id, tx_hash, block_height, block_hash, transaction_number, action_type, action_version, action_data, address, "values", block_time, sub_index, batch_atomic, values_version, onchain_created_at, cancelled_block_height)
+	SELECT p.invoice_hash, p.mint_hash, p.owner_address, p.quantity, p.created_at, p.block_height, p.block_hash, o.id, o.tx_hash, o.block_height, o.block_hash, o.transaction_number, o.action_type, o.action_version, o.action_data, o.address, o."values", o.block_time, o.sub_index, o.batch_atomic, o.values_version, o.created_at, $1
+	FROM pending_token_balances p JOIN onchain_transactions o ON o.id = p.onchain_transaction_id
+	WHERE p.invoice_hash = $2 AND p.mint_hash = $3
+	`, onchainTransaction.Height, pendingTokenBalance.InvoiceHash, pendingTokenBalance.MintHash)
+	if err != nil {
+		log.Println("Error saving cancelled pending invoice:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM pending_token_balances WHERE invoice_hash = $1 AND mint_hash = $2", pendingTokenBalance.InvoiceHash, pendingTokenBalance.MintHash)
+	if err != nil {
+		log.Println("Error deleting pending token balance:", err)
+		return err
+	}
+
+	err = transitionUnconfirmedInvoice(ctx, tx.Tx, pendingTokenBalance.InvoiceHash, InvoiceStatusCancelled, onchainTransaction.BlockRef())
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id IN ($1, $2)", invoiceTransactionId, onchainTransaction.Id)
+	if err != nil {
+		log.Println("Error deleting onchain transactions:", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func closeInvoice(ctx context.Context, tx *sql.Tx, invoice Invoice, status string, block BlockRef) error {
+	err := recordInvoiceTransition(ctx, tx, invoice.Hash, invoice.Status, status, block)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM pending_token_balances WHERE invoice_hash = $1 AND mint_hash = $2", invoice.Hash, invoice.MintHash)
+	if err != nil {
+		log.Println("Error deleting pending token balance:", err)
+		return err
+	}
+
+	closedTransactionHash := sql.NullString{String: block.TransactionHash, Valid: block.TransactionHash != ""}
+	_, err = tx.ExecContext(ctx, "UPDATE invoices SET status = $1, closed_block_height = $2, closed_transaction_hash = $3 WHERE id = $4", status, block.Height, closedTransactionHash, invoice.Id)
+	if err != nil {
+		log.Println("Error closing invoice:", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func saveOpenInvoice(t *testing.T, tokenStore *store.TokenisationStore, blockHeight int64, expiresAtHeight int64) store.Invoice {
+	ctx := context.Background()
+
+	invoice := store.Invoice{
+		Hash:            test_support.GenerateRandomHash(),
+		MintHash:        test_support.GenerateRandomHash(),
+		Quantity:        10,
+		Price:           100,
+		BuyerAddress:    test_support.GenerateDogecoinAddress(true),
+		SellerAddress:   test_support.GenerateDogecoinAddress(true),
+		BlockHeight:     blockHeight,
+		ExpiresAtHeight: expiresAtHeight,
+		CreatedAt:       time.Now(),
+	}
+	_, err := tokenStore.SaveInvoice(ctx, &invoice)
+	assert.NilError(t, err)
+
+	assert.NilError(t, tokenStore.UpsertPendingTokenBalanceAtBlock(ctx, invoice.Hash, invoice.MintHash, invoice.Quantity, "invoiceTx", invoice.SellerAddress, store.BlockRef{Height: blockHeight}, nil))
+
+	invoice, err = tokenStore.GetInvoiceByHash(ctx, invoice.Hash)
+	assert.NilError(t, err)
+	return invoice
+}
+
+func TestExpireInvoiceReleasesReservation(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	expiring := saveOpenInvoice(t, tokenStore, 5, 20)
+	saveOpenInvoice(t, tokenStore, 5, 0)
+	assert.Equal(t, store.InvoiceStatusOnChain, expiring.Status)
+
+	expired, err := tokenStore.GetExpiredInvoices(ctx, 20)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(expired))
+
+	expired, err = tokenStore.GetExpiredInvoices(ctx, 21)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(expired))
+	assert.Equal(t, expiring.Hash, expired[0].Hash)
+
+	assert.NilError(t, tokenStore.ExpireInvoice(ctx, expired[0], 21))
+
+	invoice, err := tokenStore.GetInvoiceByHash(ctx, expiring.Hash)
+	assert.NilError(t, err)
+	assert.Equal(t, store.InvoiceStatusExpired, invoice.Status)
+	assert.Assert(t, invoice.IsClosed())
+	assert.Equal(t, 0, countRows(t, tokenStore, "SELECT COUNT(*) FROM pending_token_balances WHERE invoice_hash = $1", expiring.Hash))
+
+	expired, err = tokenStore.GetExpiredInvoices(ctx, 100)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(expired))
+}
+
+func TestRollbackReopensClosedInvoices(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	invoice := saveOpenInvoice(t, tokenStore, 5, 0)
+
+	assert.NilError(t, tokenStore.CancelInvoice(ctx, invoice, store.OnChainTransaction{Id: "cancelTx", TxHash: "cancelTx", Height: 8}))
+
+	invoice, err := tokenStore.GetInvoiceByHash(ctx, invoice.Hash)
+	assert.NilError(t, err)
+	assert.Equal(t, store.InvoiceStatusCancelled, invoice.Status)
+	assert.Equal(t, 0, countRows(t, tokenStore, "SELECT COUNT(*) FROM pending_token_balances WHERE invoice_hash = $1", invoice.Hash))
+
+	assert.NilError(t, tokenStore.RollbackToHeight(ctx, 7))
+
+	invoice, err = tokenStore.GetInvoiceByHash(ctx, invoice.Hash)
+	assert.NilError(t, err)
+	assert.Equal(t, store.InvoiceStatusOnChain, invoice.Status)
+
+	pending, err := tokenStore.GetPendingTokenBalance(ctx, invoice.Hash, invoice.MintHash, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, invoice.Quantity, pending.Quantity)
+	assert.Equal(t, invoice.SellerAddress, pending.OwnerAddress)
+}
@@ -0,0 +1,119 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GetInvoiceHistory returns the status transitions of an invoice, oldest first.
+func (s *TokenisationStore) GetInvoiceHistory(ctx context.Context, invoiceHash string) ([]InvoiceTransition, error) {
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, invoice_hash, from_status, to_status, block_height, transaction_hash, created_at FROM invoice_history WHERE invoice_hash = $1 ORDER BY created_at ASC", invoiceHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transitions []InvoiceTransition
+	for rows.Next() {
+		var transition InvoiceTransition
+		var transactionHash sql.NullString
+		if err := rows.Scan(&transition.Id, &transition.InvoiceHash, &transition.FromStatus, &transition.ToStatus, &transition.BlockHeight, &transactionHash, &transition.CreatedAt); err != nil {
+			return nil, err
+		}
+		transition.TransactionHash = transactionHash.String
+		transitions = append(transitions, transition)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transitions, nil
+}
+
+// ApproveSignedInvoice moves a gossiped invoice that is waiting for signatures to
+// signed once its mint's signature requirement is met. Invoices in any other status,
+// or that have not been gossiped to this node, are left alone.
+func (s *TokenisationStore) ApproveSignedInvoice(ctx context.Context, invoiceHash string) error {
+	var mintHash string
+	var status string
+	err := s.conn().QueryRowContext(ctx, "SELECT mint_hash, status FROM unconfirmed_invoices WHERE hash = $1", invoiceHash).Scan(&mintHash, &status)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if status != InvoiceStatusPendingSignatures {
+		return nil
+	}
+
+	mint, err := s.GetMintByHash(ctx, mintHash)
+	if err != nil {
+		return err
+	}
+
+	signatures, err := s.GetApprovedInvoiceSignatures(ctx, invoiceHash)
+	if err != nil {
+		return err
+	}
+
+	if !mint.HasRequiredSignatures(signatures) {
+		return nil
+	}
+
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	err = transitionUnconfirmedInvoice(ctx, tx.Tx, invoiceHash, InvoiceStatusSigned, BlockRef{})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// transitionUnconfirmedInvoice moves a gossiped invoice to a new status and records
+// the transition. Invoices that have not been gossiped to this node are left alone.
+func transitionUnconfirmedInvoice(ctx context.Context, tx *sql.Tx, invoiceHash string, to string, block BlockRef) error {
+	var from string
+	err := tx.QueryRowContext(ctx, "SELECT status FROM unconfirmed_invoices WHERE hash = $1", invoiceHash).Scan(&from)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	err = recordInvoiceTransition(ctx, tx, invoiceHash, from, to, block)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE unconfirmed_invoices SET status = $1 WHERE hash = $2", to, invoiceHash)
+	return err
+}
+
+// recordInvoiceTransition checks that an invoice can move between two statuses and
+// appends the transition to its history. The block is empty for transitions that were
+// not caused by an on-chain transaction.
+func recordInvoiceTransition(ctx context.Context, tx *sql.Tx, invoiceHash string, from string, to string, block BlockRef) error {
+	if !CanTransitionInvoice(from, to) {
+		return fmt.Errorf("%w: %s from %q to %q", ErrInvalidInvoiceTransition, invoiceHash, from, to)
+	}
+
+	blockHeight := sql.NullInt64{Int64: block.Height, Valid: block.Height > 0}
+	transactionHash := sql.NullString{String: block.TransactionHash, Valid: block.TransactionHash != ""}
+
+	_, err := tx.ExecContext(ctx, "INSERT INTO invoice_history (id, invoice_hash, from_status, to_status, block_height, transaction_hash, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)", uuid.New().String(), invoiceHash, from, to, blockHeight, transactionHash, time.Now().UTC())
+	return err
+}
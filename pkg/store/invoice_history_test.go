@@ -0,0 +1,212 @@
+package store_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+	"gotest.tools/assert"
+)
+
+func assertInvoiceHistory(t *testing.T, tokenStore *store.TokenisationStore, invoiceHash string, statuses ...string) []store.InvoiceTransition {
+	history, err := tokenStore.GetInvoiceHistory(context.Background(), invoiceHash)
+	assert.NilError(t, err)
+	assert.Equal(t, len(statuses), len(history))
+
+	from := ""
+	for i, transition := range history {
+		assert.Equal(t, from, transition.FromStatus)
+		assert.Equal(t, statuses[i], transition.ToStatus)
+		from = transition.ToStatus
+	}
+
+	return history
+}
+
+func TestCanTransitionInvoice(t *testing.T) {
+	assert.Assert(t, store.CanTransitionInvoice("", store.InvoiceStatusDraft))
+	assert.Assert(t, store.CanTransitionInvoice(store.InvoiceStatusPendingSignatures, store.InvoiceStatusSigned))
+	assert.Assert(t, store.CanTransitionInvoice(store.InvoiceStatusSigned, store.InvoiceStatusOnChain))
+	assert.Assert(t, store.CanTransitionInvoice(store.InvoiceStatusOnChain, store.InvoiceStatusPaid))
+	assert.Assert(t, store.CanTransitionInvoice(store.InvoiceStatusDraft, store.InvoiceStatusCancelled))
+
+	assert.Assert(t, !store.CanTransitionInvoice("", store.InvoiceStatusOnChain))
+	assert.Assert(t, !store.CanTransitionInvoice(store.InvoiceStatusDraft, store.InvoiceStatusSigned))
+	assert.Assert(t, !store.CanTransitionInvoice(store.InvoiceStatusDraft, store.InvoiceStatusPaid))
+	assert.Assert(t, !store.CanTransitionInvoice(store.InvoiceStatusPaid, store.InvoiceStatusCancelled))
+	assert.Assert(t, !store.CanTransitionInvoice(store.InvoiceStatusCancelled, store.InvoiceStatusOnChain))
+	assert.Assert(t, !store.CanTransitionInvoice(store.InvoiceStatusExpired, store.InvoiceStatusExpired))
+}
+
+func TestInvoiceHistoryRecordsLifecycle(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mintHash := test_support.GenerateRandomHash()
+	invoiceHash := test_support.GenerateRandomHash()
+	sellerAddress := test_support.GenerateDogecoinAddress(true)
+	buyerAddress := test_support.GenerateDogecoinAddress(true)
+	quantity := 40
+
+	// Mint requiring one asset manager signature, confirmed at height 10
+	_, err := tokenStore.SaveUnconfirmedMint(ctx, &store.MintWithoutID{
+		Hash:                     mintHash,
+		Title:                    "Test Mint",
+		FractionCount:            100,
+		SignatureRequirementType: store.SignatureRequirementType_ONE_SIGNATURE,
+	})
+	assert.NilError(t, err)
+
+	mintMsg, _ := proto.Marshal(&protocol.OnChainMintMessage{Hash: mintHash})
+	mintTxId, err := tokenStore.SaveOnChainTransaction(ctx, "mintTx", 10, "block10", 0, protocol.ACTION_MINT, protocol.DEFAULT_VERSION, mintMsg, sellerAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.NilError(t, tokenStore.MatchUnconfirmedMint(ctx, *findTransactionById(txs, mintTxId)))
+
+	mint, err := tokenStore.GetMintByHash(ctx, mintHash)
+	assert.NilError(t, err)
+
+	_, err = tokenStore.SaveUnconfirmedInvoice(ctx, &store.UnconfirmedInvoice{
+		Hash:           invoiceHash,
+		PaymentAddress: sellerAddress,
+		BuyerAddress:   buyerAddress,
+		MintHash:       mintHash,
+		Quantity:       quantity,
+		Price:          100,
+		CreatedAt:      time.Now(),
+		SellerAddress:  sellerAddress,
+		Status:         store.InitialInvoiceStatus(mint),
+	})
+	assert.NilError(t, err)
+
+	_, err = tokenStore.SaveApprovedInvoiceSignature(ctx, &store.InvoiceSignature{
+		InvoiceHash: invoiceHash,
+		Signature:   "signature",
+		PublicKey:   "publicKey",
+		CreatedAt:   time.Now(),
+	})
+	assert.NilError(t, err)
+
+	unconfirmedInvoice, err := tokenStore.GetUnconfirmedInvoiceByHash(ctx, invoiceHash)
+	assert.NilError(t, err)
+	assert.Equal(t, store.InvoiceStatusSigned, unconfirmedInvoice.Status)
+
+	// Invoice confirmed at height 11
+	invoiceHashBytes, _ := hex.DecodeString(invoiceHash)
+	mintHashBytes, _ := hex.DecodeString(mintHash)
+	invoiceMsg, _ := proto.Marshal(&protocol.OnChainInvoiceMessage{InvoiceHash: invoiceHashBytes, MintHash: mintHashBytes, Quantity: int32(quantity)})
+	invoiceTxId, err := tokenStore.SaveOnChainTransaction(ctx, "invoiceTx", 11, "block11", 0, protocol.ACTION_INVOICE, protocol.DEFAULT_VERSION, invoiceMsg, sellerAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err = tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	invoiceTx := findTransactionById(txs, invoiceTxId)
+	assert.NilError(t, tokenStore.UpsertPendingTokenBalanceAtBlock(ctx, invoiceHash, mintHash, quantity, invoiceTx.Id, sellerAddress, invoiceTx.BlockRef(), nil))
+	assert.NilError(t, tokenStore.MatchUnconfirmedInvoice(ctx, *invoiceTx))
+
+	// Payment confirmed at height 12
+	invoice, err := tokenStore.GetInvoiceByHash(ctx, invoiceHash)
+	assert.NilError(t, err)
+	_, settled := payInvoice(t, tokenStore, invoice, "paymentTx", 12, buyerAddress, int64(quantity)*100*doge.KoinuPerDoge)
+	assert.Assert(t, settled)
+
+	history := assertInvoiceHistory(t, tokenStore, invoiceHash, store.InvoiceStatusPendingSignatures, store.InvoiceStatusSigned, store.InvoiceStatusOnChain, store.InvoiceStatusPaid)
+	assert.Assert(t, !history[1].BlockHeight.Valid)
+	assert.Equal(t, int64(11), history[2].BlockHeight.Int64)
+	assert.Equal(t, "invoiceTx", history[2].TransactionHash)
+	assert.Equal(t, int64(12), history[3].BlockHeight.Int64)
+	assert.Equal(t, "paymentTx", history[3].TransactionHash)
+
+	// Reorgs unwind the on-chain transitions but keep those made over gossip
+	assert.NilError(t, tokenStore.RollbackToHeight(ctx, 11))
+	assertInvoiceHistory(t, tokenStore, invoiceHash, store.InvoiceStatusPendingSignatures, store.InvoiceStatusSigned, store.InvoiceStatusOnChain)
+
+	assert.NilError(t, tokenStore.RollbackToHeight(ctx, 10))
+	assertInvoiceHistory(t, tokenStore, invoiceHash, store.InvoiceStatusPendingSignatures, store.InvoiceStatusSigned)
+
+	unconfirmedInvoice, err = tokenStore.GetUnconfirmedInvoiceByHash(ctx, invoiceHash)
+	assert.NilError(t, err)
+	assert.Equal(t, store.InvoiceStatusSigned, unconfirmedInvoice.Status)
+}
+
+func TestCancelledUnconfirmedInvoiceCannotBeConfirmed(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	invoiceHash := test_support.GenerateRandomHash()
+	mintHash := test_support.GenerateRandomHash()
+	sellerAddress := test_support.GenerateDogecoinAddress(true)
+
+	_, err := tokenStore.SaveUnconfirmedInvoice(ctx, &store.UnconfirmedInvoice{
+		Hash:          invoiceHash,
+		MintHash:      mintHash,
+		Quantity:      10,
+		Price:         100,
+		BuyerAddress:  test_support.GenerateDogecoinAddress(true),
+		SellerAddress: sellerAddress,
+		CreatedAt:     time.Now(),
+	})
+	assert.NilError(t, err)
+
+	assert.NilError(t, tokenStore.CancelUnconfirmedInvoice(ctx, invoiceHash))
+	assertInvoiceHistory(t, tokenStore, invoiceHash, store.InvoiceStatusDraft, store.InvoiceStatusCancelled)
+
+	err = tokenStore.CancelUnconfirmedInvoice(ctx, invoiceHash)
+	assert.Assert(t, errors.Is(err, store.ErrInvalidInvoiceTransition))
+
+	invoiceHashBytes, _ := hex.DecodeString(invoiceHash)
+	mintHashBytes, _ := hex.DecodeString(mintHash)
+	invoiceMsg, _ := proto.Marshal(&protocol.OnChainInvoiceMessage{InvoiceHash: invoiceHashBytes, MintHash: mintHashBytes, Quantity: 10})
+	invoiceTxId, err := tokenStore.SaveOnChainTransaction(ctx, "invoiceTx", 11, "block11", 0, protocol.ACTION_INVOICE, protocol.DEFAULT_VERSION, invoiceMsg, sellerAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	invoiceTx := findTransactionById(txs, invoiceTxId)
+	assert.NilError(t, tokenStore.UpsertPendingTokenBalanceAtBlock(ctx, invoiceHash, mintHash, 10, invoiceTx.Id, sellerAddress, invoiceTx.BlockRef(), nil))
+
+	err = tokenStore.MatchUnconfirmedInvoice(ctx, *invoiceTx)
+	assert.Assert(t, errors.Is(err, store.ErrInvalidInvoiceTransition))
+	assert.Equal(t, 0, countRows(t, tokenStore, "SELECT COUNT(*) FROM invoices WHERE hash = $1", invoiceHash))
+}
+
+func TestExpireUnconfirmedInvoicesRecordsExpiryHeight(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	invoiceHash := test_support.GenerateRandomHash()
+	_, err := tokenStore.SaveUnconfirmedInvoice(ctx, &store.UnconfirmedInvoice{
+		Hash:            invoiceHash,
+		MintHash:        test_support.GenerateRandomHash(),
+		Quantity:        10,
+		Price:           100,
+		BuyerAddress:    test_support.GenerateDogecoinAddress(true),
+		SellerAddress:   test_support.GenerateDogecoinAddress(true),
+		CreatedAt:       time.Now(),
+		ExpiresAtHeight: 20,
+	})
+	assert.NilError(t, err)
+
+	assert.NilError(t, tokenStore.ExpireUnconfirmedInvoices(ctx, 25))
+	assert.NilError(t, tokenStore.ExpireUnconfirmedInvoices(ctx, 26))
+
+	history := assertInvoiceHistory(t, tokenStore, invoiceHash, store.InvoiceStatusDraft, store.InvoiceStatusExpired)
+	assert.Equal(t, int64(21), history[1].BlockHeight.Int64)
+
+	// A reorg below the expiry height reopens the invoice
+	assert.NilError(t, tokenStore.RollbackToHeight(ctx, 20))
+	assertInvoiceHistory(t, tokenStore, invoiceHash, store.InvoiceStatusDraft)
+
+	unconfirmedInvoice, err := tokenStore.GetUnconfirmedInvoiceByHash(ctx, invoiceHash)
+	assert.NilError(t, err)
+	assert.Equal(t, store.InvoiceStatusDraft, unconfirmedInvoice.Status)
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/hex"
 	"fmt"
+	"log"
 
 	"dogecoin.org/fractal-engine/pkg/protocol"
 	"github.com/google/uuid"
@@ -12,16 +13,16 @@ import (
 )
 
 func (s *TokenisationStore) ChooseInvoice(ctx context.Context) (Invoice, error) {
-	row := s.DB.QueryRowContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, created_at, seller_address, public_key, signature, paid_at FROM invoices WHERE hash IN (SELECT hash FROM invoices ORDER BY RANDOM() LIMIT 1)")
+	row := s.conn().QueryRowContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, doge_price, created_at, seller_address, public_key, signature, paid_at, splits, expires_at_height, status FROM invoices WHERE hash IN (SELECT hash FROM invoices ORDER BY RANDOM() LIMIT 1)")
 	var invoice Invoice
-	if err := row.Scan(&invoice.Id, &invoice.Hash, &invoice.PaymentAddress, &invoice.BuyerAddress, &invoice.MintHash, &invoice.Quantity, &invoice.Price, &invoice.CreatedAt, &invoice.SellerAddress, &invoice.PublicKey, &invoice.Signature, &invoice.PaidAt); err != nil {
+	if err := row.Scan(&invoice.Id, &invoice.Hash, &invoice.PaymentAddress, &invoice.BuyerAddress, &invoice.MintHash, &invoice.Quantity, &invoice.Price, &invoice.DogePrice, &invoice.CreatedAt, &invoice.SellerAddress, &invoice.PublicKey, &invoice.Signature, &invoice.PaidAt, &invoice.Splits, &invoice.ExpiresAtHeight, &invoice.Status); err != nil {
 		return Invoice{}, err
 	}
 	return invoice, nil
 }
 
 func (s *TokenisationStore) ChooseInvoiceSignature(ctx context.Context) (InvoiceSignature, error) {
-	row := s.DB.QueryRowContext(ctx, "SELECT id, invoice_hash, signature, public_key, created_at FROM invoice_signatures WHERE id IN (SELECT id FROM invoice_signatures ORDER BY RANDOM() LIMIT 1)")
+	row := s.conn().QueryRowContext(ctx, "SELECT id, invoice_hash, signature, public_key, created_at FROM invoice_signatures WHERE id IN (SELECT id FROM invoice_signatures ORDER BY RANDOM() LIMIT 1)")
 	var invoice InvoiceSignature
 	if err := row.Scan(&invoice.Id, &invoice.InvoiceHash, &invoice.Signature, &invoice.PublicKey, &invoice.CreatedAt); err != nil {
 		return InvoiceSignature{}, err
@@ -30,25 +31,25 @@ func (s *TokenisationStore) ChooseInvoiceSignature(ctx context.Context) (Invoice
 }
 
 func (s *TokenisationStore) GetInvoiceByHash(ctx context.Context, hash string) (Invoice, error) {
-	row := s.DB.QueryRowContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, created_at, seller_address, public_key, signature, paid_at FROM invoices WHERE hash = $1", hash)
+	row := s.conn().QueryRowContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, doge_price, created_at, seller_address, public_key, signature, paid_at, splits, expires_at_height, status FROM invoices WHERE hash = $1", hash)
 	var invoice Invoice
-	if err := row.Scan(&invoice.Id, &invoice.Hash, &invoice.PaymentAddress, &invoice.BuyerAddress, &invoice.MintHash, &invoice.Quantity, &invoice.Price, &invoice.CreatedAt, &invoice.SellerAddress, &invoice.PublicKey, &invoice.Signature, &invoice.PaidAt); err != nil {
+	if err := row.Scan(&invoice.Id, &invoice.Hash, &invoice.PaymentAddress, &invoice.BuyerAddress, &invoice.MintHash, &invoice.Quantity, &invoice.Price, &invoice.DogePrice, &invoice.CreatedAt, &invoice.SellerAddress, &invoice.PublicKey, &invoice.Signature, &invoice.PaidAt, &invoice.Splits, &invoice.ExpiresAtHeight, &invoice.Status); err != nil {
 		return Invoice{}, err
 	}
 	return invoice, nil
 }
 
 func (s *TokenisationStore) GetUnconfirmedInvoiceByHash(ctx context.Context, hash string) (UnconfirmedInvoice, error) {
-	row := s.DB.QueryRowContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, created_at, seller_address, public_key, signature FROM unconfirmed_invoices WHERE hash = $1", hash)
+	row := s.conn().QueryRowContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, doge_price, created_at, seller_address, public_key, signature, status, splits, expires_at_height FROM unconfirmed_invoices WHERE hash = $1", hash)
 	var invoice UnconfirmedInvoice
-	if err := row.Scan(&invoice.Id, &invoice.Hash, &invoice.PaymentAddress, &invoice.BuyerAddress, &invoice.MintHash, &invoice.Quantity, &invoice.Price, &invoice.CreatedAt, &invoice.SellerAddress, &invoice.PublicKey, &invoice.Signature); err != nil {
+	if err := row.Scan(&invoice.Id, &invoice.Hash, &invoice.PaymentAddress, &invoice.BuyerAddress, &invoice.MintHash, &invoice.Quantity, &invoice.Price, &invoice.DogePrice, &invoice.CreatedAt, &invoice.SellerAddress, &invoice.PublicKey, &invoice.Signature, &invoice.Status, &invoice.Splits, &invoice.ExpiresAtHeight); err != nil {
 		return UnconfirmedInvoice{}, err
 	}
 	return invoice, nil
 }
 
 func (s *TokenisationStore) GetInvoicesForMe(ctx context.Context, offset int, limit int, myAddress string) ([]Invoice, error) {
-	rows, err := s.DB.QueryContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, created_at, seller_address, public_key, signature, paid_at FROM invoices WHERE (buyer_address = $1 OR seller_address = $1) LIMIT $2 OFFSET $3", myAddress, limit, offset)
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, doge_price, created_at, seller_address, public_key, signature, paid_at, splits, expires_at_height, status FROM invoices WHERE (buyer_address = $1 OR seller_address = $1) LIMIT $2 OFFSET $3", myAddress, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +59,7 @@ func (s *TokenisationStore) GetInvoicesForMe(ctx context.Context, offset int, li
 
 	for rows.Next() {
 		var invoice Invoice
-		if err := rows.Scan(&invoice.Id, &invoice.Hash, &invoice.PaymentAddress, &invoice.BuyerAddress, &invoice.MintHash, &invoice.Quantity, &invoice.Price, &invoice.CreatedAt, &invoice.SellerAddress, &invoice.PublicKey, &invoice.Signature, &invoice.PaidAt); err != nil {
+		if err := rows.Scan(&invoice.Id, &invoice.Hash, &invoice.PaymentAddress, &invoice.BuyerAddress, &invoice.MintHash, &invoice.Quantity, &invoice.Price, &invoice.DogePrice, &invoice.CreatedAt, &invoice.SellerAddress, &invoice.PublicKey, &invoice.Signature, &invoice.PaidAt, &invoice.Splits, &invoice.ExpiresAtHeight, &invoice.Status); err != nil {
 			return nil, err
 		}
 
@@ -73,7 +74,7 @@ func (s *TokenisationStore) GetInvoicesForMe(ctx context.Context, offset int, li
 }
 
 func (s *TokenisationStore) GetInvoices(ctx context.Context, offset int, limit int, mintHash string, offererAddress string) ([]Invoice, error) {
-	rows, err := s.DB.QueryContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, created_at, seller_address, public_key, signature, paid_at FROM invoices WHERE mint_hash = $1 AND (buyer_address = $2 OR seller_address = $2) LIMIT $3 OFFSET $4", mintHash, offererAddress, limit, offset)
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, doge_price, created_at, seller_address, public_key, signature, paid_at, splits, expires_at_height, status FROM invoices WHERE mint_hash = $1 AND (buyer_address = $2 OR seller_address = $2) LIMIT $3 OFFSET $4", mintHash, offererAddress, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +84,7 @@ func (s *TokenisationStore) GetInvoices(ctx context.Context, offset int, limit i
 
 	for rows.Next() {
 		var invoice Invoice
-		if err := rows.Scan(&invoice.Id, &invoice.Hash, &invoice.PaymentAddress, &invoice.BuyerAddress, &invoice.MintHash, &invoice.Quantity, &invoice.Price, &invoice.CreatedAt, &invoice.SellerAddress, &invoice.PublicKey, &invoice.Signature, &invoice.PaidAt); err != nil {
+		if err := rows.Scan(&invoice.Id, &invoice.Hash, &invoice.PaymentAddress, &invoice.BuyerAddress, &invoice.MintHash, &invoice.Quantity, &invoice.Price, &invoice.DogePrice, &invoice.CreatedAt, &invoice.SellerAddress, &invoice.PublicKey, &invoice.Signature, &invoice.PaidAt, &invoice.Splits, &invoice.ExpiresAtHeight, &invoice.Status); err != nil {
 			return nil, err
 		}
 
@@ -102,9 +103,9 @@ func (s *TokenisationStore) GetAllInvoices(ctx context.Context, offset int, limi
 	var err error
 
 	if mintHash == "" {
-		rows, err = s.DB.QueryContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, created_at, seller_address, public_key, signature, paid_at FROM invoices LIMIT $1 OFFSET $2", limit, offset)
+		rows, err = s.conn().QueryContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, doge_price, created_at, seller_address, public_key, signature, paid_at, splits, expires_at_height, status FROM invoices LIMIT $1 OFFSET $2", limit, offset)
 	} else {
-		rows, err = s.DB.QueryContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, created_at, seller_address, public_key, signature, paid_at FROM invoices WHERE mint_hash = $1 LIMIT $2 OFFSET $3", mintHash, limit, offset)
+		rows, err = s.conn().QueryContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, doge_price, created_at, seller_address, public_key, signature, paid_at, splits, expires_at_height, status FROM invoices WHERE mint_hash = $1 LIMIT $2 OFFSET $3", mintHash, limit, offset)
 	}
 	if err != nil {
 		return nil, err
@@ -114,7 +115,7 @@ func (s *TokenisationStore) GetAllInvoices(ctx context.Context, offset int, limi
 	var invoices []Invoice
 	for rows.Next() {
 		var invoice Invoice
-		if err := rows.Scan(&invoice.Id, &invoice.Hash, &invoice.PaymentAddress, &invoice.BuyerAddress, &invoice.MintHash, &invoice.Quantity, &invoice.Price, &invoice.CreatedAt, &invoice.SellerAddress, &invoice.PublicKey, &invoice.Signature, &invoice.PaidAt); err != nil {
+		if err := rows.Scan(&invoice.Id, &invoice.Hash, &invoice.PaymentAddress, &invoice.BuyerAddress, &invoice.MintHash, &invoice.Quantity, &invoice.Price, &invoice.DogePrice, &invoice.CreatedAt, &invoice.SellerAddress, &invoice.PublicKey, &invoice.Signature, &invoice.PaidAt, &invoice.Splits, &invoice.ExpiresAtHeight, &invoice.Status); err != nil {
 			return nil, err
 		}
 		invoices = append(invoices, invoice)
@@ -128,14 +129,14 @@ func (s *TokenisationStore) GetAllInvoices(ctx context.Context, offset int, limi
 }
 
 func (s *TokenisationStore) CountUnconfirmedInvoices(ctx context.Context, mintHash string, offererAddress string) (int, error) {
-	row := s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM unconfirmed_invoices WHERE mint_hash = $1 AND buyer_address = $2", mintHash, offererAddress)
+	row := s.conn().QueryRowContext(ctx, "SELECT COUNT(*) FROM unconfirmed_invoices WHERE mint_hash = $1 AND buyer_address = $2", mintHash, offererAddress)
 	var count int
 	err := row.Scan(&count)
 	return count, err
 }
 
 func (s *TokenisationStore) GetUnconfirmedInvoices(ctx context.Context, offset int, limit int, mintHash string, offererAddress string) ([]UnconfirmedInvoice, error) {
-	rows, err := s.DB.QueryContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, created_at, seller_address, public_key, signature, status FROM unconfirmed_invoices WHERE mint_hash = $1 AND buyer_address = $2 LIMIT $3 OFFSET $4", mintHash, offererAddress, limit, offset)
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, doge_price, created_at, seller_address, public_key, signature, status, splits, expires_at_height FROM unconfirmed_invoices WHERE mint_hash = $1 AND buyer_address = $2 LIMIT $3 OFFSET $4", mintHash, offererAddress, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -145,7 +146,7 @@ func (s *TokenisationStore) GetUnconfirmedInvoices(ctx context.Context, offset i
 
 	for rows.Next() {
 		var invoice UnconfirmedInvoice
-		if err := rows.Scan(&invoice.Id, &invoice.Hash, &invoice.PaymentAddress, &invoice.BuyerAddress, &invoice.MintHash, &invoice.Quantity, &invoice.Price, &invoice.CreatedAt, &invoice.SellerAddress, &invoice.PublicKey, &invoice.Signature, &invoice.Status); err != nil {
+		if err := rows.Scan(&invoice.Id, &invoice.Hash, &invoice.PaymentAddress, &invoice.BuyerAddress, &invoice.MintHash, &invoice.Quantity, &invoice.Price, &invoice.DogePrice, &invoice.CreatedAt, &invoice.SellerAddress, &invoice.PublicKey, &invoice.Signature, &invoice.Status, &invoice.Splits, &invoice.ExpiresAtHeight); err != nil {
 			return nil, err
 		}
 
@@ -160,16 +161,67 @@ func (s *TokenisationStore) GetUnconfirmedInvoices(ctx context.Context, offset i
 }
 
 func (s *TokenisationStore) SaveUnconfirmedInvoice(ctx context.Context, invoice *UnconfirmedInvoice) (string, error) {
+	return s.SaveUnconfirmedInvoiceWithTx(ctx, invoice, nil)
+}
+
+// SaveUnconfirmedInvoiceWithTx saves a gossiped invoice and records its first
+// transition. Invoices saved without a status start as drafts.
+func (s *TokenisationStore) SaveUnconfirmedInvoiceWithTx(ctx context.Context, invoice *UnconfirmedInvoice, tx *sql.Tx) (string, error) {
+	if tx == nil {
+		tx, err := s.Begin(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		defer tx.Rollback()
+
+		id, err := s.SaveUnconfirmedInvoiceWithTx(ctx, invoice, tx.Tx)
+		if err != nil {
+			return "", err
+		}
+
+		return id, tx.Commit()
+	}
+
 	id := uuid.New().String()
 
-	_, err := s.DB.ExecContext(ctx, `
-	INSERT INTO unconfirmed_invoices (id, hash, payment_address, buyer_address, mint_hash, quantity, price, created_at, seller_address, public_key, signature, status)
-	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
-	`, id, invoice.Hash, invoice.PaymentAddress, invoice.BuyerAddress, invoice.MintHash, invoice.Quantity, invoice.Price, invoice.CreatedAt, invoice.SellerAddress, invoice.PublicKey, invoice.Signature, invoice.Status)
+	invoiceStatus := invoice.Status
+	if invoiceStatus == "" {
+		invoiceStatus = InvoiceStatusDraft
+	}
+
+	err := recordInvoiceTransition(ctx, tx, invoice.Hash, "", invoiceStatus, BlockRef{})
+	if err != nil {
+		return "", err
+	}
+
+	query := `
+	INSERT INTO unconfirmed_invoices (id, hash, payment_address, buyer_address, mint_hash, quantity, price, doge_price, created_at, seller_address, public_key, signature, status, splits, expires_at_height)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+	`
+
+	_, err = tx.ExecContext(ctx, query, id, invoice.Hash, invoice.PaymentAddress, invoice.BuyerAddress, invoice.MintHash, invoice.Quantity, invoice.Price, invoice.DogePrice, invoice.CreatedAt, invoice.SellerAddress, invoice.PublicKey, invoice.Signature, invoiceStatus, invoice.Splits, invoice.ExpiresAtHeight)
 
 	return id, err
 }
 
+// SignUnconfirmedInvoice sets the seller's signature on an invoice that the order
+// book produced unsigned. It reports whether such an invoice was signed, so callers
+// can save the invoice as a new one otherwise.
+func (s *TokenisationStore) SignUnconfirmedInvoice(ctx context.Context, invoice UnconfirmedInvoice) (bool, error) {
+	result, err := s.conn().ExecContext(ctx, "UPDATE unconfirmed_invoices SET signature = $1 WHERE hash = $2 AND public_key = $3 AND payment_address = $4 AND signature = ''", invoice.Signature, invoice.Hash, invoice.PublicKey, invoice.PaymentAddress)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
 func (s *TokenisationStore) SaveInvoice(ctx context.Context, invoice *Invoice) (string, error) {
 	return s.SaveInvoiceWithTx(ctx, invoice, nil)
 }
@@ -177,21 +229,51 @@ func (s *TokenisationStore) SaveInvoice(ctx context.Context, invoice *Invoice) (
 func (s *TokenisationStore) SaveInvoiceWithTx(ctx context.Context, invoice *Invoice, tx *sql.Tx) (string, error) {
 	id := uuid.New().String()
 
+	invoiceStatus := invoice.Status
+	if invoiceStatus == "" {
+		invoiceStatus = InvoiceStatusOnChain
+	}
+
 	query := `
-	INSERT INTO invoices (id, hash, payment_address, buyer_address, mint_hash, quantity, price, created_at, seller_address, block_height, transaction_hash, public_key, signature)
-	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	INSERT INTO invoices (id, hash, payment_address, buyer_address, mint_hash, quantity, price, doge_price, created_at, seller_address, block_height, transaction_hash, public_key, signature, block_hash, splits, expires_at_height, status)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 	`
 
 	var err error
 	if tx != nil {
-		_, err = tx.ExecContext(ctx, query, id, invoice.Hash, invoice.PaymentAddress, invoice.BuyerAddress, invoice.MintHash, invoice.Quantity, invoice.Price, invoice.CreatedAt, invoice.SellerAddress, invoice.BlockHeight, invoice.TransactionHash, invoice.PublicKey, invoice.Signature)
+		_, err = tx.ExecContext(ctx, query, id, invoice.Hash, invoice.PaymentAddress, invoice.BuyerAddress, invoice.MintHash, invoice.Quantity, invoice.Price, invoice.DogePrice, invoice.CreatedAt, invoice.SellerAddress, invoice.BlockHeight, invoice.TransactionHash, invoice.PublicKey, invoice.Signature, invoice.BlockHash, invoice.Splits, invoice.ExpiresAtHeight, invoiceStatus)
 	} else {
-		_, err = s.DB.ExecContext(ctx, query, id, invoice.Hash, invoice.PaymentAddress, invoice.BuyerAddress, invoice.MintHash, invoice.Quantity, invoice.Price, invoice.CreatedAt, invoice.SellerAddress, invoice.BlockHeight, invoice.TransactionHash, invoice.PublicKey, invoice.Signature)
+		_, err = s.conn().ExecContext(ctx, query, id, invoice.Hash, invoice.PaymentAddress, invoice.BuyerAddress, invoice.MintHash, invoice.Quantity, invoice.Price, invoice.DogePrice, invoice.CreatedAt, invoice.SellerAddress, invoice.BlockHeight, invoice.TransactionHash, invoice.PublicKey, invoice.Signature, invoice.BlockHash, invoice.Splits, invoice.ExpiresAtHeight, invoiceStatus)
 	}
 
 	return id, err
 }
 
+// DiscardInvoiceTransaction consumes an on-chain invoice that will never be honoured
+// and releases the token balance reserved for it.
+func (s *TokenisationStore) DiscardInvoiceTransaction(ctx context.Context, onchainTransactionId string, invoiceHash string, mintHash string) error {
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM pending_token_balances WHERE invoice_hash = $1 AND mint_hash = $2", invoiceHash, mintHash)
+	if err != nil {
+		log.Println("Error deleting pending token balance:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id = $1", onchainTransactionId)
+	if err != nil {
+		log.Println("Error deleting onchain transaction:", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (s *TokenisationStore) MatchInvoice(ctx context.Context, onchainTransaction OnChainTransaction) bool {
 	if onchainTransaction.ActionType != protocol.ACTION_INVOICE {
 		return false
@@ -203,7 +285,7 @@ func (s *TokenisationStore) MatchInvoice(ctx context.Context, onchainTransaction
 		return false
 	}
 
-	rows, err := s.DB.QueryContext(ctx, "SELECT hash, transaction_hash FROM invoices WHERE transaction_hash = $1 and block_height = $2 and hash = $3", onchainTransaction.TxHash, onchainTransaction.Height, hex.EncodeToString(onchainMessage.InvoiceHash))
+	rows, err := s.conn().QueryContext(ctx, "SELECT hash, transaction_hash FROM invoices WHERE transaction_hash = $1 and block_height = $2 and hash = $3", onchainTransaction.TxHash, onchainTransaction.Height, hex.EncodeToString(onchainMessage.InvoiceHash))
 	if err != nil {
 		return false
 	}
@@ -212,7 +294,7 @@ func (s *TokenisationStore) MatchInvoice(ctx context.Context, onchainTransaction
 	exists := rows.Next()
 
 	if exists {
-		_, err = s.DB.ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id = $1", onchainTransaction.Id)
+		_, err = s.conn().ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id = $1", onchainTransaction.Id)
 		if err != nil {
 			return false
 		}
@@ -233,13 +315,13 @@ func (s *TokenisationStore) MatchUnconfirmedInvoice(ctx context.Context, onchain
 	}
 
 	// Start transaction for atomic operations
-	tx, err := s.DB.BeginTx(ctx, nil)
+	tx, err := s.Begin(ctx)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
-	rows, err := tx.QueryContext(ctx, "SELECT id, hash, buyer_address, mint_hash, quantity, price, created_at, seller_address, public_key, signature, status FROM unconfirmed_invoices WHERE hash = $1", hex.EncodeToString(onchainMessage.InvoiceHash))
+	rows, err := tx.QueryContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, doge_price, created_at, seller_address, public_key, signature, status, splits, expires_at_height FROM unconfirmed_invoices WHERE hash = $1", hex.EncodeToString(onchainMessage.InvoiceHash))
 	if err != nil {
 		return err
 	}
@@ -247,7 +329,7 @@ func (s *TokenisationStore) MatchUnconfirmedInvoice(ctx context.Context, onchain
 	var unconfirmedInvoice UnconfirmedInvoice
 	if rows.Next() {
 		if err := rows.Scan(
-			&unconfirmedInvoice.Id, &unconfirmedInvoice.Hash, &unconfirmedInvoice.BuyerAddress, &unconfirmedInvoice.MintHash, &unconfirmedInvoice.Quantity, &unconfirmedInvoice.Price, &unconfirmedInvoice.CreatedAt, &unconfirmedInvoice.SellerAddress, &unconfirmedInvoice.PublicKey, &unconfirmedInvoice.Signature, &unconfirmedInvoice.Status); err != nil {
+			&unconfirmedInvoice.Id, &unconfirmedInvoice.Hash, &unconfirmedInvoice.PaymentAddress, &unconfirmedInvoice.BuyerAddress, &unconfirmedInvoice.MintHash, &unconfirmedInvoice.Quantity, &unconfirmedInvoice.Price, &unconfirmedInvoice.DogePrice, &unconfirmedInvoice.CreatedAt, &unconfirmedInvoice.SellerAddress, &unconfirmedInvoice.PublicKey, &unconfirmedInvoice.Signature, &unconfirmedInvoice.Status, &unconfirmedInvoice.Splits, &unconfirmedInvoice.ExpiresAtHeight); err != nil {
 			return err
 		}
 	} else {
@@ -257,7 +339,11 @@ func (s *TokenisationStore) MatchUnconfirmedInvoice(ctx context.Context, onchain
 
 	rows.Close()
 
-	pendingTokenBalance, err := s.GetPendingTokenBalance(ctx, unconfirmedInvoice.Hash, unconfirmedInvoice.MintHash, tx)
+	if onchainMessage.ExpiresAtHeight != unconfirmedInvoice.ExpiresAtHeight {
+		return fmt.Errorf("%w: %d != %d", ErrInvoiceExpiryMismatch, onchainMessage.ExpiresAtHeight, unconfirmedInvoice.ExpiresAtHeight)
+	}
+
+	pendingTokenBalance, err := s.GetPendingTokenBalance(ctx, unconfirmedInvoice.Hash, unconfirmedInvoice.MintHash, tx.Tx)
 	if err != nil {
 		return err
 	}
@@ -274,13 +360,17 @@ func (s *TokenisationStore) MatchUnconfirmedInvoice(ctx context.Context, onchain
 		MintHash:        unconfirmedInvoice.MintHash,
 		Quantity:        unconfirmedInvoice.Quantity,
 		Price:           unconfirmedInvoice.Price,
+		DogePrice:       unconfirmedInvoice.DogePrice,
 		CreatedAt:       unconfirmedInvoice.CreatedAt,
 		SellerAddress:   unconfirmedInvoice.SellerAddress,
 		PublicKey:       unconfirmedInvoice.PublicKey,
 		Signature:       unconfirmedInvoice.Signature,
+		Splits:          unconfirmedInvoice.Splits,
+		ExpiresAtHeight: unconfirmedInvoice.ExpiresAtHeight,
 		BlockHeight:     onchainTransaction.Height,
+		BlockHash:       onchainTransaction.BlockHash,
 		TransactionHash: onchainTransaction.TxHash,
-	}, tx)
+	}, tx.Tx)
 
 	if err != nil {
 		return err
@@ -288,6 +378,11 @@ func (s *TokenisationStore) MatchUnconfirmedInvoice(ctx context.Context, onchain
 
 	fmt.Println("Saved invoice:", id)
 
+	err = recordInvoiceTransition(ctx, tx.Tx, unconfirmedInvoice.Hash, unconfirmedInvoice.Status, InvoiceStatusOnChain, onchainTransaction.BlockRef())
+	if err != nil {
+		return err
+	}
+
 	_, err = tx.ExecContext(ctx, "DELETE FROM unconfirmed_invoices WHERE id = $1", unconfirmedInvoice.Id)
 	if err != nil {
 		return err
@@ -310,12 +405,16 @@ func (s *TokenisationStore) MatchUnconfirmedInvoice(ctx context.Context, onchain
 func (s *TokenisationStore) SaveApprovedInvoiceSignature(ctx context.Context, signature *InvoiceSignature) (string, error) {
 	id := uuid.New().String()
 
-	_, err := s.DB.ExecContext(ctx, "INSERT INTO invoice_signatures (id, invoice_hash, signature, public_key, created_at) VALUES ($1, $2, $3, $4, $5)", id, signature.InvoiceHash, signature.Signature, signature.PublicKey, signature.CreatedAt)
-	return id, err
+	_, err := s.conn().ExecContext(ctx, "INSERT INTO invoice_signatures (id, invoice_hash, signature, public_key, created_at) VALUES ($1, $2, $3, $4, $5)", id, signature.InvoiceHash, signature.Signature, signature.PublicKey, signature.CreatedAt)
+	if err != nil {
+		return id, err
+	}
+
+	return id, s.ApproveSignedInvoice(ctx, signature.InvoiceHash)
 }
 
 func (s *TokenisationStore) GetApprovedInvoiceSignatures(ctx context.Context, invoiceHash string) ([]InvoiceSignature, error) {
-	rows, err := s.DB.QueryContext(ctx, "SELECT id, invoice_hash, signature, public_key, created_at FROM invoice_signatures WHERE invoice_hash = $1", invoiceHash)
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, invoice_hash, signature, public_key, created_at FROM invoice_signatures WHERE invoice_hash = $1", invoiceHash)
 	if err != nil {
 		return []InvoiceSignature{}, err
 	}
@@ -0,0 +1,346 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// System accounts of the token ledger. They are not addresses, so they never hold
+// tokens that can be spent and are left out of holder queries.
+const (
+	// LedgerAccountIssued is debited with the fraction count of a mint when it is confirmed
+	LedgerAccountIssued = "@issued"
+	// LedgerAccountBurned is credited with every confirmed burn
+	LedgerAccountBurned = "@burned"
+)
+
+var ErrLedgerInvariant = errors.New("ledger invariant violated")
+
+// ErrActionRejected is wrapped by a transfer or burn that was consumed without moving
+// any fractions, e.g. because it is unfunded, so that it is never retried.
+var ErrActionRejected = errors.New("action rejected")
+
+/*
+* A LedgerPosting moves a quantity of a mint from one account to another. Every posting
+* is written as a debit entry on the source account and a matching credit entry on the
+* destination account, so the balances of a mint always sum to zero: issuance moves the
+* fraction count from LedgerAccountIssued to the minter, transfers and payments move
+* fractions between addresses, and burns move them to LedgerAccountBurned.
+ */
+type LedgerPosting struct {
+	MintHash    string
+	FromAccount string
+	ToAccount   string
+	Quantity    int
+	ActionType  uint8
+	Block       BlockRef
+}
+
+func (p LedgerPosting) Validate() error {
+	if p.MintHash == "" {
+		return fmt.Errorf("ledger posting has no mint")
+	}
+
+	if p.FromAccount == "" || p.ToAccount == "" {
+		return fmt.Errorf("ledger posting needs a source and destination account")
+	}
+
+	if p.FromAccount == p.ToAccount {
+		return fmt.Errorf("ledger posting source and destination are the same: %s", p.FromAccount)
+	}
+
+	if p.Quantity <= 0 {
+		return fmt.Errorf("ledger posting quantity must be positive: %d", p.Quantity)
+	}
+
+	return nil
+}
+
+// LedgerEntry is one side of a posting. Entries are never updated, they are only
+// removed when the block that caused them is rolled back.
+type LedgerEntry struct {
+	Id              string    `json:"id"`
+	PostingId       string    `json:"posting_id"`
+	MintHash        string    `json:"mint_hash"`
+	Account         string    `json:"account"`
+	Debit           int       `json:"debit"`
+	Credit          int       `json:"credit"`
+	ActionType      uint8     `json:"action_type"`
+	TransactionHash string    `json:"transaction_hash"`
+	BlockHeight     int64     `json:"block_height"`
+	BlockHash       string    `json:"block_hash"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// Quantity is the change the entry makes to the balance of its account.
+func (e LedgerEntry) Quantity() int {
+	return e.Credit - e.Debit
+}
+
+/*
+* LedgerSupply summarises the ledger of a mint so that supply conservation can be checked:
+* the fractions held by addresses must equal the fraction count less everything burned,
+* the issuance and burned accounts must match the mint and its burns, every posting must
+* balance, and the materialized balances must agree with the entries they summarise.
+ */
+type LedgerSupply struct {
+	MintHash      string `json:"mint_hash"`
+	FractionCount int    `json:"fraction_count"`
+	Burned        int    `json:"burned"`
+	Held          int    `json:"held"`
+	Issued        int    `json:"issued"`
+	BurnedAccount int    `json:"burned_account"`
+	Unbalanced    int    `json:"unbalanced"`
+	Drifted       int    `json:"drifted"`
+}
+
+func (l LedgerSupply) Validate() error {
+	if l.Held != l.FractionCount-l.Burned {
+		return fmt.Errorf("%w: mint %s has %d fractions held, expected %d (%d minted, %d burned)", ErrLedgerInvariant, l.MintHash, l.Held, l.FractionCount-l.Burned, l.FractionCount, l.Burned)
+	}
+
+	if l.Issued != l.FractionCount {
+		return fmt.Errorf("%w: mint %s issued %d fractions, expected %d", ErrLedgerInvariant, l.MintHash, l.Issued, l.FractionCount)
+	}
+
+	if l.BurnedAccount != l.Burned {
+		return fmt.Errorf("%w: mint %s has %d fractions in the burned account, expected %d", ErrLedgerInvariant, l.MintHash, l.BurnedAccount, l.Burned)
+	}
+
+	if l.Unbalanced != 0 {
+		return fmt.Errorf("%w: mint %s has %d unbalanced postings", ErrLedgerInvariant, l.MintHash, l.Unbalanced)
+	}
+
+	if l.Drifted != 0 {
+		return fmt.Errorf("%w: mint %s has %d balances that differ from their entries", ErrLedgerInvariant, l.MintHash, l.Drifted)
+	}
+
+	return nil
+}
+
+/*
+* PostToLedger writes both entries of a posting and applies them to the materialized
+* balances. Without a transaction the posting is written in one of its own, so a posting
+* is never half applied. Callers are responsible for checking that the source account
+* can cover the quantity.
+ */
+func (s *TokenisationStore) PostToLedger(ctx context.Context, posting LedgerPosting, tx *sql.Tx) error {
+	if err := posting.Validate(); err != nil {
+		return err
+	}
+
+	if tx == nil {
+		tx, err := s.Begin(ctx)
+		if err != nil {
+			return err
+		}
+
+		defer tx.Rollback()
+
+		if err := s.PostToLedger(ctx, posting, tx.Tx); err != nil {
+			return err
+		}
+
+		return tx.Commit()
+	}
+
+	log.Println("Posting to ledger:", posting.MintHash, posting.FromAccount, "->", posting.ToAccount, posting.Quantity)
+
+	postingId := uuid.New().String()
+	now := time.Now()
+
+	for _, entry := range []struct {
+		account string
+		debit   int
+		credit  int
+	}{
+		{posting.FromAccount, posting.Quantity, 0},
+		{posting.ToAccount, 0, posting.Quantity},
+	} {
+		_, err := tx.ExecContext(ctx, `
+		INSERT INTO ledger_entries (id, posting_id, mint_hash, account, debit, credit, action_type, transaction_hash, block_height, block_hash, block_time, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		`, uuid.New().String(), postingId, posting.MintHash, entry.account, entry.debit, entry.credit, posting.ActionType, posting.Block.TransactionHash, posting.Block.Height, posting.Block.Hash, nullTime(posting.Block.Time), now)
+		if err != nil {
+			log.Println("Error inserting ledger entry:", err)
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+		INSERT INTO ledger_balances (mint_hash, account, quantity, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (mint_hash, account)
+		DO UPDATE SET quantity = ledger_balances.quantity + excluded.quantity, updated_at = excluded.updated_at
+		`, posting.MintHash, entry.account, entry.credit-entry.debit, now)
+		if err != nil {
+			log.Println("Error updating ledger balance:", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetLedgerBalance returns the materialized balance of an account for a mint.
+func (s *TokenisationStore) GetLedgerBalance(ctx context.Context, account string, mintHash string, tx *sql.Tx) (int, error) {
+	query := "SELECT COALESCE(SUM(quantity), 0) FROM ledger_balances WHERE account = $1 AND mint_hash = $2"
+
+	var balance int
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, account, mintHash).Scan(&balance)
+	} else {
+		err = s.conn().QueryRowContext(ctx, query, account, mintHash).Scan(&balance)
+	}
+
+	return balance, err
+}
+
+// GetLedgerEntries returns the entries of a mint, oldest first, optionally limited to
+// a single account.
+func (s *TokenisationStore) GetLedgerEntries(ctx context.Context, mintHash string, account string) ([]LedgerEntry, error) {
+	rows, err := s.conn().QueryContext(ctx, `
+		SELECT id, posting_id, mint_hash, account, debit, credit, COALESCE(action_type, 0), COALESCE(transaction_hash, ''), COALESCE(block_height, 0), COALESCE(block_hash, ''), created_at
+		FROM ledger_entries
+		WHERE mint_hash = $1 AND ($2 = '' OR account = $3)
+		ORDER BY COALESCE(block_height, 0) ASC, created_at ASC, debit DESC
+	`, mintHash, account, account)
+	if err != nil {
+		return []LedgerEntry{}, err
+	}
+
+	defer rows.Close()
+
+	entries := []LedgerEntry{}
+	for rows.Next() {
+		var entry LedgerEntry
+		err := rows.Scan(&entry.Id, &entry.PostingId, &entry.MintHash, &entry.Account, &entry.Debit, &entry.Credit, &entry.ActionType, &entry.TransactionHash, &entry.BlockHeight, &entry.BlockHash, &entry.CreatedAt)
+		if err != nil {
+			return []LedgerEntry{}, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// GetLedgerSupply summarises the ledger of a confirmed mint. It returns sql.ErrNoRows
+// if the mint is not confirmed.
+func (s *TokenisationStore) GetLedgerSupply(ctx context.Context, mintHash string) (LedgerSupply, error) {
+	supplies, err := s.getLedgerSupplies(ctx, mintHash)
+	if err != nil {
+		return LedgerSupply{}, err
+	}
+
+	if len(supplies) == 0 {
+		return LedgerSupply{}, sql.ErrNoRows
+	}
+
+	return supplies[0], nil
+}
+
+// GetLedgerSupplies summarises the ledger of every confirmed mint.
+func (s *TokenisationStore) GetLedgerSupplies(ctx context.Context) ([]LedgerSupply, error) {
+	return s.getLedgerSupplies(ctx, "")
+}
+
+func (s *TokenisationStore) getLedgerSupplies(ctx context.Context, mintHash string) ([]LedgerSupply, error) {
+	rows, err := s.conn().QueryContext(ctx, `
+		SELECT
+			m.hash,
+			m.fraction_count,
+			(SELECT COALESCE(SUM(b.quantity), 0) FROM burns b WHERE b.mint_hash = m.hash),
+			(SELECT COALESCE(SUM(l.quantity), 0) FROM ledger_balances l WHERE l.mint_hash = m.hash AND l.account NOT IN ($1, $2)),
+			(SELECT COALESCE(-SUM(l.quantity), 0) FROM ledger_balances l WHERE l.mint_hash = m.hash AND l.account = $3),
+			(SELECT COALESCE(SUM(l.quantity), 0) FROM ledger_balances l WHERE l.mint_hash = m.hash AND l.account = $4),
+			(SELECT COUNT(*) FROM (
+				SELECT e.posting_id FROM ledger_entries e WHERE e.mint_hash = m.hash
+				GROUP BY e.posting_id
+				HAVING SUM(e.credit) <> SUM(e.debit)
+			) unbalanced),
+			(SELECT COUNT(*) FROM ledger_balances l WHERE l.mint_hash = m.hash AND l.quantity <> (
+				SELECT COALESCE(SUM(e.credit - e.debit), 0) FROM ledger_entries e
+				WHERE e.mint_hash = l.mint_hash AND e.account = l.account
+			))
+		FROM mints m
+		WHERE $5 = '' OR m.hash = $6
+		ORDER BY m.hash ASC
+	`, LedgerAccountIssued, LedgerAccountBurned, LedgerAccountIssued, LedgerAccountBurned, mintHash, mintHash)
+	if err != nil {
+		return []LedgerSupply{}, err
+	}
+
+	defer rows.Close()
+
+	supplies := []LedgerSupply{}
+	for rows.Next() {
+		var supply LedgerSupply
+		err := rows.Scan(&supply.MintHash, &supply.FractionCount, &supply.Burned, &supply.Held, &supply.Issued, &supply.BurnedAccount, &supply.Unbalanced, &supply.Drifted)
+		if err != nil {
+			return []LedgerSupply{}, err
+		}
+
+		supplies = append(supplies, supply)
+	}
+
+	return supplies, rows.Err()
+}
+
+// CheckLedgerInvariants verifies that the supply of a confirmed mint is conserved.
+// Violations are reported as ErrLedgerInvariant.
+func (s *TokenisationStore) CheckLedgerInvariants(ctx context.Context, mintHash string) error {
+	supply, err := s.GetLedgerSupply(ctx, mintHash)
+	if err != nil {
+		return err
+	}
+
+	return supply.Validate()
+}
+
+// rollbackLedgerToHeight removes the entries written above the given height and takes
+// them back out of the materialized balances.
+func rollbackLedgerToHeight(ctx context.Context, tx *sql.Tx, height int64) error {
+	_, err := tx.ExecContext(ctx, `
+	UPDATE ledger_balances SET
+		quantity = quantity - (
+			SELECT COALESCE(SUM(e.credit - e.debit), 0) FROM ledger_entries e
+			WHERE e.mint_hash = ledger_balances.mint_hash AND e.account = ledger_balances.account AND e.block_height > $1
+		),
+		updated_at = $2
+	WHERE EXISTS (
+		SELECT 1 FROM ledger_entries e
+		WHERE e.mint_hash = ledger_balances.mint_hash AND e.account = ledger_balances.account AND e.block_height > $3
+	)
+	`, height, time.Now(), height)
+	if err != nil {
+		log.Println("Error reverting ledger balances:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM ledger_entries WHERE block_height > $1", height)
+	if err != nil {
+		log.Println("Error deleting ledger entries:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	DELETE FROM ledger_balances
+	WHERE NOT EXISTS (
+		SELECT 1 FROM ledger_entries e
+		WHERE e.mint_hash = ledger_balances.mint_hash AND e.account = ledger_balances.account
+	)
+	`)
+	if err != nil {
+		log.Println("Error deleting ledger balances:", err)
+		return err
+	}
+
+	return nil
+}
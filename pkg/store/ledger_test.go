@@ -0,0 +1,114 @@
+package store_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func TestLedgerConservesSupply(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mintHash := test_support.GenerateRandomHash()
+	ownerAddress := test_support.GenerateDogecoinAddress(true)
+	holderAddress := test_support.GenerateDogecoinAddress(true)
+
+	_, err := tokenStore.SaveMint(ctx, &store.MintWithoutID{
+		Hash:            mintHash,
+		Title:           "Ledger Mint",
+		FractionCount:   100,
+		TransactionHash: "mintTx",
+		BlockHeight:     1,
+	}, ownerAddress)
+	assert.NilError(t, err)
+
+	assert.NilError(t, tokenStore.PostToLedger(ctx, store.LedgerPosting{
+		MintHash:    mintHash,
+		FromAccount: store.LedgerAccountIssued,
+		ToAccount:   ownerAddress,
+		Quantity:    100,
+		ActionType:  protocol.ACTION_MINT,
+		Block:       store.BlockRef{Height: 1, Hash: "block1", TransactionHash: "mintTx"},
+	}, nil))
+
+	assert.NilError(t, tokenStore.PostToLedger(ctx, store.LedgerPosting{
+		MintHash:    mintHash,
+		FromAccount: ownerAddress,
+		ToAccount:   holderAddress,
+		Quantity:    30,
+		ActionType:  protocol.ACTION_TRANSFER,
+		Block:       store.BlockRef{Height: 2, Hash: "block2", TransactionHash: "transferTx"},
+	}, nil))
+
+	burn := saveBurnTransaction(t, tokenStore, "burnTx", 3, holderAddress, test_support.GenerateRandomHash(), mintHash, 10)
+	assert.NilError(t, tokenStore.ProcessBurn(ctx, burn))
+
+	// Postings must move a positive quantity between two accounts
+	err = tokenStore.PostToLedger(ctx, store.LedgerPosting{MintHash: mintHash, FromAccount: ownerAddress, ToAccount: ownerAddress, Quantity: 1}, nil)
+	assert.ErrorContains(t, err, "source and destination are the same")
+	err = tokenStore.PostToLedger(ctx, store.LedgerPosting{MintHash: mintHash, FromAccount: ownerAddress, ToAccount: holderAddress}, nil)
+	assert.ErrorContains(t, err, "quantity must be positive")
+
+	owner, err := tokenStore.GetLedgerBalance(ctx, ownerAddress, mintHash, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, 70, owner)
+
+	holder, err := tokenStore.GetLedgerBalance(ctx, holderAddress, mintHash, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, 20, holder)
+
+	entries, err := tokenStore.GetLedgerEntries(ctx, mintHash, holderAddress)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(entries))
+	assert.Equal(t, 30, entries[0].Credit)
+	assert.Equal(t, uint8(protocol.ACTION_TRANSFER), entries[0].ActionType)
+	assert.Equal(t, 10, entries[1].Debit)
+	assert.Equal(t, uint8(protocol.ACTION_BURN), entries[1].ActionType)
+	assert.Equal(t, "burnTx", entries[1].TransactionHash)
+
+	entries, err = tokenStore.GetLedgerEntries(ctx, mintHash, "")
+	assert.NilError(t, err)
+	assert.Equal(t, 6, len(entries))
+
+	supply, err := tokenStore.GetLedgerSupply(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 90, supply.Held)
+	assert.Equal(t, 10, supply.Burned)
+	assert.Equal(t, 10, supply.BurnedAccount)
+	assert.Equal(t, 100, supply.Issued)
+	assert.NilError(t, supply.Validate())
+
+	holders, err := tokenStore.GetTokenHoldersAtHeight(ctx, mintHash, 3)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(holders))
+
+	// A reorg below the burn takes it back out of the materialized balances
+	assert.NilError(t, tokenStore.RollbackToHeight(ctx, 2))
+
+	holder, err = tokenStore.GetLedgerBalance(ctx, holderAddress, mintHash, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, 30, holder)
+
+	burned, err := tokenStore.GetLedgerBalance(ctx, store.LedgerAccountBurned, mintHash, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, burned)
+	assert.NilError(t, tokenStore.CheckLedgerInvariants(ctx, mintHash))
+
+	// Issuing more than the fraction count breaks conservation
+	assert.NilError(t, tokenStore.PostToLedger(ctx, store.LedgerPosting{
+		MintHash:    mintHash,
+		FromAccount: store.LedgerAccountIssued,
+		ToAccount:   holderAddress,
+		Quantity:    5,
+	}, nil))
+
+	err = tokenStore.CheckLedgerInvariants(ctx, mintHash)
+	assert.Assert(t, errors.Is(err, store.ErrLedgerInvariant))
+	assert.ErrorContains(t, err, "105 fractions held, expected 100")
+}
@@ -0,0 +1,186 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+)
+
+type LockupType string
+
+const (
+	LockupTypeBlockHeight LockupType = "block_height"
+	LockupTypeTime        LockupType = "time"
+)
+
+type LockupAnchor string
+
+const (
+	// LockupFromMint locks every fraction of the mint until the lockup has elapsed
+	// since the mint was confirmed.
+	LockupFromMint LockupAnchor = "mint"
+	// LockupFromAcquisition locks fractions until the lockup has elapsed since the
+	// holder received them. The issuer's initial supply is not subject to it.
+	LockupFromAcquisition LockupAnchor = "acquisition"
+)
+
+// LockupOptions is the typed form of a mint's lockup_options, e.g.
+// {"type": "block_height", "from": "acquisition", "blocks": 1440} or
+// {"type": "time", "seconds": 86400}.
+type LockupOptions struct {
+	Type    LockupType   `json:"type"`
+	From    LockupAnchor `json:"from,omitempty"`
+	Blocks  int64        `json:"blocks,omitempty"`
+	Seconds int64        `json:"seconds,omitempty"`
+}
+
+// ParseLockupOptions decodes the lockup options of a mint. An empty map means the
+// mint has no lockup and returns nil.
+func ParseLockupOptions(options StringInterfaceMap) (*LockupOptions, error) {
+	if len(options) == 0 {
+		return nil, nil
+	}
+
+	optionsBytes, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	var lockup LockupOptions
+	err = json.Unmarshal(optionsBytes, &lockup)
+	if err != nil {
+		return nil, fmt.Errorf("invalid lockup options: %w", err)
+	}
+
+	if lockup.From == "" {
+		lockup.From = LockupFromMint
+	}
+
+	if err := lockup.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &lockup, nil
+}
+
+func (l *LockupOptions) Validate() error {
+	switch l.Type {
+	case LockupTypeBlockHeight:
+		if l.Blocks <= 0 {
+			return fmt.Errorf("lockup blocks must be greater than 0")
+		}
+	case LockupTypeTime:
+		if l.Seconds <= 0 {
+			return fmt.Errorf("lockup seconds must be greater than 0")
+		}
+	default:
+		return fmt.Errorf("invalid lockup type: %q", l.Type)
+	}
+
+	if l.From != LockupFromMint && l.From != LockupFromAcquisition {
+		return fmt.Errorf("invalid lockup anchor: %q", l.From)
+	}
+
+	return nil
+}
+
+// IsLocked reports whether fractions anchored at the given block are still locked at
+// the given height and time. An unknown anchor block time never locks.
+func (l *LockupOptions) IsLocked(anchorHeight int64, anchorTime time.Time, atHeight int64, atTime time.Time) bool {
+	switch l.Type {
+	case LockupTypeBlockHeight:
+		return atHeight < anchorHeight+l.Blocks
+	case LockupTypeTime:
+		if anchorTime.IsZero() {
+			return false
+		}
+		return atTime.Before(anchorTime.Add(time.Duration(l.Seconds) * time.Second))
+	}
+
+	return false
+}
+
+// GetLockedTokenBalance returns how much of an address's confirmed balance for a mint
+// is still locked at the given height and time. Holders spend unlocked fractions
+// first, so the locked quantity never exceeds the balance.
+func (s *TokenisationStore) GetLockedTokenBalance(ctx context.Context, address string, mintHash string, atHeight int64, atTime time.Time, tx *sql.Tx) (int, error) {
+	query := func(query string, args ...interface{}) (*sql.Rows, error) {
+		if tx != nil {
+			return tx.QueryContext(ctx, query, args...)
+		}
+		return s.conn().QueryContext(ctx, query, args...)
+	}
+
+	rows, err := query("SELECT lockup_options, block_height, block_time, transaction_hash FROM mints WHERE hash = $1", mintHash)
+	if err != nil {
+		return 0, err
+	}
+
+	var options StringInterfaceMap
+	var mintHeight sql.NullInt64
+	var mintTime sql.NullTime
+	var mintTransactionHash sql.NullString
+	found := rows.Next()
+	if found {
+		err = rows.Scan(&options, &mintHeight, &mintTime, &mintTransactionHash)
+	}
+	rows.Close()
+	if err != nil || !found {
+		return 0, err
+	}
+
+	lockup, err := ParseLockupOptions(options)
+	if err != nil {
+		log.Println("Ignoring invalid lockup options for mint:", mintHash, err)
+		return 0, nil
+	}
+
+	if lockup == nil {
+		return 0, nil
+	}
+
+	rows, err = query("SELECT credit - debit, block_height, block_time, transaction_hash FROM ledger_entries WHERE account = $1 AND mint_hash = $2", address, mintHash)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	total := 0
+	locked := 0
+	for rows.Next() {
+		var quantity int
+		var height sql.NullInt64
+		var blockTime sql.NullTime
+		var transactionHash sql.NullString
+		if err := rows.Scan(&quantity, &height, &blockTime, &transactionHash); err != nil {
+			return 0, err
+		}
+
+		total += quantity
+
+		if lockup.From != LockupFromAcquisition || quantity <= 0 || !height.Valid {
+			continue
+		}
+
+		if mintTransactionHash.Valid && transactionHash.String == mintTransactionHash.String {
+			continue
+		}
+
+		if lockup.IsLocked(height.Int64, blockTime.Time, atHeight, atTime) {
+			locked += quantity
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	if lockup.From == LockupFromMint && mintHeight.Valid && lockup.IsLocked(mintHeight.Int64, mintTime.Time, atHeight, atTime) {
+		locked = total
+	}
+
+	return max(0, min(locked, total)), nil
+}
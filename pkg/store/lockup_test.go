@@ -0,0 +1,134 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+	"gotest.tools/assert"
+)
+
+func saveLockedMint(t *testing.T, tokenStore *store.TokenisationStore, ownerAddress string, lockupOptions store.StringInterfaceMap, blockHeight int64, blockTime time.Time) string {
+	ctx := context.Background()
+	mintHash := test_support.GenerateRandomHash()
+
+	_, err := tokenStore.SaveUnconfirmedMint(ctx, &store.MintWithoutID{
+		Hash:          mintHash,
+		Title:         "Locked Mint",
+		Description:   "Test Description",
+		FractionCount: 100,
+		LockupOptions: lockupOptions,
+	})
+	assert.NilError(t, err)
+
+	mintMsg, _ := proto.Marshal(&protocol.OnChainMintMessage{Hash: mintHash})
+	mintTxId, err := tokenStore.SaveOnChainTransactionAtTime(ctx, mintHash, blockHeight, "mintBlock", 0, protocol.ACTION_MINT, protocol.DEFAULT_VERSION, mintMsg, ownerAddress, map[string]interface{}{}, blockTime)
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 100)
+	assert.NilError(t, err)
+	assert.NilError(t, tokenStore.MatchUnconfirmedMint(ctx, *findTransactionById(txs, mintTxId)))
+
+	return mintHash
+}
+
+func saveTimedTransferTransaction(t *testing.T, tokenStore *store.TokenisationStore, txHash string, blockHeight int64, blockTime time.Time, transfer store.TokenTransfer) store.OnChainTransaction {
+	id, err := tokenStore.SaveOnChainTransactionAtTime(context.Background(), txHash, blockHeight, "block", 0, protocol.ACTION_TRANSFER, protocol.DEFAULT_VERSION, encodeTransferMessage(t, transfer), transfer.FromAddress, map[string]interface{}{}, blockTime)
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(context.Background(), 0, 100)
+	assert.NilError(t, err)
+
+	tx := findTransactionById(txs, id)
+	assert.Assert(t, tx.BlockTime.Equal(blockTime))
+
+	return *tx
+}
+
+func TestParseLockupOptions(t *testing.T) {
+	lockup, err := store.ParseLockupOptions(store.StringInterfaceMap{})
+	assert.NilError(t, err)
+	assert.Assert(t, lockup == nil)
+
+	lockup, err = store.ParseLockupOptions(store.StringInterfaceMap{"type": "block_height", "blocks": float64(10)})
+	assert.NilError(t, err)
+	assert.Equal(t, store.LockupTypeBlockHeight, lockup.Type)
+	assert.Equal(t, store.LockupFromMint, lockup.From)
+	assert.Equal(t, int64(10), lockup.Blocks)
+
+	_, err = store.ParseLockupOptions(store.StringInterfaceMap{"lockup": "option"})
+	assert.ErrorContains(t, err, "invalid lockup type")
+
+	_, err = store.ParseLockupOptions(store.StringInterfaceMap{"type": "time", "seconds": float64(0)})
+	assert.ErrorContains(t, err, "lockup seconds must be greater than 0")
+
+	_, err = store.ParseLockupOptions(store.StringInterfaceMap{"type": "time", "seconds": float64(60), "from": "listing"})
+	assert.ErrorContains(t, err, "invalid lockup anchor")
+}
+
+func TestGetLockedTokenBalanceFromMint(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	ownerAddress := test_support.GenerateDogecoinAddress(true)
+	mintHash := saveLockedMint(t, tokenStore, ownerAddress, store.StringInterfaceMap{"type": "block_height", "blocks": float64(10)}, 10, time.Time{})
+
+	locked, err := tokenStore.GetLockedTokenBalance(ctx, ownerAddress, mintHash, 19, time.Now(), nil)
+	assert.NilError(t, err)
+	assert.Equal(t, 100, locked)
+
+	locked, err = tokenStore.GetLockedTokenBalance(ctx, ownerAddress, mintHash, 20, time.Now(), nil)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, locked)
+}
+
+func TestGetLockedTokenBalanceFromAcquisition(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	_, _, ownerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	_, _, buyerAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	_, _, otherAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	mintTime := time.Unix(1700000000, 0)
+	mintHash := saveLockedMint(t, tokenStore, ownerAddress, store.StringInterfaceMap{"type": "time", "from": "acquisition", "seconds": float64(86400)}, 10, mintTime)
+
+	// The issuer's initial supply is not locked
+	locked, err := tokenStore.GetLockedTokenBalance(ctx, ownerAddress, mintHash, 10, mintTime, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, locked)
+
+	// The buyer acquires 30 fractions an hour after the mint
+	acquiredAt := mintTime.Add(time.Hour)
+	transfer := saveTokenTransfer(t, tokenStore, ownerAddress, buyerAddress, mintHash, 30)
+	assert.NilError(t, tokenStore.ProcessTransfer(ctx, transfer, saveTimedTransferTransaction(t, tokenStore, "transferTx", 11, acquiredAt, transfer)))
+
+	locked, err = tokenStore.GetLockedTokenBalance(ctx, buyerAddress, mintHash, 12, acquiredAt.Add(time.Hour), nil)
+	assert.NilError(t, err)
+	assert.Equal(t, 30, locked)
+
+	locked, err = tokenStore.GetLockedTokenBalance(ctx, buyerAddress, mintHash, 12, acquiredAt.Add(24*time.Hour), nil)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, locked)
+
+	// Reselling inside the lockup window is rejected on-chain
+	resale := saveTokenTransfer(t, tokenStore, buyerAddress, otherAddress, mintHash, 10)
+	err = tokenStore.ProcessTransfer(ctx, resale, saveTimedTransferTransaction(t, tokenStore, "resaleTx", 12, acquiredAt.Add(time.Hour), resale))
+	assert.ErrorContains(t, err, "insufficient unlocked balance for transfer")
+	assert.Equal(t, 30, sumBalances(t, tokenStore, buyerAddress, mintHash))
+	assert.Equal(t, 0, sumBalances(t, tokenStore, otherAddress, mintHash))
+
+	// Once the lockup has elapsed the resale goes through
+	resale = saveTokenTransfer(t, tokenStore, buyerAddress, otherAddress, mintHash, 10)
+	assert.NilError(t, tokenStore.ProcessTransfer(ctx, resale, saveTimedTransferTransaction(t, tokenStore, "resaleTx2", 13, acquiredAt.Add(25*time.Hour), resale)))
+	assert.Equal(t, 20, sumBalances(t, tokenStore, buyerAddress, mintHash))
+	assert.Equal(t, 10, sumBalances(t, tokenStore, otherAddress, mintHash))
+}
@@ -0,0 +1,216 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrMintVersionNotFound is returned when a mint has no version with the requested number.
+var ErrMintVersionNotFound = errors.New("mint version not found")
+
+func (s *TokenisationStore) SaveMintAmendment(ctx context.Context, amendment *MintAmendment) (string, error) {
+	id := uuid.New().String()
+
+	metadata, err := json.Marshal(amendment.Metadata)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = s.conn().ExecContext(ctx, `
+	INSERT INTO mint_amendments (id, hash, mint_hash, description, metadata, feed_url, contract_of_sale, public_key, signature, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`, id, amendment.Hash, amendment.MintHash, amendment.Description, string(metadata), amendment.FeedURL, amendment.ContractOfSale, amendment.PublicKey, amendment.Signature, amendment.CreatedAt)
+	return id, err
+}
+
+func (s *TokenisationStore) GetMintAmendment(ctx context.Context, hash string) (MintAmendment, error) {
+	var amendment MintAmendment
+	var version sql.NullInt64
+	var transactionHash sql.NullString
+	var blockHeight sql.NullInt64
+
+	err := s.conn().QueryRowContext(ctx, "SELECT id, hash, mint_hash, description, metadata, feed_url, contract_of_sale, public_key, signature, created_at, version, transaction_hash, block_height FROM mint_amendments WHERE hash = $1", hash).Scan(
+		&amendment.Id, &amendment.Hash, &amendment.MintHash, &amendment.Description, &amendment.Metadata, &amendment.FeedURL, &amendment.ContractOfSale, &amendment.PublicKey, &amendment.Signature, &amendment.CreatedAt, &version, &transactionHash, &blockHeight)
+	if err != nil {
+		return MintAmendment{}, err
+	}
+
+	amendment.Version = int(version.Int64)
+	amendment.TransactionHash = transactionHash.String
+	amendment.BlockHeight = blockHeight.Int64
+	return amendment, nil
+}
+
+func (s *TokenisationStore) SaveMintAmendmentSignature(ctx context.Context, signature *MintAmendmentSignature) (string, error) {
+	id := uuid.New().String()
+
+	_, err := s.conn().ExecContext(ctx, `
+	INSERT INTO mint_amendment_signatures (id, amendment_hash, mint_hash, signature, public_key, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	`, id, signature.AmendmentHash, signature.MintHash, signature.Signature, signature.PublicKey, signature.CreatedAt)
+	return id, err
+}
+
+func (s *TokenisationStore) GetMintAmendmentSignatures(ctx context.Context, amendmentHash string) ([]MintAmendmentSignature, error) {
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, amendment_hash, mint_hash, signature, public_key, created_at FROM mint_amendment_signatures WHERE amendment_hash = $1", amendmentHash)
+	if err != nil {
+		return []MintAmendmentSignature{}, err
+	}
+	defer rows.Close()
+
+	var signatures []MintAmendmentSignature
+	for rows.Next() {
+		var signature MintAmendmentSignature
+		if err := rows.Scan(&signature.Id, &signature.AmendmentHash, &signature.MintHash, &signature.Signature, &signature.PublicKey, &signature.CreatedAt); err != nil {
+			return []MintAmendmentSignature{}, err
+		}
+		signatures = append(signatures, signature)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []MintAmendmentSignature{}, err
+	}
+
+	return signatures, nil
+}
+
+/*
+* ApplyMintAmendment applies a confirmed amendment to its mint. The version being
+* replaced is kept in mint_versions together with the block that superseded it, the
+* amendment is stamped with the new version number and its block, and the on-chain
+* transaction is consumed.
+ */
+func (s *TokenisationStore) ApplyMintAmendment(ctx context.Context, amendment MintAmendment, onchainTransaction OnChainTransaction) error {
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	var version int
+	err = tx.QueryRowContext(ctx, "SELECT COUNT(*) + 1 FROM mint_versions WHERE mint_hash = $1", amendment.MintHash).Scan(&version)
+	if err != nil {
+		return err
+	}
+
+	// The version being replaced was either confirmed with the mint or made by an earlier amendment
+	var amendmentHash, transactionHash sql.NullString
+	var blockHeight sql.NullInt64
+	if version == 1 {
+		err = tx.QueryRowContext(ctx, "SELECT transaction_hash, block_height FROM mints WHERE hash = $1", amendment.MintHash).Scan(&transactionHash, &blockHeight)
+	} else {
+		err = tx.QueryRowContext(ctx, "SELECT hash, transaction_hash, block_height FROM mint_amendments WHERE mint_hash = $1 AND version = $2", amendment.MintHash, version).Scan(&amendmentHash, &transactionHash, &blockHeight)
+	}
+	if err != nil {
+		return err
+	}
+
+	block := onchainTransaction.BlockRef()
+
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO mint_versions (id, mint_hash, version, description, metadata, feed_url, contract_of_sale, amendment_hash, transaction_hash, block_height, superseded_block_height, created_at)
+	SELECT $1, hash, $2, description, metadata, feed_url, contract_of_sale, $3, $4, $5, $6, $7
+	FROM mints WHERE hash = $8
+	`, uuid.New().String(), version, amendmentHash, transactionHash, blockHeight, block.Height, time.Now().UTC(), amendment.MintHash)
+	if err != nil {
+		log.Println("Error saving mint version:", err)
+		return err
+	}
+
+	metadata, err := json.Marshal(amendment.Metadata)
+	if err != nil {
+		return err
+	}
+
+	contractOfSale, err := json.Marshal(amendment.ContractOfSale)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE mints SET description = $1, metadata = $2, feed_url = $3, contract_of_sale = $4 WHERE hash = $5", amendment.Description, string(metadata), amendment.FeedURL, string(contractOfSale), amendment.MintHash)
+	if err != nil {
+		log.Println("Error amending mint:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE mint_amendments SET version = $1, transaction_hash = $2, block_height = $3, block_hash = $4 WHERE hash = $5", version+1, block.TransactionHash, block.Height, block.Hash, amendment.Hash)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id = $1", onchainTransaction.Id)
+	if err != nil {
+		log.Println("Error deleting onchain transaction:", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetMintVersions returns the superseded versions of a mint, oldest first. The current
+// version is the mint itself and is numbered one more than the last of these.
+func (s *TokenisationStore) GetMintVersions(ctx context.Context, mintHash string) ([]MintVersion, error) {
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, mint_hash, version, description, metadata, feed_url, contract_of_sale, amendment_hash, transaction_hash, block_height, superseded_block_height, created_at FROM mint_versions WHERE mint_hash = $1 ORDER BY version ASC", mintHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var versions []MintVersion
+	for rows.Next() {
+		var version MintVersion
+		var amendmentHash, transactionHash sql.NullString
+		var blockHeight sql.NullInt64
+		if err := rows.Scan(&version.Id, &version.MintHash, &version.Version, &version.Description, &version.Metadata, &version.FeedURL, &version.ContractOfSale, &amendmentHash, &transactionHash, &blockHeight, &version.SupersededBlockHeight, &version.CreatedAt); err != nil {
+			return nil, err
+		}
+		version.AmendmentHash = amendmentHash.String
+		version.TransactionHash = transactionHash.String
+		version.BlockHeight = blockHeight.Int64
+		versions = append(versions, version)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// GetMintVersion returns a confirmed mint as it was at a version, along with the
+// number of its current version. Version 0 is the current version.
+func (s *TokenisationStore) GetMintVersion(ctx context.Context, mintHash string, version int) (Mint, int, error) {
+	mint, err := s.GetMintByHash(ctx, mintHash)
+	if err != nil {
+		return Mint{}, 0, err
+	}
+
+	versions, err := s.GetMintVersions(ctx, mintHash)
+	if err != nil {
+		return Mint{}, 0, err
+	}
+
+	current := len(versions) + 1
+	if version == 0 || version == current {
+		return mint, current, nil
+	}
+
+	if version < 0 || version > current {
+		return Mint{}, current, fmt.Errorf("%w: %s version %d", ErrMintVersionNotFound, mintHash, version)
+	}
+
+	superseded := versions[version-1]
+	mint.Description = superseded.Description
+	mint.Metadata = superseded.Metadata
+	mint.FeedURL = superseded.FeedURL
+	mint.ContractOfSale = superseded.ContractOfSale
+	return mint, current, nil
+}
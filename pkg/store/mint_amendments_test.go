@@ -0,0 +1,130 @@
+package store_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func saveMintAmendment(t *testing.T, tokenStore *store.TokenisationStore, mintHash string, description string, feedURL string) store.MintAmendment {
+	amendment := store.MintAmendment{
+		MintHash:    mintHash,
+		Description: description,
+		Metadata:    store.StringInterfaceMap{"valuation": description},
+		FeedURL:     feedURL,
+		PublicKey:   "publicKey",
+		Signature:   "signature",
+		CreatedAt:   time.Now(),
+	}
+
+	var err error
+	amendment.Hash, err = amendment.GenerateHash()
+	assert.NilError(t, err)
+
+	_, err = tokenStore.SaveMintAmendment(context.Background(), &amendment)
+	assert.NilError(t, err)
+
+	amendment, err = tokenStore.GetMintAmendment(context.Background(), amendment.Hash)
+	assert.NilError(t, err)
+	return amendment
+}
+
+func TestApplyMintAmendmentKeepsVersionHistory(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mintHash := test_support.GenerateRandomHash()
+	ownerAddress := test_support.GenerateDogecoinAddress(true)
+
+	_, err := tokenStore.SaveMint(ctx, &store.MintWithoutID{
+		Hash:            mintHash,
+		Title:           "Test Mint",
+		Description:     "Original description",
+		FractionCount:   100,
+		FeedURL:         "https://example.com/original",
+		Metadata:        store.StringInterfaceMap{"valuation": "original"},
+		ContractOfSale:  "Original contract",
+		TransactionHash: "mintTx",
+		BlockHeight:     5,
+	}, ownerAddress)
+	assert.NilError(t, err)
+
+	original, err := tokenStore.GetMintByHash(ctx, mintHash)
+	assert.NilError(t, err)
+
+	first := saveMintAmendment(t, tokenStore, mintHash, "First amendment", "https://example.com/first")
+	assert.Equal(t, 0, first.Version)
+	assert.NilError(t, tokenStore.ApplyMintAmendment(ctx, first, store.OnChainTransaction{Id: "firstTx", TxHash: "firstTx", Height: 8}))
+
+	second := saveMintAmendment(t, tokenStore, mintHash, "Second amendment", "")
+	assert.NilError(t, tokenStore.ApplyMintAmendment(ctx, second, store.OnChainTransaction{Id: "secondTx", TxHash: "secondTx", Height: 12}))
+
+	first, err = tokenStore.GetMintAmendment(ctx, first.Hash)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, first.Version)
+	assert.Equal(t, "firstTx", first.TransactionHash)
+	assert.Equal(t, int64(8), first.BlockHeight)
+
+	versions, err := tokenStore.GetMintVersions(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(versions))
+	assert.Equal(t, 1, versions[0].Version)
+	assert.Equal(t, "", versions[0].AmendmentHash)
+	assert.Equal(t, "mintTx", versions[0].TransactionHash)
+	assert.Equal(t, int64(8), versions[0].SupersededBlockHeight)
+	assert.Equal(t, 2, versions[1].Version)
+	assert.Equal(t, first.Hash, versions[1].AmendmentHash)
+	assert.Equal(t, "firstTx", versions[1].TransactionHash)
+	assert.Equal(t, int64(12), versions[1].SupersededBlockHeight)
+
+	current, latest, err := tokenStore.GetMintVersion(ctx, mintHash, 0)
+	assert.NilError(t, err)
+	assert.Equal(t, 3, latest)
+	assert.Equal(t, "Second amendment", current.Description)
+	assert.Equal(t, "", current.FeedURL)
+	assert.Equal(t, "Test Mint", current.Title)
+
+	mint, _, err := tokenStore.GetMintVersion(ctx, mintHash, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, original.Description, mint.Description)
+	assert.Equal(t, original.FeedURL, mint.FeedURL)
+	assert.Equal(t, original.ContractOfSale, mint.ContractOfSale)
+	assert.Equal(t, "original", mint.Metadata["valuation"])
+
+	mint, _, err = tokenStore.GetMintVersion(ctx, mintHash, 2)
+	assert.NilError(t, err)
+	assert.Equal(t, "First amendment", mint.Description)
+	assert.Equal(t, "https://example.com/first", mint.FeedURL)
+
+	_, _, err = tokenStore.GetMintVersion(ctx, mintHash, 4)
+	assert.Assert(t, errors.Is(err, store.ErrMintVersionNotFound))
+
+	// A reorg below the second amendment restores the first
+	assert.NilError(t, tokenStore.RollbackToHeight(ctx, 10))
+
+	mint, latest, err = tokenStore.GetMintVersion(ctx, mintHash, 0)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, latest)
+	assert.Equal(t, "First amendment", mint.Description)
+
+	second, err = tokenStore.GetMintAmendment(ctx, second.Hash)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, second.Version)
+	assert.Equal(t, "", second.TransactionHash)
+
+	// A reorg below both amendments restores the mint as it was confirmed
+	assert.NilError(t, tokenStore.RollbackToHeight(ctx, 6))
+
+	mint, latest, err = tokenStore.GetMintVersion(ctx, mintHash, 0)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, latest)
+	assert.Equal(t, original.Description, mint.Description)
+	assert.Equal(t, original.FeedURL, mint.FeedURL)
+	assert.Equal(t, original.ContractOfSale, mint.ContractOfSale)
+	assert.Equal(t, "original", mint.Metadata["valuation"])
+}
@@ -0,0 +1,86 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+func (s *TokenisationStore) SaveMintOwnershipTransfer(ctx context.Context, transfer *MintOwnershipTransfer) (string, error) {
+	id := uuid.New().String()
+
+	_, err := s.conn().ExecContext(ctx, `
+	INSERT INTO mint_ownership_transfers (id, hash, mint_hash, new_owner_address, new_public_key, signature_requirement_type, asset_managers, min_signatures, public_key, signature, new_owner_signature, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`, id, transfer.Hash, transfer.MintHash, transfer.NewOwnerAddress, transfer.NewPublicKey, transfer.SignatureRequirementType, transfer.AssetManagers, transfer.MinSignatures, transfer.PublicKey, transfer.Signature, transfer.NewOwnerSignature, transfer.CreatedAt)
+	return id, err
+}
+
+func (s *TokenisationStore) GetMintOwnershipTransfer(ctx context.Context, hash string) (MintOwnershipTransfer, error) {
+	var transfer MintOwnershipTransfer
+	var signatureRequirementType, previousOwnerAddress, transactionHash sql.NullString
+	var blockHeight sql.NullInt64
+
+	err := s.conn().QueryRowContext(ctx, "SELECT id, hash, mint_hash, new_owner_address, new_public_key, signature_requirement_type, asset_managers, min_signatures, public_key, signature, new_owner_signature, created_at, previous_owner_address, transaction_hash, block_height FROM mint_ownership_transfers WHERE hash = $1", hash).Scan(
+		&transfer.Id, &transfer.Hash, &transfer.MintHash, &transfer.NewOwnerAddress, &transfer.NewPublicKey, &signatureRequirementType, &transfer.AssetManagers, &transfer.MinSignatures, &transfer.PublicKey, &transfer.Signature, &transfer.NewOwnerSignature, &transfer.CreatedAt, &previousOwnerAddress, &transactionHash, &blockHeight)
+	if err != nil {
+		return MintOwnershipTransfer{}, err
+	}
+
+	transfer.SignatureRequirementType = SignatureRequirementType(signatureRequirementType.String)
+	transfer.PreviousOwnerAddress = previousOwnerAddress.String
+	transfer.TransactionHash = transactionHash.String
+	transfer.BlockHeight = blockHeight.Int64
+	return transfer, nil
+}
+
+/*
+* ApplyMintOwnershipTransfer hands a mint over to its new owner. The owner and asset
+* managers being replaced are kept on the transfer so that a reorg can restore them,
+* the transfer is stamped with its block, and the on-chain transaction is consumed.
+ */
+func (s *TokenisationStore) ApplyMintOwnershipTransfer(ctx context.Context, transfer MintOwnershipTransfer, onchainTransaction OnChainTransaction) error {
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	var ownerAddress, publicKey, signatureRequirementType sql.NullString
+	var assetManagers AssetManagers
+	var minSignatures sql.NullInt64
+	err = tx.QueryRowContext(ctx, "SELECT owner_address, public_key, signature_requirement_type, asset_managers, min_signatures FROM mints WHERE hash = $1", transfer.MintHash).Scan(&ownerAddress, &publicKey, &signatureRequirementType, &assetManagers, &minSignatures)
+	if err != nil {
+		return err
+	}
+
+	block := onchainTransaction.BlockRef()
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE mint_ownership_transfers SET previous_owner_address = $1, previous_public_key = $2, previous_signature_requirement_type = $3, previous_asset_managers = $4, previous_min_signatures = $5, transaction_hash = $6, block_height = $7, block_hash = $8
+	WHERE hash = $9
+	`, ownerAddress, publicKey, signatureRequirementType, assetManagers, minSignatures, block.TransactionHash, block.Height, block.Hash, transfer.Hash)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE mints SET owner_address = $1, public_key = $2, signature_requirement_type = $3, asset_managers = $4, min_signatures = $5
+	WHERE hash = $6
+	`, transfer.NewOwnerAddress, transfer.NewPublicKey, transfer.SignatureRequirementType, transfer.AssetManagers, transfer.MinSignatures, transfer.MintHash)
+	if err != nil {
+		log.Println("Error transferring mint ownership:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id = $1", onchainTransaction.Id)
+	if err != nil {
+		log.Println("Error deleting onchain transaction:", err)
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,97 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func TestApplyMintOwnershipTransferMovesIssuer(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mintHash := test_support.GenerateRandomHash()
+	ownerAddress := test_support.GenerateDogecoinAddress(true)
+	newOwnerAddress := test_support.GenerateDogecoinAddress(true)
+
+	_, err := tokenStore.SaveMint(ctx, &store.MintWithoutID{
+		Hash:                     mintHash,
+		Title:                    "Test Mint",
+		FractionCount:            100,
+		PublicKey:                "ownerPublicKey",
+		SignatureRequirementType: store.SignatureRequirementType_ONE_SIGNATURE,
+		AssetManagers:            store.AssetManagers{{Name: "Old Manager", PublicKey: "oldManagerKey"}},
+		TransactionHash:          "mintTx",
+		BlockHeight:              5,
+	}, ownerAddress)
+	assert.NilError(t, err)
+
+	transfer := store.MintOwnershipTransfer{
+		MintHash:                 mintHash,
+		NewOwnerAddress:          newOwnerAddress,
+		NewPublicKey:             "newOwnerPublicKey",
+		SignatureRequirementType: store.SignatureRequirementType_MIN_SIGNATURES,
+		AssetManagers:            store.AssetManagers{{Name: "New Manager", PublicKey: "newManagerKey"}, {Name: "Auditor", PublicKey: "auditorKey"}},
+		MinSignatures:            2,
+		PublicKey:                "ownerPublicKey",
+		Signature:                "signature",
+		NewOwnerSignature:        "newOwnerSignature",
+		CreatedAt:                time.Now(),
+	}
+	transfer.Hash, err = transfer.GenerateHash()
+	assert.NilError(t, err)
+
+	_, err = tokenStore.SaveMintOwnershipTransfer(ctx, &transfer)
+	assert.NilError(t, err)
+
+	transfer, err = tokenStore.GetMintOwnershipTransfer(ctx, transfer.Hash)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(0), transfer.BlockHeight)
+
+	assert.NilError(t, tokenStore.ApplyMintOwnershipTransfer(ctx, transfer, store.OnChainTransaction{Id: "transferTx", TxHash: "transferTx", Height: 8}))
+
+	mint, err := tokenStore.GetMintByHash(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, newOwnerAddress, mint.OwnerAddress)
+	assert.Equal(t, "newOwnerPublicKey", mint.PublicKey)
+	assert.Equal(t, store.SignatureRequirementType_MIN_SIGNATURES, mint.SignatureRequirementType)
+	assert.Equal(t, 2, len(mint.AssetManagers))
+	assert.Equal(t, 2, mint.MinSignatures)
+
+	transfer, err = tokenStore.GetMintOwnershipTransfer(ctx, transfer.Hash)
+	assert.NilError(t, err)
+	assert.Equal(t, ownerAddress, transfer.PreviousOwnerAddress)
+	assert.Equal(t, int64(8), transfer.BlockHeight)
+
+	// The issuer queries follow the new owner
+	mints, err := tokenStore.GetMintsByAddress(ctx, 0, 10, newOwnerAddress, false)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(mints))
+	mints, err = tokenStore.GetMintsByAddress(ctx, 0, 10, ownerAddress, false)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(mints))
+	mints, err = tokenStore.GetMintsByPublicKey(ctx, 0, 10, "newOwnerPublicKey", false)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(mints))
+
+	// A reorg below the transfer restores the previous issuer and asset managers
+	assert.NilError(t, tokenStore.RollbackToHeight(ctx, 7))
+
+	mint, err = tokenStore.GetMintByHash(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, ownerAddress, mint.OwnerAddress)
+	assert.Equal(t, "ownerPublicKey", mint.PublicKey)
+	assert.Equal(t, store.SignatureRequirementType_ONE_SIGNATURE, mint.SignatureRequirementType)
+	assert.Equal(t, 1, len(mint.AssetManagers))
+	assert.Equal(t, "oldManagerKey", mint.AssetManagers[0].PublicKey)
+	assert.Equal(t, 0, mint.MinSignatures)
+
+	transfer, err = tokenStore.GetMintOwnershipTransfer(ctx, transfer.Hash)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(0), transfer.BlockHeight)
+	assert.Equal(t, "", transfer.PreviousOwnerAddress)
+}
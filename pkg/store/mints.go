@@ -13,7 +13,7 @@ import (
 )
 
 func (s *TokenisationStore) GetMintByHash(ctx context.Context, hash string) (Mint, error) {
-	rows, err := s.DB.QueryContext(ctx, "SELECT id, created_at, title, description, fraction_count, tags, metadata, hash, transaction_hash, requirements, lockup_options, feed_url, owner_address, public_key, contract_of_sale, signature_requirement_type, asset_managers, min_signatures FROM mints WHERE hash = $1", hash)
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, created_at, title, description, fraction_count, tags, metadata, hash, transaction_hash, requirements, lockup_options, feed_url, owner_address, public_key, contract_of_sale, signature_requirement_type, asset_managers, min_signatures FROM mints WHERE hash = $1", hash)
 	if err != nil {
 		return Mint{}, err
 	}
@@ -31,7 +31,7 @@ func (s *TokenisationStore) GetMintByHash(ctx context.Context, hash string) (Min
 }
 
 func (s *TokenisationStore) GetMintsByPublicKey(ctx context.Context, offset int, limit int, publicKey string, includeUnconfirmed bool) ([]Mint, error) {
-	rows, err := s.DB.QueryContext(ctx, "SELECT id, created_at, title, description, fraction_count, tags, metadata, hash, transaction_hash, requirements, lockup_options, feed_url, owner_address, public_key, contract_of_sale, signature_requirement_type, asset_managers, min_signatures FROM mints WHERE public_key = $1 and transaction_hash is not null LIMIT $2 OFFSET $3", publicKey, limit, offset)
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, created_at, title, description, fraction_count, tags, metadata, hash, transaction_hash, requirements, lockup_options, feed_url, owner_address, public_key, contract_of_sale, signature_requirement_type, asset_managers, min_signatures FROM mints WHERE public_key = $1 and transaction_hash is not null LIMIT $2 OFFSET $3", publicKey, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +51,7 @@ func (s *TokenisationStore) GetMintsByPublicKey(ctx context.Context, offset int,
 	rows.Close()
 
 	if includeUnconfirmed {
-		rows, err = s.DB.QueryContext(ctx, "SELECT id, created_at, title, description, fraction_count, tags, metadata, hash, transaction_hash, requirements, lockup_options, feed_url, owner_address, public_key, contract_of_sale, signature_requirement_type, asset_managers, min_signatures FROM unconfirmed_mints WHERE public_key = $1 LIMIT $2 OFFSET $3", publicKey, limit, offset)
+		rows, err = s.conn().QueryContext(ctx, "SELECT id, created_at, title, description, fraction_count, tags, metadata, hash, transaction_hash, requirements, lockup_options, feed_url, owner_address, public_key, contract_of_sale, signature_requirement_type, asset_managers, min_signatures FROM unconfirmed_mints WHERE public_key = $1 LIMIT $2 OFFSET $3", publicKey, limit, offset)
 		if err != nil {
 			return nil, err
 		}
@@ -75,7 +75,7 @@ func (s *TokenisationStore) GetMintsByPublicKey(ctx context.Context, offset int,
 }
 
 func (s *TokenisationStore) GetMintsByAddress(ctx context.Context, offset int, limit int, address string, includeUnconfirmed bool) ([]Mint, error) {
-	rows, err := s.DB.QueryContext(ctx, "SELECT id, created_at, title, description, fraction_count, tags, metadata, hash, transaction_hash, requirements, lockup_options, feed_url, owner_address, public_key, contract_of_sale, signature_requirement_type, asset_managers, min_signatures FROM mints WHERE owner_address = $1 LIMIT $2 OFFSET $3", address, limit, offset)
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, created_at, title, description, fraction_count, tags, metadata, hash, transaction_hash, requirements, lockup_options, feed_url, owner_address, public_key, contract_of_sale, signature_requirement_type, asset_managers, min_signatures FROM mints WHERE owner_address = $1 LIMIT $2 OFFSET $3", address, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +95,7 @@ func (s *TokenisationStore) GetMintsByAddress(ctx context.Context, offset int, l
 	rows.Close()
 
 	if includeUnconfirmed {
-		rows, err = s.DB.QueryContext(ctx, "SELECT id, created_at, title, description, fraction_count, tags, metadata, hash, transaction_hash, requirements, lockup_options, feed_url, owner_address, public_key, contract_of_sale, signature_requirement_type, asset_managers, min_signatures FROM unconfirmed_mints WHERE owner_address = $1 LIMIT $2 OFFSET $3", address, limit, offset)
+		rows, err = s.conn().QueryContext(ctx, "SELECT id, created_at, title, description, fraction_count, tags, metadata, hash, transaction_hash, requirements, lockup_options, feed_url, owner_address, public_key, contract_of_sale, signature_requirement_type, asset_managers, min_signatures FROM unconfirmed_mints WHERE owner_address = $1 LIMIT $2 OFFSET $3", address, limit, offset)
 		if err != nil {
 			return nil, err
 		}
@@ -119,7 +119,7 @@ func (s *TokenisationStore) GetMintsByAddress(ctx context.Context, offset int, l
 }
 
 func (s *TokenisationStore) ChooseMint(ctx context.Context) (Mint, error) {
-	row := s.DB.QueryRowContext(ctx, "SELECT id, created_at, title, description, fraction_count, tags, metadata, hash, transaction_hash, requirements, lockup_options, feed_url, owner_address, public_key, contract_of_sale, signature_requirement_type, asset_managers, min_signatures FROM mints WHERE hash IN (SELECT hash FROM mints ORDER BY RANDOM() LIMIT 1)")
+	row := s.conn().QueryRowContext(ctx, "SELECT id, created_at, title, description, fraction_count, tags, metadata, hash, transaction_hash, requirements, lockup_options, feed_url, owner_address, public_key, contract_of_sale, signature_requirement_type, asset_managers, min_signatures FROM mints WHERE hash IN (SELECT hash FROM mints ORDER BY RANDOM() LIMIT 1)")
 	var m Mint
 	if err := row.Scan(&m.Id, &m.CreatedAt, &m.Title, &m.Description, &m.FractionCount, &m.Tags, &m.Metadata, &m.Hash, &m.TransactionHash, &m.Requirements, &m.LockupOptions, &m.FeedURL, &m.OwnerAddress, &m.PublicKey, &m.ContractOfSale, &m.SignatureRequirementType, &m.AssetManagers, &m.MinSignatures); err != nil {
 		return Mint{}, err
@@ -128,7 +128,7 @@ func (s *TokenisationStore) ChooseMint(ctx context.Context) (Mint, error) {
 }
 
 func (s *TokenisationStore) GetMints(ctx context.Context, offset int, limit int) ([]Mint, error) {
-	rows, err := s.DB.QueryContext(ctx, "SELECT id, created_at, title, description, fraction_count, tags, metadata, hash, transaction_hash, requirements, lockup_options, feed_url, owner_address, public_key, contract_of_sale, signature_requirement_type, asset_managers, min_signatures FROM mints LIMIT $1 OFFSET $2", limit, offset)
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, created_at, title, description, fraction_count, tags, metadata, hash, transaction_hash, requirements, lockup_options, feed_url, owner_address, public_key, contract_of_sale, signature_requirement_type, asset_managers, min_signatures FROM mints LIMIT $1 OFFSET $2", limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -149,8 +149,19 @@ func (s *TokenisationStore) GetMints(ctx context.Context, offset int, limit int)
 	return mints, nil
 }
 
+func (s *TokenisationStore) GetUnconfirmedMintByHash(ctx context.Context, hash string) (Mint, error) {
+	row := s.conn().QueryRowContext(ctx, "SELECT id, created_at, title, description, fraction_count, tags, metadata, hash, transaction_hash, requirements, lockup_options, feed_url, public_key, contract_of_sale, signature_requirement_type, asset_managers, min_signatures FROM unconfirmed_mints WHERE hash = $1", hash)
+
+	var m Mint
+	if err := row.Scan(&m.Id, &m.CreatedAt, &m.Title, &m.Description, &m.FractionCount, &m.Tags, &m.Metadata, &m.Hash, &m.TransactionHash, &m.Requirements, &m.LockupOptions, &m.FeedURL, &m.PublicKey, &m.ContractOfSale, &m.SignatureRequirementType, &m.AssetManagers, &m.MinSignatures); err != nil {
+		return Mint{}, err
+	}
+
+	return m, nil
+}
+
 func (s *TokenisationStore) GetUnconfirmedMints(ctx context.Context, offset int, limit int) ([]Mint, error) {
-	rows, err := s.DB.QueryContext(ctx, "SELECT id, created_at, title, description, fraction_count, tags, metadata, hash, transaction_hash, requirements, lockup_options, feed_url, public_key, contract_of_sale, signature_requirement_type, asset_managers, min_signatures FROM unconfirmed_mints LIMIT $1 OFFSET $2", limit, offset)
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, created_at, title, description, fraction_count, tags, metadata, hash, transaction_hash, requirements, lockup_options, feed_url, public_key, contract_of_sale, signature_requirement_type, asset_managers, min_signatures FROM unconfirmed_mints LIMIT $1 OFFSET $2", limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -204,14 +215,14 @@ func (s *TokenisationStore) SaveMintWithTx(ctx context.Context, mint *MintWithou
 	}
 
 	query := `
-	INSERT INTO mints (id, title, description, fraction_count, tags, metadata, hash, requirements, lockup_options, feed_url, owner_address, public_key, block_height, transaction_hash, contract_of_sale, signature_requirement_type, asset_managers, min_signatures)
-	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+	INSERT INTO mints (id, title, description, fraction_count, tags, metadata, hash, requirements, lockup_options, feed_url, owner_address, public_key, block_height, transaction_hash, contract_of_sale, signature_requirement_type, asset_managers, min_signatures, block_hash)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
 	`
 
 	if tx != nil {
-		_, err = tx.ExecContext(ctx, query, id, mint.Title, mint.Description, mint.FractionCount, string(tags), string(metadata), mint.Hash, string(requirements), string(lockupOptions), mint.FeedURL, ownerAddress, mint.PublicKey, mint.BlockHeight, mint.TransactionHash, string(contractOfSale), mint.SignatureRequirementType, mint.AssetManagers, mint.MinSignatures)
+		_, err = tx.ExecContext(ctx, query, id, mint.Title, mint.Description, mint.FractionCount, string(tags), string(metadata), mint.Hash, string(requirements), string(lockupOptions), mint.FeedURL, ownerAddress, mint.PublicKey, mint.BlockHeight, mint.TransactionHash, string(contractOfSale), mint.SignatureRequirementType, mint.AssetManagers, mint.MinSignatures, mint.BlockHash)
 	} else {
-		_, err = s.DB.ExecContext(ctx, query, id, mint.Title, mint.Description, mint.FractionCount, string(tags), string(metadata), mint.Hash, string(requirements), string(lockupOptions), mint.FeedURL, ownerAddress, mint.PublicKey, mint.BlockHeight, mint.TransactionHash, string(contractOfSale), mint.SignatureRequirementType, mint.AssetManagers, mint.MinSignatures)
+		_, err = s.conn().ExecContext(ctx, query, id, mint.Title, mint.Description, mint.FractionCount, string(tags), string(metadata), mint.Hash, string(requirements), string(lockupOptions), mint.FeedURL, ownerAddress, mint.PublicKey, mint.BlockHeight, mint.TransactionHash, string(contractOfSale), mint.SignatureRequirementType, mint.AssetManagers, mint.MinSignatures, mint.BlockHash)
 	}
 
 	return id, err
@@ -219,7 +230,7 @@ func (s *TokenisationStore) SaveMintWithTx(ctx context.Context, mint *MintWithou
 
 func (s *TokenisationStore) TrimOldUnconfirmedMints(ctx context.Context, limit int) error {
 	sqlQuery := fmt.Sprintf("DELETE FROM unconfirmed_mints WHERE id NOT IN (SELECT id FROM unconfirmed_mints ORDER BY id DESC LIMIT %d)", limit)
-	_, err := s.DB.ExecContext(ctx, sqlQuery)
+	_, err := s.conn().ExecContext(ctx, sqlQuery)
 	if err != nil {
 		return err
 	}
@@ -256,7 +267,7 @@ func (s *TokenisationStore) SaveUnconfirmedMint(ctx context.Context, mint *MintW
 		return "", err
 	}
 
-	_, err = s.DB.ExecContext(ctx, `
+	_, err = s.conn().ExecContext(ctx, `
 	INSERT INTO unconfirmed_mints (id, title, description, fraction_count, tags, metadata, hash, requirements, lockup_options, feed_url, public_key, owner_address, transaction_hash, contract_of_sale, signature_requirement_type, asset_managers, min_signatures)
 	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 	`, id, mint.Title, mint.Description, mint.FractionCount, string(tags), string(metadata), mint.Hash, string(requirements), string(lockupOptions), mint.FeedURL, mint.PublicKey, mint.OwnerAddress, mint.TransactionHash, string(contractOfSale), mint.SignatureRequirementType, mint.AssetManagers, mint.MinSignatures)
@@ -280,7 +291,7 @@ func (s *TokenisationStore) MatchMint(ctx context.Context, onchainTransaction On
 		return false
 	}
 
-	rows, err := s.DB.QueryContext(ctx, "SELECT hash, transaction_hash FROM mints WHERE transaction_hash = $1 and block_height = $2 and hash = $3", onchainTransaction.TxHash, onchainTransaction.Height, onchainMessage.Hash)
+	rows, err := s.conn().QueryContext(ctx, "SELECT hash, transaction_hash FROM mints WHERE transaction_hash = $1 and block_height = $2 and hash = $3", onchainTransaction.TxHash, onchainTransaction.Height, onchainMessage.Hash)
 	if err != nil {
 		return false
 	}
@@ -289,7 +300,7 @@ func (s *TokenisationStore) MatchMint(ctx context.Context, onchainTransaction On
 	exists := rows.Next()
 
 	if exists {
-		_, err = s.DB.ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id = $1", onchainTransaction.Id)
+		_, err = s.conn().ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id = $1", onchainTransaction.Id)
 		if err != nil {
 			return false
 		}
@@ -311,7 +322,7 @@ func (s *TokenisationStore) MatchUnconfirmedMint(ctx context.Context, onchainTra
 	}
 
 	// Start transaction for atomic operations
-	tx, err := s.DB.BeginTx(ctx, nil)
+	tx, err := s.Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -349,6 +360,7 @@ func (s *TokenisationStore) MatchUnconfirmedMint(ctx context.Context, onchainTra
 		Metadata:                 unconfirmedMint.Metadata,
 		TransactionHash:          onchainTransaction.TxHash,
 		BlockHeight:              onchainTransaction.Height,
+		BlockHash:                onchainTransaction.BlockHash,
 		CreatedAt:                unconfirmedMint.CreatedAt,
 		Requirements:             unconfirmedMint.Requirements,
 		LockupOptions:            unconfirmedMint.LockupOptions,
@@ -359,7 +371,7 @@ func (s *TokenisationStore) MatchUnconfirmedMint(ctx context.Context, onchainTra
 		SignatureRequirementType: unconfirmedMint.SignatureRequirementType,
 		AssetManagers:            unconfirmedMint.AssetManagers,
 		MinSignatures:            unconfirmedMint.MinSignatures,
-	}, onchainTransaction.Address, tx)
+	}, onchainTransaction.Address, tx.Tx)
 
 	if err != nil {
 		return err
@@ -367,10 +379,23 @@ func (s *TokenisationStore) MatchUnconfirmedMint(ctx context.Context, onchainTra
 
 	log.Println("Saved mint:", id)
 
-	// Use transaction-aware UpsertTokenBalance
-	err = s.UpsertTokenBalanceWithTransaction(ctx, onchainTransaction.Address, unconfirmedMint.Hash, unconfirmedMint.FractionCount, tx)
+	_, err = tx.ExecContext(ctx, "UPDATE mints SET block_time = $1 WHERE id = $2", nullTime(onchainTransaction.BlockTime), id)
+	if err != nil {
+		log.Println("error setting mint block time", err)
+		return err
+	}
+
+	// Issue the fraction count to the minter
+	err = s.PostToLedger(ctx, LedgerPosting{
+		MintHash:    unconfirmedMint.Hash,
+		FromAccount: LedgerAccountIssued,
+		ToAccount:   onchainTransaction.Address,
+		Quantity:    unconfirmedMint.FractionCount,
+		ActionType:  protocol.ACTION_MINT,
+		Block:       onchainTransaction.BlockRef(),
+	}, tx.Tx)
 	if err != nil {
-		log.Println("error upserting token balance", err)
+		log.Println("error issuing mint to the ledger", err)
 		return err
 	}
 
@@ -399,7 +424,7 @@ func getMintsCount(ctx context.Context, s *TokenisationStore) (int, error) {
 	if s.backend == "postgres" {
 		return approximateTableCountPostgres(ctx, s.DB, "mints")
 	}
-	rows, err := s.DB.QueryContext(ctx, "SELECT COUNT(*) FROM mints")
+	rows, err := s.conn().QueryContext(ctx, "SELECT COUNT(*) FROM mints")
 	if err != nil {
 		return 0, err
 	}
@@ -422,7 +447,7 @@ func getUnconfirmedMintsCount(ctx context.Context, s *TokenisationStore) (int, e
 	if s.backend == "postgres" {
 		return approximateTableCountPostgres(ctx, s.DB, "unconfirmed_mints")
 	}
-	rows, err := s.DB.QueryContext(ctx, "SELECT COUNT(*) FROM unconfirmed_mints")
+	rows, err := s.conn().QueryContext(ctx, "SELECT COUNT(*) FROM unconfirmed_mints")
 	if err != nil {
 		return 0, err
 	}
@@ -442,7 +467,7 @@ func getUnconfirmedMintsCount(ctx context.Context, s *TokenisationStore) (int, e
 }
 
 func (s *TokenisationStore) ClearMints(ctx context.Context) error {
-	_, err := s.DB.ExecContext(ctx, "DELETE FROM mints")
+	_, err := s.conn().ExecContext(ctx, "DELETE FROM mints")
 	if err != nil {
 		return err
 	}
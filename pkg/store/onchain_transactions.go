@@ -2,9 +2,12 @@ package store
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
+	"time"
 
+	"dogecoin.org/fractal-engine/pkg/protocol"
 	"github.com/google/uuid"
 )
 
@@ -12,7 +15,7 @@ func getOnChainTransactionsCount(ctx context.Context, s *TokenisationStore) (int
 	if s.backend == "postgres" {
 		return approximateTableCountPostgres(ctx, s.DB, "onchain_transactions")
 	}
-	rows, err := s.DB.QueryContext(ctx, "SELECT COUNT(*) FROM onchain_transactions")
+	rows, err := s.conn().QueryContext(ctx, "SELECT COUNT(*) FROM onchain_transactions")
 	if err != nil {
 		return 0, err
 	}
@@ -32,21 +35,135 @@ func getOnChainTransactionsCount(ctx context.Context, s *TokenisationStore) (int
 }
 
 func (s *TokenisationStore) SaveOnChainTransaction(ctx context.Context, tx_hash string, height int64, blockHash string, transaction_number int, action_type uint8, action_version uint8, action_data []byte, address string, values StringInterfaceMap) (string, error) {
+	return s.SaveOnChainTransactionAtTime(ctx, tx_hash, height, blockHash, transaction_number, action_type, action_version, action_data, address, values, time.Time{})
+}
+
+// SaveOnChainTransactionAtTime records an on-chain transaction along with the time of
+// the block that included it. A zero block time is stored as NULL.
+func (s *TokenisationStore) SaveOnChainTransactionAtTime(ctx context.Context, tx_hash string, height int64, blockHash string, transaction_number int, action_type uint8, action_version uint8, action_data []byte, address string, values StringInterfaceMap, blockTime time.Time) (string, error) {
 	id := uuid.New().String()
 
 	jsonValues, err := json.Marshal(values)
 	if err != nil {
 		return "", err
 	}
-	_, err = s.DB.ExecContext(ctx, `
-	INSERT INTO onchain_transactions (id, tx_hash, block_height, block_hash, transaction_number, action_type, action_version, action_data, address, "values")
-	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-	`, id, tx_hash, height, blockHash, transaction_number, action_type, action_version, action_data, address, jsonValues)
+	_, err = s.conn().ExecContext(ctx, `
+	INSERT INTO onchain_transactions (id, tx_hash, block_height, block_hash, transaction_number, action_type, action_version, action_data, address, "values", block_time, values_version)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`, id, tx_hash, height, blockHash, transaction_number, action_type, action_version, action_data, address, jsonValues, nullTime(blockTime), valuesVersionKoinu)
 	return id, err
 }
 
+// SaveOnChainBatchAtTime records every action of a batch envelope as its own on-chain
+// transaction. The rows share the transaction hash and number and are told apart by
+// their sub index, which is the position of the action within the batch.
+func (s *TokenisationStore) SaveOnChainBatchAtTime(ctx context.Context, tx_hash string, height int64, blockHash string, transaction_number int, batch protocol.BatchEnvelope, address string, values StringInterfaceMap, blockTime time.Time) ([]string, error) {
+	jsonValues, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	ids := make([]string, 0, len(batch.Actions))
+	for subIndex, action := range batch.Actions {
+		id := uuid.New().String()
+		_, err = tx.ExecContext(ctx, `
+		INSERT INTO onchain_transactions (id, tx_hash, block_height, block_hash, transaction_number, action_type, action_version, action_data, address, "values", block_time, sub_index, batch_atomic, values_version)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		`, id, tx_hash, height, blockHash, transaction_number, action.Action, action.Version, action.Data, address, jsonValues, nullTime(blockTime), subIndex, batch.Atomic, valuesVersionKoinu)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, tx.Commit()
+}
+
+// GetOnChainTransactionsByTxHash returns the unprocessed actions of a Dogecoin
+// transaction in batch order.
+func (s *TokenisationStore) GetOnChainTransactionsByTxHash(ctx context.Context, tx_hash string) ([]OnChainTransaction, error) {
+	rows, err := s.conn().QueryContext(ctx, `SELECT id, tx_hash, block_height, block_hash, transaction_number, action_type, action_version, action_data, address, "values", block_time, sub_index, batch_atomic, values_version FROM onchain_transactions WHERE tx_hash = $1 ORDER BY sub_index ASC`, tx_hash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transactions []OnChainTransaction
+	for rows.Next() {
+		var transaction OnChainTransaction
+		var blockTime sql.NullTime
+		var valuesVersion int
+		if err := rows.Scan(&transaction.Id, &transaction.TxHash, &transaction.Height, &transaction.BlockHash, &transaction.TransactionNumber, &transaction.ActionType, &transaction.ActionVersion, &transaction.ActionData, &transaction.Address, exactValues{&transaction.Values}, &blockTime, &transaction.SubIndex, &transaction.BatchAtomic, &valuesVersion); err != nil {
+			return nil, err
+		}
+		transaction.BlockTime = blockTime.Time
+
+		if err := transaction.Values.upgrade(valuesVersion); err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// GetOnChainTransactionsByAction returns the unprocessed on-chain transactions of one
+// action type, oldest first.
+func (s *TokenisationStore) GetOnChainTransactionsByAction(ctx context.Context, actionType uint8) ([]OnChainTransaction, error) {
+	rows, err := s.conn().QueryContext(ctx, `SELECT id, tx_hash, block_height, block_hash, transaction_number, action_type, action_version, action_data, address, "values", block_time, sub_index, batch_atomic, values_version FROM onchain_transactions WHERE action_type = $1 ORDER BY block_height ASC, transaction_number ASC, sub_index ASC`, actionType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transactions []OnChainTransaction
+	for rows.Next() {
+		var transaction OnChainTransaction
+		var blockTime sql.NullTime
+		var valuesVersion int
+		if err := rows.Scan(&transaction.Id, &transaction.TxHash, &transaction.Height, &transaction.BlockHash, &transaction.TransactionNumber, &transaction.ActionType, &transaction.ActionVersion, &transaction.ActionData, &transaction.Address, exactValues{&transaction.Values}, &blockTime, &transaction.SubIndex, &transaction.BatchAtomic, &valuesVersion); err != nil {
+			return nil, err
+		}
+		transaction.BlockTime = blockTime.Time
+
+		if err := transaction.Values.upgrade(valuesVersion); err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// HasOnChainTransaction reports whether an on-chain transaction is still waiting to be processed.
+func (s *TokenisationStore) HasOnChainTransaction(ctx context.Context, id string) (bool, error) {
+	var count int
+	err := s.conn().QueryRowContext(ctx, "SELECT COUNT(*) FROM onchain_transactions WHERE id = $1", id).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
 func (s *TokenisationStore) GetOldOnchainTransactions(ctx context.Context, blockHeight int) ([]OnChainTransaction, error) {
-	rows, err := s.DB.QueryContext(ctx, `SELECT id, tx_hash, block_height, block_hash, transaction_number, action_type, action_version, action_data, address, "values" FROM onchain_transactions WHERE block_height < $1`, blockHeight)
+	rows, err := s.conn().QueryContext(ctx, `SELECT id, tx_hash, block_height, block_hash, transaction_number, action_type, action_version, action_data, address, "values", block_time, sub_index, batch_atomic, values_version FROM onchain_transactions WHERE block_height < $1`, blockHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +172,14 @@ func (s *TokenisationStore) GetOldOnchainTransactions(ctx context.Context, block
 	var transactions []OnChainTransaction
 	for rows.Next() {
 		var transaction OnChainTransaction
-		if err := rows.Scan(&transaction.Id, &transaction.TxHash, &transaction.Height, &transaction.BlockHash, &transaction.TransactionNumber, &transaction.ActionType, &transaction.ActionVersion, &transaction.ActionData, &transaction.Address, &transaction.Values); err != nil {
+		var blockTime sql.NullTime
+		var valuesVersion int
+		if err := rows.Scan(&transaction.Id, &transaction.TxHash, &transaction.Height, &transaction.BlockHash, &transaction.TransactionNumber, &transaction.ActionType, &transaction.ActionVersion, &transaction.ActionData, &transaction.Address, exactValues{&transaction.Values}, &blockTime, &transaction.SubIndex, &transaction.BatchAtomic, &valuesVersion); err != nil {
+			return nil, err
+		}
+		transaction.BlockTime = blockTime.Time
+
+		if err := transaction.Values.upgrade(valuesVersion); err != nil {
 			return nil, err
 		}
 		transactions = append(transactions, transaction)
@@ -70,7 +194,7 @@ func (s *TokenisationStore) GetOldOnchainTransactions(ctx context.Context, block
 
 func (s *TokenisationStore) TrimOldOnChainTransactions(ctx context.Context, blockHeightToKeep int) error {
 	sqlQuery := fmt.Sprintf("DELETE FROM onchain_transactions WHERE block_height < %d", blockHeightToKeep)
-	_, err := s.DB.ExecContext(ctx, sqlQuery)
+	_, err := s.conn().ExecContext(ctx, sqlQuery)
 	if err != nil {
 		return err
 	}
@@ -78,7 +202,7 @@ func (s *TokenisationStore) TrimOldOnChainTransactions(ctx context.Context, bloc
 }
 
 func (s *TokenisationStore) RemoveOnChainTransaction(ctx context.Context, id string) error {
-	_, err := s.DB.ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id = $1", id)
+	_, err := s.conn().ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id = $1", id)
 	if err != nil {
 		return err
 	}
@@ -87,7 +211,19 @@ func (s *TokenisationStore) RemoveOnChainTransaction(ctx context.Context, id str
 
 func (s *TokenisationStore) CountOnChainTransactions(ctx context.Context, blockHeight int64) (int, error) {
 	var count int
-	err := s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM onchain_transactions WHERE block_height = $1", blockHeight).Scan(&count)
+	err := s.conn().QueryRowContext(ctx, "SELECT COUNT(*) FROM onchain_transactions WHERE block_height = $1", blockHeight).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// CountAllOnChainTransactions is the number of on-chain transactions still waiting
+// to be matched by the processor.
+func (s *TokenisationStore) CountAllOnChainTransactions(ctx context.Context) (int, error) {
+	var count int
+	err := s.conn().QueryRowContext(ctx, "SELECT COUNT(*) FROM onchain_transactions").Scan(&count)
 	if err != nil {
 		return 0, err
 	}
@@ -96,7 +232,7 @@ func (s *TokenisationStore) CountOnChainTransactions(ctx context.Context, blockH
 }
 
 func (s *TokenisationStore) GetOnChainTransactions(ctx context.Context, offset int, limit int) ([]OnChainTransaction, error) {
-	rows, err := s.DB.QueryContext(ctx, `SELECT id, tx_hash, block_height, block_hash, transaction_number, action_type, action_version, action_data, address, "values" FROM onchain_transactions ORDER BY block_height ASC, transaction_number ASC LIMIT $1 OFFSET $2`, limit, offset)
+	rows, err := s.conn().QueryContext(ctx, `SELECT id, tx_hash, block_height, block_hash, transaction_number, action_type, action_version, action_data, address, "values", block_time, sub_index, batch_atomic, values_version FROM onchain_transactions ORDER BY block_height ASC, transaction_number ASC, sub_index ASC LIMIT $1 OFFSET $2`, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -105,7 +241,14 @@ func (s *TokenisationStore) GetOnChainTransactions(ctx context.Context, offset i
 	var transactions []OnChainTransaction
 	for rows.Next() {
 		var transaction OnChainTransaction
-		if err := rows.Scan(&transaction.Id, &transaction.TxHash, &transaction.Height, &transaction.BlockHash, &transaction.TransactionNumber, &transaction.ActionType, &transaction.ActionVersion, &transaction.ActionData, &transaction.Address, &transaction.Values); err != nil {
+		var blockTime sql.NullTime
+		var valuesVersion int
+		if err := rows.Scan(&transaction.Id, &transaction.TxHash, &transaction.Height, &transaction.BlockHash, &transaction.TransactionNumber, &transaction.ActionType, &transaction.ActionVersion, &transaction.ActionData, &transaction.Address, exactValues{&transaction.Values}, &blockTime, &transaction.SubIndex, &transaction.BatchAtomic, &valuesVersion); err != nil {
+			return nil, err
+		}
+		transaction.BlockTime = blockTime.Time
+
+		if err := transaction.Values.upgrade(valuesVersion); err != nil {
 			return nil, err
 		}
 		transactions = append(transactions, transaction)
@@ -117,3 +260,7 @@ func (s *TokenisationStore) GetOnChainTransactions(ctx context.Context, offset i
 
 	return transactions, nil
 }
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
@@ -270,3 +270,26 @@ func TestGetOnChainTransactionsEmptyResult(t *testing.T) {
 	assert.NilError(t, err)
 	assert.Equal(t, len(transactions), 0)
 }
+
+func TestGetOnChainTransactionsConvertsDogeValues(t *testing.T) {
+	db := support.SetupTestDB(t)
+
+	// Rows saved before values were recorded in koinu hold DOGE amounts
+	_, err := db.DB.ExecContext(onchainTestCtx, `
+	INSERT INTO onchain_transactions (id, tx_hash, block_height, block_hash, transaction_number, action_type, action_version, action_data, address, "values", values_version)
+	VALUES ('dogeValues', 'txHash', 1, 'blockHash', 1, 1, 1, $1, 'address', $2, 1)
+	`, []byte{}, `{"seller": 12.5, "change": 0.00000001}`)
+	assert.NilError(t, err)
+
+	transactions, err := db.GetOnChainTransactions(onchainTestCtx, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(transactions))
+
+	seller, err := transactions[0].Values.Koinu("seller")
+	assert.NilError(t, err)
+	assert.Equal(t, int64(1_250_000_000), seller)
+
+	change, err := transactions[0].Values.Koinu("change")
+	assert.NilError(t, err)
+	assert.Equal(t, int64(1), change)
+}
@@ -0,0 +1,196 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// GetOrderBook returns the open buy and sell offers for a mint in price-time
+// priority: bids by highest price first, asks by lowest price first, and the
+// oldest offer first within a price level.
+func (s *TokenisationStore) GetOrderBook(ctx context.Context, mintHash string) ([]BuyOffer, []SellOffer, error) {
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, created_at, offerer_address, seller_address, hash, mint_hash, quantity, price, public_key, signature FROM buy_offers WHERE mint_hash = $1 AND quantity > 0 AND delete_pending = FALSE AND deleted_block_height IS NULL ORDER BY price DESC, created_at ASC, hash ASC", mintHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var bids []BuyOffer
+	for rows.Next() {
+		var offer BuyOffer
+		if err := rows.Scan(&offer.Id, &offer.CreatedAt, &offer.OffererAddress, &offer.SellerAddress, &offer.Hash, &offer.MintHash, &offer.Quantity, &offer.Price, &offer.PublicKey, &offer.Signature); err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		bids = append(bids, offer)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	rows, err = s.conn().QueryContext(ctx, "SELECT id, created_at, offerer_address, hash, mint_hash, quantity, price, public_key, signature FROM sell_offers WHERE mint_hash = $1 AND quantity > 0 AND delete_pending = FALSE AND deleted_block_height IS NULL ORDER BY price ASC, created_at ASC, hash ASC", mintHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var asks []SellOffer
+	for rows.Next() {
+		var offer SellOffer
+		if err := rows.Scan(&offer.Id, &offer.CreatedAt, &offer.OffererAddress, &offer.Hash, &offer.MintHash, &offer.Quantity, &offer.Price, &offer.PublicKey, &offer.Signature); err != nil {
+			return nil, nil, err
+		}
+		asks = append(asks, offer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return bids, asks, nil
+}
+
+// GetOrderBookDepth aggregates the open offers for a mint into price levels.
+func (s *TokenisationStore) GetOrderBookDepth(ctx context.Context, mintHash string) ([]OrderBookLevel, []OrderBookLevel, error) {
+	bids, err := s.getOrderBookLevels(ctx, "SELECT price, SUM(quantity), COUNT(*) FROM buy_offers WHERE mint_hash = $1 AND quantity > 0 AND delete_pending = FALSE AND deleted_block_height IS NULL GROUP BY price ORDER BY price DESC", mintHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	asks, err := s.getOrderBookLevels(ctx, "SELECT price, SUM(quantity), COUNT(*) FROM sell_offers WHERE mint_hash = $1 AND quantity > 0 AND delete_pending = FALSE AND deleted_block_height IS NULL GROUP BY price ORDER BY price ASC", mintHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return bids, asks, nil
+}
+
+func (s *TokenisationStore) getOrderBookLevels(ctx context.Context, query string, mintHash string) ([]OrderBookLevel, error) {
+	rows, err := s.conn().QueryContext(ctx, query, mintHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	levels := []OrderBookLevel{}
+	for rows.Next() {
+		var level OrderBookLevel
+		if err := rows.Scan(&level.Price, &level.Quantity, &level.Orders); err != nil {
+			return nil, err
+		}
+		levels = append(levels, level)
+	}
+
+	return levels, rows.Err()
+}
+
+// GetCrossableMintHashes returns the mints that have at least one open buy offer and
+// one open sell offer.
+func (s *TokenisationStore) GetCrossableMintHashes(ctx context.Context) ([]string, error) {
+	rows, err := s.conn().QueryContext(ctx, "SELECT DISTINCT mint_hash FROM sell_offers WHERE quantity > 0 AND delete_pending = FALSE AND deleted_block_height IS NULL AND mint_hash IN (SELECT mint_hash FROM buy_offers WHERE quantity > 0 AND delete_pending = FALSE AND deleted_block_height IS NULL) ORDER BY mint_hash")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mintHashes []string
+	for rows.Next() {
+		var mintHash string
+		if err := rows.Scan(&mintHash); err != nil {
+			return nil, err
+		}
+		mintHashes = append(mintHashes, mintHash)
+	}
+
+	return mintHashes, rows.Err()
+}
+
+// FillOffers decrements both offers by the filled quantity, saves the invoice for the
+// fill and reserves the filled fractions of the seller against it, all in a single
+// transaction. Fully filled offers are kept at a quantity of zero so that a deletion
+// can still be undone by a rollback. The reservation is not tied to a block and is
+// only released when the invoice is cancelled or closed.
+func (s *TokenisationStore) FillOffers(ctx context.Context, buyOffer BuyOffer, sellOffer SellOffer, fill *OfferFill, invoice *UnconfirmedInvoice) (string, error) {
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "UPDATE buy_offers SET quantity = quantity - $1 WHERE id = $2 AND quantity >= $1", fill.Quantity, buyOffer.Id)
+	if err != nil {
+		return "", err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return "", fmt.Errorf("buy offer cannot fill quantity: %s", buyOffer.Hash)
+	}
+
+	result, err = tx.ExecContext(ctx, "UPDATE sell_offers SET quantity = quantity - $1 WHERE id = $2 AND quantity >= $1", fill.Quantity, sellOffer.Id)
+	if err != nil {
+		return "", err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return "", fmt.Errorf("sell offer cannot fill quantity: %s", sellOffer.Hash)
+	}
+
+	id, err := s.SaveUnconfirmedInvoiceWithTx(ctx, invoice, tx.Tx)
+	if err != nil {
+		log.Println("Error saving matched invoice:", err)
+		return "", err
+	}
+
+	err = s.UpsertPendingTokenBalanceWithTx(ctx, invoice.Hash, invoice.MintHash, fill.Quantity, "", sellOffer.OffererAddress, tx.Tx)
+	if err != nil {
+		log.Println("Error reserving matched quantity:", err)
+		return "", err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO offer_fills (id, mint_hash, buy_offer_hash, sell_offer_hash, invoice_hash, quantity, price, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, uuid.New().String(), fill.MintHash, fill.BuyOfferHash, fill.SellOfferHash, fill.InvoiceHash, fill.Quantity, fill.Price, fill.CreatedAt)
+	if err != nil {
+		log.Println("Error saving offer fill:", err)
+		return "", err
+	}
+
+	return id, tx.Commit()
+}
+
+func (s *TokenisationStore) GetOfferFills(ctx context.Context, mintHash string) ([]OfferFill, error) {
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, mint_hash, buy_offer_hash, sell_offer_hash, invoice_hash, quantity, price, created_at FROM offer_fills WHERE mint_hash = $1 ORDER BY created_at ASC", mintHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fills []OfferFill
+	for rows.Next() {
+		var fill OfferFill
+		if err := rows.Scan(&fill.Id, &fill.MintHash, &fill.BuyOfferHash, &fill.SellOfferHash, &fill.InvoiceHash, &fill.Quantity, &fill.Price, &fill.CreatedAt); err != nil {
+			return nil, err
+		}
+		fills = append(fills, fill)
+	}
+
+	return fills, rows.Err()
+}
+
+// TrimDeletedOffers purges offers that were cancelled on chain below the given block
+// height. Cancellations that deep can no longer be rolled back.
+func (s *TokenisationStore) TrimDeletedOffers(ctx context.Context, blockHeightToKeep int) error {
+	_, err := s.conn().ExecContext(ctx, "DELETE FROM buy_offers WHERE deleted_block_height < $1", blockHeightToKeep)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.conn().ExecContext(ctx, "DELETE FROM sell_offers WHERE deleted_block_height < $1", blockHeightToKeep)
+	return err
+}
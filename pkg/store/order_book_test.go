@@ -0,0 +1,201 @@
+package store_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func TestGetOrderBookDepth(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mintHash := test_support.GenerateRandomHash()
+	now := time.Now()
+
+	for _, bid := range []struct{ quantity, price int }{{10, 50}, {5, 50}, {20, 45}} {
+		_, err := tokenStore.SaveBuyOffer(ctx, &store.BuyOfferWithoutID{
+			Hash:           test_support.GenerateRandomHash(),
+			MintHash:       mintHash,
+			OffererAddress: "buyer",
+			Quantity:       bid.quantity,
+			Price:          bid.price,
+			CreatedAt:      now,
+		})
+		assert.NilError(t, err)
+	}
+
+	for _, ask := range []struct{ quantity, price int }{{8, 60}, {3, 55}} {
+		_, err := tokenStore.SaveSellOffer(ctx, &store.SellOfferWithoutID{
+			Hash:           test_support.GenerateRandomHash(),
+			MintHash:       mintHash,
+			OffererAddress: "seller",
+			Quantity:       ask.quantity,
+			Price:          ask.price,
+			CreatedAt:      now,
+		})
+		assert.NilError(t, err)
+	}
+
+	bids, asks, err := tokenStore.GetOrderBookDepth(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []store.OrderBookLevel{{Price: 50, Quantity: 15, Orders: 2}, {Price: 45, Quantity: 20, Orders: 1}}, bids)
+	assert.DeepEqual(t, []store.OrderBookLevel{{Price: 55, Quantity: 3, Orders: 1}, {Price: 60, Quantity: 8, Orders: 1}}, asks)
+}
+
+func TestFillOffersPartialFill(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mintHash := test_support.GenerateRandomHash()
+	now := time.Now()
+
+	_, err := tokenStore.SaveBuyOffer(ctx, &store.BuyOfferWithoutID{
+		Hash:           test_support.GenerateRandomHash(),
+		MintHash:       mintHash,
+		OffererAddress: "buyer",
+		Quantity:       10,
+		Price:          50,
+		CreatedAt:      now,
+	})
+	assert.NilError(t, err)
+
+	_, err = tokenStore.SaveSellOffer(ctx, &store.SellOfferWithoutID{
+		Hash:           test_support.GenerateRandomHash(),
+		MintHash:       mintHash,
+		OffererAddress: "seller",
+		Quantity:       4,
+		Price:          50,
+		CreatedAt:      now,
+	})
+	assert.NilError(t, err)
+
+	bids, asks, err := tokenStore.GetOrderBook(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(bids))
+	assert.Equal(t, 1, len(asks))
+
+	invoice := &store.UnconfirmedInvoice{
+		MintHash:       mintHash,
+		Quantity:       4,
+		Price:          50,
+		BuyerAddress:   "buyer",
+		PaymentAddress: "seller",
+		SellerAddress:  "seller",
+		CreatedAt:      now,
+		Status:         "draft",
+	}
+	invoice.Hash, err = invoice.GenerateHash()
+	assert.NilError(t, err)
+
+	fill := &store.OfferFill{
+		MintHash:      mintHash,
+		BuyOfferHash:  bids[0].Hash,
+		SellOfferHash: asks[0].Hash,
+		InvoiceHash:   invoice.Hash,
+		Quantity:      4,
+		Price:         50,
+		CreatedAt:     now,
+	}
+
+	_, err = tokenStore.FillOffers(ctx, bids[0], asks[0], fill, invoice)
+	assert.NilError(t, err)
+
+	remainingBids, remainingAsks, err := tokenStore.GetOrderBook(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(remainingBids))
+	assert.Equal(t, 6, remainingBids[0].Quantity)
+	assert.Equal(t, 0, len(remainingAsks))
+
+	savedInvoice, err := tokenStore.GetUnconfirmedInvoiceByHash(ctx, fill.InvoiceHash)
+	assert.NilError(t, err)
+	assert.Equal(t, "buyer", savedInvoice.BuyerAddress)
+	assert.Equal(t, 4, savedInvoice.Quantity)
+
+	fills, err := tokenStore.GetOfferFills(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(fills))
+	assert.Equal(t, fill.InvoiceHash, fills[0].InvoiceHash)
+
+	// The filled quantity of the seller is reserved against the invoice
+	reserved, err := tokenStore.GetPendingTokenBalance(ctx, invoice.Hash, mintHash, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, "seller", reserved.OwnerAddress)
+	assert.Equal(t, 4, reserved.Quantity)
+
+	// The filled sell offer is kept so that its history survives, but it cannot fill twice
+	filledAsk, err := tokenStore.GetSellOfferByHash(ctx, asks[0].Hash)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, filledAsk.Quantity)
+
+	sellOffers, err := tokenStore.GetSellOffers(ctx, 0, 10, mintHash, "")
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(sellOffers))
+
+	_, err = tokenStore.FillOffers(ctx, bids[0], asks[0], fill, invoice)
+	assert.ErrorContains(t, err, "sell offer cannot fill quantity")
+
+	// Cancelling the invoice releases the reservation
+	err = tokenStore.CancelUnconfirmedInvoice(ctx, invoice.Hash)
+	assert.NilError(t, err)
+
+	_, err = tokenStore.GetPendingTokenBalance(ctx, invoice.Hash, mintHash, nil)
+	assert.ErrorContains(t, err, "no pending token balance found")
+}
+
+func TestTrimDeletedOffers(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mintHash := test_support.GenerateRandomHash()
+	offererAddress := test_support.GenerateDogecoinAddress(true)
+
+	saveDeletedAsk := func(height int64) string {
+		offer := &store.SellOfferWithoutID{
+			Hash:           test_support.GenerateRandomHash(),
+			MintHash:       mintHash,
+			OffererAddress: offererAddress,
+			Quantity:       10,
+			Price:          50,
+			CreatedAt:      time.Now(),
+		}
+		_, err := tokenStore.SaveSellOffer(ctx, offer)
+		assert.NilError(t, err)
+
+		envelope := protocol.NewDeleteSellOfferTransactionEnvelope(offer.Hash, protocol.ACTION_DELETE_SELL_OFFER)
+		id, err := tokenStore.SaveOnChainTransaction(ctx, test_support.GenerateRandomHash(), height, "blockHash", 0, protocol.ACTION_DELETE_SELL_OFFER, protocol.DEFAULT_VERSION, envelope.Data, offererAddress, map[string]interface{}{})
+		assert.NilError(t, err)
+
+		txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+		assert.NilError(t, err)
+		for _, tx := range txs {
+			if tx.Id == id {
+				assert.NilError(t, tokenStore.MatchDeleteSellOffer(ctx, tx))
+			}
+		}
+
+		return offer.Hash
+	}
+
+	oldHash := saveDeletedAsk(10)
+	recentHash := saveDeletedAsk(100)
+
+	assert.NilError(t, tokenStore.TrimDeletedOffers(ctx, 50))
+
+	// Only the cancellation within the rollback window can still be undone
+	assert.NilError(t, tokenStore.RollbackToHeight(ctx, 5))
+
+	_, err := tokenStore.GetSellOfferByHash(ctx, oldHash)
+	assert.Assert(t, errors.Is(err, sql.ErrNoRows))
+
+	restored, err := tokenStore.GetSellOfferByHash(ctx, recentHash)
+	assert.NilError(t, err)
+	assert.Equal(t, 10, restored.Quantity)
+}
@@ -2,52 +2,275 @@ package store
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"log"
 	"time"
 
 	"dogecoin.org/fractal-engine/pkg/protocol"
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/proto"
 )
 
-func (s *TokenisationStore) ProcessPayment(ctx context.Context, onchainTransaction OnChainTransaction, invoice Invoice) error {
-	tx, err := s.DB.BeginTx(ctx, nil)
+/*
+* ProcessPayment records an on-chain payment towards an invoice. Payments accumulate
+* across transactions: each one is applied to the amount still outstanding, and any
+* excess is recorded as an overpayment so that it can be refunded. For invoices with
+* splits, the amount applied is what every recipient was paid its share of (see
+* Invoice.PaymentKoinu), and anything sent on top of that counts as overpaid. The
+* payment that brings the total up to the amount due settles the invoice and moves
+* the fractions to the buyer. It returns the recorded payment and whether it settled
+* the invoice.
+ */
+func (s *TokenisationStore) ProcessPayment(ctx context.Context, onchainTransaction OnChainTransaction, invoice Invoice) (InvoicePayment, bool, error) {
+	credited, amount, err := invoice.PaymentKoinu(onchainTransaction.Values)
 	if err != nil {
-		return err
+		return InvoicePayment{}, false, err
+	}
+
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return InvoicePayment{}, false, err
 	}
 
 	defer tx.Rollback()
 
-	_, err = tx.ExecContext(ctx, "UPDATE invoices SET paid_at = $1 WHERE id = $2", time.Now().UTC(), invoice.Id)
+	paid, _, err := s.GetInvoicePaidKoinu(ctx, invoice.Hash, tx.Tx)
+	if err != nil {
+		log.Println("Error getting invoice payments:", err)
+		return InvoicePayment{}, false, err
+	}
+
+	due := invoice.AmountDueKoinu()
+
+	// Invoices settled before payments were recorded have no payment rows
+	if invoice.PaidAt.Valid {
+		paid = due
+	}
+
+	outstanding := max(due-paid, 0)
+	applied := min(credited, outstanding)
+
+	payment := InvoicePayment{
+		Id:              uuid.New().String(),
+		InvoiceHash:     invoice.Hash,
+		TransactionHash: onchainTransaction.TxHash,
+		PayerAddress:    onchainTransaction.Address,
+		AmountKoinu:     amount,
+		AppliedKoinu:    applied,
+		OverpaidKoinu:   amount - applied,
+		BlockHeight:     onchainTransaction.Height,
+		BlockHash:       onchainTransaction.BlockHash,
+		CreatedAt:       time.Now().UTC(),
+	}
+
+	err = saveInvoicePayment(ctx, tx.Tx, payment)
 	if err != nil {
-		log.Println("Error updating invoice:", err)
-		return err
+		return InvoicePayment{}, false, err
+	}
+
+	settled := applied > 0 && applied == outstanding
+	if settled {
+		_, err = tx.ExecContext(ctx, "UPDATE invoices SET paid_at = $1, paid_block_height = $2, paid_block_hash = $3, paid_transaction_hash = $4, status = $5 WHERE id = $6", time.Now().UTC(), onchainTransaction.Height, onchainTransaction.BlockHash, onchainTransaction.TxHash, InvoiceStatusPaid, invoice.Id)
+		if err != nil {
+			log.Println("Error updating invoice:", err)
+			return InvoicePayment{}, false, err
+		}
+
+		err = recordInvoiceTransition(ctx, tx.Tx, invoice.Hash, invoice.Status, InvoiceStatusPaid, onchainTransaction.BlockRef())
+		if err != nil {
+			return InvoicePayment{}, false, err
+		}
+
+		pendingTokenBalance, err := s.GetPendingTokenBalanceForQuantity(ctx, invoice.Hash, invoice.MintHash, invoice.Quantity, tx.Tx)
+		if err != nil {
+			log.Println("Error getting pending token balance:", err)
+			return InvoicePayment{}, false, err
+		}
+
+		err = s.MovePendingToTokenBalanceAtBlock(ctx, pendingTokenBalance, invoice.BuyerAddress, onchainTransaction.BlockRef(), tx.Tx)
+		if err != nil {
+			log.Println("Error moving pending to token balance:", err)
+			return InvoicePayment{}, false, err
+		}
 	}
 
 	_, err = tx.ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id = $1", onchainTransaction.Id)
 	if err != nil {
 		log.Println("Error deleting onchain transaction:", err)
-		return err
+		return InvoicePayment{}, false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return InvoicePayment{}, false, err
 	}
 
-	pendingTokenBalance, err := s.GetPendingTokenBalanceForQuantity(ctx, invoice.Hash, invoice.MintHash, invoice.Quantity, tx)
+	return payment, settled, nil
+}
+
+/*
+* RecordOverpayment records an on-chain payment towards an invoice without applying
+* any of it, e.g. because the seller's fractions were locked when it was mined. The
+* whole amount counts as overpaid so that it can be refunded, and the invoice stays
+* open for a later payment to settle.
+ */
+func (s *TokenisationStore) RecordOverpayment(ctx context.Context, onchainTransaction OnChainTransaction, invoice Invoice) (InvoicePayment, error) {
+	_, amount, err := invoice.PaymentKoinu(onchainTransaction.Values)
 	if err != nil {
-		log.Println("Error getting pending token balance:", err)
-		return err
+		return InvoicePayment{}, err
 	}
 
-	err = s.MovePendingToTokenBalance(ctx, pendingTokenBalance, invoice.BuyerAddress, tx)
+	tx, err := s.Begin(ctx)
 	if err != nil {
-		log.Println("Error moving pending to token balance:", err)
-		return err
+		return InvoicePayment{}, err
+	}
+
+	defer tx.Rollback()
+
+	payment := InvoicePayment{
+		Id:              uuid.New().String(),
+		InvoiceHash:     invoice.Hash,
+		TransactionHash: onchainTransaction.TxHash,
+		PayerAddress:    onchainTransaction.Address,
+		AmountKoinu:     amount,
+		AppliedKoinu:    0,
+		OverpaidKoinu:   amount,
+		BlockHeight:     onchainTransaction.Height,
+		BlockHash:       onchainTransaction.BlockHash,
+		CreatedAt:       time.Now().UTC(),
+	}
+
+	err = saveInvoicePayment(ctx, tx.Tx, payment)
+	if err != nil {
+		return InvoicePayment{}, err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id = $1", onchainTransaction.Id)
+	if err != nil {
+		log.Println("Error deleting onchain transaction:", err)
+		return InvoicePayment{}, err
 	}
 
 	err = tx.Commit()
 	if err != nil {
-		return err
+		return InvoicePayment{}, err
 	}
 
-	return nil
+	return payment, nil
+}
+
+func saveInvoicePayment(ctx context.Context, tx *sql.Tx, payment InvoicePayment) error {
+	_, err := tx.ExecContext(ctx, `
+	INSERT INTO invoice_payments (id, invoice_hash, transaction_hash, payer_address, amount_koinu, applied_koinu, overpaid_koinu, block_height, block_hash, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`, payment.Id, payment.InvoiceHash, payment.TransactionHash, payment.PayerAddress, payment.AmountKoinu, payment.AppliedKoinu, payment.OverpaidKoinu, payment.BlockHeight, payment.BlockHash, payment.CreatedAt)
+	if err != nil {
+		log.Println("Error saving invoice payment:", err)
+	}
+
+	return err
+}
+
+// GetInvoicePaidKoinu returns the koinu applied towards an invoice and the koinu
+// overpaid on it. A nil tx reads outside of a transaction.
+func (s *TokenisationStore) GetInvoicePaidKoinu(ctx context.Context, invoiceHash string, tx *sql.Tx) (int64, int64, error) {
+	query := "SELECT COALESCE(SUM(applied_koinu), 0), COALESCE(SUM(overpaid_koinu), 0) FROM invoice_payments WHERE invoice_hash = $1"
+
+	var row *sql.Row
+	if tx != nil {
+		row = tx.QueryRowContext(ctx, query, invoiceHash)
+	} else {
+		row = s.conn().QueryRowContext(ctx, query, invoiceHash)
+	}
+
+	var paid, overpaid int64
+	err := row.Scan(&paid, &overpaid)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return paid, overpaid, nil
+}
+
+// GetInvoicePayments returns the payments made towards an invoice, oldest first.
+func (s *TokenisationStore) GetInvoicePayments(ctx context.Context, invoiceHash string) ([]InvoicePayment, error) {
+	rows, err := s.conn().QueryContext(ctx, `
+	SELECT id, invoice_hash, transaction_hash, payer_address, amount_koinu, applied_koinu, overpaid_koinu, block_height, block_hash, created_at
+	FROM invoice_payments WHERE invoice_hash = $1 ORDER BY block_height ASC, created_at ASC
+	`, invoiceHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanInvoicePayments(rows)
+}
+
+// HasInvoicePayments reports whether a payment towards an invoice has been applied, or
+// was mined at or before the given height and is still waiting to be processed.
+func (s *TokenisationStore) HasInvoicePayments(ctx context.Context, invoiceHash string, atHeight int64) (bool, error) {
+	var applied int
+	err := s.conn().QueryRowContext(ctx, "SELECT COUNT(*) FROM invoice_payments WHERE invoice_hash = $1", invoiceHash).Scan(&applied)
+	if err != nil {
+		return false, err
+	}
+
+	if applied > 0 {
+		return true, nil
+	}
+
+	rows, err := s.conn().QueryContext(ctx, "SELECT action_data FROM onchain_transactions WHERE action_type = $1 AND block_height <= $2", protocol.ACTION_PAYMENT, atHeight)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var actionData []byte
+		if err := rows.Scan(&actionData); err != nil {
+			return false, err
+		}
+
+		var message protocol.OnChainPaymentMessage
+		if proto.Unmarshal(actionData, &message) == nil && message.Hash == invoiceHash {
+			return true, nil
+		}
+	}
+
+	return false, rows.Err()
+}
+
+// GetOverpayments returns the payments that paid more than was outstanding on their
+// invoice, newest first, so that the excess can be refunded to the payer.
+func (s *TokenisationStore) GetOverpayments(ctx context.Context, offset int, limit int) ([]InvoicePayment, error) {
+	rows, err := s.conn().QueryContext(ctx, `
+	SELECT id, invoice_hash, transaction_hash, payer_address, amount_koinu, applied_koinu, overpaid_koinu, block_height, block_hash, created_at
+	FROM invoice_payments WHERE overpaid_koinu > 0 ORDER BY block_height DESC, created_at DESC LIMIT $1 OFFSET $2
+	`, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanInvoicePayments(rows)
+}
+
+func scanInvoicePayments(rows *sql.Rows) ([]InvoicePayment, error) {
+	defer rows.Close()
+
+	payments := []InvoicePayment{}
+	for rows.Next() {
+		var payment InvoicePayment
+		if err := rows.Scan(&payment.Id, &payment.InvoiceHash, &payment.TransactionHash, &payment.PayerAddress, &payment.AmountKoinu, &payment.AppliedKoinu, &payment.OverpaidKoinu, &payment.BlockHeight, &payment.BlockHash, &payment.CreatedAt); err != nil {
+			return nil, err
+		}
+		payments = append(payments, payment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return payments, nil
 }
 
 func (s *TokenisationStore) MatchPayment(ctx context.Context, onchainTransaction OnChainTransaction) (Invoice, error) {
@@ -61,7 +284,7 @@ func (s *TokenisationStore) MatchPayment(ctx context.Context, onchainTransaction
 		return Invoice{}, err
 	}
 
-	rows, err := s.DB.QueryContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, created_at, seller_address FROM invoices WHERE hash = $1", onchainMessage.Hash)
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, hash, payment_address, buyer_address, mint_hash, quantity, price, doge_price, created_at, seller_address, paid_at, splits, expires_at_height, status FROM invoices WHERE hash = $1", onchainMessage.Hash)
 	if err != nil {
 		log.Println("Error querying invoices:", err)
 		return Invoice{}, err
@@ -70,7 +293,7 @@ func (s *TokenisationStore) MatchPayment(ctx context.Context, onchainTransaction
 	var invoice Invoice
 
 	if rows.Next() {
-		err := rows.Scan(&invoice.Id, &invoice.Hash, &invoice.PaymentAddress, &invoice.BuyerAddress, &invoice.MintHash, &invoice.Quantity, &invoice.Price, &invoice.CreatedAt, &invoice.SellerAddress)
+		err := rows.Scan(&invoice.Id, &invoice.Hash, &invoice.PaymentAddress, &invoice.BuyerAddress, &invoice.MintHash, &invoice.Quantity, &invoice.Price, &invoice.DogePrice, &invoice.CreatedAt, &invoice.SellerAddress, &invoice.PaidAt, &invoice.Splits, &invoice.ExpiresAtHeight, &invoice.Status)
 		if err != nil {
 			log.Println("Error scanning invoice:", err)
 			return Invoice{}, err
@@ -83,12 +306,10 @@ func (s *TokenisationStore) MatchPayment(ctx context.Context, onchainTransaction
 		return Invoice{}, fmt.Errorf("invoice not found")
 	}
 
-	value := float64(invoice.Quantity * invoice.Price)
-
-	paymentValue := onchainTransaction.Values[invoice.SellerAddress]
-
-	if paymentValue != value {
-		return Invoice{}, fmt.Errorf("payment value is not equal to buy offer value: %f != %f", paymentValue, value)
+	// The payment must have an output for every recipient of the invoice
+	_, _, err = invoice.PaymentKoinu(onchainTransaction.Values)
+	if err != nil {
+		return Invoice{}, err
 	}
 
 	return invoice, nil
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
 	"dogecoin.org/fractal-engine/pkg/protocol"
 	"dogecoin.org/fractal-engine/pkg/store"
 	"google.golang.org/protobuf/proto"
@@ -39,7 +40,7 @@ func TestMatchPaymentSuccess(t *testing.T) {
 	sellerAddress := test_support.GenerateDogecoinAddress(true)
 	buyerAddress := test_support.GenerateDogecoinAddress(true)
 	quantity := 50
-	value := 50.0 * 100
+	value := int64(quantity) * 100 * doge.KoinuPerDoge
 
 	// Step 1: Create and match mint
 	_, err := tokenStore.SaveUnconfirmedMint(ctx, &store.MintWithoutID{
@@ -74,7 +75,7 @@ func TestMatchPaymentSuccess(t *testing.T) {
 		BuyerAddress:   buyerAddress,
 		MintHash:       mintHash,
 		Quantity:       quantity,
-		Price:          100,
+		Price:          100 * doge.KoinuPerDoge,
 		CreatedAt:      time.Now(),
 		SellerAddress:  sellerAddress,
 	})
@@ -128,8 +129,11 @@ func TestMatchPaymentSuccess(t *testing.T) {
 	invoice, err := tokenStore.MatchPayment(ctx, *paymentTx)
 	assert.NilError(t, err)
 
-	err = tokenStore.ProcessPayment(ctx, *paymentTx, invoice)
+	payment, settled, err := tokenStore.ProcessPayment(ctx, *paymentTx, invoice)
 	assert.NilError(t, err)
+	assert.Assert(t, settled)
+	assert.Equal(t, value, payment.AppliedKoinu)
+	assert.Equal(t, int64(0), payment.OverpaidKoinu)
 
 	row := tokenStore.DB.QueryRowContext(ctx, "SELECT paid_at FROM invoices WHERE hash = $1", invoiceHash)
 	var paidAt sql.NullTime
@@ -235,7 +239,7 @@ func TestMatchPaymentValueMismatch(t *testing.T) {
 		MintHash:       mintHash,
 		BuyerAddress:   buyerAddress,
 		Quantity:       50,
-		Price:          100,
+		Price:          100 * doge.KoinuPerDoge,
 		CreatedAt:      time.Now(),
 		SellerAddress:  sellerAddress,
 	}
@@ -259,7 +263,7 @@ func TestMatchPaymentValueMismatch(t *testing.T) {
 	assert.Assert(t, paymentTx != nil)
 
 	_, err = tokenStore.MatchPayment(ctx, *paymentTx)
-	assert.ErrorContains(t, err, "payment value is not equal to buy offer value")
+	assert.ErrorContains(t, err, "does not pay "+sellerAddress)
 }
 
 func TestMatchPaymentPendingBalanceMismatch(t *testing.T) {
@@ -278,7 +282,7 @@ func TestMatchPaymentPendingBalanceMismatch(t *testing.T) {
 		BuyerAddress:   buyerAddress,
 		MintHash:       mintHash,
 		Quantity:       50, // Expected quantity
-		Price:          100,
+		Price:          100 * doge.KoinuPerDoge,
 		CreatedAt:      time.Now(),
 		SellerAddress:  sellerAddress,
 	}
@@ -296,7 +300,7 @@ func TestMatchPaymentPendingBalanceMismatch(t *testing.T) {
 	encodedPaymentMsg, _ := proto.Marshal(paymentMsg)
 
 	paymentTxId, err := tokenStore.SaveOnChainTransaction(ctx, "paymentTx", 1, "blockHash", 1, protocol.ACTION_PAYMENT, protocol.DEFAULT_VERSION, encodedPaymentMsg, buyerAddress, map[string]interface{}{
-		sellerAddress: 5000,
+		sellerAddress: 5000 * doge.KoinuPerDoge,
 	})
 	assert.NilError(t, err)
 
@@ -308,7 +312,7 @@ func TestMatchPaymentPendingBalanceMismatch(t *testing.T) {
 	invoice, err := tokenStore.MatchPayment(ctx, *paymentTx)
 	assert.NilError(t, err)
 
-	err = tokenStore.ProcessPayment(ctx, *paymentTx, invoice)
+	_, _, err = tokenStore.ProcessPayment(ctx, *paymentTx, invoice)
 	assert.ErrorContains(t, err, "no pending token balance found")
 }
 
@@ -327,7 +331,7 @@ func TestMatchPaymentNoPendingBalance(t *testing.T) {
 		BuyerAddress:   buyerAddress,
 		MintHash:       "mint123",
 		Quantity:       50,
-		Price:          100,
+		Price:          100 * doge.KoinuPerDoge,
 		CreatedAt:      time.Now(),
 		SellerAddress:  sellerAddress,
 	}
@@ -354,7 +358,7 @@ func TestMatchPaymentNoPendingBalance(t *testing.T) {
 	inv, err := tokenStore.MatchPayment(ctx, *paymentTx)
 	assert.Assert(t, err != nil, "Should fail without pending balance")
 
-	err = tokenStore.ProcessPayment(ctx, *paymentTx, inv)
+	_, _, err = tokenStore.ProcessPayment(ctx, *paymentTx, inv)
 	assert.Assert(t, err != nil, "Should fail without pending balance")
 
 	var paidAt sql.NullTime
@@ -363,3 +367,252 @@ func TestMatchPaymentNoPendingBalance(t *testing.T) {
 	assert.NilError(t, err)
 	assert.Assert(t, !paidAt.Valid, "Invoice should NOT be paid due to rollback")
 }
+
+// setupPayableInvoice confirms a mint owned by the seller and an invoice for part of it
+func setupPayableInvoice(t *testing.T, tokenStore *store.TokenisationStore, quantity int, price int64) (store.Invoice, string, string) {
+	return setupPayableInvoiceWith(t, tokenStore, quantity, price, func(invoice *store.Invoice) {})
+}
+
+func setupPayableInvoiceWith(t *testing.T, tokenStore *store.TokenisationStore, quantity int, price int64, configure func(invoice *store.Invoice)) (store.Invoice, string, string) {
+	ctx := context.Background()
+
+	mintHash := test_support.GenerateRandomHash()
+	invoiceHash := test_support.GenerateRandomHash()
+	sellerAddress := test_support.GenerateDogecoinAddress(true)
+	buyerAddress := test_support.GenerateDogecoinAddress(true)
+
+	_, err := tokenStore.SaveUnconfirmedMint(ctx, &store.MintWithoutID{
+		Hash:            mintHash,
+		Title:           "Test Mint",
+		FractionCount:   100,
+		BlockHeight:     1,
+		TransactionHash: "mintTx",
+	})
+	assert.NilError(t, err)
+
+	mintMsg, _ := proto.Marshal(&protocol.OnChainMintMessage{Hash: mintHash})
+	mintTxId, err := tokenStore.SaveOnChainTransaction(ctx, "mintTx", 1, "blockHash", 1, protocol.ACTION_MINT, protocol.DEFAULT_VERSION, mintMsg, sellerAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.NilError(t, tokenStore.MatchUnconfirmedMint(ctx, *findTransactionById(txs, mintTxId)))
+
+	invoice := store.Invoice{
+		Hash:           invoiceHash,
+		PaymentAddress: sellerAddress,
+		BuyerAddress:   buyerAddress,
+		MintHash:       mintHash,
+		Quantity:       quantity,
+		Price:          price,
+		CreatedAt:      time.Now(),
+		SellerAddress:  sellerAddress,
+	}
+	configure(&invoice)
+	invoice.Id, err = tokenStore.SaveInvoice(ctx, &invoice)
+	assert.NilError(t, err)
+	assert.NilError(t, tokenStore.UpsertPendingTokenBalance(ctx, invoiceHash, mintHash, quantity, "invoiceTxId", sellerAddress))
+
+	return invoice, sellerAddress, buyerAddress
+}
+
+func payInvoice(t *testing.T, tokenStore *store.TokenisationStore, invoice store.Invoice, txHash string, height int64, payerAddress string, koinu int64) (store.InvoicePayment, bool) {
+	return payInvoiceOutputs(t, tokenStore, invoice, txHash, height, payerAddress, map[string]interface{}{
+		invoice.SellerAddress: koinu,
+	})
+}
+
+func savePayment(t *testing.T, tokenStore *store.TokenisationStore, invoice store.Invoice, txHash string, height int64, payerAddress string, outputs map[string]interface{}) store.OnChainTransaction {
+	ctx := context.Background()
+
+	paymentMsg, _ := proto.Marshal(&protocol.OnChainPaymentMessage{Hash: invoice.Hash})
+	paymentTxId, err := tokenStore.SaveOnChainTransaction(ctx, txHash, height, "blockHash", 1, protocol.ACTION_PAYMENT, protocol.DEFAULT_VERSION, paymentMsg, payerAddress, outputs)
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	paymentTx := findTransactionById(txs, paymentTxId)
+	assert.Assert(t, paymentTx != nil)
+
+	return *paymentTx
+}
+
+func payInvoiceOutputs(t *testing.T, tokenStore *store.TokenisationStore, invoice store.Invoice, txHash string, height int64, payerAddress string, outputs map[string]interface{}) (store.InvoicePayment, bool) {
+	ctx := context.Background()
+
+	paymentTx := savePayment(t, tokenStore, invoice, txHash, height, payerAddress, outputs)
+
+	matched, err := tokenStore.MatchPayment(ctx, paymentTx)
+	assert.NilError(t, err)
+
+	payment, settled, err := tokenStore.ProcessPayment(ctx, paymentTx, matched)
+	assert.NilError(t, err)
+
+	return payment, settled
+}
+
+func TestProcessPaymentAccumulatesPartialPayments(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	invoice, _, buyerAddress := setupPayableInvoice(t, tokenStore, 10, 3*doge.KoinuPerDoge)
+	assert.Equal(t, int64(30)*doge.KoinuPerDoge, invoice.AmountDueKoinu())
+
+	// Partial payments are exact to the koinu
+	payment, settled := payInvoice(t, tokenStore, invoice, "paymentTx1", 3, buyerAddress, 10*doge.KoinuPerDoge+1)
+	assert.Assert(t, !settled)
+	assert.Equal(t, 10*doge.KoinuPerDoge+1, payment.AppliedKoinu)
+
+	paid, overpaid, err := tokenStore.GetInvoicePaidKoinu(ctx, invoice.Hash, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, 10*doge.KoinuPerDoge+1, paid)
+	assert.Equal(t, int64(0), overpaid)
+	assert.Equal(t, 0, countRows(t, tokenStore, "SELECT COUNT(*) FROM invoices WHERE hash = $1 AND paid_at IS NOT NULL", invoice.Hash))
+
+	_, settled = payInvoice(t, tokenStore, invoice, "paymentTx2", 4, buyerAddress, 10*doge.KoinuPerDoge)
+	assert.Assert(t, !settled)
+
+	payment, settled = payInvoice(t, tokenStore, invoice, "paymentTx3", 5, buyerAddress, 10*doge.KoinuPerDoge-1)
+	assert.Assert(t, settled)
+	assert.Equal(t, int64(0), payment.OverpaidKoinu)
+
+	assert.Equal(t, 1, countRows(t, tokenStore, "SELECT COUNT(*) FROM invoices WHERE hash = $1 AND paid_transaction_hash = 'paymentTx3'", invoice.Hash))
+	assert.Equal(t, 10, sumBalances(t, tokenStore, buyerAddress, invoice.MintHash))
+
+	payments, err := tokenStore.GetInvoicePayments(ctx, invoice.Hash)
+	assert.NilError(t, err)
+	assert.Equal(t, 3, len(payments))
+	assert.Equal(t, "paymentTx1", payments[0].TransactionHash)
+	assert.Equal(t, buyerAddress, payments[0].PayerAddress)
+}
+
+func TestProcessPaymentRecordsOverpayments(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	invoice, _, buyerAddress := setupPayableInvoice(t, tokenStore, 5, 2*doge.KoinuPerDoge)
+
+	// Overpaying settles the invoice and records the excess
+	payment, settled := payInvoice(t, tokenStore, invoice, "paymentTx1", 3, buyerAddress, 12*doge.KoinuPerDoge)
+	assert.Assert(t, settled)
+	assert.Equal(t, 10*doge.KoinuPerDoge, payment.AppliedKoinu)
+	assert.Equal(t, 2*doge.KoinuPerDoge, payment.OverpaidKoinu)
+	assert.Equal(t, 5, sumBalances(t, tokenStore, buyerAddress, invoice.MintHash))
+
+	// Paying a settled invoice again is all overpayment and moves no fractions
+	payment, settled = payInvoice(t, tokenStore, invoice, "paymentTx2", 4, buyerAddress, 7)
+	assert.Assert(t, !settled)
+	assert.Equal(t, int64(0), payment.AppliedKoinu)
+	assert.Equal(t, int64(7), payment.OverpaidKoinu)
+	assert.Equal(t, 5, sumBalances(t, tokenStore, buyerAddress, invoice.MintHash))
+
+	paid, overpaid, err := tokenStore.GetInvoicePaidKoinu(ctx, invoice.Hash, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, 10*doge.KoinuPerDoge, paid)
+	assert.Equal(t, 2*doge.KoinuPerDoge+7, overpaid)
+
+	overpayments, err := tokenStore.GetOverpayments(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(overpayments))
+	assert.Equal(t, "paymentTx2", overpayments[0].TransactionHash)
+	assert.Equal(t, buyerAddress, overpayments[0].PayerAddress)
+}
+
+func TestMatchPaymentHonoursPaymentAddress(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	paymentAddress := test_support.GenerateDogecoinAddress(true)
+	invoice, sellerAddress, buyerAddress := setupPayableInvoiceWith(t, tokenStore, 4, 5*doge.KoinuPerDoge, func(invoice *store.Invoice) {
+		invoice.PaymentAddress = paymentAddress
+	})
+
+	// Paying the seller instead of the declared payment address does not count
+	paymentTx := savePayment(t, tokenStore, invoice, "paymentTx1", 3, buyerAddress, map[string]interface{}{
+		sellerAddress: invoice.AmountDueKoinu(),
+	})
+	_, err := tokenStore.MatchPayment(ctx, paymentTx)
+	assert.ErrorContains(t, err, "does not pay "+paymentAddress)
+
+	payment, settled := payInvoiceOutputs(t, tokenStore, invoice, "paymentTx2", 4, buyerAddress, map[string]interface{}{
+		paymentAddress: invoice.AmountDueKoinu(),
+	})
+	assert.Assert(t, settled)
+	assert.Equal(t, invoice.AmountDueKoinu(), payment.AppliedKoinu)
+	assert.Equal(t, 4, sumBalances(t, tokenStore, buyerAddress, invoice.MintHash))
+}
+
+func TestProcessPaymentRequiresEverySplit(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	royaltyAddress := test_support.GenerateDogecoinAddress(true)
+	platformAddress := test_support.GenerateDogecoinAddress(true)
+	invoice, sellerAddress, buyerAddress := setupPayableInvoiceWith(t, tokenStore, 10, 10*doge.KoinuPerDoge, func(invoice *store.Invoice) {
+		invoice.Splits = store.InvoiceSplits{
+			{Address: invoice.SellerAddress, BasisPoints: 9500},
+			{Address: royaltyAddress, BasisPoints: 300},
+			{Address: platformAddress, BasisPoints: 200},
+		}
+	})
+
+	stored, err := tokenStore.GetInvoiceByHash(ctx, invoice.Hash)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, invoice.Splits, stored.Splits)
+
+	amounts := invoice.SplitAmountsKoinu()
+	assert.Equal(t, 95*doge.KoinuPerDoge, amounts[sellerAddress])
+	assert.Equal(t, 3*doge.KoinuPerDoge, amounts[royaltyAddress])
+	assert.Equal(t, 2*doge.KoinuPerDoge, amounts[platformAddress])
+
+	// A payment without the platform fee output is not valid
+	paymentTx := savePayment(t, tokenStore, invoice, "paymentTx1", 3, buyerAddress, map[string]interface{}{
+		sellerAddress:  amounts[sellerAddress],
+		royaltyAddress: amounts[royaltyAddress],
+	})
+	_, err = tokenStore.MatchPayment(ctx, paymentTx)
+	assert.ErrorContains(t, err, "does not pay "+platformAddress)
+
+	// Only what every recipient was paid its share of counts, the rest is overpaid
+	payment, settled := payInvoiceOutputs(t, tokenStore, invoice, "paymentTx2", 4, buyerAddress, map[string]interface{}{
+		sellerAddress:   amounts[sellerAddress],
+		royaltyAddress:  amounts[royaltyAddress] / 2,
+		platformAddress: amounts[platformAddress],
+	})
+	assert.Assert(t, !settled)
+	assert.Equal(t, invoice.AmountDueKoinu()/2, payment.AppliedKoinu)
+	assert.Equal(t, payment.AmountKoinu-payment.AppliedKoinu, payment.OverpaidKoinu)
+
+	payment, settled = payInvoiceOutputs(t, tokenStore, invoice, "paymentTx3", 5, buyerAddress, map[string]interface{}{
+		sellerAddress:   amounts[sellerAddress] / 2,
+		royaltyAddress:  amounts[royaltyAddress] / 2,
+		platformAddress: amounts[platformAddress] / 2,
+	})
+	assert.Assert(t, settled)
+	assert.Equal(t, int64(0), payment.OverpaidKoinu)
+	assert.Equal(t, 10, sumBalances(t, tokenStore, buyerAddress, invoice.MintHash))
+}
+
+func TestKoinuValuesSurviveStorage(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	// Larger than float64 can hold exactly
+	koinu := int64(9_007_199_254_740_993)
+	_, err := tokenStore.SaveOnChainTransaction(ctx, "bigTx", 1, "blockHash", 1, protocol.ACTION_PAYMENT, protocol.DEFAULT_VERSION, []byte{}, "payer", map[string]interface{}{
+		"seller": koinu,
+	})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(txs))
+
+	value, err := txs[0].Values.Koinu("seller")
+	assert.NilError(t, err)
+	assert.Equal(t, koinu, value)
+
+	value, err = txs[0].Values.Koinu("someone else")
+	assert.NilError(t, err)
+	assert.Equal(t, int64(0), value)
+}
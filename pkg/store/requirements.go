@@ -0,0 +1,126 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrMintRequirementsNotMet is wrapped by every rule violation so that callers can
+// tell an ineligible holder apart from a failure to evaluate the rules.
+var ErrMintRequirementsNotMet = errors.New("mint requirements not met")
+
+// MintRequirements is the typed form of a mint's requirements, e.g.
+// {"allow_list": ["D..."], "max_holding": 100, "attestation_required": true}.
+// Every rule that is set must pass for an address to receive fractions.
+// MinConfirmations raises the confirmation depth of every action on the mint.
+type MintRequirements struct {
+	AllowList           []string `json:"allow_list,omitempty"`
+	DenyList            []string `json:"deny_list,omitempty"`
+	MaxHolding          int      `json:"max_holding,omitempty"`
+	AttestationRequired bool     `json:"attestation_required,omitempty"`
+	MinConfirmations    int      `json:"min_confirmations,omitempty"`
+}
+
+// ParseMintRequirements decodes the requirements of a mint. An empty map means the
+// mint is unrestricted and returns nil.
+func ParseMintRequirements(requirements StringInterfaceMap) (*MintRequirements, error) {
+	if len(requirements) == 0 {
+		return nil, nil
+	}
+
+	requirementsBytes, err := json.Marshal(requirements)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(requirementsBytes))
+	decoder.DisallowUnknownFields()
+
+	var mintRequirements MintRequirements
+	if err := decoder.Decode(&mintRequirements); err != nil {
+		return nil, fmt.Errorf("invalid requirements: %w", err)
+	}
+
+	if err := mintRequirements.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &mintRequirements, nil
+}
+
+func (r *MintRequirements) Validate() error {
+	if r.MaxHolding < 0 {
+		return fmt.Errorf("max_holding must not be negative")
+	}
+
+	if r.MinConfirmations < 0 {
+		return fmt.Errorf("min_confirmations must not be negative")
+	}
+
+	for _, address := range r.DenyList {
+		if slices.Contains(r.AllowList, address) {
+			return fmt.Errorf("address is on both the allow and deny list: %s", address)
+		}
+	}
+
+	return nil
+}
+
+// CheckMintRequirements evaluates the mint's requirements for an address that is about
+// to receive the given quantity. Rule violations wrap ErrMintRequirementsNotMet, as do
+// requirements that cannot be parsed, so that a malformed rule never admits a holder.
+func (s *TokenisationStore) CheckMintRequirements(ctx context.Context, mint Mint, address string, quantity int, tx *sql.Tx) error {
+	requirements, err := ParseMintRequirements(mint.Requirements)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrMintRequirementsNotMet, err)
+	}
+
+	if requirements == nil {
+		return nil
+	}
+
+	if slices.Contains(requirements.DenyList, address) {
+		return fmt.Errorf("%w: address %s is on the deny list", ErrMintRequirementsNotMet, address)
+	}
+
+	if len(requirements.AllowList) > 0 && !slices.Contains(requirements.AllowList, address) {
+		return fmt.Errorf("%w: address %s is not on the allow list", ErrMintRequirementsNotMet, address)
+	}
+
+	if requirements.MaxHolding > 0 {
+		holding, err := s.GetLedgerBalance(ctx, address, mint.Hash, tx)
+		if err != nil {
+			return err
+		}
+
+		if holding+quantity > requirements.MaxHolding {
+			return fmt.Errorf("%w: holding of %d would exceed the maximum of %d", ErrMintRequirementsNotMet, holding+quantity, requirements.MaxHolding)
+		}
+	}
+
+	if requirements.AttestationRequired {
+		attestations, err := s.GetAttestations(ctx, mint.Hash, address, tx)
+		if err != nil {
+			return err
+		}
+
+		attested := false
+		for _, attestation := range attestations {
+			if attestation.Validate(mint) == nil {
+				attested = true
+				break
+			}
+		}
+
+		if !attested {
+			return fmt.Errorf("%w: address %s has no attestation from an asset manager", ErrMintRequirementsNotMet, address)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package store_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func TestParseMintRequirements(t *testing.T) {
+	requirements, err := store.ParseMintRequirements(store.StringInterfaceMap{})
+	assert.NilError(t, err)
+	assert.Assert(t, requirements == nil)
+
+	requirements, err = store.ParseMintRequirements(store.StringInterfaceMap{
+		"allow_list":           []interface{}{"address1"},
+		"max_holding":          float64(10),
+		"attestation_required": true,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"address1"}, requirements.AllowList)
+	assert.Equal(t, 10, requirements.MaxHolding)
+	assert.Assert(t, requirements.AttestationRequired)
+
+	_, err = store.ParseMintRequirements(store.StringInterfaceMap{"req": "value"})
+	assert.ErrorContains(t, err, "invalid requirements")
+
+	_, err = store.ParseMintRequirements(store.StringInterfaceMap{
+		"allow_list": []interface{}{"address1"},
+		"deny_list":  []interface{}{"address1"},
+	})
+	assert.ErrorContains(t, err, "address is on both the allow and deny list")
+}
+
+func TestCheckMintRequirementsLists(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mint := store.Mint{MintWithoutID: store.MintWithoutID{
+		Hash: test_support.GenerateRandomHash(),
+		Requirements: store.StringInterfaceMap{
+			"allow_list":  []interface{}{"allowed", "holder"},
+			"deny_list":   []interface{}{"denied"},
+			"max_holding": float64(50),
+		},
+	}}
+
+	assert.NilError(t, tokenStore.CheckMintRequirements(ctx, mint, "allowed", 50, nil))
+
+	err := tokenStore.CheckMintRequirements(ctx, mint, "denied", 1, nil)
+	assert.Assert(t, errors.Is(err, store.ErrMintRequirementsNotMet))
+	assert.ErrorContains(t, err, "deny list")
+
+	err = tokenStore.CheckMintRequirements(ctx, mint, "stranger", 1, nil)
+	assert.Assert(t, errors.Is(err, store.ErrMintRequirementsNotMet))
+	assert.ErrorContains(t, err, "not on the allow list")
+
+	// An existing holding counts towards the maximum
+	assert.NilError(t, tokenStore.UpsertTokenBalance(ctx, "holder", mint.Hash, 40))
+	assert.NilError(t, tokenStore.CheckMintRequirements(ctx, mint, "holder", 10, nil))
+
+	err = tokenStore.CheckMintRequirements(ctx, mint, "holder", 11, nil)
+	assert.Assert(t, errors.Is(err, store.ErrMintRequirementsNotMet))
+	assert.ErrorContains(t, err, "would exceed the maximum of 50")
+}
+
+func TestCheckMintRequirementsInvalid(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mint := store.Mint{MintWithoutID: store.MintWithoutID{
+		Hash:         test_support.GenerateRandomHash(),
+		Requirements: store.StringInterfaceMap{"req": "value"},
+	}}
+
+	// Requirements that cannot be parsed admit nobody
+	err := tokenStore.CheckMintRequirements(ctx, mint, "anyone", 1, nil)
+	assert.Assert(t, errors.Is(err, store.ErrMintRequirementsNotMet))
+	assert.ErrorContains(t, err, "invalid requirements")
+}
+
+func TestCheckMintRequirementsAttestation(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	privHex, pubHex, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	otherPrivHex, otherPubHex, _, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	buyerAddress := test_support.GenerateDogecoinAddress(true)
+	mint := store.Mint{MintWithoutID: store.MintWithoutID{
+		Hash:          test_support.GenerateRandomHash(),
+		Requirements:  store.StringInterfaceMap{"attestation_required": true},
+		AssetManagers: store.AssetManagers{{Name: "KYC Provider", PublicKey: pubHex}},
+	}}
+
+	err = tokenStore.CheckMintRequirements(ctx, mint, buyerAddress, 1, nil)
+	assert.Assert(t, errors.Is(err, store.ErrMintRequirementsNotMet))
+	assert.ErrorContains(t, err, "no attestation from an asset manager")
+
+	body := store.AttestationBody{MintHash: mint.Hash, Address: buyerAddress}
+
+	// An attestation from a key that is not an asset manager is not accepted
+	otherSignature, err := doge.SignPayload(body, otherPrivHex, otherPubHex)
+	assert.NilError(t, err)
+	otherAttestation := &store.Attestation{MintHash: mint.Hash, Address: buyerAddress, PublicKey: otherPubHex, Signature: otherSignature, CreatedAt: time.Now()}
+	assert.ErrorContains(t, otherAttestation.Validate(mint), "public key does not match any asset managers")
+
+	signature, err := doge.SignPayload(body, privHex, pubHex)
+	assert.NilError(t, err)
+	attestation := &store.Attestation{MintHash: mint.Hash, Address: buyerAddress, PublicKey: pubHex, Signature: signature, CreatedAt: time.Now()}
+	assert.NilError(t, attestation.Validate(mint))
+
+	_, err = tokenStore.SaveAttestation(ctx, attestation)
+	assert.NilError(t, err)
+
+	assert.NilError(t, tokenStore.CheckMintRequirements(ctx, mint, buyerAddress, 1, nil))
+}
@@ -0,0 +1,299 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+type paidInvoiceAtBlock struct {
+	hash                string
+	mintHash            string
+	quantity            int
+	sellerAddress       string
+	transactionHash     sql.NullString
+	paidTransactionHash sql.NullString
+	blockHeight         int64
+	blockHash           sql.NullString
+}
+
+// RollbackToHeight reverts every chain-derived state change recorded above the given
+// height. Payments are unwound back into pending balances and their payment records
+// removed, mint amendments, ownership transfers, token transfers and offer and
+// invoice cancellations are undone, invoices and mints confirmed above the fork point are
+// returned to their unconfirmed tables so that they can be re-matched when the new
+// chain is replayed, and unprocessed on-chain transactions from the orphaned blocks
+// are discarded. All changes are applied in a single transaction.
+func (s *TokenisationStore) RollbackToHeight(ctx context.Context, height int64) error {
+	log.Println("Rolling back state to height:", height)
+
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	// Payments confirmed above the fork point for invoices that remain confirmed
+	rows, err := tx.QueryContext(ctx, `
+	SELECT hash, mint_hash, quantity, seller_address, transaction_hash, paid_transaction_hash, block_height, block_hash
+	FROM invoices WHERE paid_block_height > $1 AND block_height <= $1
+	`, height)
+	if err != nil {
+		return err
+	}
+
+	var paidInvoices []paidInvoiceAtBlock
+	for rows.Next() {
+		var invoice paidInvoiceAtBlock
+		if err := rows.Scan(&invoice.hash, &invoice.mintHash, &invoice.quantity, &invoice.sellerAddress, &invoice.transactionHash, &invoice.paidTransactionHash, &invoice.blockHeight, &invoice.blockHash); err != nil {
+			rows.Close()
+			return err
+		}
+		paidInvoices = append(paidInvoices, invoice)
+	}
+	rows.Close()
+
+	for _, invoice := range paidInvoices {
+		// The payment debited the owner of the pending balance, so recover the owner from that entry
+		ownerAddress := invoice.sellerAddress
+		err := tx.QueryRowContext(ctx, `
+		SELECT account FROM ledger_entries WHERE transaction_hash = $1 AND mint_hash = $2 AND debit > 0
+		`, invoice.paidTransactionHash.String, invoice.mintHash).Scan(&ownerAddress)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+		INSERT INTO pending_token_balances (invoice_hash, mint_hash, quantity, onchain_transaction_id, created_at, owner_address, block_height, block_hash)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (invoice_hash, mint_hash)
+		DO UPDATE SET quantity = $3
+		`, invoice.hash, invoice.mintHash, invoice.quantity, invoice.transactionHash.String, time.Now(), ownerAddress, invoice.blockHeight, invoice.blockHash.String)
+		if err != nil {
+			log.Println("Error restoring pending token balance:", err)
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE invoices SET paid_at = NULL, paid_block_height = NULL, paid_block_hash = NULL, paid_transaction_hash = NULL, status = $1
+	WHERE paid_block_height > $2
+	`, InvoiceStatusOnChain, height)
+	if err != nil {
+		log.Println("Error reverting paid invoices:", err)
+		return err
+	}
+
+	// Invoices expired or cancelled above the fork point are reopened with their reservation
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO pending_token_balances (invoice_hash, mint_hash, quantity, onchain_transaction_id, created_at, owner_address, block_height, block_hash)
+	SELECT hash, mint_hash, quantity, COALESCE(transaction_hash, ''), CURRENT_TIMESTAMP, seller_address, block_height, COALESCE(block_hash, '')
+	FROM invoices WHERE closed_block_height > $1 AND block_height <= $1
+	ON CONFLICT (invoice_hash, mint_hash) DO NOTHING
+	`, height)
+	if err != nil {
+		log.Println("Error restoring pending token balances of closed invoices:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE invoices SET status = $1, closed_block_height = NULL, closed_transaction_hash = NULL
+	WHERE closed_block_height > $2
+	`, InvoiceStatusOnChain, height)
+	if err != nil {
+		log.Println("Error reopening closed invoices:", err)
+		return err
+	}
+
+	// Pending invoices cancelled above the fork point get their reservation and on-chain invoice back
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO pending_token_balances (invoice_hash, mint_hash, quantity, onchain_transaction_id, created_at, owner_address, block_height, block_hash)
+	SELECT invoice_hash, mint_hash, quantity, onchain_transaction_id, pending_created_at, owner_address, pending_block_height, pending_block_hash
+	FROM cancelled_pending_invoices WHERE cancelled_block_height > $1 AND block_height <= $1
+	ON CONFLICT (invoice_hash, mint_hash) DO NOTHING
+	`, height)
+	if err != nil {
+		log.Println("Error restoring pending token balances of cancelled pending invoices:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO onchain_transactions (id, tx_hash, block_height, block_hash, transaction_number, action_type, action_version, action_data, address, "values", block_time, sub_index, batch_atomic, values_version, created_at)
+	SELECT onchain_transaction_id, tx_hash, block_height, block_hash, transaction_number, action_type, action_version, action_data, address, "values", block_time, sub_index, batch_atomic, values_version, onchain_created_at
+	FROM cancelled_pending_invoices WHERE cancelled_block_height > $1 AND block_height <= $1
+	ON CONFLICT (id) DO NOTHING
+	`, height)
+	if err != nil {
+		log.Println("Error restoring onchain transactions of cancelled pending invoices:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM cancelled_pending_invoices WHERE cancelled_block_height > $1", height)
+	if err != nil {
+		log.Println("Error deleting cancelled pending invoices:", err)
+		return err
+	}
+
+	// Invoices confirmed above the fork point go back to unconfirmed
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO unconfirmed_invoices (id, hash, payment_address, buyer_address, mint_hash, quantity, price, doge_price, created_at, seller_address, public_key, signature, status, splits, expires_at_height)
+	SELECT id, hash, COALESCE(payment_address, ''), buyer_address, mint_hash, quantity, price, doge_price, created_at, seller_address, public_key, signature, 'draft', splits, expires_at_height
+	FROM invoices WHERE block_height > $1
+	`, height)
+	if err != nil {
+		log.Println("Error restoring unconfirmed invoices:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM invoices WHERE block_height > $1", height)
+	if err != nil {
+		log.Println("Error deleting invoices:", err)
+		return err
+	}
+
+	// Gossiped invoices go back to the status they had before their first transition above the fork point
+	_, err = tx.ExecContext(ctx, `
+	UPDATE unconfirmed_invoices SET status = (
+		SELECT h.from_status FROM invoice_history h
+		WHERE h.invoice_hash = unconfirmed_invoices.hash AND h.block_height > $1
+		ORDER BY h.created_at ASC LIMIT 1
+	)
+	WHERE hash IN (SELECT invoice_hash FROM invoice_history WHERE block_height > $2)
+	`, height, height)
+	if err != nil {
+		log.Println("Error restoring unconfirmed invoice statuses:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM invoice_history WHERE block_height > $1", height)
+	if err != nil {
+		log.Println("Error deleting invoice history:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM invoice_payments WHERE block_height > $1", height)
+	if err != nil {
+		log.Println("Error deleting invoice payments:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM distribution_payments WHERE block_height > $1", height)
+	if err != nil {
+		log.Println("Error deleting distribution payments:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM pending_token_balances WHERE block_height > $1", height)
+	if err != nil {
+		log.Println("Error deleting pending token balances:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM burns WHERE block_height > $1", height)
+	if err != nil {
+		log.Println("Error deleting burns:", err)
+		return err
+	}
+
+	// Offers cancelled on chain above the fork point are listed again
+	_, err = tx.ExecContext(ctx, "UPDATE buy_offers SET deleted_block_height = NULL WHERE deleted_block_height > $1", height)
+	if err != nil {
+		log.Println("Error restoring buy offers:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE sell_offers SET deleted_block_height = NULL WHERE deleted_block_height > $1", height)
+	if err != nil {
+		log.Println("Error restoring sell offers:", err)
+		return err
+	}
+
+	err = rollbackLedgerToHeight(ctx, tx.Tx, height)
+	if err != nil {
+		return err
+	}
+
+	// Amended mints go back to the first version that was superseded above the fork point
+	_, err = tx.ExecContext(ctx, `
+	UPDATE mints SET
+		description = (SELECT v.description FROM mint_versions v WHERE v.mint_hash = mints.hash AND v.superseded_block_height > $1 ORDER BY v.version ASC LIMIT 1),
+		metadata = (SELECT v.metadata FROM mint_versions v WHERE v.mint_hash = mints.hash AND v.superseded_block_height > $2 ORDER BY v.version ASC LIMIT 1),
+		feed_url = (SELECT v.feed_url FROM mint_versions v WHERE v.mint_hash = mints.hash AND v.superseded_block_height > $3 ORDER BY v.version ASC LIMIT 1),
+		contract_of_sale = (SELECT v.contract_of_sale FROM mint_versions v WHERE v.mint_hash = mints.hash AND v.superseded_block_height > $4 ORDER BY v.version ASC LIMIT 1)
+	WHERE hash IN (SELECT mint_hash FROM mint_versions WHERE superseded_block_height > $5)
+	`, height, height, height, height, height)
+	if err != nil {
+		log.Println("Error restoring amended mints:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM mint_versions WHERE superseded_block_height > $1", height)
+	if err != nil {
+		log.Println("Error deleting mint versions:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE mint_amendments SET version = NULL, transaction_hash = NULL, block_height = NULL, block_hash = NULL WHERE block_height > $1", height)
+	if err != nil {
+		log.Println("Error reverting mint amendments:", err)
+		return err
+	}
+
+	// Transferred mints go back to the owner they had before their first transfer above the fork point
+	_, err = tx.ExecContext(ctx, `
+	UPDATE mints SET
+		owner_address = (SELECT t.previous_owner_address FROM mint_ownership_transfers t WHERE t.mint_hash = mints.hash AND t.block_height > $1 ORDER BY t.block_height ASC LIMIT 1),
+		public_key = (SELECT t.previous_public_key FROM mint_ownership_transfers t WHERE t.mint_hash = mints.hash AND t.block_height > $2 ORDER BY t.block_height ASC LIMIT 1),
+		signature_requirement_type = (SELECT t.previous_signature_requirement_type FROM mint_ownership_transfers t WHERE t.mint_hash = mints.hash AND t.block_height > $3 ORDER BY t.block_height ASC LIMIT 1),
+		asset_managers = (SELECT t.previous_asset_managers FROM mint_ownership_transfers t WHERE t.mint_hash = mints.hash AND t.block_height > $4 ORDER BY t.block_height ASC LIMIT 1),
+		min_signatures = (SELECT t.previous_min_signatures FROM mint_ownership_transfers t WHERE t.mint_hash = mints.hash AND t.block_height > $5 ORDER BY t.block_height ASC LIMIT 1)
+	WHERE hash IN (SELECT mint_hash FROM mint_ownership_transfers WHERE block_height > $6)
+	`, height, height, height, height, height, height)
+	if err != nil {
+		log.Println("Error restoring transferred mints:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE mint_ownership_transfers SET previous_owner_address = NULL, previous_public_key = NULL, previous_signature_requirement_type = NULL, previous_asset_managers = NULL, previous_min_signatures = NULL, transaction_hash = NULL, block_height = NULL, block_hash = NULL
+	WHERE block_height > $1
+	`, height)
+	if err != nil {
+		log.Println("Error reverting mint ownership transfers:", err)
+		return err
+	}
+
+	// Token transfers applied above the fork point can be applied again by the replayed chain
+	_, err = tx.ExecContext(ctx, "UPDATE token_transfers SET transaction_hash = NULL, block_height = NULL, block_hash = NULL WHERE block_height > $1", height)
+	if err != nil {
+		log.Println("Error reverting token transfers:", err)
+		return err
+	}
+
+	// Mints confirmed above the fork point go back to unconfirmed, without the transaction they were confirmed by
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO unconfirmed_mints (id, title, description, fraction_count, tags, metadata, hash, requirements, lockup_options, feed_url, public_key, owner_address, transaction_hash, contract_of_sale, signature_requirement_type, asset_managers, min_signatures, created_at)
+	SELECT id, title, description, fraction_count, tags, metadata, hash, requirements, lockup_options, feed_url, public_key, owner_address, '', contract_of_sale, signature_requirement_type, asset_managers, min_signatures, created_at
+	FROM mints WHERE block_height > $1
+	`, height)
+	if err != nil {
+		log.Println("Error restoring unconfirmed mints:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM mints WHERE block_height > $1", height)
+	if err != nil {
+		log.Println("Error deleting mints:", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM onchain_transactions WHERE block_height > $1", height)
+	if err != nil {
+		log.Println("Error deleting onchain transactions:", err)
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,223 @@
+package store_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+	"gotest.tools/assert"
+)
+
+func sumBalances(t *testing.T, tokenStore *store.TokenisationStore, address string, mintHash string) int {
+	balances, err := tokenStore.GetTokenBalances(context.Background(), address, mintHash)
+	assert.NilError(t, err)
+
+	total := 0
+	for _, balance := range balances {
+		total += balance.Quantity
+	}
+
+	return total
+}
+
+func countRows(t *testing.T, tokenStore *store.TokenisationStore, query string, args ...interface{}) int {
+	var count int
+	err := tokenStore.DB.QueryRowContext(context.Background(), query, args...).Scan(&count)
+	assert.NilError(t, err)
+	return count
+}
+
+func TestRollbackToHeight(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mintHash := test_support.GenerateRandomHash()
+	invoiceHash := test_support.GenerateRandomHash()
+	sellerAddress := test_support.GenerateDogecoinAddress(true)
+	buyerAddress := test_support.GenerateDogecoinAddress(true)
+	quantity := 40
+
+	// Mint confirmed at height 10
+	_, err := tokenStore.SaveUnconfirmedMint(ctx, &store.MintWithoutID{
+		Hash:          mintHash,
+		Title:         "Test Mint",
+		Description:   "Test Description",
+		FractionCount: 100,
+	})
+	assert.NilError(t, err)
+
+	mintMsg, _ := proto.Marshal(&protocol.OnChainMintMessage{Hash: mintHash})
+	mintTxId, err := tokenStore.SaveOnChainTransaction(ctx, "mintTx", 10, "block10", 0, protocol.ACTION_MINT, protocol.DEFAULT_VERSION, mintMsg, sellerAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.NilError(t, tokenStore.MatchUnconfirmedMint(ctx, *findTransactionById(txs, mintTxId)))
+
+	// Invoice confirmed at height 11
+	_, err = tokenStore.SaveUnconfirmedInvoice(ctx, &store.UnconfirmedInvoice{
+		Hash:           invoiceHash,
+		PaymentAddress: sellerAddress,
+		BuyerAddress:   buyerAddress,
+		MintHash:       mintHash,
+		Quantity:       quantity,
+		Price:          100,
+		CreatedAt:      time.Now(),
+		SellerAddress:  sellerAddress,
+		Status:         "draft",
+	})
+	assert.NilError(t, err)
+
+	invoiceHashBytes, _ := hex.DecodeString(invoiceHash)
+	mintHashBytes, _ := hex.DecodeString(mintHash)
+	invoiceMsg, _ := proto.Marshal(&protocol.OnChainInvoiceMessage{InvoiceHash: invoiceHashBytes, MintHash: mintHashBytes, Quantity: int32(quantity)})
+	invoiceTxId, err := tokenStore.SaveOnChainTransaction(ctx, "invoiceTx", 11, "block11", 0, protocol.ACTION_INVOICE, protocol.DEFAULT_VERSION, invoiceMsg, sellerAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err = tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	invoiceTx := findTransactionById(txs, invoiceTxId)
+	assert.NilError(t, tokenStore.UpsertPendingTokenBalanceAtBlock(ctx, invoiceHash, mintHash, quantity, invoiceTx.Id, sellerAddress, invoiceTx.BlockRef(), nil))
+	assert.NilError(t, tokenStore.MatchUnconfirmedInvoice(ctx, *invoiceTx))
+
+	// Payment confirmed at height 12
+	paymentMsg, _ := proto.Marshal(&protocol.OnChainPaymentMessage{Hash: invoiceHash})
+	paymentTxId, err := tokenStore.SaveOnChainTransaction(ctx, "paymentTx", 12, "block12", 0, protocol.ACTION_PAYMENT, protocol.DEFAULT_VERSION, paymentMsg, buyerAddress, map[string]interface{}{
+		sellerAddress: int64(quantity) * 100 * doge.KoinuPerDoge,
+	})
+	assert.NilError(t, err)
+
+	txs, err = tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	paymentTx := findTransactionById(txs, paymentTxId)
+	invoice, err := tokenStore.MatchPayment(ctx, *paymentTx)
+	assert.NilError(t, err)
+	_, settled, err := tokenStore.ProcessPayment(ctx, *paymentTx, invoice)
+	assert.NilError(t, err)
+	assert.Assert(t, settled)
+
+	// An unprocessed transaction from an orphaned block
+	_, err = tokenStore.SaveOnChainTransaction(ctx, "orphanTx", 13, "block13", 0, protocol.ACTION_MINT, protocol.DEFAULT_VERSION, mintMsg, sellerAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	assert.Equal(t, quantity, sumBalances(t, tokenStore, buyerAddress, mintHash))
+	assert.Equal(t, 60, sumBalances(t, tokenStore, sellerAddress, mintHash))
+
+	// Reorg removes the payment block
+	assert.NilError(t, tokenStore.RollbackToHeight(ctx, 11))
+
+	assert.Equal(t, 0, sumBalances(t, tokenStore, buyerAddress, mintHash))
+	assert.Equal(t, 100, sumBalances(t, tokenStore, sellerAddress, mintHash))
+	assert.Equal(t, 0, countRows(t, tokenStore, "SELECT COUNT(*) FROM onchain_transactions WHERE block_height > 11"))
+	assert.Equal(t, 0, countRows(t, tokenStore, "SELECT COUNT(*) FROM invoice_payments WHERE invoice_hash = $1", invoiceHash))
+
+	var paidAt sql.NullTime
+	err = tokenStore.DB.QueryRowContext(ctx, "SELECT paid_at FROM invoices WHERE hash = $1", invoiceHash).Scan(&paidAt)
+	assert.NilError(t, err)
+	assert.Assert(t, !paidAt.Valid)
+
+	pending, err := tokenStore.GetPendingTokenBalance(ctx, invoiceHash, mintHash, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, quantity, pending.Quantity)
+	assert.Equal(t, sellerAddress, pending.OwnerAddress)
+
+	// Reorg removes the invoice block
+	assert.NilError(t, tokenStore.RollbackToHeight(ctx, 10))
+
+	assert.Equal(t, 0, countRows(t, tokenStore, "SELECT COUNT(*) FROM invoices WHERE hash = $1", invoiceHash))
+	assert.Equal(t, 1, countRows(t, tokenStore, "SELECT COUNT(*) FROM unconfirmed_invoices WHERE hash = $1", invoiceHash))
+	assert.Equal(t, 0, countRows(t, tokenStore, "SELECT COUNT(*) FROM pending_token_balances WHERE invoice_hash = $1", invoiceHash))
+	assert.Equal(t, 100, sumBalances(t, tokenStore, sellerAddress, mintHash))
+
+	// Reorg removes the mint block
+	assert.NilError(t, tokenStore.RollbackToHeight(ctx, 9))
+
+	assert.Equal(t, 0, countRows(t, tokenStore, "SELECT COUNT(*) FROM mints WHERE hash = $1", mintHash))
+	assert.Equal(t, 1, countRows(t, tokenStore, "SELECT COUNT(*) FROM unconfirmed_mints WHERE hash = $1", mintHash))
+	assert.Equal(t, 0, sumBalances(t, tokenStore, sellerAddress, mintHash))
+}
+
+func TestRollbackToHeightKeepsStateBelowForkPoint(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mintHash := test_support.GenerateRandomHash()
+	ownerAddress := test_support.GenerateDogecoinAddress(true)
+
+	_, err := tokenStore.SaveUnconfirmedMint(ctx, &store.MintWithoutID{
+		Hash:          mintHash,
+		Title:         "Test Mint",
+		Description:   "Test Description",
+		FractionCount: 100,
+	})
+	assert.NilError(t, err)
+
+	mintMsg, _ := proto.Marshal(&protocol.OnChainMintMessage{Hash: mintHash})
+	mintTxId, err := tokenStore.SaveOnChainTransaction(ctx, "mintTx", 5, "block5", 0, protocol.ACTION_MINT, protocol.DEFAULT_VERSION, mintMsg, ownerAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.NilError(t, tokenStore.MatchUnconfirmedMint(ctx, *findTransactionById(txs, mintTxId)))
+
+	assert.NilError(t, tokenStore.RollbackToHeight(ctx, 5))
+
+	mint, err := tokenStore.GetMintByHash(ctx, mintHash)
+	assert.NilError(t, err)
+	assert.Equal(t, mintHash, mint.Hash)
+	assert.Equal(t, 100, sumBalances(t, tokenStore, ownerAddress, mintHash))
+}
+
+func TestRollbackToHeightRestoresCancelledOffers(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	offererAddress := test_support.GenerateDogecoinAddress(true)
+	sellerAddress := test_support.GenerateDogecoinAddress(true)
+	mintHash := test_support.GenerateRandomHash()
+
+	offer := &store.BuyOfferWithoutID{
+		OffererAddress: offererAddress,
+		SellerAddress:  sellerAddress,
+		MintHash:       mintHash,
+		Quantity:       10,
+		Price:          100,
+		PublicKey:      "publicKey",
+	}
+	hash, err := offer.GenerateHash()
+	assert.NilError(t, err)
+	offer.Hash = hash
+
+	_, err = tokenStore.SaveBuyOffer(ctx, offer)
+	assert.NilError(t, err)
+
+	envelope := protocol.NewDeleteBuyOfferTransactionEnvelope(hash, protocol.ACTION_DELETE_BUY_OFFER)
+	_, err = tokenStore.SaveOnChainTransaction(ctx, "deleteTx", 5, "blockHash5", 1, protocol.ACTION_DELETE_BUY_OFFER, protocol.DEFAULT_VERSION, envelope.Data, offererAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(ctx, 0, 10)
+	assert.NilError(t, err)
+	assert.NilError(t, tokenStore.MatchDeleteBuyOffer(ctx, txs[0]))
+
+	count, err := tokenStore.CountBuyOffers(ctx, mintHash, offererAddress, sellerAddress)
+	assert.NilError(t, err)
+	assert.Equal(t, count, 0)
+
+	// Rolling back to below the cancellation lists the offer again
+	assert.NilError(t, tokenStore.RollbackToHeight(ctx, 4))
+
+	count, err = tokenStore.CountBuyOffers(ctx, mintHash, offererAddress, sellerAddress)
+	assert.NilError(t, err)
+	assert.Equal(t, count, 1)
+
+	restored, err := tokenStore.GetBuyOfferByHash(ctx, hash)
+	assert.NilError(t, err)
+	assert.Equal(t, restored.Quantity, 10)
+}
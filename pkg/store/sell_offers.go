@@ -3,9 +3,13 @@ package store
 import (
 	"context"
 	"database/sql"
+	"encoding/hex"
+	"fmt"
 	"log"
 
+	"dogecoin.org/fractal-engine/pkg/protocol"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 )
 
 func (s *TokenisationStore) GetSellOffers(ctx context.Context, offset int, limit int, mintHash string, offererAddress string) ([]SellOffer, error) {
@@ -14,9 +18,9 @@ func (s *TokenisationStore) GetSellOffers(ctx context.Context, offset int, limit
 
 	if offererAddress != "" {
 		log.Println("Getting sell offers for mint:", mintHash, "and offerer address:", offererAddress, "with limit:", limit, "and offset:", offset, s)
-		rows, err = s.DB.QueryContext(ctx, "SELECT id, created_at, offerer_address, hash, mint_hash, quantity, price, public_key FROM sell_offers WHERE mint_hash = $1 AND offerer_address = $2 LIMIT $3 OFFSET $4", mintHash, offererAddress, limit, offset)
+		rows, err = s.conn().QueryContext(ctx, "SELECT id, created_at, offerer_address, hash, mint_hash, quantity, price, public_key FROM sell_offers WHERE mint_hash = $1 AND offerer_address = $2 AND quantity > 0 AND delete_pending = FALSE AND deleted_block_height IS NULL LIMIT $3 OFFSET $4", mintHash, offererAddress, limit, offset)
 	} else {
-		rows, err = s.DB.QueryContext(ctx, "SELECT id, created_at, offerer_address, hash, mint_hash, quantity, price, public_key FROM sell_offers WHERE mint_hash = $1 LIMIT $2 OFFSET $3", mintHash, limit, offset)
+		rows, err = s.conn().QueryContext(ctx, "SELECT id, created_at, offerer_address, hash, mint_hash, quantity, price, public_key FROM sell_offers WHERE mint_hash = $1 AND quantity > 0 AND delete_pending = FALSE AND deleted_block_height IS NULL LIMIT $2 OFFSET $3", mintHash, limit, offset)
 	}
 	if err != nil {
 		return nil, err
@@ -42,14 +46,14 @@ func (s *TokenisationStore) GetSellOffers(ctx context.Context, offset int, limit
 }
 
 func (s *TokenisationStore) CountSellOffers(ctx context.Context, mintHash string, offererAddress string) (int, error) {
-	row := s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM sell_offers WHERE mint_hash = $1 AND offerer_address = $2", mintHash, offererAddress)
+	row := s.conn().QueryRowContext(ctx, "SELECT COUNT(*) FROM sell_offers WHERE mint_hash = $1 AND offerer_address = $2 AND quantity > 0 AND delete_pending = FALSE AND deleted_block_height IS NULL", mintHash, offererAddress)
 	var count int
 	err := row.Scan(&count)
 	return count, err
 }
 
 func (s *TokenisationStore) GetSellOffersTotalQuantity(ctx context.Context, mintHash string, offererAddress string) (int, error) {
-	row := s.DB.QueryRowContext(ctx, "SELECT COALESCE(SUM(quantity), 0) FROM sell_offers WHERE mint_hash = $1 AND offerer_address = $2", mintHash, offererAddress)
+	row := s.conn().QueryRowContext(ctx, "SELECT COALESCE(SUM(quantity), 0) FROM sell_offers WHERE mint_hash = $1 AND offerer_address = $2 AND delete_pending = FALSE AND deleted_block_height IS NULL", mintHash, offererAddress)
 	var totalQuantity int
 	err := row.Scan(&totalQuantity)
 	return totalQuantity, err
@@ -71,13 +75,79 @@ func (s *TokenisationStore) SaveSellOfferWithTx(ctx context.Context, d *SellOffe
 	if tx != nil {
 		_, err = tx.ExecContext(ctx, query, id, d.OffererAddress, d.Hash, d.MintHash, d.Quantity, d.Price, d.CreatedAt, d.PublicKey, d.Signature)
 	} else {
-		_, err = s.DB.ExecContext(ctx, query, id, d.OffererAddress, d.Hash, d.MintHash, d.Quantity, d.Price, d.CreatedAt, d.PublicKey, d.Signature)
+		_, err = s.conn().ExecContext(ctx, query, id, d.OffererAddress, d.Hash, d.MintHash, d.Quantity, d.Price, d.CreatedAt, d.PublicKey, d.Signature)
 	}
 
 	return id, err
 }
 
-func (s *TokenisationStore) DeleteSellOffer(ctx context.Context, hash string, publicKey string) error {
-	_, err := s.DB.ExecContext(ctx, "DELETE FROM sell_offers WHERE hash = $1 AND public_key = $2", hash, publicKey)
+func (s *TokenisationStore) GetSellOfferByHash(ctx context.Context, hash string) (SellOffer, error) {
+	var offer SellOffer
+	err := s.conn().QueryRowContext(ctx, "SELECT id, created_at, offerer_address, hash, mint_hash, quantity, price, public_key, signature FROM sell_offers WHERE hash = $1 AND deleted_block_height IS NULL", hash).Scan(&offer.Id, &offer.CreatedAt, &offer.OffererAddress, &offer.Hash, &offer.MintHash, &offer.Quantity, &offer.Price, &offer.PublicKey, &offer.Signature)
+	return offer, err
+}
+
+// MarkSellOfferDeletePending withdraws a sell offer its offerer has asked to delete. The
+// offer is kept, hidden from the order book, until the on-chain cancellation is matched.
+func (s *TokenisationStore) MarkSellOfferDeletePending(ctx context.Context, hash string, publicKey string) error {
+	_, err := s.conn().ExecContext(ctx, "UPDATE sell_offers SET delete_pending = TRUE WHERE hash = $1 AND public_key = $2", hash, publicKey)
 	return err
 }
+
+// MatchDeleteSellOffer applies a confirmed ACTION_DELETE_SELL_OFFER. Only the offerer, identified
+// by the sender of the on-chain transaction, can cancel the offer, and a cancellation
+// of an offer made by another address returns ErrOfferNotOwned. The offer is marked
+// deleted at the block height so that a rollback can restore it, and the on-chain
+// transaction is consumed once the offer has been removed.
+func (s *TokenisationStore) MatchDeleteSellOffer(ctx context.Context, onchainTransaction OnChainTransaction) error {
+	if onchainTransaction.ActionType != protocol.ACTION_DELETE_SELL_OFFER {
+		return fmt.Errorf("action type is not delete sell offer: %d", onchainTransaction.ActionType)
+	}
+
+	var onchainMessage protocol.OnChainDeleteSellOfferMessage
+	err := proto.Unmarshal(onchainTransaction.ActionData, &onchainMessage)
+	if err != nil {
+		return err
+	}
+
+	offerHash := hex.EncodeToString(onchainMessage.OfferHash)
+
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "UPDATE sell_offers SET deleted_block_height = $1 WHERE hash = $2 AND offerer_address = $3 AND deleted_block_height IS NULL", onchainTransaction.Height, offerHash, onchainTransaction.Address)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		var others int
+		err = tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM sell_offers WHERE hash = $1 AND offerer_address != $2", offerHash, onchainTransaction.Address).Scan(&others)
+		if err != nil {
+			return err
+		}
+
+		if others > 0 {
+			return fmt.Errorf("%w: sell offer %s", ErrOfferNotOwned, offerHash)
+		}
+
+		return fmt.Errorf("%w: no sell offer found for hash: %s", ErrOfferNotFound, offerHash)
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id = $1", onchainTransaction.Id)
+	if err != nil {
+		log.Println("Error deleting onchain transaction:", err)
+		return err
+	}
+
+	return tx.Commit()
+}
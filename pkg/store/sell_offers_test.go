@@ -2,9 +2,12 @@ package store_test
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"testing"
 
 	"dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/protocol"
 	"dogecoin.org/fractal-engine/pkg/store"
 	"gotest.tools/assert"
 )
@@ -144,3 +147,69 @@ func TestGetSellOffersTotalQuantityDifferentMints(t *testing.T) {
 	assert.NilError(t, err)
 	assert.Equal(t, total2, 75)
 }
+
+func TestMatchDeleteSellOffer(t *testing.T) {
+	db := support.SetupTestDB(t)
+
+	mintHash := support.GenerateRandomHash()
+	offererAddress := support.GenerateDogecoinAddress(true)
+	otherAddress := support.GenerateDogecoinAddress(true)
+
+	offer := &store.SellOfferWithoutID{
+		OffererAddress: offererAddress,
+		MintHash:       mintHash,
+		Quantity:       100,
+		Price:          50,
+		PublicKey:      "02b4632d08485ff1df2db55b9dafd23347d1c47a457072a1e87be26896549a8737",
+		Signature:      "signature1",
+	}
+	hash, err := offer.GenerateHash()
+	assert.NilError(t, err)
+	offer.Hash = hash
+
+	_, err = db.SaveSellOffer(sellOffersTestCtx, offer)
+	assert.NilError(t, err)
+
+	envelope := protocol.NewDeleteSellOfferTransactionEnvelope(hash, protocol.ACTION_DELETE_SELL_OFFER)
+
+	// Only the offerer can cancel the offer
+	id, err := db.SaveOnChainTransaction(sellOffersTestCtx, "deleteTx1", 2, "blockHash", 0, protocol.ACTION_DELETE_SELL_OFFER, protocol.DEFAULT_VERSION, envelope.Data, otherAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err := db.GetOnChainTransactions(sellOffersTestCtx, 0, 10)
+	assert.NilError(t, err)
+	err = db.MatchDeleteSellOffer(sellOffersTestCtx, *findTransactionById(txs, id))
+	assert.Assert(t, errors.Is(err, store.ErrOfferNotOwned))
+
+	total, err := db.GetSellOffersTotalQuantity(sellOffersTestCtx, mintHash, offererAddress)
+	assert.NilError(t, err)
+	assert.Equal(t, total, 100)
+
+	// An offer withdrawn by its offerer is hidden but kept for the on-chain cancellation
+	assert.NilError(t, db.MarkSellOfferDeletePending(sellOffersTestCtx, hash, offer.PublicKey))
+
+	total, err = db.GetSellOffersTotalQuantity(sellOffersTestCtx, mintHash, offererAddress)
+	assert.NilError(t, err)
+	assert.Equal(t, total, 0)
+
+	_, err = db.GetSellOfferByHash(sellOffersTestCtx, hash)
+	assert.NilError(t, err)
+
+	id, err = db.SaveOnChainTransaction(sellOffersTestCtx, "deleteTx2", 2, "blockHash", 1, protocol.ACTION_DELETE_SELL_OFFER, protocol.DEFAULT_VERSION, envelope.Data, offererAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err = db.GetOnChainTransactions(sellOffersTestCtx, 0, 10)
+	assert.NilError(t, err)
+	assert.NilError(t, db.MatchDeleteSellOffer(sellOffersTestCtx, *findTransactionById(txs, id)))
+
+	total, err = db.GetSellOffersTotalQuantity(sellOffersTestCtx, mintHash, offererAddress)
+	assert.NilError(t, err)
+	assert.Equal(t, total, 0)
+
+	_, err = db.GetSellOfferByHash(sellOffersTestCtx, hash)
+	assert.Assert(t, errors.Is(err, sql.ErrNoRows))
+
+	txs, err = db.GetOnChainTransactions(sellOffersTestCtx, 0, 10)
+	assert.NilError(t, err)
+	assert.Assert(t, findTransactionById(txs, id) == nil)
+}
@@ -8,6 +8,7 @@ import (
 
 	"dogecoin.org/fractal-engine/db/migrations"
 	"dogecoin.org/fractal-engine/pkg/config"
+	"dogecoin.org/fractal-engine/pkg/events"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
@@ -18,10 +19,15 @@ import (
 	_ "github.com/mattn/go-sqlite3"
 )
 
+// eventBufferSize is how many events a subscriber may fall behind before events are dropped
+const eventBufferSize = 256
+
 type TokenisationStore struct {
 	DB      *sql.DB
+	Events  *events.Bus
 	backend string
 	cfg     config.Config
+	scope   *scope
 }
 
 func NewTokenisationStore(dbUrl string, cfg config.Config) (*TokenisationStore, error) {
@@ -31,20 +37,20 @@ func NewTokenisationStore(dbUrl string, cfg config.Config) (*TokenisationStore,
 	}
 
 	if u.Scheme == "postgres" {
-		postgres, err := sql.Open("postgres", dbUrl)
+		postgres, err := openInstrumented("postgres", dbUrl)
 		if err != nil {
 			return nil, err
 		}
 
-		return &TokenisationStore{DB: postgres, backend: "postgres", cfg: cfg}, nil
+		return &TokenisationStore{DB: postgres, Events: events.NewBus(eventBufferSize), backend: "postgres", cfg: cfg}, nil
 	}
 
-	sqlite, err := sql.Open("sqlite3", dbUrl)
+	sqlite, err := openInstrumented("sqlite3", dbUrl)
 	if err != nil {
 		return nil, err
 	}
 
-	return &TokenisationStore{DB: sqlite, backend: "sqlite", cfg: cfg}, nil
+	return &TokenisationStore{DB: sqlite, Events: events.NewBus(eventBufferSize), backend: "sqlite", cfg: cfg}, nil
 }
 
 func (s *TokenisationStore) Migrate(ctx context.Context) error {
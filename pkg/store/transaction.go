@@ -0,0 +1,149 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"dogecoin.org/fractal-engine/pkg/events"
+)
+
+// querier runs statements against either the database or an open transaction.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// scope is the transaction a store returned by InTransaction runs every statement in.
+type scope struct {
+	tx         *sql.Tx
+	savepoints int
+	events     []events.Event
+}
+
+/*
+* Tx is a database transaction started by the store. Outside of InTransaction it is a
+* plain transaction. Inside it, it is a savepoint of the enclosing transaction, so that
+* methods which commit their own changes only release the savepoint and a failed method
+* is undone without aborting the enclosing transaction.
+ */
+type Tx struct {
+	*sql.Tx
+	savepoint string
+	done      bool
+}
+
+func (t *Tx) Commit() error {
+	if t.savepoint == "" {
+		return t.Tx.Commit()
+	}
+
+	if t.done {
+		return sql.ErrTxDone
+	}
+	t.done = true
+
+	_, err := t.Tx.Exec("RELEASE SAVEPOINT " + t.savepoint)
+	return err
+}
+
+func (t *Tx) Rollback() error {
+	if t.savepoint == "" {
+		return t.Tx.Rollback()
+	}
+
+	if t.done {
+		return sql.ErrTxDone
+	}
+	t.done = true
+
+	_, err := t.Tx.Exec("ROLLBACK TO SAVEPOINT " + t.savepoint)
+	if err != nil {
+		return err
+	}
+
+	_, err = t.Tx.Exec("RELEASE SAVEPOINT " + t.savepoint)
+	return err
+}
+
+// Begin starts a transaction, or a savepoint when the store is inside InTransaction.
+func (s *TokenisationStore) Begin(ctx context.Context) (*Tx, error) {
+	if s.scope == nil {
+		tx, err := s.DB.BeginTx(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Tx{Tx: tx}, nil
+	}
+
+	s.scope.savepoints++
+	savepoint := fmt.Sprintf("sp_%d", s.scope.savepoints)
+
+	_, err := s.scope.tx.ExecContext(ctx, "SAVEPOINT "+savepoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tx{Tx: s.scope.tx, savepoint: savepoint}, nil
+}
+
+/*
+* InTransaction runs fn with a copy of the store whose every statement, including those
+* of methods that start their own transaction, runs in one database transaction. It is
+* committed when fn returns nil and rolled back otherwise, and events published through
+* the copy are only delivered once it commits. The copy must not be used after fn
+* returns.
+ */
+func (s *TokenisationStore) InTransaction(ctx context.Context, fn func(tokenStore *TokenisationStore) error) error {
+	if s.scope != nil {
+		return fn(s)
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	scoped := *s
+	scoped.scope = &scope{tx: tx}
+
+	err = fn(&scoped)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	for _, event := range scoped.scope.events {
+		s.Events.Publish(event)
+	}
+
+	return nil
+}
+
+// PublishEvent delivers an event to subscribers. Inside InTransaction the event is held
+// back until the transaction commits.
+func (s *TokenisationStore) PublishEvent(event events.Event) {
+	if s.scope != nil {
+		s.scope.events = append(s.scope.events, event)
+		return
+	}
+
+	s.Events.Publish(event)
+}
+
+// conn returns the transaction the store is scoped to, or the database.
+func (s *TokenisationStore) conn() querier {
+	if s.scope != nil {
+		return s.scope.tx
+	}
+
+	return s.DB
+}
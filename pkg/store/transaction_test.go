@@ -0,0 +1,75 @@
+package store_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/events"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"gotest.tools/assert"
+)
+
+func TestInTransactionCommitsOrRollsBackTogether(t *testing.T) {
+	tokenStore := support.SetupTestDB(t)
+	ctx := context.Background()
+
+	received, unsubscribe := tokenStore.Events.Subscribe(events.Filter{})
+	defer unsubscribe()
+
+	var id string
+	err := tokenStore.InTransaction(ctx, func(tokenStore *store.TokenisationStore) error {
+		var err error
+		id, err = tokenStore.SaveOnChainTransaction(ctx, "txHash", 1, "blockHash", 1, protocol.ACTION_MINT, protocol.DEFAULT_VERSION, []byte{}, "address", map[string]interface{}{})
+		if err != nil {
+			return err
+		}
+
+		tokenStore.PublishEvent(events.Event{Type: events.EventMintConfirmed, TxHash: "txHash"})
+		return errors.New("failed")
+	})
+	assert.ErrorContains(t, err, "failed")
+
+	// Nothing written or published inside a failed transaction survives it
+	found, err := tokenStore.HasOnChainTransaction(ctx, id)
+	assert.NilError(t, err)
+	assert.Assert(t, !found)
+	assert.Equal(t, len(received), 0)
+
+	err = tokenStore.InTransaction(ctx, func(tokenStore *store.TokenisationStore) error {
+		var err error
+		id, err = tokenStore.SaveOnChainTransaction(ctx, "txHash", 1, "blockHash", 1, protocol.ACTION_MINT, protocol.DEFAULT_VERSION, []byte{}, "address", map[string]interface{}{})
+		if err != nil {
+			return err
+		}
+
+		// A transaction begun inside is a savepoint that only undoes its own changes
+		tx, err := tokenStore.Begin(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id = $1", id)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Rollback()
+		if err != nil {
+			return err
+		}
+
+		tokenStore.PublishEvent(events.Event{Type: events.EventMintConfirmed, TxHash: "txHash"})
+		return nil
+	})
+	assert.NilError(t, err)
+
+	found, err = tokenStore.HasOnChainTransaction(ctx, id)
+	assert.NilError(t, err)
+	assert.Assert(t, found)
+
+	event := <-received
+	assert.Equal(t, event.TxHash, "txHash")
+}
@@ -0,0 +1,139 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"github.com/google/uuid"
+)
+
+func (s *TokenisationStore) SaveTokenTransfer(ctx context.Context, transfer *TokenTransfer) (string, error) {
+	id := uuid.New().String()
+
+	_, err := s.conn().ExecContext(ctx, `
+	INSERT INTO token_transfers (id, hash, mint_hash, from_address, to_address, quantity, nonce, public_key, signature, redeem_script, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`, id, transfer.Hash, transfer.MintHash, transfer.FromAddress, transfer.ToAddress, transfer.Quantity, transfer.Nonce, transfer.PublicKey, transfer.Signature, transfer.RedeemScript, transfer.CreatedAt)
+	return id, err
+}
+
+func (s *TokenisationStore) GetTokenTransfer(ctx context.Context, hash string) (TokenTransfer, error) {
+	var transfer TokenTransfer
+	var redeemScript, transactionHash sql.NullString
+	var blockHeight sql.NullInt64
+
+	err := s.conn().QueryRowContext(ctx, "SELECT id, hash, mint_hash, from_address, to_address, quantity, nonce, public_key, signature, redeem_script, created_at, transaction_hash, block_height FROM token_transfers WHERE hash = $1", hash).Scan(
+		&transfer.Id, &transfer.Hash, &transfer.MintHash, &transfer.FromAddress, &transfer.ToAddress, &transfer.Quantity, &transfer.Nonce, &transfer.PublicKey, &transfer.Signature, &redeemScript, &transfer.CreatedAt, &transactionHash, &blockHeight)
+	if err != nil {
+		return TokenTransfer{}, err
+	}
+
+	transfer.RedeemScript = redeemScript.String
+	transfer.TransactionHash = transactionHash.String
+	transfer.BlockHeight = blockHeight.Int64
+	return transfer, nil
+}
+
+// GetAvailableTokenBalance returns the confirmed balance of an address for a mint
+// minus the quantity reserved by pending invoices.
+func (s *TokenisationStore) GetAvailableTokenBalance(ctx context.Context, address string, mintHash string, tx *sql.Tx) (int, error) {
+	query := `
+	SELECT
+		(SELECT COALESCE(SUM(quantity), 0) FROM ledger_balances WHERE account = $1 AND mint_hash = $2) -
+		(SELECT COALESCE(SUM(quantity), 0) FROM pending_token_balances WHERE owner_address = $1 AND mint_hash = $2)
+	`
+
+	var available int
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, address, mintHash).Scan(&available)
+	} else {
+		err = s.conn().QueryRowContext(ctx, query, address, mintHash).Scan(&available)
+	}
+
+	return available, err
+}
+
+/*
+* ProcessTransfer applies a signed transfer written on chain by its sender. The on-chain
+* transaction is always consumed; the balances only move if the sender has enough
+* available tokens and the recipient meets the mint's requirements, in which case the transfer is stamped with its block so that it
+* cannot be applied again and a reorg can revert it.
+ */
+func (s *TokenisationStore) ProcessTransfer(ctx context.Context, transfer TokenTransfer, onchainTransaction OnChainTransaction) error {
+	if onchainTransaction.ActionType != protocol.ACTION_TRANSFER {
+		return fmt.Errorf("action type is not transfer: %d", onchainTransaction.ActionType)
+	}
+
+	mint, err := s.GetMintByHash(ctx, transfer.MintHash)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM onchain_transactions WHERE id = $1", onchainTransaction.Id)
+	if err != nil {
+		log.Println("Error deleting onchain transaction:", err)
+		return err
+	}
+
+	var transferErr error
+	requirementsErr := s.CheckMintRequirements(ctx, mint, transfer.ToAddress, transfer.Quantity, tx.Tx)
+	if requirementsErr != nil && !errors.Is(requirementsErr, ErrMintRequirementsNotMet) {
+		return requirementsErr
+	}
+
+	available, err := s.GetAvailableTokenBalance(ctx, transfer.FromAddress, transfer.MintHash, tx.Tx)
+	if err != nil {
+		return err
+	}
+
+	locked, err := s.GetLockedTokenBalance(ctx, transfer.FromAddress, transfer.MintHash, onchainTransaction.Height, onchainTransaction.BlockTime, tx.Tx)
+	if err != nil {
+		return err
+	}
+
+	if requirementsErr != nil {
+		transferErr = fmt.Errorf("%w: %w", ErrActionRejected, requirementsErr)
+	} else if available < transfer.Quantity {
+		transferErr = fmt.Errorf("%w: insufficient available balance for transfer: %d < %d", ErrActionRejected, available, transfer.Quantity)
+	} else if available-locked < transfer.Quantity {
+		transferErr = fmt.Errorf("%w: insufficient unlocked balance for transfer: %d < %d", ErrActionRejected, available-locked, transfer.Quantity)
+	} else {
+		block := onchainTransaction.BlockRef()
+
+		err = s.PostToLedger(ctx, LedgerPosting{
+			MintHash:    transfer.MintHash,
+			FromAccount: transfer.FromAddress,
+			ToAccount:   transfer.ToAddress,
+			Quantity:    transfer.Quantity,
+			ActionType:  protocol.ACTION_TRANSFER,
+			Block:       block,
+		}, tx.Tx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE token_transfers SET transaction_hash = $1, block_height = $2, block_hash = $3 WHERE hash = $4", block.TransactionHash, block.Height, block.Hash, transfer.Hash)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return transferErr
+}
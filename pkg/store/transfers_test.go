@@ -0,0 +1,178 @@
+package store_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	test_support "dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
+	"dogecoin.org/fractal-engine/pkg/protocol"
+	"dogecoin.org/fractal-engine/pkg/store"
+	"google.golang.org/protobuf/proto"
+	"gotest.tools/assert"
+)
+
+func saveTokenTransfer(t *testing.T, tokenStore *store.TokenisationStore, fromAddress string, toAddress string, mintHash string, quantity int) store.TokenTransfer {
+	transfer := store.TokenTransfer{
+		MintHash:    mintHash,
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		Quantity:    quantity,
+		Nonce:       time.Now().UnixNano(),
+		PublicKey:   "publicKey",
+		Signature:   "signature",
+		CreatedAt:   time.Now(),
+	}
+
+	hash, err := transfer.GenerateHash()
+	assert.NilError(t, err)
+	transfer.Hash = hash
+
+	transfer.Id, err = tokenStore.SaveTokenTransfer(context.Background(), &transfer)
+	assert.NilError(t, err)
+
+	return transfer
+}
+
+func encodeTransferMessage(t *testing.T, transfer store.TokenTransfer) []byte {
+	transferHashBytes, err := hex.DecodeString(transfer.Hash)
+	assert.NilError(t, err)
+	mintHashBytes, err := hex.DecodeString(transfer.MintHash)
+	assert.NilError(t, err)
+
+	message, err := proto.Marshal(&protocol.OnChainTransferMessage{
+		TransferHash: transferHashBytes,
+		MintHash:     mintHashBytes,
+	})
+	assert.NilError(t, err)
+
+	return message
+}
+
+func saveTransferTransaction(t *testing.T, tokenStore *store.TokenisationStore, txHash string, blockHeight int64, transfer store.TokenTransfer) store.OnChainTransaction {
+	id, err := tokenStore.SaveOnChainTransaction(context.Background(), txHash, blockHeight, "block", 0, protocol.ACTION_TRANSFER, protocol.DEFAULT_VERSION, encodeTransferMessage(t, transfer), transfer.FromAddress, map[string]interface{}{})
+	assert.NilError(t, err)
+
+	txs, err := tokenStore.GetOnChainTransactions(context.Background(), 0, 100)
+	assert.NilError(t, err)
+
+	return *findTransactionById(txs, id)
+}
+
+func TestProcessTransfer(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mintHash := test_support.GenerateRandomHash()
+	_, _, fromAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	_, _, toAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	err = tokenStore.UpsertTokenBalance(ctx, fromAddress, mintHash, 100)
+	assert.NilError(t, err)
+
+	transfer := saveTokenTransfer(t, tokenStore, fromAddress, toAddress, mintHash, 30)
+	onchainTransfer := saveTransferTransaction(t, tokenStore, "transferTx", 2, transfer)
+	assert.NilError(t, tokenStore.ProcessTransfer(ctx, transfer, onchainTransfer))
+
+	assert.Equal(t, 70, sumBalances(t, tokenStore, fromAddress, mintHash))
+	assert.Equal(t, 30, sumBalances(t, tokenStore, toAddress, mintHash))
+	assert.Equal(t, 0, countRows(t, tokenStore, "SELECT COUNT(*) FROM onchain_transactions WHERE id = $1", onchainTransfer.Id))
+
+	// The applied transfer is stamped with its block so it cannot be applied twice
+	applied, err := tokenStore.GetTokenTransfer(ctx, transfer.Hash)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(2), applied.BlockHeight)
+	assert.Equal(t, "transferTx", applied.TransactionHash)
+
+	// A reorg reverts the balances and lets the replayed chain apply the transfer again
+	assert.NilError(t, tokenStore.RollbackToHeight(ctx, 1))
+
+	assert.Equal(t, 100, sumBalances(t, tokenStore, fromAddress, mintHash))
+	assert.Equal(t, 0, sumBalances(t, tokenStore, toAddress, mintHash))
+
+	applied, err = tokenStore.GetTokenTransfer(ctx, transfer.Hash)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(0), applied.BlockHeight)
+}
+
+func TestProcessTransferWithInsufficientAvailableBalance(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mintHash := test_support.GenerateRandomHash()
+	_, _, fromAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	_, _, toAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	err = tokenStore.UpsertTokenBalance(ctx, fromAddress, mintHash, 100)
+	assert.NilError(t, err)
+
+	// 80 tokens are reserved by a pending invoice
+	err = tokenStore.UpsertPendingTokenBalanceAtBlock(ctx, test_support.GenerateRandomHash(), mintHash, 80, "invoiceTxId", fromAddress, store.BlockRef{Height: 1, Hash: "block1"}, nil)
+	assert.NilError(t, err)
+
+	available, err := tokenStore.GetAvailableTokenBalance(ctx, fromAddress, mintHash, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, 20, available)
+
+	transfer := saveTokenTransfer(t, tokenStore, fromAddress, toAddress, mintHash, 30)
+	onchainTransfer := saveTransferTransaction(t, tokenStore, "transferTx", 2, transfer)
+	err = tokenStore.ProcessTransfer(ctx, transfer, onchainTransfer)
+	assert.ErrorContains(t, err, "insufficient available balance")
+
+	assert.Equal(t, 100, sumBalances(t, tokenStore, fromAddress, mintHash))
+	assert.Equal(t, 0, sumBalances(t, tokenStore, toAddress, mintHash))
+	assert.Equal(t, 0, countRows(t, tokenStore, "SELECT COUNT(*) FROM onchain_transactions WHERE id = $1", onchainTransfer.Id))
+}
+
+func TestProcessTransferToRecipientFailingMintRequirements(t *testing.T) {
+	tokenStore := test_support.SetupTestDB(t)
+	ctx := context.Background()
+
+	mintHash := test_support.GenerateRandomHash()
+	_, _, fromAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	_, _, deniedAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+	_, _, holderAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixRegtest)
+	assert.NilError(t, err)
+
+	_, err = tokenStore.SaveMint(ctx, &store.MintWithoutID{
+		Title:         "Test Mint",
+		FractionCount: 100,
+		Hash:          mintHash,
+		Requirements: store.StringInterfaceMap{
+			"deny_list":   []interface{}{deniedAddress},
+			"max_holding": float64(40),
+		},
+	}, fromAddress)
+	assert.NilError(t, err)
+
+	err = tokenStore.UpsertTokenBalance(ctx, fromAddress, mintHash, 100)
+	assert.NilError(t, err)
+
+	denied := saveTokenTransfer(t, tokenStore, fromAddress, deniedAddress, mintHash, 10)
+	onchainDenied := saveTransferTransaction(t, tokenStore, "deniedTx", 2, denied)
+	err = tokenStore.ProcessTransfer(ctx, denied, onchainDenied)
+	assert.Assert(t, errors.Is(err, store.ErrActionRejected))
+	assert.Assert(t, errors.Is(err, store.ErrMintRequirementsNotMet))
+	assert.ErrorContains(t, err, "deny list")
+
+	overHolding := saveTokenTransfer(t, tokenStore, fromAddress, holderAddress, mintHash, 50)
+	onchainOverHolding := saveTransferTransaction(t, tokenStore, "overHoldingTx", 2, overHolding)
+	err = tokenStore.ProcessTransfer(ctx, overHolding, onchainOverHolding)
+	assert.Assert(t, errors.Is(err, store.ErrActionRejected))
+	assert.ErrorContains(t, err, "would exceed the maximum of 40")
+
+	// Rejected transfers are consumed without moving any fractions
+	assert.Equal(t, 100, sumBalances(t, tokenStore, fromAddress, mintHash))
+	assert.Equal(t, 0, sumBalances(t, tokenStore, deniedAddress, mintHash))
+	assert.Equal(t, 0, sumBalances(t, tokenStore, holderAddress, mintHash))
+	assert.Equal(t, 0, countRows(t, tokenStore, "SELECT COUNT(*) FROM onchain_transactions WHERE id IN ($1, $2)", onchainDenied.Id, onchainOverHolding.Id))
+}
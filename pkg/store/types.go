@@ -10,12 +10,18 @@ import (
 	"encoding/hex"
 
 	"encoding/json"
+	"errors"
 
 	"fmt"
 
+	"math"
+	"math/big"
+	"slices"
+
 	"time"
 
 	"dogecoin.org/fractal-engine/pkg/doge"
+	"github.com/shopspring/decimal"
 )
 
 type StringInterfaceMap map[string]interface{}
@@ -46,6 +52,10 @@ func (m StringInterfaceMap) Equal(other StringInterfaceMap) bool {
 }
 
 func (m *StringInterfaceMap) Scan(src interface{}) error {
+	return m.scan(src, false)
+}
+
+func (m *StringInterfaceMap) scan(src interface{}, exact bool) error {
 	var source []byte
 	switch src := src.(type) {
 	case string:
@@ -58,7 +68,85 @@ func (m *StringInterfaceMap) Scan(src interface{}) error {
 	default:
 		return fmt.Errorf("unsupported type: %T", src)
 	}
-	return json.Unmarshal(source, m)
+
+	if !exact {
+		return json.Unmarshal(source, m)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(source))
+	decoder.UseNumber()
+	return decoder.Decode(m)
+}
+
+// exactValues scans a StringInterfaceMap keeping numbers as json.Number, so that
+// koinu amounts are not rounded through float64.
+type exactValues struct {
+	m *StringInterfaceMap
+}
+
+func (e exactValues) Scan(src interface{}) error {
+	return e.m.scan(src, true)
+}
+
+const (
+	// valuesVersionDoge is the encoding of on-chain transaction values saved before
+	// the follower recorded koinu: each value is an amount of DOGE.
+	valuesVersionDoge = 1
+	// valuesVersionKoinu is the encoding of values recorded as whole koinu.
+	valuesVersionKoinu = 2
+)
+
+// upgrade converts values scanned with an older encoding to koinu.
+func (m StringInterfaceMap) upgrade(version int) error {
+	if version != valuesVersionDoge {
+		return nil
+	}
+
+	for address, value := range m {
+		number, ok := value.(json.Number)
+		if !ok {
+			return fmt.Errorf("unsupported value type for %s: %T", address, value)
+		}
+
+		amount, err := decimal.NewFromString(number.String())
+		if err != nil {
+			return err
+		}
+
+		koinu, err := doge.ToKoinu(amount)
+		if err != nil {
+			return err
+		}
+
+		m[address] = koinu
+	}
+
+	return nil
+}
+
+// Koinu returns the amount, in koinu, recorded against an address. Addresses that
+// are not in the map have a zero amount.
+func (m StringInterfaceMap) Koinu(address string) (int64, error) {
+	value, ok := m[address]
+	if !ok {
+		return 0, nil
+	}
+
+	switch v := value.(type) {
+	case json.Number:
+		return v.Int64()
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	case float64:
+		if v != math.Trunc(v) {
+			return 0, fmt.Errorf("value for %s is not a whole number of koinu: %v", address, v)
+		}
+		return int64(v), nil
+	}
+
+	return 0, fmt.Errorf("unsupported value type for %s: %T", address, value)
 }
 
 type AssetManager struct {
@@ -118,6 +206,97 @@ func (a *AssetManagers) Scan(src interface{}) error {
 	return json.Unmarshal(data, a)
 }
 
+// InvoiceSplit is one recipient of an invoice payment and its share of the amount due,
+// in basis points.
+type InvoiceSplit struct {
+	Address     string `json:"address"`
+	BasisPoints int    `json:"basis_points"`
+}
+
+// BasisPointsTotal is the sum of the basis points of an invoice's splits.
+const BasisPointsTotal = 10_000
+
+type InvoiceSplits []InvoiceSplit
+
+// Value implements driver.Valuer — converts to JSON for DB insertion.
+func (s InvoiceSplits) Value() (driver.Value, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("marshal InvoiceSplits: %w", err)
+	}
+	return string(b), nil
+}
+
+// Scan implements sql.Scanner — converts DB value to the slice.
+func (s *InvoiceSplits) Scan(src interface{}) error {
+	if s == nil {
+		return fmt.Errorf("InvoiceSplits: Scan on nil pointer")
+	}
+	if src == nil {
+		*s = nil
+		return nil
+	}
+
+	var data []byte
+	switch v := src.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fmt.Errorf("unsupported scan type for InvoiceSplits: %T", src)
+	}
+
+	if len(data) == 0 {
+		*s = nil
+		return nil
+	}
+
+	return json.Unmarshal(data, s)
+}
+
+// Validate checks that every recipient appears once with a positive share and that
+// the shares add up to the whole amount.
+func (s InvoiceSplits) Validate() error {
+	seen := make(map[string]bool, len(s))
+	total := 0
+	for _, split := range s {
+		if split.Address == "" {
+			return fmt.Errorf("split address is required")
+		}
+
+		if seen[split.Address] {
+			return fmt.Errorf("duplicate split address: %s", split.Address)
+		}
+		seen[split.Address] = true
+
+		if split.BasisPoints <= 0 || split.BasisPoints > BasisPointsTotal {
+			return fmt.Errorf("split basis points for %s must be between 1 and %d", split.Address, BasisPointsTotal)
+		}
+		total += split.BasisPoints
+	}
+
+	if total != BasisPointsTotal {
+		return fmt.Errorf("split basis points must add up to %d, got %d", BasisPointsTotal, total)
+	}
+
+	return nil
+}
+
+// Contains reports whether the address is one of the recipients.
+func (s InvoiceSplits) Contains(address string) bool {
+	for _, split := range s {
+		if split.Address == address {
+			return true
+		}
+	}
+
+	return false
+}
+
 type SignatureRequirementType string
 
 const (
@@ -136,6 +315,7 @@ type MintWithoutID struct {
 	Metadata                 StringInterfaceMap       `json:"metadata"`
 	TransactionHash          string                   `json:"transaction_hash"`
 	BlockHeight              int64                    `json:"block_height"`
+	BlockHash                string                   `json:"block_hash"`
 	CreatedAt                time.Time                `json:"created_at"`
 	Requirements             StringInterfaceMap       `json:"requirements"`
 	LockupOptions            StringInterfaceMap       `json:"lockup_options"`
@@ -176,6 +356,22 @@ type OnChainTransaction struct {
 	Address           string             `json:"address"`
 	Values            StringInterfaceMap `json:"values"`
 	TransactionNumber int                `json:"transaction_number"`
+	SubIndex          int                `json:"sub_index"`
+	BatchAtomic       bool               `json:"batch_atomic"`
+	BlockTime         time.Time          `json:"block_time"`
+}
+
+// BlockRef identifies the block and transaction that caused a state change,
+// so that the change can be reverted if the block is reorganised away.
+type BlockRef struct {
+	Height          int64
+	Hash            string
+	TransactionHash string
+	Time            time.Time
+}
+
+func (t OnChainTransaction) BlockRef() BlockRef {
+	return BlockRef{Height: t.Height, Hash: t.BlockHash, TransactionHash: t.TxHash, Time: t.BlockTime}
 }
 
 func (m *MintWithoutID) GenerateHash() (string, error) {
@@ -221,13 +417,19 @@ func (m *Mint) SignatureRequired() bool {
 }
 
 func (m *Mint) HasRequiredSignatures(signatures []InvoiceSignature) bool {
+	return m.HasRequiredSignatureCount(len(signatures))
+}
+
+// HasRequiredSignatureCount checks a number of distinct, valid asset manager
+// signatures against the mint's signature requirement.
+func (m *Mint) HasRequiredSignatureCount(count int) bool {
 	switch m.SignatureRequirementType {
 	case SignatureRequirementType_ALL_SIGNATURES:
-		return len(signatures) == len(m.AssetManagers)
+		return count == len(m.AssetManagers)
 	case SignatureRequirementType_ONE_SIGNATURE:
-		return len(signatures) == 1
+		return count == 1
 	case SignatureRequirementType_MIN_SIGNATURES:
-		return len(signatures) >= m.MinSignatures
+		return count >= m.MinSignatures
 	}
 
 	return false
@@ -331,28 +533,35 @@ type SellOffer struct {
 }
 
 type UnconfirmedInvoice struct {
-	Id             string    `json:"id"`
-	Hash           string    `json:"hash"`
-	BuyerAddress   string    `json:"buyer_address"`
-	MintHash       string    `json:"mint_hash"`
-	Quantity       int       `json:"quantity"`
-	Price          int       `json:"price"`
-	CreatedAt      time.Time `json:"created_at"`
-	PaymentAddress string    `json:"payment_address"`
-	SellerAddress  string    `json:"seller_address"`
-	PublicKey      string    `json:"public_key"`
-	Signature      string    `json:"signature"`
-	Status         string    `json:"status"`
+	Id              string        `json:"id"`
+	Hash            string        `json:"hash"`
+	BuyerAddress    string        `json:"buyer_address"`
+	MintHash        string        `json:"mint_hash"`
+	Quantity        int           `json:"quantity"`
+	Price           int64         `json:"price"`
+	DogePrice       int           `json:"doge_price,omitempty"`
+	CreatedAt       time.Time     `json:"created_at"`
+	PaymentAddress  string        `json:"payment_address"`
+	SellerAddress   string        `json:"seller_address"`
+	PublicKey       string        `json:"public_key"`
+	Signature       string        `json:"signature"`
+	Status          string        `json:"status"`
+	Splits          InvoiceSplits `json:"splits,omitempty"`
+	ExpiresAtHeight int64         `json:"expires_at_height,omitempty"`
 }
 
 func (u *UnconfirmedInvoice) GenerateHash() (string, error) {
+	price, priceKoinu := hashedInvoicePrice(u.Price, u.DogePrice)
 	input := UnconfirmedInvoiceHash{
-		MintHash:      u.MintHash,
-		Quantity:      u.Quantity,
-		Price:         u.Price,
-		BuyerAddress:  u.BuyerAddress,
-		SellerAddress: u.SellerAddress,
-		PublicKey:     u.PublicKey,
+		MintHash:        u.MintHash,
+		Quantity:        u.Quantity,
+		Price:           price,
+		PriceKoinu:      priceKoinu,
+		BuyerAddress:    u.BuyerAddress,
+		SellerAddress:   u.SellerAddress,
+		PublicKey:       u.PublicKey,
+		Splits:          u.Splits,
+		ExpiresAtHeight: u.ExpiresAtHeight,
 	}
 
 	jsonBytes, err := json.Marshal(input)
@@ -366,53 +575,237 @@ func (u *UnconfirmedInvoice) GenerateHash() (string, error) {
 }
 
 type UnconfirmedInvoiceHash struct {
-	MintHash       string `json:"mint_hash"`
-	Quantity       int    `json:"quantity"`
-	Price          int    `json:"price"`
-	BuyerAddress   string `json:"buyer_address"`
-	PaymentAddress string `json:"payment_address"`
-	SellerAddress  string `json:"seller_address"`
-	PublicKey      string `json:"public_key"`
-	Signature      string `json:"signature"`
+	MintHash        string        `json:"mint_hash"`
+	Quantity        int           `json:"quantity"`
+	Price           int           `json:"price"`
+	PriceKoinu      int64         `json:"price_koinu,omitempty"`
+	BuyerAddress    string        `json:"buyer_address"`
+	PaymentAddress  string        `json:"payment_address"`
+	SellerAddress   string        `json:"seller_address"`
+	PublicKey       string        `json:"public_key"`
+	Signature       string        `json:"signature"`
+	Splits          InvoiceSplits `json:"splits,omitempty"`
+	ExpiresAtHeight int64         `json:"expires_at_height,omitempty"`
 }
 
 type InvoiceHash struct {
-	MintHash       string `json:"mint_hash"`
-	Quantity       int    `json:"quantity"`
-	Price          int    `json:"price"`
-	PaymentAddress string `json:"payment_address"`
-	SellerAddress  string `json:"seller_address"`
-	PublicKey      string `json:"public_key"`
-	Signature      string `json:"signature"`
+	MintHash        string        `json:"mint_hash"`
+	Quantity        int           `json:"quantity"`
+	Price           int           `json:"price"`
+	PriceKoinu      int64         `json:"price_koinu,omitempty"`
+	PaymentAddress  string        `json:"payment_address"`
+	SellerAddress   string        `json:"seller_address"`
+	PublicKey       string        `json:"public_key"`
+	Signature       string        `json:"signature"`
+	Splits          InvoiceSplits `json:"splits,omitempty"`
+	ExpiresAtHeight int64         `json:"expires_at_height,omitempty"`
+}
+
+// Statuses of an invoice. A gossiped invoice starts as a draft, or waits for the asset
+// managers' signatures when its mint requires them. Once written on chain it stays on
+// chain until it is paid in full, passes its expiry height or is cancelled by the
+// buyer or seller. Gossiped invoices can also be expired or cancelled before they are
+// confirmed.
+const (
+	InvoiceStatusDraft             = "draft"
+	InvoiceStatusPendingSignatures = "pending_signatures"
+	InvoiceStatusSigned            = "signed"
+	InvoiceStatusOnChain           = "on_chain"
+	InvoiceStatusPaid              = "paid"
+	InvoiceStatusExpired           = "expired"
+	InvoiceStatusCancelled         = "cancelled"
+)
+
+// invoiceTransitions lists the statuses each status can move to. Paid, expired and
+// cancelled invoices are final. An invoice still pending signatures can move straight
+// on chain because the on-chain invoice is only accepted once its signatures are
+// approved, which may happen before the last signature is gossiped to this node.
+var invoiceTransitions = map[string][]string{
+	"":                             {InvoiceStatusDraft, InvoiceStatusPendingSignatures},
+	InvoiceStatusDraft:             {InvoiceStatusOnChain, InvoiceStatusExpired, InvoiceStatusCancelled},
+	InvoiceStatusPendingSignatures: {InvoiceStatusSigned, InvoiceStatusOnChain, InvoiceStatusExpired, InvoiceStatusCancelled},
+	InvoiceStatusSigned:            {InvoiceStatusOnChain, InvoiceStatusExpired, InvoiceStatusCancelled},
+	InvoiceStatusOnChain:           {InvoiceStatusPaid, InvoiceStatusExpired, InvoiceStatusCancelled},
+}
+
+var ErrInvalidInvoiceTransition = errors.New("invalid invoice transition")
+
+// CanTransitionInvoice reports whether an invoice can move from one status to another.
+// The empty status is an invoice that does not exist yet.
+func CanTransitionInvoice(from string, to string) bool {
+	return slices.Contains(invoiceTransitions[from], to)
+}
+
+// InitialInvoiceStatus is the status a new gossiped invoice for the mint starts in.
+func InitialInvoiceStatus(mint Mint) string {
+	if mint.SignatureRequired() {
+		return InvoiceStatusPendingSignatures
+	}
+
+	return InvoiceStatusDraft
+}
+
+// InvoiceTransition records an invoice moving between statuses. Transitions caused by
+// an on-chain transaction carry its block height and transaction hash; those made
+// over gossip or by this node have neither.
+type InvoiceTransition struct {
+	Id              string        `json:"id"`
+	InvoiceHash     string        `json:"invoice_hash"`
+	FromStatus      string        `json:"from_status"`
+	ToStatus        string        `json:"to_status"`
+	BlockHeight     sql.NullInt64 `json:"block_height"`
+	TransactionHash string        `json:"transaction_hash,omitempty"`
+	CreatedAt       time.Time     `json:"created_at"`
 }
 
 type Invoice struct {
-	Id                    string       `json:"id"`
-	Hash                  string       `json:"hash"`
-	PaymentAddress        string       `json:"payment_address"`
-	BuyerAddress          string       `json:"buyer_address"`
-	MintHash              string       `json:"mint_hash"`
-	Quantity              int          `json:"quantity"`
-	Price                 int          `json:"price"`
-	CreatedAt             time.Time    `json:"created_at"`
-	SellerAddress         string       `json:"seller_address"`
-	BlockHeight           int64        `json:"block_height"`
-	TransactionHash       string       `json:"transaction_hash"`
-	PendingTokenBalanceId string       `json:"pending_token_balance_id"`
-	PublicKey             string       `json:"public_key"`
-	Signature             string       `json:"signature"`
-	PaidAt                sql.NullTime `json:"paid_at"`
+	Id                    string        `json:"id"`
+	Hash                  string        `json:"hash"`
+	PaymentAddress        string        `json:"payment_address"`
+	BuyerAddress          string        `json:"buyer_address"`
+	MintHash              string        `json:"mint_hash"`
+	Quantity              int           `json:"quantity"`
+	Price                 int64         `json:"price"`
+	DogePrice             int           `json:"doge_price,omitempty"`
+	CreatedAt             time.Time     `json:"created_at"`
+	SellerAddress         string        `json:"seller_address"`
+	BlockHeight           int64         `json:"block_height"`
+	BlockHash             string        `json:"block_hash"`
+	TransactionHash       string        `json:"transaction_hash"`
+	PendingTokenBalanceId string        `json:"pending_token_balance_id"`
+	PublicKey             string        `json:"public_key"`
+	Signature             string        `json:"signature"`
+	PaidAt                sql.NullTime  `json:"paid_at"`
+	Splits                InvoiceSplits `json:"splits,omitempty"`
+	ExpiresAtHeight       int64         `json:"expires_at_height,omitempty"`
+	Status                string        `json:"status"`
+}
+
+// IsClosed reports whether the invoice has expired or been cancelled, after which it
+// can no longer be paid.
+func (i *Invoice) IsClosed() bool {
+	return i.Status == InvoiceStatusExpired || i.Status == InvoiceStatusCancelled
+}
+
+// ExpiredAt reports whether a payment mined at a block height is too late for the
+// invoice. The expiry height is the last block in which the invoice can be paid.
+func (i *Invoice) ExpiredAt(height int64) bool {
+	return i.ExpiresAtHeight > 0 && height > i.ExpiresAtHeight
+}
+
+// IsParty reports whether an address is the buyer or the seller of the invoice.
+func (i *Invoice) IsParty(address string) bool {
+	return address != "" && (address == i.BuyerAddress || address == i.SellerAddress)
+}
+
+// AmountDueKoinu is the total price of the invoice. Prices are koinu per fraction, and
+// DogePrice is the whole DOGE per fraction an invoice from before koinu prices was
+// created with. Such an invoice is hashed and signed with its DOGE price.
+func (i *Invoice) AmountDueKoinu() int64 {
+	return int64(i.Quantity) * i.Price
+}
+
+// PaymentSplits returns the recipients of the invoice payment. An invoice without
+// splits is paid in full to its payment address, or to the seller for invoices that
+// were confirmed without one.
+func (i *Invoice) PaymentSplits() InvoiceSplits {
+	if len(i.Splits) > 0 {
+		return i.Splits
+	}
+
+	address := i.PaymentAddress
+	if address == "" {
+		address = i.SellerAddress
+	}
+
+	return InvoiceSplits{{Address: address, BasisPoints: BasisPointsTotal}}
+}
+
+/*
+* PaymentKoinu works out how much of the invoice a transaction pays. Every split
+* recipient must have an output in the transaction, otherwise the payment is not
+* valid. The amount credited is limited by the recipient that was paid the smallest
+* fraction of its share, so paying one recipient more does not make up for another.
+* It returns the koinu credited and the koinu received across all recipients.
+ */
+func (i *Invoice) PaymentKoinu(values StringInterfaceMap) (int64, int64, error) {
+	credited := int64(math.MaxInt64)
+	received := int64(0)
+
+	for _, split := range i.PaymentSplits() {
+		amount, err := values.Koinu(split.Address)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if amount <= 0 {
+			return 0, 0, fmt.Errorf("transaction does not pay %s for invoice: %s", split.Address, i.Hash)
+		}
+
+		credited = min(credited, mulDiv(amount, BasisPointsTotal, int64(split.BasisPoints)))
+		received += amount
+	}
+
+	return credited, received, nil
+}
+
+// SplitAmountsKoinu divides the amount due between the payment recipients. Any
+// koinu lost to rounding go to the first recipient.
+func (i *Invoice) SplitAmountsKoinu() map[string]int64 {
+	due := i.AmountDueKoinu()
+	splits := i.PaymentSplits()
+
+	amounts := make(map[string]int64, len(splits))
+	remaining := due
+	for _, split := range splits {
+		amount := mulDiv(due, int64(split.BasisPoints), BasisPointsTotal)
+		amounts[split.Address] = amount
+		remaining -= amount
+	}
+	amounts[splits[0].Address] += remaining
+
+	return amounts
+}
+
+// mulDiv returns a*b/c rounded down without overflowing, capped at math.MaxInt64.
+func mulDiv(a int64, b int64, c int64) int64 {
+	result := new(big.Int).Mul(big.NewInt(a), big.NewInt(b))
+	result.Quo(result, big.NewInt(c))
+	if !result.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return result.Int64()
+}
+
+// InvoicePayment is one on-chain payment towards an invoice. AppliedKoinu counts
+// towards the amount due and OverpaidKoinu is the excess to be refunded to the payer.
+type InvoicePayment struct {
+	Id              string    `json:"id"`
+	InvoiceHash     string    `json:"invoice_hash"`
+	TransactionHash string    `json:"transaction_hash"`
+	PayerAddress    string    `json:"payer_address"`
+	AmountKoinu     int64     `json:"amount_koinu"`
+	AppliedKoinu    int64     `json:"applied_koinu"`
+	OverpaidKoinu   int64     `json:"overpaid_koinu"`
+	BlockHeight     int64     `json:"block_height"`
+	BlockHash       string    `json:"block_hash"`
+	CreatedAt       time.Time `json:"created_at"`
 }
 
 func (i *Invoice) GenerateHash() (string, error) {
+	price, priceKoinu := hashedInvoicePrice(i.Price, i.DogePrice)
 	input := InvoiceHash{
-		MintHash:       i.MintHash,
-		Quantity:       i.Quantity,
-		Price:          i.Price,
-		PaymentAddress: i.PaymentAddress,
-		SellerAddress:  i.SellerAddress,
-		PublicKey:      i.PublicKey,
-		Signature:      i.Signature,
+		MintHash:        i.MintHash,
+		Quantity:        i.Quantity,
+		Price:           price,
+		PriceKoinu:      priceKoinu,
+		PaymentAddress:  i.PaymentAddress,
+		SellerAddress:   i.SellerAddress,
+		PublicKey:       i.PublicKey,
+		Signature:       i.Signature,
+		Splits:          i.Splits,
+		ExpiresAtHeight: i.ExpiresAtHeight,
 	}
 
 	jsonBytes, err := json.Marshal(input)
@@ -425,6 +818,16 @@ func (i *Invoice) GenerateHash() (string, error) {
 	return hex.EncodeToString(hash[:]), nil
 }
 
+// hashedInvoicePrice returns the prices an invoice is hashed and signed with: the DOGE
+// price of an invoice from before koinu prices, otherwise the koinu price.
+func hashedInvoicePrice(price int64, dogePrice int) (int, int64) {
+	if dogePrice > 0 {
+		return dogePrice, 0
+	}
+
+	return 0, price
+}
+
 type InvoiceSignature struct {
 	Id          string    `json:"id"`
 	InvoiceHash string    `json:"invoice_hash"`
@@ -434,13 +837,16 @@ type InvoiceSignature struct {
 }
 
 type InvoiceSignatureBody struct {
-	Hash           string `json:"hash"`
-	MintHash       string `json:"mint_hash"`
-	Price          int    `json:"price"`
-	Quantity       int    `json:"quantity"`
-	BuyerAddress   string `json:"buyer_address"`
-	PaymentAddress string `json:"payment_address"`
-	SellerAddress  string `json:"seller_address"`
+	Hash            string        `json:"hash"`
+	MintHash        string        `json:"mint_hash"`
+	Price           int           `json:"price"`
+	PriceKoinu      int64         `json:"price_koinu,omitempty"`
+	Quantity        int           `json:"quantity"`
+	BuyerAddress    string        `json:"buyer_address"`
+	PaymentAddress  string        `json:"payment_address"`
+	SellerAddress   string        `json:"seller_address"`
+	Splits          InvoiceSplits `json:"splits,omitempty"`
+	ExpiresAtHeight int64         `json:"expires_at_height,omitempty"`
 }
 
 func (i *InvoiceSignature) Validate(mint Mint, invoice UnconfirmedInvoice) error {
@@ -457,14 +863,18 @@ func (i *InvoiceSignature) Validate(mint Mint, invoice UnconfirmedInvoice) error
 		return fmt.Errorf("public key does not match any asset managers")
 	}
 
+	price, priceKoinu := hashedInvoicePrice(invoice.Price, invoice.DogePrice)
 	invoiceBody := InvoiceSignatureBody{
-		Hash:           invoice.Hash,
-		MintHash:       invoice.MintHash,
-		Price:          invoice.Price,
-		Quantity:       invoice.Quantity,
-		BuyerAddress:   invoice.BuyerAddress,
-		PaymentAddress: invoice.PaymentAddress,
-		SellerAddress:  invoice.SellerAddress,
+		Hash:            invoice.Hash,
+		MintHash:        invoice.MintHash,
+		Price:           price,
+		PriceKoinu:      priceKoinu,
+		Quantity:        invoice.Quantity,
+		BuyerAddress:    invoice.BuyerAddress,
+		PaymentAddress:  invoice.PaymentAddress,
+		SellerAddress:   invoice.SellerAddress,
+		Splits:          invoice.Splits,
+		ExpiresAtHeight: invoice.ExpiresAtHeight,
 	}
 
 	err := doge.ValidateSignature(invoiceBody, i.PublicKey, i.Signature)
@@ -477,18 +887,22 @@ func (i *InvoiceSignature) Validate(mint Mint, invoice UnconfirmedInvoice) error
 
 type TokenBalanceWithMint struct {
 	Mint
-	Address   string    `json:"address"`
-	Quantity  int       `json:"quantity"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	Address          string    `json:"address"`
+	Quantity         int       `json:"quantity"`
+	LockedQuantity   int       `json:"locked_quantity"`
+	UnlockedQuantity int       `json:"unlocked_quantity"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
 }
 
 type TokenBalance struct {
-	MintHash  string    `json:"mint_hash"`
-	Address   string    `json:"address"`
-	Quantity  int       `json:"quantity"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	MintHash        string    `json:"mint_hash"`
+	Address         string    `json:"address"`
+	Quantity        int       `json:"quantity"`
+	BlockHeight     int64     `json:"block_height,omitempty"`
+	TransactionHash string    `json:"transaction_hash,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
 }
 
 type PendingTokenBalance struct {
@@ -499,3 +913,653 @@ type PendingTokenBalance struct {
 	UpdatedAt    time.Time `json:"updated_at"`
 	OwnerAddress string    `json:"owner_address"`
 }
+
+type BurnWithoutID struct {
+	Hash         string    `json:"hash"`
+	MintHash     string    `json:"mint_hash"`
+	OwnerAddress string    `json:"owner_address"`
+	Quantity     int       `json:"quantity"`
+	PublicKey    string    `json:"public_key"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type BurnHash struct {
+	MintHash     string    `json:"mint_hash"`
+	OwnerAddress string    `json:"owner_address"`
+	Quantity     int       `json:"quantity"`
+	PublicKey    string    `json:"public_key"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func (b *BurnWithoutID) GenerateHash() (string, error) {
+	input := BurnHash{
+		MintHash:     b.MintHash,
+		OwnerAddress: b.OwnerAddress,
+		Quantity:     b.Quantity,
+		PublicKey:    b.PublicKey,
+		CreatedAt:    b.CreatedAt,
+	}
+
+	jsonBytes, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(jsonBytes)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+type Burn struct {
+	Id              string    `json:"id"`
+	Hash            string    `json:"hash"`
+	MintHash        string    `json:"mint_hash"`
+	OwnerAddress    string    `json:"owner_address"`
+	Quantity        int       `json:"quantity"`
+	TransactionHash string    `json:"transaction_hash"`
+	BlockHeight     int64     `json:"block_height"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+type BurnSignature struct {
+	Id           string    `json:"id"`
+	BurnHash     string    `json:"burn_hash"`
+	MintHash     string    `json:"mint_hash"`
+	OwnerAddress string    `json:"owner_address"`
+	Quantity     int       `json:"quantity"`
+	Signature    string    `json:"signature"`
+	PublicKey    string    `json:"public_key"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// BurnSignatureBody is the payload an asset manager signs to approve a burn.
+type BurnSignatureBody struct {
+	Hash         string `json:"hash"`
+	MintHash     string `json:"mint_hash"`
+	OwnerAddress string `json:"owner_address"`
+	Quantity     int    `json:"quantity"`
+}
+
+func (b *BurnSignature) Validate(mint Mint) error {
+	if b.MintHash != mint.Hash {
+		return fmt.Errorf("burn signature is for a different mint")
+	}
+
+	var assetManager AssetManager
+
+	for _, am := range mint.AssetManagers {
+		if am.PublicKey == b.PublicKey {
+			assetManager = am
+			break
+		}
+	}
+
+	if assetManager.PublicKey == "" {
+		return fmt.Errorf("public key does not match any asset managers")
+	}
+
+	burnBody := BurnSignatureBody{
+		Hash:         b.BurnHash,
+		MintHash:     b.MintHash,
+		OwnerAddress: b.OwnerAddress,
+		Quantity:     b.Quantity,
+	}
+
+	err := doge.ValidateSignature(burnBody, b.PublicKey, b.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	return nil
+}
+
+// MintAmendment replaces the description, metadata, feed URL and contract of sale of
+// a confirmed mint. Amendments are signed by the mint owner and gossiped, and take
+// effect once the owner writes them on chain. The version and block are only set once
+// the amendment has been applied.
+type MintAmendment struct {
+	Id              string             `json:"id"`
+	Hash            string             `json:"hash"`
+	MintHash        string             `json:"mint_hash"`
+	Description     string             `json:"description"`
+	Metadata        StringInterfaceMap `json:"metadata"`
+	FeedURL         string             `json:"feed_url"`
+	ContractOfSale  string             `json:"contract_of_sale"`
+	PublicKey       string             `json:"public_key"`
+	Signature       string             `json:"signature"`
+	CreatedAt       time.Time          `json:"created_at"`
+	Version         int                `json:"version,omitempty"`
+	TransactionHash string             `json:"transaction_hash,omitempty"`
+	BlockHeight     int64              `json:"block_height,omitempty"`
+}
+
+// MintAmendmentBody is the payload the mint owner signs to amend a mint.
+type MintAmendmentBody struct {
+	MintHash       string             `json:"mint_hash"`
+	Description    string             `json:"description"`
+	Metadata       StringInterfaceMap `json:"metadata,omitempty"`
+	FeedURL        string             `json:"feed_url,omitempty"`
+	ContractOfSale string             `json:"contract_of_sale,omitempty"`
+}
+
+type MintAmendmentHash struct {
+	MintAmendmentBody
+	PublicKey string    `json:"public_key"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (a *MintAmendment) Body() MintAmendmentBody {
+	return MintAmendmentBody{
+		MintHash:       a.MintHash,
+		Description:    a.Description,
+		Metadata:       a.Metadata,
+		FeedURL:        a.FeedURL,
+		ContractOfSale: a.ContractOfSale,
+	}
+}
+
+func (a *MintAmendment) GenerateHash() (string, error) {
+	input := MintAmendmentHash{
+		MintAmendmentBody: a.Body(),
+		PublicKey:         a.PublicKey,
+		CreatedAt:         a.CreatedAt,
+	}
+
+	jsonBytes, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(jsonBytes)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// Validate checks the amendment hash and that the amendment is signed by its public
+// key. Callers check that the public key belongs to the mint owner.
+func (a *MintAmendment) Validate() error {
+	if a.Description == "" {
+		return fmt.Errorf("description is required")
+	}
+
+	hash, err := a.GenerateHash()
+	if err != nil {
+		return err
+	}
+
+	if hash != a.Hash {
+		return fmt.Errorf("amendment hash does not match its contents")
+	}
+
+	if err := doge.ValidateSignature(a.Body(), a.PublicKey, a.Signature); err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	return nil
+}
+
+type MintAmendmentSignature struct {
+	Id            string    `json:"id"`
+	AmendmentHash string    `json:"amendment_hash"`
+	MintHash      string    `json:"mint_hash"`
+	Signature     string    `json:"signature"`
+	PublicKey     string    `json:"public_key"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// MintAmendmentSignatureBody is the payload an asset manager signs to approve a mint
+// amendment.
+type MintAmendmentSignatureBody struct {
+	Hash     string `json:"hash"`
+	MintHash string `json:"mint_hash"`
+}
+
+func (a *MintAmendmentSignature) Validate(mint Mint) error {
+	if a.MintHash != mint.Hash {
+		return fmt.Errorf("amendment signature is for a different mint")
+	}
+
+	if !slices.ContainsFunc(mint.AssetManagers, func(am AssetManager) bool { return am.PublicKey == a.PublicKey }) {
+		return fmt.Errorf("public key does not match any asset managers")
+	}
+
+	body := MintAmendmentSignatureBody{
+		Hash:     a.AmendmentHash,
+		MintHash: a.MintHash,
+	}
+
+	if err := doge.ValidateSignature(body, a.PublicKey, a.Signature); err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	return nil
+}
+
+// MintVersion is a superseded version of the amendable fields of a mint. Version 1 is
+// the mint as it was confirmed; every later version was made by an amendment.
+type MintVersion struct {
+	Id                    string             `json:"id"`
+	MintHash              string             `json:"mint_hash"`
+	Version               int                `json:"version"`
+	Description           string             `json:"description"`
+	Metadata              StringInterfaceMap `json:"metadata"`
+	FeedURL               string             `json:"feed_url"`
+	ContractOfSale        string             `json:"contract_of_sale"`
+	AmendmentHash         string             `json:"amendment_hash,omitempty"`
+	TransactionHash       string             `json:"transaction_hash"`
+	BlockHeight           int64              `json:"block_height"`
+	SupersededBlockHeight int64              `json:"superseded_block_height"`
+	CreatedAt             time.Time          `json:"created_at"`
+}
+
+// MintOwnershipTransfer hands a confirmed mint over to a new issuer, together with the
+// asset managers that gate operations on the mint from then on. Both the current and
+// the new owner sign the transfer, and it takes effect once the current owner writes
+// it on chain.
+type MintOwnershipTransfer struct {
+	Id                       string                   `json:"id"`
+	Hash                     string                   `json:"hash"`
+	MintHash                 string                   `json:"mint_hash"`
+	NewOwnerAddress          string                   `json:"new_owner_address"`
+	NewPublicKey             string                   `json:"new_public_key"`
+	SignatureRequirementType SignatureRequirementType `json:"signature_requirement_type"`
+	AssetManagers            AssetManagers            `json:"asset_managers"`
+	MinSignatures            int                      `json:"min_signatures"`
+	PublicKey                string                   `json:"public_key"`
+	Signature                string                   `json:"signature"`
+	NewOwnerSignature        string                   `json:"new_owner_signature"`
+	CreatedAt                time.Time                `json:"created_at"`
+	PreviousOwnerAddress     string                   `json:"previous_owner_address,omitempty"`
+	TransactionHash          string                   `json:"transaction_hash,omitempty"`
+	BlockHeight              int64                    `json:"block_height,omitempty"`
+}
+
+// MintOwnershipTransferBody is the payload both the current and the new owner sign.
+type MintOwnershipTransferBody struct {
+	MintHash                 string                   `json:"mint_hash"`
+	NewOwnerAddress          string                   `json:"new_owner_address"`
+	NewPublicKey             string                   `json:"new_public_key"`
+	SignatureRequirementType SignatureRequirementType `json:"signature_requirement_type,omitempty"`
+	AssetManagers            AssetManagers            `json:"asset_managers,omitempty"`
+	MinSignatures            int                      `json:"min_signatures,omitempty"`
+}
+
+type MintOwnershipTransferHash struct {
+	MintOwnershipTransferBody
+	PublicKey string    `json:"public_key"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (t *MintOwnershipTransfer) Body() MintOwnershipTransferBody {
+	return MintOwnershipTransferBody{
+		MintHash:                 t.MintHash,
+		NewOwnerAddress:          t.NewOwnerAddress,
+		NewPublicKey:             t.NewPublicKey,
+		SignatureRequirementType: t.SignatureRequirementType,
+		AssetManagers:            t.AssetManagers,
+		MinSignatures:            t.MinSignatures,
+	}
+}
+
+func (t *MintOwnershipTransfer) GenerateHash() (string, error) {
+	input := MintOwnershipTransferHash{
+		MintOwnershipTransferBody: t.Body(),
+		PublicKey:                 t.PublicKey,
+		CreatedAt:                 t.CreatedAt,
+	}
+
+	jsonBytes, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(jsonBytes)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// Validate checks the transfer hash and that the transfer is signed by both the
+// current and the new owner's public key. Callers check that the public keys belong
+// to the current owner of the mint and to the new owner address.
+func (t *MintOwnershipTransfer) Validate() error {
+	if t.NewOwnerAddress == "" || t.NewPublicKey == "" {
+		return fmt.Errorf("new owner is required")
+	}
+
+	hash, err := t.GenerateHash()
+	if err != nil {
+		return err
+	}
+
+	if hash != t.Hash {
+		return fmt.Errorf("transfer hash does not match its contents")
+	}
+
+	if err := doge.ValidateSignature(t.Body(), t.PublicKey, t.Signature); err != nil {
+		return fmt.Errorf("invalid owner signature: %w", err)
+	}
+
+	if err := doge.ValidateSignature(t.Body(), t.NewPublicKey, t.NewOwnerSignature); err != nil {
+		return fmt.Errorf("invalid new owner signature: %w", err)
+	}
+
+	return nil
+}
+
+// TokenTransfer moves fractions of a mint from one address to another. The sender
+// signs the transfer, and it takes effect once the sender writes it on chain.
+type TokenTransfer struct {
+	Id              string    `json:"id"`
+	Hash            string    `json:"hash"`
+	MintHash        string    `json:"mint_hash"`
+	FromAddress     string    `json:"from_address"`
+	ToAddress       string    `json:"to_address"`
+	Quantity        int       `json:"quantity"`
+	Nonce           int64     `json:"nonce"`
+	PublicKey       string    `json:"public_key"`
+	Signature       string    `json:"signature"`
+	RedeemScript    string    `json:"redeem_script,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	TransactionHash string    `json:"transaction_hash,omitempty"`
+	BlockHeight     int64     `json:"block_height,omitempty"`
+}
+
+// TokenTransferBody is the payload the sender signs. The nonce sets apart transfers
+// that are otherwise the same, so that a signed transfer can only be applied once.
+type TokenTransferBody struct {
+	FromAddress string `json:"from_address"`
+	ToAddress   string `json:"to_address"`
+	MintHash    string `json:"mint_hash"`
+	Quantity    int    `json:"quantity"`
+	Nonce       int64  `json:"nonce"`
+}
+
+type TokenTransferHash struct {
+	TokenTransferBody
+	PublicKey string `json:"public_key"`
+}
+
+func (t *TokenTransfer) Body() TokenTransferBody {
+	return TokenTransferBody{
+		FromAddress: t.FromAddress,
+		ToAddress:   t.ToAddress,
+		MintHash:    t.MintHash,
+		Quantity:    t.Quantity,
+		Nonce:       t.Nonce,
+	}
+}
+
+// GenerateHash hashes the signed body and the key that signed it, so that the same
+// signed transfer always has the same hash.
+func (t *TokenTransfer) GenerateHash() (string, error) {
+	input := TokenTransferHash{
+		TokenTransferBody: t.Body(),
+		PublicKey:         t.PublicKey,
+	}
+
+	jsonBytes, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(jsonBytes)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// Validate checks the transfer hash and that the transfer is signed by its public key.
+// Callers check that the public key may sign for the sender address.
+func (t *TokenTransfer) Validate() error {
+	if t.Quantity <= 0 {
+		return fmt.Errorf("quantity must be greater than 0")
+	}
+
+	if t.FromAddress == t.ToAddress {
+		return fmt.Errorf("transfer sender and recipient are the same: %s", t.ToAddress)
+	}
+
+	hash, err := t.GenerateHash()
+	if err != nil {
+		return err
+	}
+
+	if hash != t.Hash {
+		return fmt.Errorf("transfer hash does not match its contents")
+	}
+
+	if err := doge.ValidateSignature(t.Body(), t.PublicKey, t.Signature); err != nil {
+		return fmt.Errorf("invalid sender signature: %w", err)
+	}
+
+	return nil
+}
+
+// Distribution pays DOGE to the holders of a mint in proportion to the fractions they
+// held at the record height. It is declared by the mint owner or one of its asset
+// managers, and paid out on chain by distribution payments.
+type Distribution struct {
+	Id           string    `json:"id"`
+	Hash         string    `json:"hash"`
+	MintHash     string    `json:"mint_hash"`
+	TotalKoinu   int64     `json:"total_koinu"`
+	RecordHeight int64     `json:"record_height"`
+	PublicKey    string    `json:"public_key"`
+	Signature    string    `json:"signature"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// DistributionBody is the payload the owner or an asset manager signs to declare a
+// distribution.
+type DistributionBody struct {
+	MintHash     string `json:"mint_hash"`
+	TotalKoinu   int64  `json:"total_koinu"`
+	RecordHeight int64  `json:"record_height"`
+}
+
+type DistributionHash struct {
+	DistributionBody
+	PublicKey string    `json:"public_key"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (d *Distribution) Body() DistributionBody {
+	return DistributionBody{
+		MintHash:     d.MintHash,
+		TotalKoinu:   d.TotalKoinu,
+		RecordHeight: d.RecordHeight,
+	}
+}
+
+func (d *Distribution) GenerateHash() (string, error) {
+	input := DistributionHash{
+		DistributionBody: d.Body(),
+		PublicKey:        d.PublicKey,
+		CreatedAt:        d.CreatedAt,
+	}
+
+	jsonBytes, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(jsonBytes)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// Validate checks the distribution hash and that the distribution is signed by its
+// public key. Callers check that the public key belongs to the mint owner or one of
+// its asset managers.
+func (d *Distribution) Validate() error {
+	if d.TotalKoinu <= 0 {
+		return fmt.Errorf("total_koinu must be greater than 0")
+	}
+
+	if d.RecordHeight <= 0 {
+		return fmt.Errorf("record_height must be greater than 0")
+	}
+
+	hash, err := d.GenerateHash()
+	if err != nil {
+		return err
+	}
+
+	if hash != d.Hash {
+		return fmt.Errorf("distribution hash does not match its contents")
+	}
+
+	if err := doge.ValidateSignature(d.Body(), d.PublicKey, d.Signature); err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	return nil
+}
+
+// Entitlements divides the distribution between the holders at the record height in
+// proportion to their fractions. Any koinu lost to rounding go to the first holder,
+// which is the largest one when the holders come from GetTokenHoldersAtHeight.
+func (d *Distribution) Entitlements(holders []TokenBalance) []DistributionEntitlement {
+	supply := int64(0)
+	for _, holder := range holders {
+		supply += int64(holder.Quantity)
+	}
+
+	entitlements := make([]DistributionEntitlement, 0, len(holders))
+	if supply == 0 {
+		return entitlements
+	}
+
+	remaining := d.TotalKoinu
+	for _, holder := range holders {
+		amount := mulDiv(d.TotalKoinu, int64(holder.Quantity), supply)
+		entitlements = append(entitlements, DistributionEntitlement{
+			Address:          holder.Address,
+			Quantity:         holder.Quantity,
+			EntitlementKoinu: amount,
+		})
+		remaining -= amount
+	}
+	entitlements[0].EntitlementKoinu += remaining
+
+	return entitlements
+}
+
+// DistributionEntitlement is what a holder is owed by a distribution and how much of
+// it has been paid out on chain.
+type DistributionEntitlement struct {
+	Address          string `json:"address"`
+	Quantity         int    `json:"quantity"`
+	EntitlementKoinu int64  `json:"entitlement_koinu"`
+	ClaimedKoinu     int64  `json:"claimed_koinu"`
+}
+
+// DistributionPayment is an output of a payout transaction to one holder. AppliedKoinu
+// counts towards the holder's entitlement and OverpaidKoinu is anything paid above it.
+type DistributionPayment struct {
+	Id               string    `json:"id"`
+	DistributionHash string    `json:"distribution_hash"`
+	TransactionHash  string    `json:"transaction_hash"`
+	Address          string    `json:"address"`
+	AmountKoinu      int64     `json:"amount_koinu"`
+	AppliedKoinu     int64     `json:"applied_koinu"`
+	OverpaidKoinu    int64     `json:"overpaid_koinu"`
+	BlockHeight      int64     `json:"block_height"`
+	BlockHash        string    `json:"block_hash"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+type Attestation struct {
+	Id        string    `json:"id"`
+	MintHash  string    `json:"mint_hash"`
+	Address   string    `json:"address"`
+	Signature string    `json:"signature"`
+	PublicKey string    `json:"public_key"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AttestationBody is the payload an asset manager signs to attest that an address
+// is eligible to hold fractions of a mint.
+type AttestationBody struct {
+	MintHash string `json:"mint_hash"`
+	Address  string `json:"address"`
+}
+
+func (a *Attestation) Validate(mint Mint) error {
+	if a.MintHash != mint.Hash {
+		return fmt.Errorf("attestation is for a different mint")
+	}
+
+	var assetManager AssetManager
+
+	for _, am := range mint.AssetManagers {
+		if am.PublicKey == a.PublicKey {
+			assetManager = am
+			break
+		}
+	}
+
+	if assetManager.PublicKey == "" {
+		return fmt.Errorf("public key does not match any asset managers")
+	}
+
+	attestationBody := AttestationBody{
+		MintHash: a.MintHash,
+		Address:  a.Address,
+	}
+
+	err := doge.ValidateSignature(attestationBody, a.PublicKey, a.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	return nil
+}
+
+// OfferFill records a buy offer and a sell offer that crossed in the order book and
+// the unconfirmed invoice that was produced for the filled quantity. The invoice is
+// hashed like any other, so the seller can sign and gossip it unchanged.
+type OfferFill struct {
+	Id            string    `json:"id"`
+	MintHash      string    `json:"mint_hash"`
+	BuyOfferHash  string    `json:"buy_offer_hash"`
+	SellOfferHash string    `json:"sell_offer_hash"`
+	InvoiceHash   string    `json:"invoice_hash"`
+	Quantity      int       `json:"quantity"`
+	Price         int       `json:"price"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type OrderBookLevel struct {
+	Price    int `json:"price"`
+	Quantity int `json:"quantity"`
+	Orders   int `json:"orders"`
+}
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryDead      = "dead"
+)
+
+type Webhook struct {
+	Id         string    `json:"id"`
+	Url        string    `json:"url"`
+	Secret     string    `json:"-"`
+	EventTypes []string  `json:"event_types"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type WebhookDelivery struct {
+	Id            string     `json:"id"`
+	WebhookId     string     `json:"webhook_id"`
+	EventType     string     `json:"event_type"`
+	Payload       string     `json:"payload"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	LastError     string     `json:"last_error"`
+	CreatedAt     time.Time  `json:"created_at"`
+	DeliveredAt   *time.Time `json:"delivered_at"`
+	Url           string     `json:"url"`
+	Secret        string     `json:"-"`
+}
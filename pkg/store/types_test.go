@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"dogecoin.org/fractal-engine/internal/test/support"
+	"dogecoin.org/fractal-engine/pkg/doge"
 	"dogecoin.org/fractal-engine/pkg/store"
 	"gotest.tools/assert"
 )
@@ -27,7 +28,7 @@ func TestInvoiceGenerateHash(t *testing.T) {
 	inputHash := store.UnconfirmedInvoiceHash{
 		MintHash:      invoice.MintHash,
 		Quantity:      invoice.Quantity,
-		Price:         invoice.Price,
+		PriceKoinu:    invoice.Price,
 		BuyerAddress:  invoice.BuyerAddress,
 		SellerAddress: invoice.SellerAddress,
 		PublicKey:     invoice.PublicKey,
@@ -43,3 +44,114 @@ func TestInvoiceGenerateHash(t *testing.T) {
 	assert.NilError(t, err)
 	assert.Equal(t, expectedHash, hash)
 }
+
+func TestInvoiceGenerateHashKeepsDogePriceOfLegacyInvoices(t *testing.T) {
+	invoice := store.UnconfirmedInvoice{
+		MintHash:      "buyOfferMintHash",
+		Quantity:      100,
+		Price:         20 * doge.KoinuPerDoge,
+		DogePrice:     20,
+		BuyerAddress:  "buyer",
+		SellerAddress: "seller",
+		PublicKey:     "publicKey",
+	}
+
+	// The hash input of an invoice created when prices were whole DOGE
+	legacyInput := `{"mint_hash":"buyOfferMintHash","quantity":100,"price":20,"buyer_address":"buyer","payment_address":"","seller_address":"seller","public_key":"publicKey","signature":""}`
+	expectedHashBytes := sha256.Sum256([]byte(legacyInput))
+
+	legacyHash, err := invoice.GenerateHash()
+	assert.NilError(t, err)
+	assert.Equal(t, hex.EncodeToString(expectedHashBytes[:]), legacyHash)
+
+	confirmed := store.Invoice{MintHash: invoice.MintHash, Quantity: invoice.Quantity, Price: invoice.Price, DogePrice: invoice.DogePrice}
+	legacyInput = `{"mint_hash":"buyOfferMintHash","quantity":100,"price":20,"payment_address":"","seller_address":"","public_key":"","signature":""}`
+	expectedHashBytes = sha256.Sum256([]byte(legacyInput))
+
+	hash, err := confirmed.GenerateHash()
+	assert.NilError(t, err)
+	assert.Equal(t, hex.EncodeToString(expectedHashBytes[:]), hash)
+
+	// The same price in koinu is a different invoice
+	invoice.DogePrice = 0
+	koinuHash, err := invoice.GenerateHash()
+	assert.NilError(t, err)
+	assert.Assert(t, koinuHash != legacyHash)
+}
+
+func TestInvoiceGenerateHashIncludesSplits(t *testing.T) {
+	invoice := store.UnconfirmedInvoice{
+		MintHash:      "buyOfferMintHash",
+		Quantity:      100,
+		Price:         20,
+		BuyerAddress:  "buyer",
+		SellerAddress: "seller",
+		PublicKey:     "publicKey",
+	}
+
+	hash, err := invoice.GenerateHash()
+	assert.NilError(t, err)
+
+	invoice.Splits = store.InvoiceSplits{
+		{Address: "seller", BasisPoints: 9000},
+		{Address: "royalty", BasisPoints: 1000},
+	}
+
+	splitHash, err := invoice.GenerateHash()
+	assert.NilError(t, err)
+	assert.Assert(t, hash != splitHash)
+}
+
+func TestInvoiceSplitsValidate(t *testing.T) {
+	valid := store.InvoiceSplits{
+		{Address: "seller", BasisPoints: 9500},
+		{Address: "royalty", BasisPoints: 300},
+		{Address: "platform", BasisPoints: 200},
+	}
+	assert.NilError(t, valid.Validate())
+
+	cases := map[string]store.InvoiceSplits{
+		"missing address": {{Address: "", BasisPoints: 10000}},
+		"duplicate":       {{Address: "seller", BasisPoints: 5000}, {Address: "seller", BasisPoints: 5000}},
+		"zero share":      {{Address: "seller", BasisPoints: 10000}, {Address: "royalty", BasisPoints: 0}},
+		"under total":     {{Address: "seller", BasisPoints: 9000}},
+		"over total":      {{Address: "seller", BasisPoints: 9000}, {Address: "royalty", BasisPoints: 1001}},
+	}
+
+	for name, splits := range cases {
+		assert.Assert(t, splits.Validate() != nil, name)
+	}
+}
+
+func TestInvoiceSplitAmountsKoinu(t *testing.T) {
+	invoice := store.Invoice{
+		PaymentAddress: "seller",
+		Quantity:       1,
+		Price:          doge.KoinuPerDoge,
+		Splits: store.InvoiceSplits{
+			{Address: "seller", BasisPoints: 3333},
+			{Address: "royalty", BasisPoints: 3333},
+			{Address: "platform", BasisPoints: 3334},
+		},
+	}
+
+	// The shares always add up to the amount due
+	amounts := invoice.SplitAmountsKoinu()
+	assert.Equal(t, int64(33_330_000), amounts["royalty"])
+	assert.Equal(t, int64(33_340_000), amounts["platform"])
+	assert.Equal(t, invoice.AmountDueKoinu(), amounts["seller"]+amounts["royalty"]+amounts["platform"])
+
+	credited, received, err := invoice.PaymentKoinu(store.StringInterfaceMap{
+		"seller":   amounts["seller"],
+		"royalty":  amounts["royalty"],
+		"platform": amounts["platform"],
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, invoice.AmountDueKoinu(), credited)
+	assert.Equal(t, invoice.AmountDueKoinu(), received)
+
+	// Without splits the whole amount goes to the payment address
+	invoice.Splits = nil
+	amounts = invoice.SplitAmountsKoinu()
+	assert.DeepEqual(t, map[string]int64{"seller": invoice.AmountDueKoinu()}, amounts)
+}
@@ -0,0 +1,222 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"dogecoin.org/fractal-engine/pkg/events"
+	"github.com/google/uuid"
+)
+
+// WebhookPayload is the JSON body posted to a webhook. The id is the delivery id, so
+// receivers can use it to drop duplicates when a delivery is retried or replayed.
+type WebhookPayload struct {
+	Id        string       `json:"id"`
+	Type      string       `json:"type"`
+	CreatedAt time.Time    `json:"created_at"`
+	Data      events.Event `json:"data"`
+}
+
+func (s *TokenisationStore) SaveWebhook(ctx context.Context, webhook *Webhook) (string, error) {
+	id := uuid.New().String()
+
+	_, err := s.conn().ExecContext(ctx, `
+	INSERT INTO webhooks (id, url, secret, event_types, created_at)
+	VALUES ($1, $2, $3, $4, $5)
+	`, id, webhook.Url, webhook.Secret, strings.Join(webhook.EventTypes, ","), webhook.CreatedAt)
+	return id, err
+}
+
+func (s *TokenisationStore) GetWebhooks(ctx context.Context) ([]Webhook, error) {
+	rows, err := s.conn().QueryContext(ctx, "SELECT id, url, secret, event_types, created_at FROM webhooks ORDER BY created_at ASC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	webhooks := []Webhook{}
+	for rows.Next() {
+		var webhook Webhook
+		var eventTypes string
+		if err := rows.Scan(&webhook.Id, &webhook.Url, &webhook.Secret, &eventTypes, &webhook.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		if eventTypes != "" {
+			webhook.EventTypes = strings.Split(eventTypes, ",")
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, rows.Err()
+}
+
+// DeleteWebhook removes a registration together with its outstanding deliveries.
+func (s *TokenisationStore) DeleteWebhook(ctx context.Context, id string) error {
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM webhooks WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return fmt.Errorf("no webhook found for id: %s", id)
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM webhook_deliveries WHERE webhook_id = $1", id)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// EnqueueWebhookEvent writes a pending delivery to the outbox for every webhook
+// registered for the event's type. A webhook without event types receives every event.
+func (s *TokenisationStore) EnqueueWebhookEvent(ctx context.Context, event events.Event) error {
+	webhooks, err := s.GetWebhooks(ctx)
+	if err != nil {
+		return err
+	}
+
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	now := time.Now().UTC()
+
+	for _, webhook := range webhooks {
+		if len(webhook.EventTypes) > 0 && !slices.Contains(webhook.EventTypes, string(event.Type)) {
+			continue
+		}
+
+		id := uuid.New().String()
+
+		payload, err := json.Marshal(WebhookPayload{
+			Id:        id,
+			Type:      string(event.Type),
+			CreatedAt: event.CreatedAt,
+			Data:      event,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = s.conn().ExecContext(ctx, `
+		INSERT INTO webhook_deliveries (id, webhook_id, event_type, payload, status, attempts, next_attempt_at, last_error, created_at)
+		VALUES ($1, $2, $3, $4, $5, 0, $6, '', $7)
+		`, id, webhook.Id, string(event.Type), string(payload), WebhookDeliveryPending, now, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetDueWebhookDeliveries returns pending deliveries whose next attempt is due,
+// oldest first, with the target url and signing secret of their webhook.
+func (s *TokenisationStore) GetDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]WebhookDelivery, error) {
+	return s.queryWebhookDeliveries(ctx, `
+	SELECT d.id, d.webhook_id, d.event_type, d.payload, d.status, d.attempts, d.next_attempt_at, d.last_error, d.created_at, d.delivered_at, w.url, w.secret
+	FROM webhook_deliveries d JOIN webhooks w ON w.id = d.webhook_id
+	WHERE d.status = $1 AND d.next_attempt_at <= $2
+	ORDER BY d.next_attempt_at ASC LIMIT $3
+	`, WebhookDeliveryPending, now.UTC(), limit)
+}
+
+// GetWebhookDeliveries lists deliveries, newest first, optionally filtered by status.
+// Listing the dead status gives the dead-letter list.
+func (s *TokenisationStore) GetWebhookDeliveries(ctx context.Context, status string, offset int, limit int) ([]WebhookDelivery, error) {
+	if status == "" {
+		return s.queryWebhookDeliveries(ctx, `
+		SELECT d.id, d.webhook_id, d.event_type, d.payload, d.status, d.attempts, d.next_attempt_at, d.last_error, d.created_at, d.delivered_at, w.url, w.secret
+		FROM webhook_deliveries d JOIN webhooks w ON w.id = d.webhook_id
+		ORDER BY d.created_at DESC LIMIT $1 OFFSET $2
+		`, limit, offset)
+	}
+
+	return s.queryWebhookDeliveries(ctx, `
+	SELECT d.id, d.webhook_id, d.event_type, d.payload, d.status, d.attempts, d.next_attempt_at, d.last_error, d.created_at, d.delivered_at, w.url, w.secret
+	FROM webhook_deliveries d JOIN webhooks w ON w.id = d.webhook_id
+	WHERE d.status = $1
+	ORDER BY d.created_at DESC LIMIT $2 OFFSET $3
+	`, status, limit, offset)
+}
+
+func (s *TokenisationStore) queryWebhookDeliveries(ctx context.Context, query string, args ...interface{}) ([]WebhookDelivery, error) {
+	rows, err := s.conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := []WebhookDelivery{}
+	for rows.Next() {
+		var delivery WebhookDelivery
+		var deliveredAt sql.NullTime
+		if err := rows.Scan(&delivery.Id, &delivery.WebhookId, &delivery.EventType, &delivery.Payload, &delivery.Status, &delivery.Attempts, &delivery.NextAttemptAt, &delivery.LastError, &delivery.CreatedAt, &deliveredAt, &delivery.Url, &delivery.Secret); err != nil {
+			return nil, err
+		}
+
+		if deliveredAt.Valid {
+			delivery.DeliveredAt = &deliveredAt.Time
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+func (s *TokenisationStore) MarkWebhookDelivered(ctx context.Context, id string, attempts int, deliveredAt time.Time) error {
+	_, err := s.conn().ExecContext(ctx, "UPDATE webhook_deliveries SET status = $1, attempts = $2, last_error = '', delivered_at = $3 WHERE id = $4", WebhookDeliveryDelivered, attempts, deliveredAt.UTC(), id)
+	return err
+}
+
+// MarkWebhookDeliveryFailed records a failed attempt. The delivery is retried at
+// nextAttemptAt, or moved to the dead-letter list when dead is set.
+func (s *TokenisationStore) MarkWebhookDeliveryFailed(ctx context.Context, id string, attempts int, nextAttemptAt time.Time, lastError string, dead bool) error {
+	status := WebhookDeliveryPending
+	if dead {
+		status = WebhookDeliveryDead
+	}
+
+	_, err := s.conn().ExecContext(ctx, "UPDATE webhook_deliveries SET status = $1, attempts = $2, next_attempt_at = $3, last_error = $4 WHERE id = $5", status, attempts, nextAttemptAt.UTC(), lastError, id)
+	return err
+}
+
+// ReplayWebhookDelivery moves a dead delivery back onto the outbox for immediate
+// delivery with a fresh retry budget.
+func (s *TokenisationStore) ReplayWebhookDelivery(ctx context.Context, id string) error {
+	result, err := s.conn().ExecContext(ctx, "UPDATE webhook_deliveries SET status = $1, attempts = 0, next_attempt_at = $2 WHERE id = $3 AND status = $4", WebhookDeliveryPending, time.Now().UTC(), id, WebhookDeliveryDead)
+	if err != nil {
+		return err
+	}
+
+	replayed, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if replayed == 0 {
+		return fmt.Errorf("no dead webhook delivery found for id: %s", id)
+	}
+
+	return nil
+}
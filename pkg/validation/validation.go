@@ -3,11 +3,14 @@ package validation
 import (
 	"encoding/hex"
 	"fmt"
+	"math"
 	"regexp"
 	"strings"
 	"unicode/utf8"
 
 	"dogecoin.org/fractal-engine/pkg/doge"
+	"github.com/btcsuite/btcutil"
+	dogecore "github.com/dogeorg/doge"
 )
 
 const (
@@ -24,6 +27,9 @@ const (
 	MaxPrice         = 1000000000 // 1 billion (in smallest unit)
 	MaxFractionCount = 1000000000 // 1 billion
 
+	// Invoice prices are koinu per fraction
+	MaxInvoicePrice = 1000000 * doge.KoinuPerDoge // 1 million DOGE
+
 	// Hash and address formats
 	HashLength       = 64 // SHA256 hex length
 	MinAddressLength = 26
@@ -32,8 +38,8 @@ const (
 
 var (
 	// Dogecoin address regex patterns
-	// Mainnet: P2PKH (D) or P2SH (A)
-	mainnetRegex = regexp.MustCompile(`^(D|A)[1-9A-HJ-NP-Za-km-z]{25,34}$`)
+	// Mainnet: P2PKH (D) or P2SH (9 or A)
+	mainnetRegex = regexp.MustCompile(`^(D|A|9)[1-9A-HJ-NP-Za-km-z]{25,34}$`)
 
 	// Testnet/Regtest: P2PKH (m or n) or P2SH (2)
 	testnetRegex = regexp.MustCompile(`^([mn2])[1-9A-HJ-NP-Za-km-z]{25,34}$`)
@@ -144,13 +150,92 @@ func ValidateAddressPublicKeyMatch(address, pubKey string) error {
 			return fmt.Errorf("address does not match public key")
 		}
 		return nil
-	case 'A', '2':
+	case 'A', '9', '2':
 		return fmt.Errorf("address must be P2PKH to match public key")
 	default:
 		return fmt.Errorf("unsupported address prefix")
 	}
 }
 
+// ValidateOwnerPublicKey checks that a public key may sign for an address. P2PKH
+// addresses must match the key. P2SH addresses need the redeem script, which must
+// hash to the address and be a standard 1-of-n multisig script containing the key.
+func ValidateOwnerPublicKey(address, pubKey, redeemScript string) error {
+	if redeemScript == "" {
+		return ValidateAddressPublicKeyMatch(address, pubKey)
+	}
+
+	return ValidateRedeemScriptPublicKey(address, pubKey, redeemScript)
+}
+
+// ValidateRedeemScriptPublicKey checks that a P2SH address is the hash of a
+// multisig redeem script and that the public key is one of its members. Requests
+// carry a single signature, so only scripts that any one member can spend from are
+// accepted; an m-of-n script with m > 1 is rejected rather than letting one member
+// sign for the others.
+func ValidateRedeemScriptPublicKey(address, pubKey, redeemScript string) error {
+	if err := ValidateAddressChecksum(address); err != nil {
+		return err
+	}
+
+	if err := ValidatePublicKey(pubKey); err != nil {
+		return err
+	}
+
+	script, err := hex.DecodeString(redeemScript)
+	if err != nil {
+		return fmt.Errorf("redeem script is not valid hexadecimal: %w", err)
+	}
+
+	decoded, err := dogecore.Base58DecodeCheck(address)
+	if err != nil {
+		return fmt.Errorf("invalid Dogecoin address checksum")
+	}
+
+	if decoded[0] != doge.ScriptPrefixMainnet && decoded[0] != doge.ScriptPrefixTestnet {
+		return fmt.Errorf("address must be P2SH to use a redeem script")
+	}
+
+	if hex.EncodeToString(decoded[1:]) != hex.EncodeToString(btcutil.Hash160(script)) {
+		return fmt.Errorf("redeem script does not match address")
+	}
+
+	required, pubKeys, err := doge.ParseMultisigScript(script)
+	if err != nil {
+		return fmt.Errorf("invalid redeem script: %w", err)
+	}
+
+	if required != 1 {
+		return fmt.Errorf("redeem script requires %d signatures, only 1-of-n scripts are supported", required)
+	}
+
+	for _, member := range pubKeys {
+		if strings.EqualFold(hex.EncodeToString(member), pubKey) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("public key is not a member of the redeem script")
+}
+
+// ValidateAddressChecksum validates the base58check checksum of a Dogecoin address
+func ValidateAddressChecksum(address string) error {
+	if err := ValidateAddress(address); err != nil {
+		return err
+	}
+
+	decoded, err := dogecore.Base58DecodeCheck(address)
+	if err != nil {
+		return fmt.Errorf("invalid Dogecoin address checksum")
+	}
+
+	if len(decoded) != 21 {
+		return fmt.Errorf("invalid Dogecoin address length")
+	}
+
+	return nil
+}
+
 // ValidateStringLength validates string length with custom limits
 func ValidateStringLength(field, value string, maxLength int) error {
 	if !utf8.ValidString(value) {
@@ -239,6 +324,24 @@ func ValidatePrice(field string, price int) error {
 	return nil
 }
 
+// ValidateInvoicePrice validates an invoice price in koinu per fraction, including
+// that the total due for the quantity can be represented
+func ValidateInvoicePrice(field string, price int64, quantity int) error {
+	if price <= 0 {
+		return fmt.Errorf("%s must be greater than 0", field)
+	}
+
+	if price > MaxInvoicePrice {
+		return fmt.Errorf("%s exceeds maximum value of %d", field, MaxInvoicePrice)
+	}
+
+	if quantity > 0 && price > math.MaxInt64/int64(quantity) {
+		return fmt.Errorf("%s multiplied by the quantity exceeds the maximum amount", field)
+	}
+
+	return nil
+}
+
 // ValidateTags validates tag array
 func ValidateTags(tags []string) error {
 	if len(tags) > MaxTagCount {
@@ -307,7 +410,7 @@ func ValidateProtobufActionType(actionType uint32) error {
 
 // ValidateProtobufQuantity validates quantity from protobuf messages
 func ValidateProtobufQuantity(quantity int32) error {
-	if quantity == 0 {
+	if quantity <= 0 {
 		return fmt.Errorf("quantity must be greater than 0")
 	}
 
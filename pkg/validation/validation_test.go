@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"encoding/hex"
 	"strings"
 	"testing"
 
@@ -114,6 +115,66 @@ func TestValidateAddressPublicKeyMatch(t *testing.T) {
 	}
 }
 
+func TestValidateOwnerPublicKey(t *testing.T) {
+	keys := make([]string, 3)
+	for i := range keys {
+		_, pubKey, _, err := doge.GenerateDogecoinKeypair(doge.PrefixMainnet)
+		if err != nil {
+			t.Fatalf("GenerateDogecoinKeypair: %v", err)
+		}
+		keys[i] = pubKey
+	}
+
+	_, p2pkhPub, p2pkhAddress, err := doge.GenerateDogecoinKeypair(doge.PrefixMainnet)
+	if err != nil {
+		t.Fatalf("GenerateDogecoinKeypair: %v", err)
+	}
+
+	// 1-of-2 multisig over the first two keys
+	script := []byte{0x51}
+	for _, pubKey := range keys[:2] {
+		pubKeyBytes, _ := hex.DecodeString(pubKey)
+		script = append(script, byte(len(pubKeyBytes)))
+		script = append(script, pubKeyBytes...)
+	}
+	script = append(script, 0x52, 0xae)
+
+	redeemScript := hex.EncodeToString(script)
+	p2shAddress := doge.ScriptToP2SHAddress(script, doge.ScriptPrefixMainnet)
+
+	// The same keys as a 2-of-2, which a single member cannot sign for
+	thresholdScript := append([]byte{0x52}, script[1:]...)
+	thresholdRedeemScript := hex.EncodeToString(thresholdScript)
+	otherP2shAddress := doge.ScriptToP2SHAddress(thresholdScript, doge.ScriptPrefixMainnet)
+
+	tests := []struct {
+		name         string
+		addr         string
+		pubKey       string
+		redeemScript string
+		wantErr      bool
+	}{
+		{"P2PKH without redeem script", p2pkhAddress, p2pkhPub, "", false},
+		{"P2SH without redeem script", p2shAddress, keys[0], "", true},
+		{"P2SH member key", p2shAddress, keys[0], redeemScript, false},
+		{"P2SH second member key", p2shAddress, keys[1], redeemScript, false},
+		{"P2SH non member key", p2shAddress, keys[2], redeemScript, true},
+		{"Redeem script for another address", otherP2shAddress, keys[0], redeemScript, true},
+		{"P2SH member key of 2-of-2 script", otherP2shAddress, keys[0], thresholdRedeemScript, true},
+		{"Redeem script with P2PKH address", p2pkhAddress, p2pkhPub, redeemScript, true},
+		{"Invalid redeem script hex", p2shAddress, keys[0], "zz", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateOwnerPublicKey(tt.addr, tt.pubKey, tt.redeemScript)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateOwnerPublicKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestValidateTitle(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -162,6 +223,32 @@ func TestValidateQuantity(t *testing.T) {
 	}
 }
 
+func TestValidateInvoicePrice(t *testing.T) {
+	tests := []struct {
+		name     string
+		price    int64
+		quantity int
+		wantErr  bool
+	}{
+		{"One koinu", 1, 10, false},
+		{"Whole DOGE", 25 * doge.KoinuPerDoge, 10, false},
+		{"Zero price", 0, 10, true},
+		{"Negative price", -1, 10, true},
+		{"Max price", MaxInvoicePrice, 1, false},
+		{"Over max price", MaxInvoicePrice + 1, 1, true},
+		{"Amount due overflows", MaxInvoicePrice, MaxQuantity, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateInvoicePrice("price", tt.price, tt.quantity)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateInvoicePrice() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestValidateTags(t *testing.T) {
 	tests := []struct {
 		name    string
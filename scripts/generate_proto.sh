@@ -7,4 +7,6 @@ protoc --proto_path=. --go_out=. ./pkg/protocol/invoices.proto
 protoc --proto_path=. --go_out=. ./pkg/protocol/mint.proto
 protoc --proto_path=. --go_out=. ./pkg/protocol/payment.proto
 protoc --proto_path=. --go_out=. ./pkg/protocol/sell_offers.proto
-
+protoc --proto_path=. --go_out=. ./pkg/protocol/transfer.proto
+protoc --proto_path=. --go_out=. ./pkg/protocol/burn.proto
+protoc --proto_path=. --go_out=. ./pkg/protocol/attestation.proto